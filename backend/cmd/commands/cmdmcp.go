@@ -11,6 +11,7 @@ import (
 	"github.com/ya-breeze/geekbudgetbe/pkg/config"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database"
 	"github.com/ya-breeze/geekbudgetbe/pkg/mcpserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 )
 
 func CmdMCP(log *slog.Logger) *cobra.Command {
@@ -52,7 +53,8 @@ access to accounts, transactions, budgets, and other financial data.`,
 			logger.Info("Starting MCP server", "username", username, "familyID", familyID)
 
 			// Run MCP server
-			return mcpserver.Run(cmd.Context(), logger, storage, familyID)
+			rates := common.NewRateService(logger, storage, cfg.RateCacheSize)
+			return mcpserver.Run(cmd.Context(), logger, storage, rates, familyID)
 		},
 		Args: cobra.NoArgs,
 	}
@@ -0,0 +1,125 @@
+//nolint:forbidigo // it's okay to use fmt in this file
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+)
+
+func CmdRollups(log *slog.Logger) *cobra.Command {
+	res := &cobra.Command{
+		Use:   "rollups",
+		Short: "Maintain monthly rollups used by reports",
+		Run: func(_ *cobra.Command, _ []string) {
+		},
+	}
+
+	res.AddCommand(NewRollupsRebuild(log))
+	res.AddCommand(NewRollupsCheck(log))
+
+	return res
+}
+
+func NewRollupsRebuild(_ *slog.Logger) *cobra.Command {
+	var username string
+
+	res := &cobra.Command{
+		Use:   "rebuild",
+		Short: "Recalculate monthly rollups from transactions",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			storage, familyIDs, err := openRollupsStorage(cmd, username)
+			if err != nil {
+				return err
+			}
+			defer storage.Close()
+
+			for _, familyID := range familyIDs {
+				if err := storage.RebuildMonthlyRollups(familyID); err != nil {
+					return fmt.Errorf("failed to rebuild monthly rollups of family %s: %w", familyID, err)
+				}
+				fmt.Println("Rebuilt monthly rollups of family", familyID)
+			}
+			return nil
+		},
+		Args: cobra.NoArgs,
+	}
+
+	res.Flags().StringVarP(&username, "username", "u", "", "username, all families if empty")
+
+	return res
+}
+
+func NewRollupsCheck(_ *slog.Logger) *cobra.Command {
+	var username string
+
+	res := &cobra.Command{
+		Use:   "check",
+		Short: "Compare monthly rollups with transactions",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			storage, familyIDs, err := openRollupsStorage(cmd, username)
+			if err != nil {
+				return err
+			}
+			defer storage.Close()
+
+			total := 0
+			for _, familyID := range familyIDs {
+				mismatches, err := storage.CheckMonthlyRollups(familyID)
+				if err != nil {
+					return fmt.Errorf("failed to check monthly rollups of family %s: %w", familyID, err)
+				}
+				for _, m := range mismatches {
+					fmt.Printf("family %s, month %s, account %q, currency %q: stored %s (%d), expected %s (%d)\n",
+						familyID, m.Month.Format("2006-01"), m.AccountID, m.CurrencyID,
+						m.StoredAmount, m.StoredCount, m.ExpectedAmount, m.ExpectedCount)
+				}
+				total += len(mismatches)
+			}
+			if total > 0 {
+				return errors.New("monthly rollups don't match transactions, run 'rollups rebuild' to fix them")
+			}
+
+			fmt.Println("Monthly rollups match transactions")
+			return nil
+		},
+		Args: cobra.NoArgs,
+	}
+
+	res.Flags().StringVarP(&username, "username", "u", "", "username, all families if empty")
+
+	return res
+}
+
+// openRollupsStorage opens storage and returns the family of the user or all families.
+func openRollupsStorage(cmd *cobra.Command, username string) (database.Storage, []uuid.UUID, error) {
+	cfg, logger, err := createConfigAndLogger(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := database.NewStorage(logger, cfg)
+	if err = storage.Open(); err != nil {
+		return nil, nil, fmt.Errorf("failed to open storage: %w", err)
+	}
+
+	if username == "" {
+		familyIDs, err := storage.GetAllFamilyIDs()
+		if err != nil {
+			storage.Close()
+			return nil, nil, fmt.Errorf("failed to get families: %w", err)
+		}
+		return storage, familyIDs, nil
+	}
+
+	user, err := storage.GetUserByUsername(username)
+	if err != nil {
+		storage.Close()
+		return nil, nil, fmt.Errorf("failed to get user by username %q: %w", username, err)
+	}
+	return storage, []uuid.UUID{user.FamilyID}, nil
+}
@@ -44,6 +44,7 @@ func newRootCmd(cfgFile *string, logger *slog.Logger) *cobra.Command {
 		commands.CmdMatch(logger),
 		commands.CmdMCP(logger),
 		commands.CmdMCPConfig(logger),
+		commands.CmdRollups(logger),
 	)
 
 	return rootCmd
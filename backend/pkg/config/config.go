@@ -17,6 +17,7 @@ type Config struct {
 	DBPath                        string `mapstructure:"dbpath" default:":memory:"`
 	DisableImporters              bool   `mapstructure:"disableimporters" default:"false"`
 	DisableCurrenciesRatesFetch   bool   `mapstructure:"disablecurrenciesratesfetch" default:"false"`
+	RateCacheSize                 int    `mapstructure:"ratecachesize" default:"1024"`
 	CookieSecure                  bool   `mapstructure:"cookiesecure" default:"true"`
 	MatcherConfirmationHistoryMax int    `mapstructure:"matcherconfirmationhistorymax" default:"10"`
 	BankImporterFilesPath         string `mapstructure:"bankimporterfilespath" default:"bank-importer-files"`
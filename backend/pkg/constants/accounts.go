@@ -4,4 +4,6 @@ const (
 	AccountExpense = "expense"
 	AccountIncome  = "income"
 	AccountAsset   = "asset"
+	// AccountLiability is a loan or another debt, its balance is minus the owed amount
+	AccountLiability = "liability"
 )
@@ -25,13 +25,22 @@ func autoMigrateModels(db *gorm.DB) error {
 		&models.Notification{},
 		&models.Image{},
 		&models.CNBCurrencyRate{},
+		&models.ProviderCurrencyRate{},
+		&models.ExchangeRate{},
 		&models.BudgetItem{},
+		&models.BudgetPlan{},
+		&models.BudgetTransfer{},
+		&models.Goal{},
 		&models.BankImporterFile{},
 		&models.Reconciliation{},
 		&models.TransactionDuplicate{},
 
 		&models.MergedTransaction{},
 		&models.TransactionTemplate{},
+		&models.TransferRule{},
+		&models.MonthlyRollup{},
+		&models.Security{},
+		&models.SecurityPrice{},
 
 		&authdb.RefreshToken{},
 		&authdb.BlacklistedToken{},
@@ -39,7 +48,36 @@ func autoMigrateModels(db *gorm.DB) error {
 		return err
 	}
 
-	return migrateExistingMergedTransactions(db)
+	if err := migrateExistingMergedTransactions(db); err != nil {
+		return err
+	}
+
+	return migrateMonthlyRollups(db)
+}
+
+// migrateMonthlyRollups builds rollups for databases created before they existed.
+func migrateMonthlyRollups(db *gorm.DB) error {
+	var count int64
+	if err := db.Model(&models.MonthlyRollup{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	var familyIDs []uuid.UUID
+	if err := db.Model(&models.Transaction{}).Distinct("family_id").Pluck("family_id", &familyIDs).Error; err != nil {
+		return err
+	}
+	for _, familyID := range familyIDs {
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			return rebuildMonthlyRollupsWithTx(tx, familyID)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func migrateExistingMergedTransactions(db *gorm.DB) error {
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: pkg/database/storage.go
+// Source: github.com/ya-breeze/geekbudgetbe/pkg/database (interfaces: Storage)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -18,2635 +18,1670 @@ import (
 	gorm "gorm.io/gorm"
 )
 
-// MockUserStorage is a mock of UserStorage interface.
-type MockUserStorage struct {
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
 	ctrl     *gomock.Controller
-	recorder *MockUserStorageMockRecorder
+	recorder *MockStorageMockRecorder
 }
 
-// MockUserStorageMockRecorder is the mock recorder for MockUserStorage.
-type MockUserStorageMockRecorder struct {
-	mock *MockUserStorage
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
 }
 
-// NewMockUserStorage creates a new mock instance.
-func NewMockUserStorage(ctrl *gomock.Controller) *MockUserStorage {
-	mock := &MockUserStorage{ctrl: ctrl}
-	mock.recorder = &MockUserStorageMockRecorder{mock}
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockUserStorage) EXPECT() *MockUserStorageMockRecorder {
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
 	return m.recorder
 }
 
-// CreateFamily mocks base method.
-func (m *MockUserStorage) CreateFamily(name string) (*models.Family, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateFamily", name)
-	ret0, _ := ret[0].(*models.Family)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CreateFamily indicates an expected call of CreateFamily.
-func (mr *MockUserStorageMockRecorder) CreateFamily(name interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFamily", reflect.TypeOf((*MockUserStorage)(nil).CreateFamily), name)
-}
-
-// CreateUser mocks base method.
-func (m *MockUserStorage) CreateUser(username, passwordHash string, familyID uuid.UUID) (*models.User, error) {
+// AddDuplicateRelationship mocks base method.
+func (m *MockStorage) AddDuplicateRelationship(arg0 uuid.UUID, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", username, passwordHash, familyID)
-	ret0, _ := ret[0].(*models.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "AddDuplicateRelationship", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockUserStorageMockRecorder) CreateUser(username, passwordHash, familyID interface{}) *gomock.Call {
+// AddDuplicateRelationship indicates an expected call of AddDuplicateRelationship.
+func (mr *MockStorageMockRecorder) AddDuplicateRelationship(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserStorage)(nil).CreateUser), username, passwordHash, familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDuplicateRelationship", reflect.TypeOf((*MockStorage)(nil).AddDuplicateRelationship), arg0, arg1, arg2)
 }
 
-// GetAllFamilyIDs mocks base method.
-func (m *MockUserStorage) GetAllFamilyIDs() ([]uuid.UUID, error) {
+// AddMatcherConfirmation mocks base method.
+func (m *MockStorage) AddMatcherConfirmation(arg0 uuid.UUID, arg1 string, arg2 bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllFamilyIDs")
-	ret0, _ := ret[0].([]uuid.UUID)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "AddMatcherConfirmation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAllFamilyIDs indicates an expected call of GetAllFamilyIDs.
-func (mr *MockUserStorageMockRecorder) GetAllFamilyIDs() *gomock.Call {
+// AddMatcherConfirmation indicates an expected call of AddMatcherConfirmation.
+func (mr *MockStorageMockRecorder) AddMatcherConfirmation(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFamilyIDs", reflect.TypeOf((*MockUserStorage)(nil).GetAllFamilyIDs))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMatcherConfirmation", reflect.TypeOf((*MockStorage)(nil).AddMatcherConfirmation), arg0, arg1, arg2)
 }
 
-// GetFamilyByName mocks base method.
-func (m *MockUserStorage) GetFamilyByName(name string) (*models.Family, error) {
+// Backup mocks base method.
+func (m *MockStorage) Backup(arg0 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFamilyByName", name)
-	ret0, _ := ret[0].(*models.Family)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Backup", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetFamilyByName indicates an expected call of GetFamilyByName.
-func (mr *MockUserStorageMockRecorder) GetFamilyByName(name interface{}) *gomock.Call {
+// Backup indicates an expected call of Backup.
+func (mr *MockStorageMockRecorder) Backup(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFamilyByName", reflect.TypeOf((*MockUserStorage)(nil).GetFamilyByName), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockStorage)(nil).Backup), arg0)
 }
 
-// GetUser mocks base method.
-func (m *MockUserStorage) GetUser(userID uuid.UUID) (*models.User, error) {
+// CheckMonthlyRollups mocks base method.
+func (m *MockStorage) CheckMonthlyRollups(arg0 uuid.UUID) ([]database.RollupMismatch, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", userID)
-	ret0, _ := ret[0].(*models.User)
+	ret := m.ctrl.Call(m, "CheckMonthlyRollups", arg0)
+	ret0, _ := ret[0].([]database.RollupMismatch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockUserStorageMockRecorder) GetUser(userID interface{}) *gomock.Call {
+// CheckMonthlyRollups indicates an expected call of CheckMonthlyRollups.
+func (mr *MockStorageMockRecorder) CheckMonthlyRollups(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserStorage)(nil).GetUser), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckMonthlyRollups", reflect.TypeOf((*MockStorage)(nil).CheckMonthlyRollups), arg0)
 }
 
-// GetUserByUsername mocks base method.
-func (m *MockUserStorage) GetUserByUsername(username string) (*models.User, error) {
+// ClearDuplicateRelationships mocks base method.
+func (m *MockStorage) ClearDuplicateRelationships(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByUsername", username)
-	ret0, _ := ret[0].(*models.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ClearDuplicateRelationships", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetUserByUsername indicates an expected call of GetUserByUsername.
-func (mr *MockUserStorageMockRecorder) GetUserByUsername(username interface{}) *gomock.Call {
+// ClearDuplicateRelationships indicates an expected call of ClearDuplicateRelationships.
+func (mr *MockStorageMockRecorder) ClearDuplicateRelationships(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockUserStorage)(nil).GetUserByUsername), username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearDuplicateRelationships", reflect.TypeOf((*MockStorage)(nil).ClearDuplicateRelationships), arg0, arg1)
 }
 
-// PutUser mocks base method.
-func (m *MockUserStorage) PutUser(user *models.User) error {
+// Close mocks base method.
+func (m *MockStorage) Close() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutUser", user)
+	ret := m.ctrl.Call(m, "Close")
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PutUser indicates an expected call of PutUser.
-func (mr *MockUserStorageMockRecorder) PutUser(user interface{}) *gomock.Call {
+// Close indicates an expected call of Close.
+func (mr *MockStorageMockRecorder) Close() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutUser", reflect.TypeOf((*MockUserStorage)(nil).PutUser), user)
-}
-
-// MockAccountStorage is a mock of AccountStorage interface.
-type MockAccountStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockAccountStorageMockRecorder
-}
-
-// MockAccountStorageMockRecorder is the mock recorder for MockAccountStorage.
-type MockAccountStorageMockRecorder struct {
-	mock *MockAccountStorage
-}
-
-// NewMockAccountStorage creates a new mock instance.
-func NewMockAccountStorage(ctrl *gomock.Controller) *MockAccountStorage {
-	mock := &MockAccountStorage{ctrl: ctrl}
-	mock.recorder = &MockAccountStorageMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAccountStorage) EXPECT() *MockAccountStorageMockRecorder {
-	return m.recorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStorage)(nil).Close))
 }
 
-// CreateAccount mocks base method.
-func (m *MockAccountStorage) CreateAccount(familyID uuid.UUID, account *goserver.AccountNoId) (goserver.Account, error) {
+// ConfirmTransfer mocks base method.
+func (m *MockStorage) ConfirmTransfer(arg0 uuid.UUID, arg1, arg2 string) (goserver.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccount", familyID, account)
-	ret0, _ := ret[0].(goserver.Account)
+	ret := m.ctrl.Call(m, "ConfirmTransfer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(goserver.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateAccount indicates an expected call of CreateAccount.
-func (mr *MockAccountStorageMockRecorder) CreateAccount(familyID, account interface{}) *gomock.Call {
+// ConfirmTransfer indicates an expected call of ConfirmTransfer.
+func (mr *MockStorageMockRecorder) ConfirmTransfer(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAccountStorage)(nil).CreateAccount), familyID, account)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTransfer", reflect.TypeOf((*MockStorage)(nil).ConfirmTransfer), arg0, arg1, arg2)
 }
 
-// DeleteAccount mocks base method.
-func (m *MockAccountStorage) DeleteAccount(familyID uuid.UUID, id string, replaceWithAccountID *string) error {
+// CountUnprocessedTransactionsForAccount mocks base method.
+func (m *MockStorage) CountUnprocessedTransactionsForAccount(arg0 uuid.UUID, arg1 string, arg2 time.Time) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccount", familyID, id, replaceWithAccountID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CountUnprocessedTransactionsForAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteAccount indicates an expected call of DeleteAccount.
-func (mr *MockAccountStorageMockRecorder) DeleteAccount(familyID, id, replaceWithAccountID interface{}) *gomock.Call {
+// CountUnprocessedTransactionsForAccount indicates an expected call of CountUnprocessedTransactionsForAccount.
+func (mr *MockStorageMockRecorder) CountUnprocessedTransactionsForAccount(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockAccountStorage)(nil).DeleteAccount), familyID, id, replaceWithAccountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnprocessedTransactionsForAccount", reflect.TypeOf((*MockStorage)(nil).CountUnprocessedTransactionsForAccount), arg0, arg1, arg2)
 }
 
-// GetAccount mocks base method.
-func (m *MockAccountStorage) GetAccount(familyID uuid.UUID, id string) (goserver.Account, error) {
+// CreateAccount mocks base method.
+func (m *MockStorage) CreateAccount(arg0 uuid.UUID, arg1 *goserver.AccountNoId) (goserver.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccount", familyID, id)
+	ret := m.ctrl.Call(m, "CreateAccount", arg0, arg1)
 	ret0, _ := ret[0].(goserver.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccount indicates an expected call of GetAccount.
-func (mr *MockAccountStorageMockRecorder) GetAccount(familyID, id interface{}) *gomock.Call {
+// CreateAccount indicates an expected call of CreateAccount.
+func (mr *MockStorageMockRecorder) CreateAccount(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAccountStorage)(nil).GetAccount), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStorage)(nil).CreateAccount), arg0, arg1)
 }
 
-// GetAccountBalance mocks base method.
-func (m *MockAccountStorage) GetAccountBalance(familyID uuid.UUID, accountID, currencyID string) (decimal.Decimal, error) {
+// CreateBankImporter mocks base method.
+func (m *MockStorage) CreateBankImporter(arg0 uuid.UUID, arg1 *goserver.BankImporterNoId) (goserver.BankImporter, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountBalance", familyID, accountID, currencyID)
-	ret0, _ := ret[0].(decimal.Decimal)
+	ret := m.ctrl.Call(m, "CreateBankImporter", arg0, arg1)
+	ret0, _ := ret[0].(goserver.BankImporter)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountBalance indicates an expected call of GetAccountBalance.
-func (mr *MockAccountStorageMockRecorder) GetAccountBalance(familyID, accountID, currencyID interface{}) *gomock.Call {
+// CreateBankImporter indicates an expected call of CreateBankImporter.
+func (mr *MockStorageMockRecorder) CreateBankImporter(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalance", reflect.TypeOf((*MockAccountStorage)(nil).GetAccountBalance), familyID, accountID, currencyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBankImporter", reflect.TypeOf((*MockStorage)(nil).CreateBankImporter), arg0, arg1)
 }
 
-// GetAccountHistory mocks base method.
-func (m *MockAccountStorage) GetAccountHistory(familyID uuid.UUID, accountID string) ([]goserver.Transaction, error) {
+// CreateBankImporterFile mocks base method.
+func (m *MockStorage) CreateBankImporterFile(arg0 uuid.UUID, arg1 *models.BankImporterFile) (goserver.BankImporterFile, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountHistory", familyID, accountID)
-	ret0, _ := ret[0].([]goserver.Transaction)
+	ret := m.ctrl.Call(m, "CreateBankImporterFile", arg0, arg1)
+	ret0, _ := ret[0].(goserver.BankImporterFile)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountHistory indicates an expected call of GetAccountHistory.
-func (mr *MockAccountStorageMockRecorder) GetAccountHistory(familyID, accountID interface{}) *gomock.Call {
+// CreateBankImporterFile indicates an expected call of CreateBankImporterFile.
+func (mr *MockStorageMockRecorder) CreateBankImporterFile(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountHistory", reflect.TypeOf((*MockAccountStorage)(nil).GetAccountHistory), familyID, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBankImporterFile", reflect.TypeOf((*MockStorage)(nil).CreateBankImporterFile), arg0, arg1)
 }
 
-// GetAccounts mocks base method.
-func (m *MockAccountStorage) GetAccounts(familyID uuid.UUID) ([]goserver.Account, error) {
+// CreateBudgetItem mocks base method.
+func (m *MockStorage) CreateBudgetItem(arg0 uuid.UUID, arg1 *goserver.BudgetItemNoId) (goserver.BudgetItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccounts", familyID)
-	ret0, _ := ret[0].([]goserver.Account)
+	ret := m.ctrl.Call(m, "CreateBudgetItem", arg0, arg1)
+	ret0, _ := ret[0].(goserver.BudgetItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccounts indicates an expected call of GetAccounts.
-func (mr *MockAccountStorageMockRecorder) GetAccounts(familyID interface{}) *gomock.Call {
+// CreateBudgetItem indicates an expected call of CreateBudgetItem.
+func (mr *MockStorageMockRecorder) CreateBudgetItem(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounts", reflect.TypeOf((*MockAccountStorage)(nil).GetAccounts), familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBudgetItem", reflect.TypeOf((*MockStorage)(nil).CreateBudgetItem), arg0, arg1)
 }
 
-// UpdateAccount mocks base method.
-func (m *MockAccountStorage) UpdateAccount(familyID uuid.UUID, id string, account *goserver.AccountNoId) (goserver.Account, error) {
+// CreateBudgetPlan mocks base method.
+func (m *MockStorage) CreateBudgetPlan(arg0 uuid.UUID, arg1 *goserver.BudgetPlanNoId) (goserver.BudgetPlan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAccount", familyID, id, account)
-	ret0, _ := ret[0].(goserver.Account)
+	ret := m.ctrl.Call(m, "CreateBudgetPlan", arg0, arg1)
+	ret0, _ := ret[0].(goserver.BudgetPlan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateAccount indicates an expected call of UpdateAccount.
-func (mr *MockAccountStorageMockRecorder) UpdateAccount(familyID, id, account interface{}) *gomock.Call {
+// CreateBudgetPlan indicates an expected call of CreateBudgetPlan.
+func (mr *MockStorageMockRecorder) CreateBudgetPlan(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockAccountStorage)(nil).UpdateAccount), familyID, id, account)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBudgetPlan", reflect.TypeOf((*MockStorage)(nil).CreateBudgetPlan), arg0, arg1)
 }
 
-// MockCurrencyStorage is a mock of CurrencyStorage interface.
-type MockCurrencyStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockCurrencyStorageMockRecorder
-}
-
-// MockCurrencyStorageMockRecorder is the mock recorder for MockCurrencyStorage.
-type MockCurrencyStorageMockRecorder struct {
-	mock *MockCurrencyStorage
-}
-
-// NewMockCurrencyStorage creates a new mock instance.
-func NewMockCurrencyStorage(ctrl *gomock.Controller) *MockCurrencyStorage {
-	mock := &MockCurrencyStorage{ctrl: ctrl}
-	mock.recorder = &MockCurrencyStorageMockRecorder{mock}
-	return mock
+// CreateBudgetTransfer mocks base method.
+func (m *MockStorage) CreateBudgetTransfer(arg0 uuid.UUID, arg1 *goserver.BudgetTransferNoId) (goserver.BudgetTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBudgetTransfer", arg0, arg1)
+	ret0, _ := ret[0].(goserver.BudgetTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCurrencyStorage) EXPECT() *MockCurrencyStorageMockRecorder {
-	return m.recorder
+// CreateBudgetTransfer indicates an expected call of CreateBudgetTransfer.
+func (mr *MockStorageMockRecorder) CreateBudgetTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBudgetTransfer", reflect.TypeOf((*MockStorage)(nil).CreateBudgetTransfer), arg0, arg1)
 }
 
 // CreateCurrency mocks base method.
-func (m *MockCurrencyStorage) CreateCurrency(familyID uuid.UUID, currency *goserver.CurrencyNoId) (goserver.Currency, error) {
+func (m *MockStorage) CreateCurrency(arg0 uuid.UUID, arg1 *goserver.CurrencyNoId) (goserver.Currency, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCurrency", familyID, currency)
+	ret := m.ctrl.Call(m, "CreateCurrency", arg0, arg1)
 	ret0, _ := ret[0].(goserver.Currency)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateCurrency indicates an expected call of CreateCurrency.
-func (mr *MockCurrencyStorageMockRecorder) CreateCurrency(familyID, currency interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) CreateCurrency(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCurrency", reflect.TypeOf((*MockCurrencyStorage)(nil).CreateCurrency), familyID, currency)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCurrency", reflect.TypeOf((*MockStorage)(nil).CreateCurrency), arg0, arg1)
 }
 
-// DeleteCurrency mocks base method.
-func (m *MockCurrencyStorage) DeleteCurrency(familyID uuid.UUID, id string, replaceWithCurrencyID *string) error {
+// CreateExchangeRate mocks base method.
+func (m *MockStorage) CreateExchangeRate(arg0 uuid.UUID, arg1 *goserver.ExchangeRateNoId) (goserver.ExchangeRate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCurrency", familyID, id, replaceWithCurrencyID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateExchangeRate", arg0, arg1)
+	ret0, _ := ret[0].(goserver.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteCurrency indicates an expected call of DeleteCurrency.
-func (mr *MockCurrencyStorageMockRecorder) DeleteCurrency(familyID, id, replaceWithCurrencyID interface{}) *gomock.Call {
+// CreateExchangeRate indicates an expected call of CreateExchangeRate.
+func (mr *MockStorageMockRecorder) CreateExchangeRate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCurrency", reflect.TypeOf((*MockCurrencyStorage)(nil).DeleteCurrency), familyID, id, replaceWithCurrencyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExchangeRate", reflect.TypeOf((*MockStorage)(nil).CreateExchangeRate), arg0, arg1)
 }
 
-// GetCurrencies mocks base method.
-func (m *MockCurrencyStorage) GetCurrencies(familyID uuid.UUID) ([]goserver.Currency, error) {
+// CreateFamily mocks base method.
+func (m *MockStorage) CreateFamily(arg0 string) (*models.Family, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCurrencies", familyID)
-	ret0, _ := ret[0].([]goserver.Currency)
+	ret := m.ctrl.Call(m, "CreateFamily", arg0)
+	ret0, _ := ret[0].(*models.Family)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCurrencies indicates an expected call of GetCurrencies.
-func (mr *MockCurrencyStorageMockRecorder) GetCurrencies(familyID interface{}) *gomock.Call {
+// CreateFamily indicates an expected call of CreateFamily.
+func (mr *MockStorageMockRecorder) CreateFamily(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrencies", reflect.TypeOf((*MockCurrencyStorage)(nil).GetCurrencies), familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFamily", reflect.TypeOf((*MockStorage)(nil).CreateFamily), arg0)
 }
 
-// GetCurrency mocks base method.
-func (m *MockCurrencyStorage) GetCurrency(familyID uuid.UUID, id string) (goserver.Currency, error) {
+// CreateGoal mocks base method.
+func (m *MockStorage) CreateGoal(arg0 uuid.UUID, arg1 *goserver.GoalNoId) (goserver.Goal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCurrency", familyID, id)
-	ret0, _ := ret[0].(goserver.Currency)
+	ret := m.ctrl.Call(m, "CreateGoal", arg0, arg1)
+	ret0, _ := ret[0].(goserver.Goal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCurrency indicates an expected call of GetCurrency.
-func (mr *MockCurrencyStorageMockRecorder) GetCurrency(familyID, id interface{}) *gomock.Call {
+// CreateGoal indicates an expected call of CreateGoal.
+func (mr *MockStorageMockRecorder) CreateGoal(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrency", reflect.TypeOf((*MockCurrencyStorage)(nil).GetCurrency), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGoal", reflect.TypeOf((*MockStorage)(nil).CreateGoal), arg0, arg1)
 }
 
-// UpdateCurrency mocks base method.
-func (m *MockCurrencyStorage) UpdateCurrency(familyID uuid.UUID, id string, currency *goserver.CurrencyNoId) (goserver.Currency, error) {
+// CreateImage mocks base method.
+func (m *MockStorage) CreateImage(arg0 []byte, arg1 string) (models.Image, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCurrency", familyID, id, currency)
-	ret0, _ := ret[0].(goserver.Currency)
+	ret := m.ctrl.Call(m, "CreateImage", arg0, arg1)
+	ret0, _ := ret[0].(models.Image)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateCurrency indicates an expected call of UpdateCurrency.
-func (mr *MockCurrencyStorageMockRecorder) UpdateCurrency(familyID, id, currency interface{}) *gomock.Call {
+// CreateImage indicates an expected call of CreateImage.
+func (mr *MockStorageMockRecorder) CreateImage(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCurrency", reflect.TypeOf((*MockCurrencyStorage)(nil).UpdateCurrency), familyID, id, currency)
-}
-
-// MockTransactionStorage is a mock of TransactionStorage interface.
-type MockTransactionStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockTransactionStorageMockRecorder
-}
-
-// MockTransactionStorageMockRecorder is the mock recorder for MockTransactionStorage.
-type MockTransactionStorageMockRecorder struct {
-	mock *MockTransactionStorage
-}
-
-// NewMockTransactionStorage creates a new mock instance.
-func NewMockTransactionStorage(ctrl *gomock.Controller) *MockTransactionStorage {
-	mock := &MockTransactionStorage{ctrl: ctrl}
-	mock.recorder = &MockTransactionStorageMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockTransactionStorage) EXPECT() *MockTransactionStorageMockRecorder {
-	return m.recorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImage", reflect.TypeOf((*MockStorage)(nil).CreateImage), arg0, arg1)
 }
 
-// AddDuplicateRelationship mocks base method.
-func (m *MockTransactionStorage) AddDuplicateRelationship(familyID uuid.UUID, transactionID1, transactionID2 string) error {
+// CreateMatcher mocks base method.
+func (m *MockStorage) CreateMatcher(arg0 uuid.UUID, arg1 goserver.MatcherNoIdInterface) (goserver.Matcher, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddDuplicateRelationship", familyID, transactionID1, transactionID2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateMatcher", arg0, arg1)
+	ret0, _ := ret[0].(goserver.Matcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// AddDuplicateRelationship indicates an expected call of AddDuplicateRelationship.
-func (mr *MockTransactionStorageMockRecorder) AddDuplicateRelationship(familyID, transactionID1, transactionID2 interface{}) *gomock.Call {
+// CreateMatcher indicates an expected call of CreateMatcher.
+func (mr *MockStorageMockRecorder) CreateMatcher(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDuplicateRelationship", reflect.TypeOf((*MockTransactionStorage)(nil).AddDuplicateRelationship), familyID, transactionID1, transactionID2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMatcher", reflect.TypeOf((*MockStorage)(nil).CreateMatcher), arg0, arg1)
 }
 
-// ClearDuplicateRelationships mocks base method.
-func (m *MockTransactionStorage) ClearDuplicateRelationships(familyID uuid.UUID, transactionID string) error {
+// CreateMatcherRuntimeFromNoId mocks base method.
+func (m *MockStorage) CreateMatcherRuntimeFromNoId(arg0 goserver.MatcherNoIdInterface) (database.MatcherRuntime, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ClearDuplicateRelationships", familyID, transactionID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateMatcherRuntimeFromNoId", arg0)
+	ret0, _ := ret[0].(database.MatcherRuntime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ClearDuplicateRelationships indicates an expected call of ClearDuplicateRelationships.
-func (mr *MockTransactionStorageMockRecorder) ClearDuplicateRelationships(familyID, transactionID interface{}) *gomock.Call {
+// CreateMatcherRuntimeFromNoId indicates an expected call of CreateMatcherRuntimeFromNoId.
+func (mr *MockStorageMockRecorder) CreateMatcherRuntimeFromNoId(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearDuplicateRelationships", reflect.TypeOf((*MockTransactionStorage)(nil).ClearDuplicateRelationships), familyID, transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMatcherRuntimeFromNoId", reflect.TypeOf((*MockStorage)(nil).CreateMatcherRuntimeFromNoId), arg0)
 }
 
-// CountUnprocessedTransactionsForAccount mocks base method.
-func (m *MockTransactionStorage) CountUnprocessedTransactionsForAccount(familyID uuid.UUID, accountID string, ignoreUnprocessedBefore time.Time) (int, error) {
+// CreateNotification mocks base method.
+func (m *MockStorage) CreateNotification(arg0 uuid.UUID, arg1 *goserver.Notification) (goserver.Notification, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountUnprocessedTransactionsForAccount", familyID, accountID, ignoreUnprocessedBefore)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "CreateNotification", arg0, arg1)
+	ret0, _ := ret[0].(goserver.Notification)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CountUnprocessedTransactionsForAccount indicates an expected call of CountUnprocessedTransactionsForAccount.
-func (mr *MockTransactionStorageMockRecorder) CountUnprocessedTransactionsForAccount(familyID, accountID, ignoreUnprocessedBefore interface{}) *gomock.Call {
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MockStorageMockRecorder) CreateNotification(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnprocessedTransactionsForAccount", reflect.TypeOf((*MockTransactionStorage)(nil).CountUnprocessedTransactionsForAccount), familyID, accountID, ignoreUnprocessedBefore)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockStorage)(nil).CreateNotification), arg0, arg1)
 }
 
-// CreateTransaction mocks base method.
-func (m *MockTransactionStorage) CreateTransaction(familyID uuid.UUID, transaction goserver.TransactionNoIdInterface) (goserver.Transaction, error) {
+// CreateNotificationOnce mocks base method.
+func (m *MockStorage) CreateNotificationOnce(arg0 uuid.UUID, arg1 *goserver.Notification) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransaction", familyID, transaction)
-	ret0, _ := ret[0].(goserver.Transaction)
+	ret := m.ctrl.Call(m, "CreateNotificationOnce", arg0, arg1)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTransaction indicates an expected call of CreateTransaction.
-func (mr *MockTransactionStorageMockRecorder) CreateTransaction(familyID, transaction interface{}) *gomock.Call {
+// CreateNotificationOnce indicates an expected call of CreateNotificationOnce.
+func (mr *MockStorageMockRecorder) CreateNotificationOnce(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockTransactionStorage)(nil).CreateTransaction), familyID, transaction)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationOnce", reflect.TypeOf((*MockStorage)(nil).CreateNotificationOnce), arg0, arg1)
 }
 
-// CreateTransactionsBatch mocks base method.
-func (m *MockTransactionStorage) CreateTransactionsBatch(familyID uuid.UUID, transactions []goserver.TransactionNoIdInterface) ([]goserver.Transaction, error) {
+// CreateReconciliation mocks base method.
+func (m *MockStorage) CreateReconciliation(arg0 uuid.UUID, arg1 *goserver.ReconciliationNoId) (goserver.Reconciliation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransactionsBatch", familyID, transactions)
-	ret0, _ := ret[0].([]goserver.Transaction)
+	ret := m.ctrl.Call(m, "CreateReconciliation", arg0, arg1)
+	ret0, _ := ret[0].(goserver.Reconciliation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTransactionsBatch indicates an expected call of CreateTransactionsBatch.
-func (mr *MockTransactionStorageMockRecorder) CreateTransactionsBatch(familyID, transactions interface{}) *gomock.Call {
+// CreateReconciliation indicates an expected call of CreateReconciliation.
+func (mr *MockStorageMockRecorder) CreateReconciliation(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionsBatch", reflect.TypeOf((*MockTransactionStorage)(nil).CreateTransactionsBatch), familyID, transactions)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReconciliation", reflect.TypeOf((*MockStorage)(nil).CreateReconciliation), arg0, arg1)
 }
 
-// DeleteTransaction mocks base method.
-func (m *MockTransactionStorage) DeleteTransaction(familyID uuid.UUID, id string) error {
+// CreateSecurity mocks base method.
+func (m *MockStorage) CreateSecurity(arg0 uuid.UUID, arg1 *goserver.SecurityNoId) (goserver.Security, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTransaction", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateSecurity", arg0, arg1)
+	ret0, _ := ret[0].(goserver.Security)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTransaction indicates an expected call of DeleteTransaction.
-func (mr *MockTransactionStorageMockRecorder) DeleteTransaction(familyID, id interface{}) *gomock.Call {
+// CreateSecurity indicates an expected call of CreateSecurity.
+func (mr *MockStorageMockRecorder) CreateSecurity(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MockTransactionStorage)(nil).DeleteTransaction), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurity", reflect.TypeOf((*MockStorage)(nil).CreateSecurity), arg0, arg1)
 }
 
-// GetDuplicateTransactionIDs mocks base method.
-func (m *MockTransactionStorage) GetDuplicateTransactionIDs(familyID uuid.UUID, transactionID string) ([]string, error) {
+// CreateTemplate mocks base method.
+func (m *MockStorage) CreateTemplate(arg0 uuid.UUID, arg1 *goserver.TransactionTemplateNoId) (goserver.TransactionTemplate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDuplicateTransactionIDs", familyID, transactionID)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "CreateTemplate", arg0, arg1)
+	ret0, _ := ret[0].(goserver.TransactionTemplate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDuplicateTransactionIDs indicates an expected call of GetDuplicateTransactionIDs.
-func (mr *MockTransactionStorageMockRecorder) GetDuplicateTransactionIDs(familyID, transactionID interface{}) *gomock.Call {
+// CreateTemplate indicates an expected call of CreateTemplate.
+func (mr *MockStorageMockRecorder) CreateTemplate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDuplicateTransactionIDs", reflect.TypeOf((*MockTransactionStorage)(nil).GetDuplicateTransactionIDs), familyID, transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockStorage)(nil).CreateTemplate), arg0, arg1)
 }
 
-// GetMergedTransaction mocks base method.
-func (m *MockTransactionStorage) GetMergedTransaction(familyID uuid.UUID, originalTransactionID string) (goserver.MergedTransaction, error) {
+// CreateTransaction mocks base method.
+func (m *MockStorage) CreateTransaction(arg0 uuid.UUID, arg1 goserver.TransactionNoIdInterface) (goserver.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMergedTransaction", familyID, originalTransactionID)
-	ret0, _ := ret[0].(goserver.MergedTransaction)
+	ret := m.ctrl.Call(m, "CreateTransaction", arg0, arg1)
+	ret0, _ := ret[0].(goserver.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMergedTransaction indicates an expected call of GetMergedTransaction.
-func (mr *MockTransactionStorageMockRecorder) GetMergedTransaction(familyID, originalTransactionID interface{}) *gomock.Call {
+// CreateTransaction indicates an expected call of CreateTransaction.
+func (mr *MockStorageMockRecorder) CreateTransaction(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergedTransaction", reflect.TypeOf((*MockTransactionStorage)(nil).GetMergedTransaction), familyID, originalTransactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockStorage)(nil).CreateTransaction), arg0, arg1)
 }
 
-// GetMergedTransactions mocks base method.
-func (m *MockTransactionStorage) GetMergedTransactions(familyID uuid.UUID) ([]goserver.MergedTransaction, error) {
+// CreateTransactionsBatch mocks base method.
+func (m *MockStorage) CreateTransactionsBatch(arg0 uuid.UUID, arg1 []goserver.TransactionNoIdInterface) ([]goserver.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMergedTransactions", familyID)
-	ret0, _ := ret[0].([]goserver.MergedTransaction)
+	ret := m.ctrl.Call(m, "CreateTransactionsBatch", arg0, arg1)
+	ret0, _ := ret[0].([]goserver.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMergedTransactions indicates an expected call of GetMergedTransactions.
-func (mr *MockTransactionStorageMockRecorder) GetMergedTransactions(familyID interface{}) *gomock.Call {
+// CreateTransactionsBatch indicates an expected call of CreateTransactionsBatch.
+func (mr *MockStorageMockRecorder) CreateTransactionsBatch(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergedTransactions", reflect.TypeOf((*MockTransactionStorage)(nil).GetMergedTransactions), familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionsBatch", reflect.TypeOf((*MockStorage)(nil).CreateTransactionsBatch), arg0, arg1)
 }
 
-// GetTransaction mocks base method.
-func (m *MockTransactionStorage) GetTransaction(familyID uuid.UUID, id string) (goserver.Transaction, error) {
+// CreateUser mocks base method.
+func (m *MockStorage) CreateUser(arg0, arg1 string, arg2 uuid.UUID) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransaction", familyID, id)
-	ret0, _ := ret[0].(goserver.Transaction)
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransaction indicates an expected call of GetTransaction.
-func (mr *MockTransactionStorageMockRecorder) GetTransaction(familyID, id interface{}) *gomock.Call {
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockStorageMockRecorder) CreateUser(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockTransactionStorage)(nil).GetTransaction), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStorage)(nil).CreateUser), arg0, arg1, arg2)
 }
 
-// GetTransactions mocks base method.
-func (m *MockTransactionStorage) GetTransactions(familyID uuid.UUID, dateFrom, dateTo time.Time, onlySuspicious bool) ([]goserver.Transaction, error) {
+// DeleteAccount mocks base method.
+func (m *MockStorage) DeleteAccount(arg0 uuid.UUID, arg1 string, arg2 *string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransactions", familyID, dateFrom, dateTo, onlySuspicious)
-	ret0, _ := ret[0].([]goserver.Transaction)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetTransactions indicates an expected call of GetTransactions.
-func (mr *MockTransactionStorageMockRecorder) GetTransactions(familyID, dateFrom, dateTo, onlySuspicious interface{}) *gomock.Call {
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockStorageMockRecorder) DeleteAccount(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactions", reflect.TypeOf((*MockTransactionStorage)(nil).GetTransactions), familyID, dateFrom, dateTo, onlySuspicious)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStorage)(nil).DeleteAccount), arg0, arg1, arg2)
 }
 
-// GetTransactionsIncludingDeleted mocks base method.
-func (m *MockTransactionStorage) GetTransactionsIncludingDeleted(familyID uuid.UUID, dateFrom, dateTo time.Time) ([]goserver.Transaction, error) {
+// DeleteBankImporter mocks base method.
+func (m *MockStorage) DeleteBankImporter(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransactionsIncludingDeleted", familyID, dateFrom, dateTo)
-	ret0, _ := ret[0].([]goserver.Transaction)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteBankImporter", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetTransactionsIncludingDeleted indicates an expected call of GetTransactionsIncludingDeleted.
-func (mr *MockTransactionStorageMockRecorder) GetTransactionsIncludingDeleted(familyID, dateFrom, dateTo interface{}) *gomock.Call {
+// DeleteBankImporter indicates an expected call of DeleteBankImporter.
+func (mr *MockStorageMockRecorder) DeleteBankImporter(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionsIncludingDeleted", reflect.TypeOf((*MockTransactionStorage)(nil).GetTransactionsIncludingDeleted), familyID, dateFrom, dateTo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBankImporter", reflect.TypeOf((*MockStorage)(nil).DeleteBankImporter), arg0, arg1)
 }
 
-// HasTransactionsAfterDate mocks base method.
-func (m *MockTransactionStorage) HasTransactionsAfterDate(familyID uuid.UUID, accountID string, date time.Time) (bool, error) {
+// DeleteBankImporterFile mocks base method.
+func (m *MockStorage) DeleteBankImporterFile(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasTransactionsAfterDate", familyID, accountID, date)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteBankImporterFile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// HasTransactionsAfterDate indicates an expected call of HasTransactionsAfterDate.
-func (mr *MockTransactionStorageMockRecorder) HasTransactionsAfterDate(familyID, accountID, date interface{}) *gomock.Call {
+// DeleteBankImporterFile indicates an expected call of DeleteBankImporterFile.
+func (mr *MockStorageMockRecorder) DeleteBankImporterFile(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasTransactionsAfterDate", reflect.TypeOf((*MockTransactionStorage)(nil).HasTransactionsAfterDate), familyID, accountID, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBankImporterFile", reflect.TypeOf((*MockStorage)(nil).DeleteBankImporterFile), arg0, arg1)
 }
 
-// MergeTransactions mocks base method.
-func (m *MockTransactionStorage) MergeTransactions(familyID uuid.UUID, keepID, mergeID string) (goserver.Transaction, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MergeTransactions", familyID, keepID, mergeID)
-	ret0, _ := ret[0].(goserver.Transaction)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// MergeTransactions indicates an expected call of MergeTransactions.
-func (mr *MockTransactionStorageMockRecorder) MergeTransactions(familyID, keepID, mergeID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTransactions", reflect.TypeOf((*MockTransactionStorage)(nil).MergeTransactions), familyID, keepID, mergeID)
-}
-
-// RemoveDuplicateRelationship mocks base method.
-func (m *MockTransactionStorage) RemoveDuplicateRelationship(familyID uuid.UUID, transactionID1, transactionID2 string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveDuplicateRelationship", familyID, transactionID1, transactionID2)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// RemoveDuplicateRelationship indicates an expected call of RemoveDuplicateRelationship.
-func (mr *MockTransactionStorageMockRecorder) RemoveDuplicateRelationship(familyID, transactionID1, transactionID2 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDuplicateRelationship", reflect.TypeOf((*MockTransactionStorage)(nil).RemoveDuplicateRelationship), familyID, transactionID1, transactionID2)
-}
-
-// UnmergeTransaction mocks base method.
-func (m *MockTransactionStorage) UnmergeTransaction(familyID uuid.UUID, id string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UnmergeTransaction", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// UnmergeTransaction indicates an expected call of UnmergeTransaction.
-func (mr *MockTransactionStorageMockRecorder) UnmergeTransaction(familyID, id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmergeTransaction", reflect.TypeOf((*MockTransactionStorage)(nil).UnmergeTransaction), familyID, id)
-}
-
-// UpdateTransaction mocks base method.
-func (m *MockTransactionStorage) UpdateTransaction(familyID uuid.UUID, id string, transaction goserver.TransactionNoIdInterface) (goserver.Transaction, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTransaction", familyID, id, transaction)
-	ret0, _ := ret[0].(goserver.Transaction)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// UpdateTransaction indicates an expected call of UpdateTransaction.
-func (mr *MockTransactionStorageMockRecorder) UpdateTransaction(familyID, id, transaction interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockTransactionStorage)(nil).UpdateTransaction), familyID, id, transaction)
-}
-
-// UpdateTransactionInternal mocks base method.
-func (m *MockTransactionStorage) UpdateTransactionInternal(familyID uuid.UUID, id string, transaction goserver.TransactionNoIdInterface) (goserver.Transaction, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTransactionInternal", familyID, id, transaction)
-	ret0, _ := ret[0].(goserver.Transaction)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// UpdateTransactionInternal indicates an expected call of UpdateTransactionInternal.
-func (mr *MockTransactionStorageMockRecorder) UpdateTransactionInternal(familyID, id, transaction interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransactionInternal", reflect.TypeOf((*MockTransactionStorage)(nil).UpdateTransactionInternal), familyID, id, transaction)
-}
-
-// MockBankImporterStorage is a mock of BankImporterStorage interface.
-type MockBankImporterStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockBankImporterStorageMockRecorder
-}
-
-// MockBankImporterStorageMockRecorder is the mock recorder for MockBankImporterStorage.
-type MockBankImporterStorageMockRecorder struct {
-	mock *MockBankImporterStorage
-}
-
-// NewMockBankImporterStorage creates a new mock instance.
-func NewMockBankImporterStorage(ctrl *gomock.Controller) *MockBankImporterStorage {
-	mock := &MockBankImporterStorage{ctrl: ctrl}
-	mock.recorder = &MockBankImporterStorageMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockBankImporterStorage) EXPECT() *MockBankImporterStorageMockRecorder {
-	return m.recorder
-}
-
-// CreateBankImporter mocks base method.
-func (m *MockBankImporterStorage) CreateBankImporter(familyID uuid.UUID, bankImporter *goserver.BankImporterNoId) (goserver.BankImporter, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBankImporter", familyID, bankImporter)
-	ret0, _ := ret[0].(goserver.BankImporter)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CreateBankImporter indicates an expected call of CreateBankImporter.
-func (mr *MockBankImporterStorageMockRecorder) CreateBankImporter(familyID, bankImporter interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBankImporter", reflect.TypeOf((*MockBankImporterStorage)(nil).CreateBankImporter), familyID, bankImporter)
-}
-
-// CreateBankImporterFile mocks base method.
-func (m *MockBankImporterStorage) CreateBankImporterFile(familyID uuid.UUID, file *models.BankImporterFile) (goserver.BankImporterFile, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBankImporterFile", familyID, file)
-	ret0, _ := ret[0].(goserver.BankImporterFile)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CreateBankImporterFile indicates an expected call of CreateBankImporterFile.
-func (mr *MockBankImporterStorageMockRecorder) CreateBankImporterFile(familyID, file interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBankImporterFile", reflect.TypeOf((*MockBankImporterStorage)(nil).CreateBankImporterFile), familyID, file)
-}
-
-// DeleteBankImporter mocks base method.
-func (m *MockBankImporterStorage) DeleteBankImporter(familyID uuid.UUID, id string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBankImporter", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeleteBankImporter indicates an expected call of DeleteBankImporter.
-func (mr *MockBankImporterStorageMockRecorder) DeleteBankImporter(familyID, id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBankImporter", reflect.TypeOf((*MockBankImporterStorage)(nil).DeleteBankImporter), familyID, id)
-}
-
-// DeleteBankImporterFile mocks base method.
-func (m *MockBankImporterStorage) DeleteBankImporterFile(familyID uuid.UUID, id string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBankImporterFile", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeleteBankImporterFile indicates an expected call of DeleteBankImporterFile.
-func (mr *MockBankImporterStorageMockRecorder) DeleteBankImporterFile(familyID, id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBankImporterFile", reflect.TypeOf((*MockBankImporterStorage)(nil).DeleteBankImporterFile), familyID, id)
-}
-
-// GetAllBankImporters mocks base method.
-func (m *MockBankImporterStorage) GetAllBankImporters() ([]database.ImportInfo, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllBankImporters")
-	ret0, _ := ret[0].([]database.ImportInfo)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetAllBankImporters indicates an expected call of GetAllBankImporters.
-func (mr *MockBankImporterStorageMockRecorder) GetAllBankImporters() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBankImporters", reflect.TypeOf((*MockBankImporterStorage)(nil).GetAllBankImporters))
-}
-
-// GetBankImporter mocks base method.
-func (m *MockBankImporterStorage) GetBankImporter(familyID uuid.UUID, id string) (goserver.BankImporter, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBankImporter", familyID, id)
-	ret0, _ := ret[0].(goserver.BankImporter)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetBankImporter indicates an expected call of GetBankImporter.
-func (mr *MockBankImporterStorageMockRecorder) GetBankImporter(familyID, id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankImporter", reflect.TypeOf((*MockBankImporterStorage)(nil).GetBankImporter), familyID, id)
-}
-
-// GetBankImporterFile mocks base method.
-func (m *MockBankImporterStorage) GetBankImporterFile(familyID uuid.UUID, id string) (models.BankImporterFile, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBankImporterFile", familyID, id)
-	ret0, _ := ret[0].(models.BankImporterFile)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetBankImporterFile indicates an expected call of GetBankImporterFile.
-func (mr *MockBankImporterStorageMockRecorder) GetBankImporterFile(familyID, id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankImporterFile", reflect.TypeOf((*MockBankImporterStorage)(nil).GetBankImporterFile), familyID, id)
-}
-
-// GetBankImporterFiles mocks base method.
-func (m *MockBankImporterStorage) GetBankImporterFiles(familyID uuid.UUID) ([]goserver.BankImporterFile, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBankImporterFiles", familyID)
-	ret0, _ := ret[0].([]goserver.BankImporterFile)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetBankImporterFiles indicates an expected call of GetBankImporterFiles.
-func (mr *MockBankImporterStorageMockRecorder) GetBankImporterFiles(familyID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankImporterFiles", reflect.TypeOf((*MockBankImporterStorage)(nil).GetBankImporterFiles), familyID)
-}
-
-// GetBankImporters mocks base method.
-func (m *MockBankImporterStorage) GetBankImporters(familyID uuid.UUID) ([]goserver.BankImporter, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBankImporters", familyID)
-	ret0, _ := ret[0].([]goserver.BankImporter)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetBankImporters indicates an expected call of GetBankImporters.
-func (mr *MockBankImporterStorageMockRecorder) GetBankImporters(familyID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankImporters", reflect.TypeOf((*MockBankImporterStorage)(nil).GetBankImporters), familyID)
-}
-
-// UpdateBankImporter mocks base method.
-func (m *MockBankImporterStorage) UpdateBankImporter(familyID uuid.UUID, id string, bankImporter goserver.BankImporterNoIdInterface) (goserver.BankImporter, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBankImporter", familyID, id, bankImporter)
-	ret0, _ := ret[0].(goserver.BankImporter)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// UpdateBankImporter indicates an expected call of UpdateBankImporter.
-func (mr *MockBankImporterStorageMockRecorder) UpdateBankImporter(familyID, id, bankImporter interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBankImporter", reflect.TypeOf((*MockBankImporterStorage)(nil).UpdateBankImporter), familyID, id, bankImporter)
-}
-
-// MockMatcherStorage is a mock of MatcherStorage interface.
-type MockMatcherStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockMatcherStorageMockRecorder
-}
-
-// MockMatcherStorageMockRecorder is the mock recorder for MockMatcherStorage.
-type MockMatcherStorageMockRecorder struct {
-	mock *MockMatcherStorage
-}
-
-// NewMockMatcherStorage creates a new mock instance.
-func NewMockMatcherStorage(ctrl *gomock.Controller) *MockMatcherStorage {
-	mock := &MockMatcherStorage{ctrl: ctrl}
-	mock.recorder = &MockMatcherStorageMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockMatcherStorage) EXPECT() *MockMatcherStorageMockRecorder {
-	return m.recorder
-}
-
-// AddMatcherConfirmation mocks base method.
-func (m *MockMatcherStorage) AddMatcherConfirmation(familyID uuid.UUID, id string, confirmed bool) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddMatcherConfirmation", familyID, id, confirmed)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// AddMatcherConfirmation indicates an expected call of AddMatcherConfirmation.
-func (mr *MockMatcherStorageMockRecorder) AddMatcherConfirmation(familyID, id, confirmed interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMatcherConfirmation", reflect.TypeOf((*MockMatcherStorage)(nil).AddMatcherConfirmation), familyID, id, confirmed)
-}
-
-// CreateMatcher mocks base method.
-func (m *MockMatcherStorage) CreateMatcher(familyID uuid.UUID, matcher goserver.MatcherNoIdInterface) (goserver.Matcher, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateMatcher", familyID, matcher)
-	ret0, _ := ret[0].(goserver.Matcher)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CreateMatcher indicates an expected call of CreateMatcher.
-func (mr *MockMatcherStorageMockRecorder) CreateMatcher(familyID, matcher interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMatcher", reflect.TypeOf((*MockMatcherStorage)(nil).CreateMatcher), familyID, matcher)
-}
-
-// CreateMatcherRuntimeFromNoId mocks base method.
-func (m_2 *MockMatcherStorage) CreateMatcherRuntimeFromNoId(m goserver.MatcherNoIdInterface) (database.MatcherRuntime, error) {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "CreateMatcherRuntimeFromNoId", m)
-	ret0, _ := ret[0].(database.MatcherRuntime)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CreateMatcherRuntimeFromNoId indicates an expected call of CreateMatcherRuntimeFromNoId.
-func (mr *MockMatcherStorageMockRecorder) CreateMatcherRuntimeFromNoId(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMatcherRuntimeFromNoId", reflect.TypeOf((*MockMatcherStorage)(nil).CreateMatcherRuntimeFromNoId), m)
-}
-
-// DeleteMatcher mocks base method.
-func (m *MockMatcherStorage) DeleteMatcher(familyID uuid.UUID, id string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMatcher", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeleteMatcher indicates an expected call of DeleteMatcher.
-func (mr *MockMatcherStorageMockRecorder) DeleteMatcher(familyID, id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMatcher", reflect.TypeOf((*MockMatcherStorage)(nil).DeleteMatcher), familyID, id)
-}
-
-// GetMatcher mocks base method.
-func (m *MockMatcherStorage) GetMatcher(familyID uuid.UUID, id string) (goserver.Matcher, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMatcher", familyID, id)
-	ret0, _ := ret[0].(goserver.Matcher)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetMatcher indicates an expected call of GetMatcher.
-func (mr *MockMatcherStorageMockRecorder) GetMatcher(familyID, id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatcher", reflect.TypeOf((*MockMatcherStorage)(nil).GetMatcher), familyID, id)
-}
-
-// GetMatcherRuntime mocks base method.
-func (m *MockMatcherStorage) GetMatcherRuntime(familyID uuid.UUID, id string) (database.MatcherRuntime, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMatcherRuntime", familyID, id)
-	ret0, _ := ret[0].(database.MatcherRuntime)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetMatcherRuntime indicates an expected call of GetMatcherRuntime.
-func (mr *MockMatcherStorageMockRecorder) GetMatcherRuntime(familyID, id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatcherRuntime", reflect.TypeOf((*MockMatcherStorage)(nil).GetMatcherRuntime), familyID, id)
-}
-
-// GetMatchers mocks base method.
-func (m *MockMatcherStorage) GetMatchers(familyID uuid.UUID) ([]goserver.Matcher, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMatchers", familyID)
-	ret0, _ := ret[0].([]goserver.Matcher)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetMatchers indicates an expected call of GetMatchers.
-func (mr *MockMatcherStorageMockRecorder) GetMatchers(familyID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchers", reflect.TypeOf((*MockMatcherStorage)(nil).GetMatchers), familyID)
-}
-
-// GetMatchersRuntime mocks base method.
-func (m *MockMatcherStorage) GetMatchersRuntime(familyID uuid.UUID) ([]database.MatcherRuntime, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMatchersRuntime", familyID)
-	ret0, _ := ret[0].([]database.MatcherRuntime)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetMatchersRuntime indicates an expected call of GetMatchersRuntime.
-func (mr *MockMatcherStorageMockRecorder) GetMatchersRuntime(familyID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchersRuntime", reflect.TypeOf((*MockMatcherStorage)(nil).GetMatchersRuntime), familyID)
-}
-
-// UpdateMatcher mocks base method.
-func (m *MockMatcherStorage) UpdateMatcher(familyID uuid.UUID, id string, matcher goserver.MatcherNoIdInterface) (goserver.Matcher, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateMatcher", familyID, id, matcher)
-	ret0, _ := ret[0].(goserver.Matcher)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// UpdateMatcher indicates an expected call of UpdateMatcher.
-func (mr *MockMatcherStorageMockRecorder) UpdateMatcher(familyID, id, matcher interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMatcher", reflect.TypeOf((*MockMatcherStorage)(nil).UpdateMatcher), familyID, id, matcher)
-}
-
-// MockTemplateStorage is a mock of TemplateStorage interface.
-type MockTemplateStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockTemplateStorageMockRecorder
-}
-
-// MockTemplateStorageMockRecorder is the mock recorder for MockTemplateStorage.
-type MockTemplateStorageMockRecorder struct {
-	mock *MockTemplateStorage
-}
-
-// NewMockTemplateStorage creates a new mock instance.
-func NewMockTemplateStorage(ctrl *gomock.Controller) *MockTemplateStorage {
-	mock := &MockTemplateStorage{ctrl: ctrl}
-	mock.recorder = &MockTemplateStorageMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockTemplateStorage) EXPECT() *MockTemplateStorageMockRecorder {
-	return m.recorder
-}
-
-// CreateTemplate mocks base method.
-func (m *MockTemplateStorage) CreateTemplate(familyID uuid.UUID, t *goserver.TransactionTemplateNoId) (goserver.TransactionTemplate, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTemplate", familyID, t)
-	ret0, _ := ret[0].(goserver.TransactionTemplate)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CreateTemplate indicates an expected call of CreateTemplate.
-func (mr *MockTemplateStorageMockRecorder) CreateTemplate(familyID, t interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockTemplateStorage)(nil).CreateTemplate), familyID, t)
-}
-
-// DeleteTemplate mocks base method.
-func (m *MockTemplateStorage) DeleteTemplate(familyID uuid.UUID, id string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTemplate", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeleteTemplate indicates an expected call of DeleteTemplate.
-func (mr *MockTemplateStorageMockRecorder) DeleteTemplate(familyID, id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockTemplateStorage)(nil).DeleteTemplate), familyID, id)
-}
-
-// GetTemplates mocks base method.
-func (m *MockTemplateStorage) GetTemplates(familyID uuid.UUID, accountID *string) ([]goserver.TransactionTemplate, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTemplates", familyID, accountID)
-	ret0, _ := ret[0].([]goserver.TransactionTemplate)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetTemplates indicates an expected call of GetTemplates.
-func (mr *MockTemplateStorageMockRecorder) GetTemplates(familyID, accountID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplates", reflect.TypeOf((*MockTemplateStorage)(nil).GetTemplates), familyID, accountID)
-}
-
-// UpdateTemplate mocks base method.
-func (m *MockTemplateStorage) UpdateTemplate(familyID uuid.UUID, id string, t *goserver.TransactionTemplateNoId) (goserver.TransactionTemplate, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTemplate", familyID, id, t)
-	ret0, _ := ret[0].(goserver.TransactionTemplate)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// UpdateTemplate indicates an expected call of UpdateTemplate.
-func (mr *MockTemplateStorageMockRecorder) UpdateTemplate(familyID, id, t interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplate", reflect.TypeOf((*MockTemplateStorage)(nil).UpdateTemplate), familyID, id, t)
-}
-
-// MockRateStorage is a mock of RateStorage interface.
-type MockRateStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockRateStorageMockRecorder
-}
-
-// MockRateStorageMockRecorder is the mock recorder for MockRateStorage.
-type MockRateStorageMockRecorder struct {
-	mock *MockRateStorage
-}
-
-// NewMockRateStorage creates a new mock instance.
-func NewMockRateStorage(ctrl *gomock.Controller) *MockRateStorage {
-	mock := &MockRateStorage{ctrl: ctrl}
-	mock.recorder = &MockRateStorageMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockRateStorage) EXPECT() *MockRateStorageMockRecorder {
-	return m.recorder
-}
-
-// GetCNBRates mocks base method.
-func (m *MockRateStorage) GetCNBRates(day time.Time) (map[string]decimal.Decimal, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCNBRates", day)
-	ret0, _ := ret[0].(map[string]decimal.Decimal)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetCNBRates indicates an expected call of GetCNBRates.
-func (mr *MockRateStorageMockRecorder) GetCNBRates(day interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCNBRates", reflect.TypeOf((*MockRateStorage)(nil).GetCNBRates), day)
-}
-
-// SaveCNBRates mocks base method.
-func (m *MockRateStorage) SaveCNBRates(rates map[string]decimal.Decimal, day time.Time) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveCNBRates", rates, day)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SaveCNBRates indicates an expected call of SaveCNBRates.
-func (mr *MockRateStorageMockRecorder) SaveCNBRates(rates, day interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCNBRates", reflect.TypeOf((*MockRateStorage)(nil).SaveCNBRates), rates, day)
-}
-
-// MockBudgetItemStorage is a mock of BudgetItemStorage interface.
-type MockBudgetItemStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockBudgetItemStorageMockRecorder
-}
-
-// MockBudgetItemStorageMockRecorder is the mock recorder for MockBudgetItemStorage.
-type MockBudgetItemStorageMockRecorder struct {
-	mock *MockBudgetItemStorage
-}
-
-// NewMockBudgetItemStorage creates a new mock instance.
-func NewMockBudgetItemStorage(ctrl *gomock.Controller) *MockBudgetItemStorage {
-	mock := &MockBudgetItemStorage{ctrl: ctrl}
-	mock.recorder = &MockBudgetItemStorageMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockBudgetItemStorage) EXPECT() *MockBudgetItemStorageMockRecorder {
-	return m.recorder
-}
-
-// CreateBudgetItem mocks base method.
-func (m *MockBudgetItemStorage) CreateBudgetItem(familyID uuid.UUID, budgetItem *goserver.BudgetItemNoId) (goserver.BudgetItem, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBudgetItem", familyID, budgetItem)
-	ret0, _ := ret[0].(goserver.BudgetItem)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CreateBudgetItem indicates an expected call of CreateBudgetItem.
-func (mr *MockBudgetItemStorageMockRecorder) CreateBudgetItem(familyID, budgetItem interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBudgetItem", reflect.TypeOf((*MockBudgetItemStorage)(nil).CreateBudgetItem), familyID, budgetItem)
-}
-
-// DeleteBudgetItem mocks base method.
-func (m *MockBudgetItemStorage) DeleteBudgetItem(familyID uuid.UUID, id string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBudgetItem", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeleteBudgetItem indicates an expected call of DeleteBudgetItem.
-func (mr *MockBudgetItemStorageMockRecorder) DeleteBudgetItem(familyID, id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudgetItem", reflect.TypeOf((*MockBudgetItemStorage)(nil).DeleteBudgetItem), familyID, id)
-}
-
-// GetBudgetItem mocks base method.
-func (m *MockBudgetItemStorage) GetBudgetItem(familyID uuid.UUID, id string) (goserver.BudgetItem, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBudgetItem", familyID, id)
-	ret0, _ := ret[0].(goserver.BudgetItem)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetBudgetItem indicates an expected call of GetBudgetItem.
-func (mr *MockBudgetItemStorageMockRecorder) GetBudgetItem(familyID, id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetItem", reflect.TypeOf((*MockBudgetItemStorage)(nil).GetBudgetItem), familyID, id)
-}
-
-// GetBudgetItems mocks base method.
-func (m *MockBudgetItemStorage) GetBudgetItems(familyID uuid.UUID) ([]goserver.BudgetItem, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBudgetItems", familyID)
-	ret0, _ := ret[0].([]goserver.BudgetItem)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetBudgetItems indicates an expected call of GetBudgetItems.
-func (mr *MockBudgetItemStorageMockRecorder) GetBudgetItems(familyID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetItems", reflect.TypeOf((*MockBudgetItemStorage)(nil).GetBudgetItems), familyID)
-}
-
-// UpdateBudgetItem mocks base method.
-func (m *MockBudgetItemStorage) UpdateBudgetItem(familyID uuid.UUID, id string, budgetItem *goserver.BudgetItemNoId) (goserver.BudgetItem, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBudgetItem", familyID, id, budgetItem)
-	ret0, _ := ret[0].(goserver.BudgetItem)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// UpdateBudgetItem indicates an expected call of UpdateBudgetItem.
-func (mr *MockBudgetItemStorageMockRecorder) UpdateBudgetItem(familyID, id, budgetItem interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBudgetItem", reflect.TypeOf((*MockBudgetItemStorage)(nil).UpdateBudgetItem), familyID, id, budgetItem)
-}
-
-// MockImageStorage is a mock of ImageStorage interface.
-type MockImageStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockImageStorageMockRecorder
-}
-
-// MockImageStorageMockRecorder is the mock recorder for MockImageStorage.
-type MockImageStorageMockRecorder struct {
-	mock *MockImageStorage
-}
-
-// NewMockImageStorage creates a new mock instance.
-func NewMockImageStorage(ctrl *gomock.Controller) *MockImageStorage {
-	mock := &MockImageStorage{ctrl: ctrl}
-	mock.recorder = &MockImageStorageMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockImageStorage) EXPECT() *MockImageStorageMockRecorder {
-	return m.recorder
-}
-
-// CreateImage mocks base method.
-func (m *MockImageStorage) CreateImage(data []byte, contentType string) (models.Image, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateImage", data, contentType)
-	ret0, _ := ret[0].(models.Image)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CreateImage indicates an expected call of CreateImage.
-func (mr *MockImageStorageMockRecorder) CreateImage(data, contentType interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImage", reflect.TypeOf((*MockImageStorage)(nil).CreateImage), data, contentType)
-}
-
-// DeleteImage mocks base method.
-func (m *MockImageStorage) DeleteImage(id string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteImage", id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeleteImage indicates an expected call of DeleteImage.
-func (mr *MockImageStorageMockRecorder) DeleteImage(id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockImageStorage)(nil).DeleteImage), id)
-}
-
-// GetImage mocks base method.
-func (m *MockImageStorage) GetImage(id string) (models.Image, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetImage", id)
-	ret0, _ := ret[0].(models.Image)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetImage indicates an expected call of GetImage.
-func (mr *MockImageStorageMockRecorder) GetImage(id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockImageStorage)(nil).GetImage), id)
-}
-
-// MockNotificationStorage is a mock of NotificationStorage interface.
-type MockNotificationStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockNotificationStorageMockRecorder
-}
-
-// MockNotificationStorageMockRecorder is the mock recorder for MockNotificationStorage.
-type MockNotificationStorageMockRecorder struct {
-	mock *MockNotificationStorage
-}
-
-// NewMockNotificationStorage creates a new mock instance.
-func NewMockNotificationStorage(ctrl *gomock.Controller) *MockNotificationStorage {
-	mock := &MockNotificationStorage{ctrl: ctrl}
-	mock.recorder = &MockNotificationStorageMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockNotificationStorage) EXPECT() *MockNotificationStorageMockRecorder {
-	return m.recorder
-}
-
-// CreateNotification mocks base method.
-func (m *MockNotificationStorage) CreateNotification(familyID uuid.UUID, notification *goserver.Notification) (goserver.Notification, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNotification", familyID, notification)
-	ret0, _ := ret[0].(goserver.Notification)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CreateNotification indicates an expected call of CreateNotification.
-func (mr *MockNotificationStorageMockRecorder) CreateNotification(familyID, notification interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockNotificationStorage)(nil).CreateNotification), familyID, notification)
-}
-
-// DeleteNotification mocks base method.
-func (m *MockNotificationStorage) DeleteNotification(familyID uuid.UUID, id string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNotification", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeleteNotification indicates an expected call of DeleteNotification.
-func (mr *MockNotificationStorageMockRecorder) DeleteNotification(familyID, id interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotification", reflect.TypeOf((*MockNotificationStorage)(nil).DeleteNotification), familyID, id)
-}
-
-// GetNotifications mocks base method.
-func (m *MockNotificationStorage) GetNotifications(familyID uuid.UUID) ([]goserver.Notification, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNotifications", familyID)
-	ret0, _ := ret[0].([]goserver.Notification)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetNotifications indicates an expected call of GetNotifications.
-func (mr *MockNotificationStorageMockRecorder) GetNotifications(familyID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockNotificationStorage)(nil).GetNotifications), familyID)
-}
-
-// MockReconciliationStorage is a mock of ReconciliationStorage interface.
-type MockReconciliationStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockReconciliationStorageMockRecorder
-}
-
-// MockReconciliationStorageMockRecorder is the mock recorder for MockReconciliationStorage.
-type MockReconciliationStorageMockRecorder struct {
-	mock *MockReconciliationStorage
-}
-
-// NewMockReconciliationStorage creates a new mock instance.
-func NewMockReconciliationStorage(ctrl *gomock.Controller) *MockReconciliationStorage {
-	mock := &MockReconciliationStorage{ctrl: ctrl}
-	mock.recorder = &MockReconciliationStorageMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockReconciliationStorage) EXPECT() *MockReconciliationStorageMockRecorder {
-	return m.recorder
-}
-
-// CreateReconciliation mocks base method.
-func (m *MockReconciliationStorage) CreateReconciliation(familyID uuid.UUID, rec *goserver.ReconciliationNoId) (goserver.Reconciliation, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateReconciliation", familyID, rec)
-	ret0, _ := ret[0].(goserver.Reconciliation)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CreateReconciliation indicates an expected call of CreateReconciliation.
-func (mr *MockReconciliationStorageMockRecorder) CreateReconciliation(familyID, rec interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReconciliation", reflect.TypeOf((*MockReconciliationStorage)(nil).CreateReconciliation), familyID, rec)
-}
-
-// GetBulkReconciliationData mocks base method.
-func (m *MockReconciliationStorage) GetBulkReconciliationData(familyID uuid.UUID) (*database.BulkReconciliationData, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBulkReconciliationData", familyID)
-	ret0, _ := ret[0].(*database.BulkReconciliationData)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetBulkReconciliationData indicates an expected call of GetBulkReconciliationData.
-func (mr *MockReconciliationStorageMockRecorder) GetBulkReconciliationData(familyID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBulkReconciliationData", reflect.TypeOf((*MockReconciliationStorage)(nil).GetBulkReconciliationData), familyID)
-}
-
-// GetLatestReconciliation mocks base method.
-func (m *MockReconciliationStorage) GetLatestReconciliation(familyID uuid.UUID, accountID, currencyID string) (*goserver.Reconciliation, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLatestReconciliation", familyID, accountID, currencyID)
-	ret0, _ := ret[0].(*goserver.Reconciliation)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetLatestReconciliation indicates an expected call of GetLatestReconciliation.
-func (mr *MockReconciliationStorageMockRecorder) GetLatestReconciliation(familyID, accountID, currencyID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestReconciliation", reflect.TypeOf((*MockReconciliationStorage)(nil).GetLatestReconciliation), familyID, accountID, currencyID)
-}
-
-// GetReconciliationsForAccount mocks base method.
-func (m *MockReconciliationStorage) GetReconciliationsForAccount(familyID uuid.UUID, accountID string) ([]goserver.Reconciliation, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReconciliationsForAccount", familyID, accountID)
-	ret0, _ := ret[0].([]goserver.Reconciliation)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetReconciliationsForAccount indicates an expected call of GetReconciliationsForAccount.
-func (mr *MockReconciliationStorageMockRecorder) GetReconciliationsForAccount(familyID, accountID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconciliationsForAccount", reflect.TypeOf((*MockReconciliationStorage)(nil).GetReconciliationsForAccount), familyID, accountID)
-}
-
-// GetReconciliationsForAccountAndCurrency mocks base method.
-func (m *MockReconciliationStorage) GetReconciliationsForAccountAndCurrency(familyID uuid.UUID, accountID, currencyID string) ([]goserver.Reconciliation, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReconciliationsForAccountAndCurrency", familyID, accountID, currencyID)
-	ret0, _ := ret[0].([]goserver.Reconciliation)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetReconciliationsForAccountAndCurrency indicates an expected call of GetReconciliationsForAccountAndCurrency.
-func (mr *MockReconciliationStorageMockRecorder) GetReconciliationsForAccountAndCurrency(familyID, accountID, currencyID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconciliationsForAccountAndCurrency", reflect.TypeOf((*MockReconciliationStorage)(nil).GetReconciliationsForAccountAndCurrency), familyID, accountID, currencyID)
-}
-
-// InvalidateReconciliation mocks base method.
-func (m *MockReconciliationStorage) InvalidateReconciliation(familyID uuid.UUID, accountID, currencyID string, fromDate time.Time) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InvalidateReconciliation", familyID, accountID, currencyID, fromDate)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// InvalidateReconciliation indicates an expected call of InvalidateReconciliation.
-func (mr *MockReconciliationStorageMockRecorder) InvalidateReconciliation(familyID, accountID, currencyID, fromDate interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateReconciliation", reflect.TypeOf((*MockReconciliationStorage)(nil).InvalidateReconciliation), familyID, accountID, currencyID, fromDate)
-}
-
-// MockAuditLogStorage is a mock of AuditLogStorage interface.
-type MockAuditLogStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockAuditLogStorageMockRecorder
-}
-
-// MockAuditLogStorageMockRecorder is the mock recorder for MockAuditLogStorage.
-type MockAuditLogStorageMockRecorder struct {
-	mock *MockAuditLogStorage
-}
-
-// NewMockAuditLogStorage creates a new mock instance.
-func NewMockAuditLogStorage(ctrl *gomock.Controller) *MockAuditLogStorage {
-	mock := &MockAuditLogStorage{ctrl: ctrl}
-	mock.recorder = &MockAuditLogStorageMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAuditLogStorage) EXPECT() *MockAuditLogStorageMockRecorder {
-	return m.recorder
-}
-
-// GetAuditLogs mocks base method.
-func (m *MockAuditLogStorage) GetAuditLogs(familyID uuid.UUID, filter database.AuditLogFilter) ([]models.AuditLog, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAuditLogs", familyID, filter)
-	ret0, _ := ret[0].([]models.AuditLog)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetAuditLogs indicates an expected call of GetAuditLogs.
-func (mr *MockAuditLogStorageMockRecorder) GetAuditLogs(familyID, filter interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogs", reflect.TypeOf((*MockAuditLogStorage)(nil).GetAuditLogs), familyID, filter)
-}
-
-// MockSystemStorage is a mock of SystemStorage interface.
-type MockSystemStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockSystemStorageMockRecorder
-}
-
-// MockSystemStorageMockRecorder is the mock recorder for MockSystemStorage.
-type MockSystemStorageMockRecorder struct {
-	mock *MockSystemStorage
-}
-
-// NewMockSystemStorage creates a new mock instance.
-func NewMockSystemStorage(ctrl *gomock.Controller) *MockSystemStorage {
-	mock := &MockSystemStorage{ctrl: ctrl}
-	mock.recorder = &MockSystemStorageMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSystemStorage) EXPECT() *MockSystemStorageMockRecorder {
-	return m.recorder
-}
-
-// Backup mocks base method.
-func (m *MockSystemStorage) Backup(destination string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Backup", destination)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Backup indicates an expected call of Backup.
-func (mr *MockSystemStorageMockRecorder) Backup(destination interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockSystemStorage)(nil).Backup), destination)
-}
-
-// Close mocks base method.
-func (m *MockSystemStorage) Close() error {
+// DeleteBudgetItem mocks base method.
+func (m *MockStorage) DeleteBudgetItem(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Close")
+	ret := m.ctrl.Call(m, "DeleteBudgetItem", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Close indicates an expected call of Close.
-func (mr *MockSystemStorageMockRecorder) Close() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSystemStorage)(nil).Close))
-}
-
-// GetDB mocks base method.
-func (m *MockSystemStorage) GetDB() *gorm.DB {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDB")
-	ret0, _ := ret[0].(*gorm.DB)
-	return ret0
-}
-
-// GetDB indicates an expected call of GetDB.
-func (mr *MockSystemStorageMockRecorder) GetDB() *gomock.Call {
+// DeleteBudgetItem indicates an expected call of DeleteBudgetItem.
+func (mr *MockStorageMockRecorder) DeleteBudgetItem(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDB", reflect.TypeOf((*MockSystemStorage)(nil).GetDB))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudgetItem", reflect.TypeOf((*MockStorage)(nil).DeleteBudgetItem), arg0, arg1)
 }
 
-// Open mocks base method.
-func (m *MockSystemStorage) Open() error {
+// DeleteBudgetPlan mocks base method.
+func (m *MockStorage) DeleteBudgetPlan(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Open")
+	ret := m.ctrl.Call(m, "DeleteBudgetPlan", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Open indicates an expected call of Open.
-func (mr *MockSystemStorageMockRecorder) Open() *gomock.Call {
+// DeleteBudgetPlan indicates an expected call of DeleteBudgetPlan.
+func (mr *MockStorageMockRecorder) DeleteBudgetPlan(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockSystemStorage)(nil).Open))
-}
-
-// MockStorage is a mock of Storage interface.
-type MockStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockStorageMockRecorder
-}
-
-// MockStorageMockRecorder is the mock recorder for MockStorage.
-type MockStorageMockRecorder struct {
-	mock *MockStorage
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudgetPlan", reflect.TypeOf((*MockStorage)(nil).DeleteBudgetPlan), arg0, arg1)
 }
-
-// NewMockStorage creates a new mock instance.
-func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
-	mock := &MockStorage{ctrl: ctrl}
-	mock.recorder = &MockStorageMockRecorder{mock}
-	return mock
+
+// DeleteBudgetTransfer mocks base method.
+func (m *MockStorage) DeleteBudgetTransfer(arg0 uuid.UUID, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBudgetTransfer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
-	return m.recorder
+// DeleteBudgetTransfer indicates an expected call of DeleteBudgetTransfer.
+func (mr *MockStorageMockRecorder) DeleteBudgetTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudgetTransfer", reflect.TypeOf((*MockStorage)(nil).DeleteBudgetTransfer), arg0, arg1)
 }
 
-// AddDuplicateRelationship mocks base method.
-func (m *MockStorage) AddDuplicateRelationship(familyID uuid.UUID, transactionID1, transactionID2 string) error {
+// DeleteCurrency mocks base method.
+func (m *MockStorage) DeleteCurrency(arg0 uuid.UUID, arg1 string, arg2 *string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddDuplicateRelationship", familyID, transactionID1, transactionID2)
+	ret := m.ctrl.Call(m, "DeleteCurrency", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddDuplicateRelationship indicates an expected call of AddDuplicateRelationship.
-func (mr *MockStorageMockRecorder) AddDuplicateRelationship(familyID, transactionID1, transactionID2 interface{}) *gomock.Call {
+// DeleteCurrency indicates an expected call of DeleteCurrency.
+func (mr *MockStorageMockRecorder) DeleteCurrency(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDuplicateRelationship", reflect.TypeOf((*MockStorage)(nil).AddDuplicateRelationship), familyID, transactionID1, transactionID2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCurrency", reflect.TypeOf((*MockStorage)(nil).DeleteCurrency), arg0, arg1, arg2)
 }
 
-// AddMatcherConfirmation mocks base method.
-func (m *MockStorage) AddMatcherConfirmation(familyID uuid.UUID, id string, confirmed bool) error {
+// DeleteExchangeRate mocks base method.
+func (m *MockStorage) DeleteExchangeRate(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddMatcherConfirmation", familyID, id, confirmed)
+	ret := m.ctrl.Call(m, "DeleteExchangeRate", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddMatcherConfirmation indicates an expected call of AddMatcherConfirmation.
-func (mr *MockStorageMockRecorder) AddMatcherConfirmation(familyID, id, confirmed interface{}) *gomock.Call {
+// DeleteExchangeRate indicates an expected call of DeleteExchangeRate.
+func (mr *MockStorageMockRecorder) DeleteExchangeRate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMatcherConfirmation", reflect.TypeOf((*MockStorage)(nil).AddMatcherConfirmation), familyID, id, confirmed)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExchangeRate", reflect.TypeOf((*MockStorage)(nil).DeleteExchangeRate), arg0, arg1)
 }
 
-// Backup mocks base method.
-func (m *MockStorage) Backup(destination string) error {
+// DeleteGoal mocks base method.
+func (m *MockStorage) DeleteGoal(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Backup", destination)
+	ret := m.ctrl.Call(m, "DeleteGoal", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Backup indicates an expected call of Backup.
-func (mr *MockStorageMockRecorder) Backup(destination interface{}) *gomock.Call {
+// DeleteGoal indicates an expected call of DeleteGoal.
+func (mr *MockStorageMockRecorder) DeleteGoal(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockStorage)(nil).Backup), destination)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGoal", reflect.TypeOf((*MockStorage)(nil).DeleteGoal), arg0, arg1)
 }
 
-// ClearDuplicateRelationships mocks base method.
-func (m *MockStorage) ClearDuplicateRelationships(familyID uuid.UUID, transactionID string) error {
+// DeleteImage mocks base method.
+func (m *MockStorage) DeleteImage(arg0 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ClearDuplicateRelationships", familyID, transactionID)
+	ret := m.ctrl.Call(m, "DeleteImage", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ClearDuplicateRelationships indicates an expected call of ClearDuplicateRelationships.
-func (mr *MockStorageMockRecorder) ClearDuplicateRelationships(familyID, transactionID interface{}) *gomock.Call {
+// DeleteImage indicates an expected call of DeleteImage.
+func (mr *MockStorageMockRecorder) DeleteImage(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearDuplicateRelationships", reflect.TypeOf((*MockStorage)(nil).ClearDuplicateRelationships), familyID, transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockStorage)(nil).DeleteImage), arg0)
 }
 
-// Close mocks base method.
-func (m *MockStorage) Close() error {
+// DeleteMatcher mocks base method.
+func (m *MockStorage) DeleteMatcher(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Close")
+	ret := m.ctrl.Call(m, "DeleteMatcher", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Close indicates an expected call of Close.
-func (mr *MockStorageMockRecorder) Close() *gomock.Call {
+// DeleteMatcher indicates an expected call of DeleteMatcher.
+func (mr *MockStorageMockRecorder) DeleteMatcher(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStorage)(nil).Close))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMatcher", reflect.TypeOf((*MockStorage)(nil).DeleteMatcher), arg0, arg1)
 }
 
-// CountUnprocessedTransactionsForAccount mocks base method.
-func (m *MockStorage) CountUnprocessedTransactionsForAccount(familyID uuid.UUID, accountID string, ignoreUnprocessedBefore time.Time) (int, error) {
+// DeleteNotification mocks base method.
+func (m *MockStorage) DeleteNotification(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountUnprocessedTransactionsForAccount", familyID, accountID, ignoreUnprocessedBefore)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteNotification", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CountUnprocessedTransactionsForAccount indicates an expected call of CountUnprocessedTransactionsForAccount.
-func (mr *MockStorageMockRecorder) CountUnprocessedTransactionsForAccount(familyID, accountID, ignoreUnprocessedBefore interface{}) *gomock.Call {
+// DeleteNotification indicates an expected call of DeleteNotification.
+func (mr *MockStorageMockRecorder) DeleteNotification(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnprocessedTransactionsForAccount", reflect.TypeOf((*MockStorage)(nil).CountUnprocessedTransactionsForAccount), familyID, accountID, ignoreUnprocessedBefore)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotification", reflect.TypeOf((*MockStorage)(nil).DeleteNotification), arg0, arg1)
 }
 
-// CreateAccount mocks base method.
-func (m *MockStorage) CreateAccount(familyID uuid.UUID, account *goserver.AccountNoId) (goserver.Account, error) {
+// DeleteSecurity mocks base method.
+func (m *MockStorage) DeleteSecurity(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccount", familyID, account)
-	ret0, _ := ret[0].(goserver.Account)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteSecurity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateAccount indicates an expected call of CreateAccount.
-func (mr *MockStorageMockRecorder) CreateAccount(familyID, account interface{}) *gomock.Call {
+// DeleteSecurity indicates an expected call of DeleteSecurity.
+func (mr *MockStorageMockRecorder) DeleteSecurity(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStorage)(nil).CreateAccount), familyID, account)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurity", reflect.TypeOf((*MockStorage)(nil).DeleteSecurity), arg0, arg1)
 }
 
-// CreateBankImporter mocks base method.
-func (m *MockStorage) CreateBankImporter(familyID uuid.UUID, bankImporter *goserver.BankImporterNoId) (goserver.BankImporter, error) {
+// DeleteTemplate mocks base method.
+func (m *MockStorage) DeleteTemplate(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBankImporter", familyID, bankImporter)
-	ret0, _ := ret[0].(goserver.BankImporter)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteTemplate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateBankImporter indicates an expected call of CreateBankImporter.
-func (mr *MockStorageMockRecorder) CreateBankImporter(familyID, bankImporter interface{}) *gomock.Call {
+// DeleteTemplate indicates an expected call of DeleteTemplate.
+func (mr *MockStorageMockRecorder) DeleteTemplate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBankImporter", reflect.TypeOf((*MockStorage)(nil).CreateBankImporter), familyID, bankImporter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockStorage)(nil).DeleteTemplate), arg0, arg1)
 }
 
-// CreateBankImporterFile mocks base method.
-func (m *MockStorage) CreateBankImporterFile(familyID uuid.UUID, file *models.BankImporterFile) (goserver.BankImporterFile, error) {
+// DeleteTransaction mocks base method.
+func (m *MockStorage) DeleteTransaction(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBankImporterFile", familyID, file)
-	ret0, _ := ret[0].(goserver.BankImporterFile)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteTransaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateBankImporterFile indicates an expected call of CreateBankImporterFile.
-func (mr *MockStorageMockRecorder) CreateBankImporterFile(familyID, file interface{}) *gomock.Call {
+// DeleteTransaction indicates an expected call of DeleteTransaction.
+func (mr *MockStorageMockRecorder) DeleteTransaction(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBankImporterFile", reflect.TypeOf((*MockStorage)(nil).CreateBankImporterFile), familyID, file)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MockStorage)(nil).DeleteTransaction), arg0, arg1)
 }
 
-// CreateBudgetItem mocks base method.
-func (m *MockStorage) CreateBudgetItem(familyID uuid.UUID, budgetItem *goserver.BudgetItemNoId) (goserver.BudgetItem, error) {
+// DeleteTransferRule mocks base method.
+func (m *MockStorage) DeleteTransferRule(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBudgetItem", familyID, budgetItem)
-	ret0, _ := ret[0].(goserver.BudgetItem)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteTransferRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateBudgetItem indicates an expected call of CreateBudgetItem.
-func (mr *MockStorageMockRecorder) CreateBudgetItem(familyID, budgetItem interface{}) *gomock.Call {
+// DeleteTransferRule indicates an expected call of DeleteTransferRule.
+func (mr *MockStorageMockRecorder) DeleteTransferRule(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBudgetItem", reflect.TypeOf((*MockStorage)(nil).CreateBudgetItem), familyID, budgetItem)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransferRule", reflect.TypeOf((*MockStorage)(nil).DeleteTransferRule), arg0, arg1)
 }
 
-// CreateCurrency mocks base method.
-func (m *MockStorage) CreateCurrency(familyID uuid.UUID, currency *goserver.CurrencyNoId) (goserver.Currency, error) {
+// GetAccount mocks base method.
+func (m *MockStorage) GetAccount(arg0 uuid.UUID, arg1 string) (goserver.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCurrency", familyID, currency)
-	ret0, _ := ret[0].(goserver.Currency)
+	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
+	ret0, _ := ret[0].(goserver.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateCurrency indicates an expected call of CreateCurrency.
-func (mr *MockStorageMockRecorder) CreateCurrency(familyID, currency interface{}) *gomock.Call {
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockStorageMockRecorder) GetAccount(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCurrency", reflect.TypeOf((*MockStorage)(nil).CreateCurrency), familyID, currency)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStorage)(nil).GetAccount), arg0, arg1)
 }
 
-// CreateFamily mocks base method.
-func (m *MockStorage) CreateFamily(name string) (*models.Family, error) {
+// GetAccountBalance mocks base method.
+func (m *MockStorage) GetAccountBalance(arg0 uuid.UUID, arg1, arg2 string) (decimal.Decimal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateFamily", name)
-	ret0, _ := ret[0].(*models.Family)
+	ret := m.ctrl.Call(m, "GetAccountBalance", arg0, arg1, arg2)
+	ret0, _ := ret[0].(decimal.Decimal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateFamily indicates an expected call of CreateFamily.
-func (mr *MockStorageMockRecorder) CreateFamily(name interface{}) *gomock.Call {
+// GetAccountBalance indicates an expected call of GetAccountBalance.
+func (mr *MockStorageMockRecorder) GetAccountBalance(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFamily", reflect.TypeOf((*MockStorage)(nil).CreateFamily), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalance", reflect.TypeOf((*MockStorage)(nil).GetAccountBalance), arg0, arg1, arg2)
 }
 
-// CreateImage mocks base method.
-func (m *MockStorage) CreateImage(data []byte, contentType string) (models.Image, error) {
+// GetAccountHistory mocks base method.
+func (m *MockStorage) GetAccountHistory(arg0 uuid.UUID, arg1 string) ([]goserver.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateImage", data, contentType)
-	ret0, _ := ret[0].(models.Image)
+	ret := m.ctrl.Call(m, "GetAccountHistory", arg0, arg1)
+	ret0, _ := ret[0].([]goserver.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateImage indicates an expected call of CreateImage.
-func (mr *MockStorageMockRecorder) CreateImage(data, contentType interface{}) *gomock.Call {
+// GetAccountHistory indicates an expected call of GetAccountHistory.
+func (mr *MockStorageMockRecorder) GetAccountHistory(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImage", reflect.TypeOf((*MockStorage)(nil).CreateImage), data, contentType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountHistory", reflect.TypeOf((*MockStorage)(nil).GetAccountHistory), arg0, arg1)
 }
 
-// CreateMatcher mocks base method.
-func (m *MockStorage) CreateMatcher(familyID uuid.UUID, matcher goserver.MatcherNoIdInterface) (goserver.Matcher, error) {
+// GetAccounts mocks base method.
+func (m *MockStorage) GetAccounts(arg0 uuid.UUID) ([]goserver.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateMatcher", familyID, matcher)
-	ret0, _ := ret[0].(goserver.Matcher)
+	ret := m.ctrl.Call(m, "GetAccounts", arg0)
+	ret0, _ := ret[0].([]goserver.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateMatcher indicates an expected call of CreateMatcher.
-func (mr *MockStorageMockRecorder) CreateMatcher(familyID, matcher interface{}) *gomock.Call {
+// GetAccounts indicates an expected call of GetAccounts.
+func (mr *MockStorageMockRecorder) GetAccounts(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMatcher", reflect.TypeOf((*MockStorage)(nil).CreateMatcher), familyID, matcher)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounts", reflect.TypeOf((*MockStorage)(nil).GetAccounts), arg0)
 }
 
-// CreateMatcherRuntimeFromNoId mocks base method.
-func (m_2 *MockStorage) CreateMatcherRuntimeFromNoId(m goserver.MatcherNoIdInterface) (database.MatcherRuntime, error) {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "CreateMatcherRuntimeFromNoId", m)
-	ret0, _ := ret[0].(database.MatcherRuntime)
+// GetAllBankImporters mocks base method.
+func (m *MockStorage) GetAllBankImporters() ([]database.ImportInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllBankImporters")
+	ret0, _ := ret[0].([]database.ImportInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateMatcherRuntimeFromNoId indicates an expected call of CreateMatcherRuntimeFromNoId.
-func (mr *MockStorageMockRecorder) CreateMatcherRuntimeFromNoId(m interface{}) *gomock.Call {
+// GetAllBankImporters indicates an expected call of GetAllBankImporters.
+func (mr *MockStorageMockRecorder) GetAllBankImporters() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMatcherRuntimeFromNoId", reflect.TypeOf((*MockStorage)(nil).CreateMatcherRuntimeFromNoId), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBankImporters", reflect.TypeOf((*MockStorage)(nil).GetAllBankImporters))
 }
 
-// CreateNotification mocks base method.
-func (m *MockStorage) CreateNotification(familyID uuid.UUID, notification *goserver.Notification) (goserver.Notification, error) {
+// GetAllFamilyIDs mocks base method.
+func (m *MockStorage) GetAllFamilyIDs() ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNotification", familyID, notification)
-	ret0, _ := ret[0].(goserver.Notification)
+	ret := m.ctrl.Call(m, "GetAllFamilyIDs")
+	ret0, _ := ret[0].([]uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateNotification indicates an expected call of CreateNotification.
-func (mr *MockStorageMockRecorder) CreateNotification(familyID, notification interface{}) *gomock.Call {
+// GetAllFamilyIDs indicates an expected call of GetAllFamilyIDs.
+func (mr *MockStorageMockRecorder) GetAllFamilyIDs() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockStorage)(nil).CreateNotification), familyID, notification)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFamilyIDs", reflect.TypeOf((*MockStorage)(nil).GetAllFamilyIDs))
 }
 
-// CreateReconciliation mocks base method.
-func (m *MockStorage) CreateReconciliation(familyID uuid.UUID, rec *goserver.ReconciliationNoId) (goserver.Reconciliation, error) {
+// GetAuditLogs mocks base method.
+func (m *MockStorage) GetAuditLogs(arg0 uuid.UUID, arg1 database.AuditLogFilter) ([]models.AuditLog, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateReconciliation", familyID, rec)
-	ret0, _ := ret[0].(goserver.Reconciliation)
+	ret := m.ctrl.Call(m, "GetAuditLogs", arg0, arg1)
+	ret0, _ := ret[0].([]models.AuditLog)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateReconciliation indicates an expected call of CreateReconciliation.
-func (mr *MockStorageMockRecorder) CreateReconciliation(familyID, rec interface{}) *gomock.Call {
+// GetAuditLogs indicates an expected call of GetAuditLogs.
+func (mr *MockStorageMockRecorder) GetAuditLogs(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReconciliation", reflect.TypeOf((*MockStorage)(nil).CreateReconciliation), familyID, rec)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogs", reflect.TypeOf((*MockStorage)(nil).GetAuditLogs), arg0, arg1)
 }
 
-// CreateTemplate mocks base method.
-func (m *MockStorage) CreateTemplate(familyID uuid.UUID, t *goserver.TransactionTemplateNoId) (goserver.TransactionTemplate, error) {
+// GetBankImporter mocks base method.
+func (m *MockStorage) GetBankImporter(arg0 uuid.UUID, arg1 string) (goserver.BankImporter, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTemplate", familyID, t)
-	ret0, _ := ret[0].(goserver.TransactionTemplate)
+	ret := m.ctrl.Call(m, "GetBankImporter", arg0, arg1)
+	ret0, _ := ret[0].(goserver.BankImporter)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTemplate indicates an expected call of CreateTemplate.
-func (mr *MockStorageMockRecorder) CreateTemplate(familyID, t interface{}) *gomock.Call {
+// GetBankImporter indicates an expected call of GetBankImporter.
+func (mr *MockStorageMockRecorder) GetBankImporter(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockStorage)(nil).CreateTemplate), familyID, t)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankImporter", reflect.TypeOf((*MockStorage)(nil).GetBankImporter), arg0, arg1)
 }
 
-// CreateTransaction mocks base method.
-func (m *MockStorage) CreateTransaction(familyID uuid.UUID, transaction goserver.TransactionNoIdInterface) (goserver.Transaction, error) {
+// GetBankImporterFile mocks base method.
+func (m *MockStorage) GetBankImporterFile(arg0 uuid.UUID, arg1 string) (models.BankImporterFile, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransaction", familyID, transaction)
-	ret0, _ := ret[0].(goserver.Transaction)
+	ret := m.ctrl.Call(m, "GetBankImporterFile", arg0, arg1)
+	ret0, _ := ret[0].(models.BankImporterFile)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTransaction indicates an expected call of CreateTransaction.
-func (mr *MockStorageMockRecorder) CreateTransaction(familyID, transaction interface{}) *gomock.Call {
+// GetBankImporterFile indicates an expected call of GetBankImporterFile.
+func (mr *MockStorageMockRecorder) GetBankImporterFile(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockStorage)(nil).CreateTransaction), familyID, transaction)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankImporterFile", reflect.TypeOf((*MockStorage)(nil).GetBankImporterFile), arg0, arg1)
 }
 
-// CreateTransactionsBatch mocks base method.
-func (m *MockStorage) CreateTransactionsBatch(familyID uuid.UUID, transactions []goserver.TransactionNoIdInterface) ([]goserver.Transaction, error) {
+// GetBankImporterFiles mocks base method.
+func (m *MockStorage) GetBankImporterFiles(arg0 uuid.UUID) ([]goserver.BankImporterFile, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransactionsBatch", familyID, transactions)
-	ret0, _ := ret[0].([]goserver.Transaction)
+	ret := m.ctrl.Call(m, "GetBankImporterFiles", arg0)
+	ret0, _ := ret[0].([]goserver.BankImporterFile)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTransactionsBatch indicates an expected call of CreateTransactionsBatch.
-func (mr *MockStorageMockRecorder) CreateTransactionsBatch(familyID, transactions interface{}) *gomock.Call {
+// GetBankImporterFiles indicates an expected call of GetBankImporterFiles.
+func (mr *MockStorageMockRecorder) GetBankImporterFiles(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionsBatch", reflect.TypeOf((*MockStorage)(nil).CreateTransactionsBatch), familyID, transactions)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankImporterFiles", reflect.TypeOf((*MockStorage)(nil).GetBankImporterFiles), arg0)
 }
 
-// CreateUser mocks base method.
-func (m *MockStorage) CreateUser(username, passwordHash string, familyID uuid.UUID) (*models.User, error) {
+// GetBankImporters mocks base method.
+func (m *MockStorage) GetBankImporters(arg0 uuid.UUID) ([]goserver.BankImporter, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", username, passwordHash, familyID)
-	ret0, _ := ret[0].(*models.User)
+	ret := m.ctrl.Call(m, "GetBankImporters", arg0)
+	ret0, _ := ret[0].([]goserver.BankImporter)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockStorageMockRecorder) CreateUser(username, passwordHash, familyID interface{}) *gomock.Call {
+// GetBankImporters indicates an expected call of GetBankImporters.
+func (mr *MockStorageMockRecorder) GetBankImporters(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStorage)(nil).CreateUser), username, passwordHash, familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankImporters", reflect.TypeOf((*MockStorage)(nil).GetBankImporters), arg0)
 }
 
-// DeleteAccount mocks base method.
-func (m *MockStorage) DeleteAccount(familyID uuid.UUID, id string, replaceWithAccountID *string) error {
+// GetBudgetItem mocks base method.
+func (m *MockStorage) GetBudgetItem(arg0 uuid.UUID, arg1 string) (goserver.BudgetItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccount", familyID, id, replaceWithAccountID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetBudgetItem", arg0, arg1)
+	ret0, _ := ret[0].(goserver.BudgetItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteAccount indicates an expected call of DeleteAccount.
-func (mr *MockStorageMockRecorder) DeleteAccount(familyID, id, replaceWithAccountID interface{}) *gomock.Call {
+// GetBudgetItem indicates an expected call of GetBudgetItem.
+func (mr *MockStorageMockRecorder) GetBudgetItem(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStorage)(nil).DeleteAccount), familyID, id, replaceWithAccountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetItem", reflect.TypeOf((*MockStorage)(nil).GetBudgetItem), arg0, arg1)
 }
 
-// DeleteBankImporter mocks base method.
-func (m *MockStorage) DeleteBankImporter(familyID uuid.UUID, id string) error {
+// GetBudgetItems mocks base method.
+func (m *MockStorage) GetBudgetItems(arg0 uuid.UUID) ([]goserver.BudgetItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBankImporter", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetBudgetItems", arg0)
+	ret0, _ := ret[0].([]goserver.BudgetItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteBankImporter indicates an expected call of DeleteBankImporter.
-func (mr *MockStorageMockRecorder) DeleteBankImporter(familyID, id interface{}) *gomock.Call {
+// GetBudgetItems indicates an expected call of GetBudgetItems.
+func (mr *MockStorageMockRecorder) GetBudgetItems(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBankImporter", reflect.TypeOf((*MockStorage)(nil).DeleteBankImporter), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetItems", reflect.TypeOf((*MockStorage)(nil).GetBudgetItems), arg0)
 }
 
-// DeleteBankImporterFile mocks base method.
-func (m *MockStorage) DeleteBankImporterFile(familyID uuid.UUID, id string) error {
+// GetBudgetPlan mocks base method.
+func (m *MockStorage) GetBudgetPlan(arg0 uuid.UUID, arg1 string) (goserver.BudgetPlan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBankImporterFile", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetBudgetPlan", arg0, arg1)
+	ret0, _ := ret[0].(goserver.BudgetPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteBankImporterFile indicates an expected call of DeleteBankImporterFile.
-func (mr *MockStorageMockRecorder) DeleteBankImporterFile(familyID, id interface{}) *gomock.Call {
+// GetBudgetPlan indicates an expected call of GetBudgetPlan.
+func (mr *MockStorageMockRecorder) GetBudgetPlan(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBankImporterFile", reflect.TypeOf((*MockStorage)(nil).DeleteBankImporterFile), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetPlan", reflect.TypeOf((*MockStorage)(nil).GetBudgetPlan), arg0, arg1)
 }
 
-// DeleteBudgetItem mocks base method.
-func (m *MockStorage) DeleteBudgetItem(familyID uuid.UUID, id string) error {
+// GetBudgetPlans mocks base method.
+func (m *MockStorage) GetBudgetPlans(arg0 uuid.UUID) ([]goserver.BudgetPlan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBudgetItem", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetBudgetPlans", arg0)
+	ret0, _ := ret[0].([]goserver.BudgetPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteBudgetItem indicates an expected call of DeleteBudgetItem.
-func (mr *MockStorageMockRecorder) DeleteBudgetItem(familyID, id interface{}) *gomock.Call {
+// GetBudgetPlans indicates an expected call of GetBudgetPlans.
+func (mr *MockStorageMockRecorder) GetBudgetPlans(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudgetItem", reflect.TypeOf((*MockStorage)(nil).DeleteBudgetItem), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetPlans", reflect.TypeOf((*MockStorage)(nil).GetBudgetPlans), arg0)
 }
 
-// DeleteCurrency mocks base method.
-func (m *MockStorage) DeleteCurrency(familyID uuid.UUID, id string, replaceWithCurrencyID *string) error {
+// GetBudgetTransfer mocks base method.
+func (m *MockStorage) GetBudgetTransfer(arg0 uuid.UUID, arg1 string) (goserver.BudgetTransfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCurrency", familyID, id, replaceWithCurrencyID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetBudgetTransfer", arg0, arg1)
+	ret0, _ := ret[0].(goserver.BudgetTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteCurrency indicates an expected call of DeleteCurrency.
-func (mr *MockStorageMockRecorder) DeleteCurrency(familyID, id, replaceWithCurrencyID interface{}) *gomock.Call {
+// GetBudgetTransfer indicates an expected call of GetBudgetTransfer.
+func (mr *MockStorageMockRecorder) GetBudgetTransfer(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCurrency", reflect.TypeOf((*MockStorage)(nil).DeleteCurrency), familyID, id, replaceWithCurrencyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetTransfer", reflect.TypeOf((*MockStorage)(nil).GetBudgetTransfer), arg0, arg1)
 }
 
-// DeleteImage mocks base method.
-func (m *MockStorage) DeleteImage(id string) error {
+// GetBudgetTransfers mocks base method.
+func (m *MockStorage) GetBudgetTransfers(arg0 uuid.UUID) ([]goserver.BudgetTransfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteImage", id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetBudgetTransfers", arg0)
+	ret0, _ := ret[0].([]goserver.BudgetTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteImage indicates an expected call of DeleteImage.
-func (mr *MockStorageMockRecorder) DeleteImage(id interface{}) *gomock.Call {
+// GetBudgetTransfers indicates an expected call of GetBudgetTransfers.
+func (mr *MockStorageMockRecorder) GetBudgetTransfers(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockStorage)(nil).DeleteImage), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetTransfers", reflect.TypeOf((*MockStorage)(nil).GetBudgetTransfers), arg0)
 }
 
-// DeleteMatcher mocks base method.
-func (m *MockStorage) DeleteMatcher(familyID uuid.UUID, id string) error {
+// GetBulkReconciliationData mocks base method.
+func (m *MockStorage) GetBulkReconciliationData(arg0 uuid.UUID) (*database.BulkReconciliationData, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMatcher", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetBulkReconciliationData", arg0)
+	ret0, _ := ret[0].(*database.BulkReconciliationData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteMatcher indicates an expected call of DeleteMatcher.
-func (mr *MockStorageMockRecorder) DeleteMatcher(familyID, id interface{}) *gomock.Call {
+// GetBulkReconciliationData indicates an expected call of GetBulkReconciliationData.
+func (mr *MockStorageMockRecorder) GetBulkReconciliationData(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMatcher", reflect.TypeOf((*MockStorage)(nil).DeleteMatcher), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBulkReconciliationData", reflect.TypeOf((*MockStorage)(nil).GetBulkReconciliationData), arg0)
 }
 
-// DeleteNotification mocks base method.
-func (m *MockStorage) DeleteNotification(familyID uuid.UUID, id string) error {
+// GetCNBRateDates mocks base method.
+func (m *MockStorage) GetCNBRateDates(arg0, arg1 time.Time) ([]time.Time, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNotification", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetCNBRateDates", arg0, arg1)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteNotification indicates an expected call of DeleteNotification.
-func (mr *MockStorageMockRecorder) DeleteNotification(familyID, id interface{}) *gomock.Call {
+// GetCNBRateDates indicates an expected call of GetCNBRateDates.
+func (mr *MockStorageMockRecorder) GetCNBRateDates(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotification", reflect.TypeOf((*MockStorage)(nil).DeleteNotification), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCNBRateDates", reflect.TypeOf((*MockStorage)(nil).GetCNBRateDates), arg0, arg1)
 }
 
-// DeleteTemplate mocks base method.
-func (m *MockStorage) DeleteTemplate(familyID uuid.UUID, id string) error {
+// GetCNBRates mocks base method.
+func (m *MockStorage) GetCNBRates(arg0 time.Time) (map[string]decimal.Decimal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTemplate", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetCNBRates", arg0)
+	ret0, _ := ret[0].(map[string]decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTemplate indicates an expected call of DeleteTemplate.
-func (mr *MockStorageMockRecorder) DeleteTemplate(familyID, id interface{}) *gomock.Call {
+// GetCNBRates indicates an expected call of GetCNBRates.
+func (mr *MockStorageMockRecorder) GetCNBRates(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockStorage)(nil).DeleteTemplate), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCNBRates", reflect.TypeOf((*MockStorage)(nil).GetCNBRates), arg0)
 }
 
-// DeleteTransaction mocks base method.
-func (m *MockStorage) DeleteTransaction(familyID uuid.UUID, id string) error {
+// GetCNBRatesHistory mocks base method.
+func (m *MockStorage) GetCNBRatesHistory(arg0 string, arg1, arg2 time.Time) ([]models.CNBCurrencyRate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTransaction", familyID, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetCNBRatesHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.CNBCurrencyRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTransaction indicates an expected call of DeleteTransaction.
-func (mr *MockStorageMockRecorder) DeleteTransaction(familyID, id interface{}) *gomock.Call {
+// GetCNBRatesHistory indicates an expected call of GetCNBRatesHistory.
+func (mr *MockStorageMockRecorder) GetCNBRatesHistory(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MockStorage)(nil).DeleteTransaction), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCNBRatesHistory", reflect.TypeOf((*MockStorage)(nil).GetCNBRatesHistory), arg0, arg1, arg2)
 }
 
-// GetAccount mocks base method.
-func (m *MockStorage) GetAccount(familyID uuid.UUID, id string) (goserver.Account, error) {
+// GetCurrencies mocks base method.
+func (m *MockStorage) GetCurrencies(arg0 uuid.UUID) ([]goserver.Currency, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccount", familyID, id)
-	ret0, _ := ret[0].(goserver.Account)
+	ret := m.ctrl.Call(m, "GetCurrencies", arg0)
+	ret0, _ := ret[0].([]goserver.Currency)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccount indicates an expected call of GetAccount.
-func (mr *MockStorageMockRecorder) GetAccount(familyID, id interface{}) *gomock.Call {
+// GetCurrencies indicates an expected call of GetCurrencies.
+func (mr *MockStorageMockRecorder) GetCurrencies(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStorage)(nil).GetAccount), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrencies", reflect.TypeOf((*MockStorage)(nil).GetCurrencies), arg0)
 }
 
-// GetAccountBalance mocks base method.
-func (m *MockStorage) GetAccountBalance(familyID uuid.UUID, accountID, currencyID string) (decimal.Decimal, error) {
+// GetCurrency mocks base method.
+func (m *MockStorage) GetCurrency(arg0 uuid.UUID, arg1 string) (goserver.Currency, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountBalance", familyID, accountID, currencyID)
-	ret0, _ := ret[0].(decimal.Decimal)
+	ret := m.ctrl.Call(m, "GetCurrency", arg0, arg1)
+	ret0, _ := ret[0].(goserver.Currency)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountBalance indicates an expected call of GetAccountBalance.
-func (mr *MockStorageMockRecorder) GetAccountBalance(familyID, accountID, currencyID interface{}) *gomock.Call {
+// GetCurrency indicates an expected call of GetCurrency.
+func (mr *MockStorageMockRecorder) GetCurrency(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalance", reflect.TypeOf((*MockStorage)(nil).GetAccountBalance), familyID, accountID, currencyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrency", reflect.TypeOf((*MockStorage)(nil).GetCurrency), arg0, arg1)
 }
 
-// GetAccountHistory mocks base method.
-func (m *MockStorage) GetAccountHistory(familyID uuid.UUID, accountID string) ([]goserver.Transaction, error) {
+// GetDB mocks base method.
+func (m *MockStorage) GetDB() *gorm.DB {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountHistory", familyID, accountID)
-	ret0, _ := ret[0].([]goserver.Transaction)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "GetDB")
+	ret0, _ := ret[0].(*gorm.DB)
+	return ret0
 }
 
-// GetAccountHistory indicates an expected call of GetAccountHistory.
-func (mr *MockStorageMockRecorder) GetAccountHistory(familyID, accountID interface{}) *gomock.Call {
+// GetDB indicates an expected call of GetDB.
+func (mr *MockStorageMockRecorder) GetDB() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountHistory", reflect.TypeOf((*MockStorage)(nil).GetAccountHistory), familyID, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDB", reflect.TypeOf((*MockStorage)(nil).GetDB))
 }
 
-// GetAccounts mocks base method.
-func (m *MockStorage) GetAccounts(familyID uuid.UUID) ([]goserver.Account, error) {
+// GetDuplicateTransactionIDs mocks base method.
+func (m *MockStorage) GetDuplicateTransactionIDs(arg0 uuid.UUID, arg1 string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccounts", familyID)
-	ret0, _ := ret[0].([]goserver.Account)
+	ret := m.ctrl.Call(m, "GetDuplicateTransactionIDs", arg0, arg1)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccounts indicates an expected call of GetAccounts.
-func (mr *MockStorageMockRecorder) GetAccounts(familyID interface{}) *gomock.Call {
+// GetDuplicateTransactionIDs indicates an expected call of GetDuplicateTransactionIDs.
+func (mr *MockStorageMockRecorder) GetDuplicateTransactionIDs(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounts", reflect.TypeOf((*MockStorage)(nil).GetAccounts), familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDuplicateTransactionIDs", reflect.TypeOf((*MockStorage)(nil).GetDuplicateTransactionIDs), arg0, arg1)
 }
 
-// GetAllBankImporters mocks base method.
-func (m *MockStorage) GetAllBankImporters() ([]database.ImportInfo, error) {
+// GetExchangeRate mocks base method.
+func (m *MockStorage) GetExchangeRate(arg0 uuid.UUID, arg1 string) (goserver.ExchangeRate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllBankImporters")
-	ret0, _ := ret[0].([]database.ImportInfo)
+	ret := m.ctrl.Call(m, "GetExchangeRate", arg0, arg1)
+	ret0, _ := ret[0].(goserver.ExchangeRate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllBankImporters indicates an expected call of GetAllBankImporters.
-func (mr *MockStorageMockRecorder) GetAllBankImporters() *gomock.Call {
+// GetExchangeRate indicates an expected call of GetExchangeRate.
+func (mr *MockStorageMockRecorder) GetExchangeRate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBankImporters", reflect.TypeOf((*MockStorage)(nil).GetAllBankImporters))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRate", reflect.TypeOf((*MockStorage)(nil).GetExchangeRate), arg0, arg1)
 }
 
-// GetAllFamilyIDs mocks base method.
-func (m *MockStorage) GetAllFamilyIDs() ([]uuid.UUID, error) {
+// GetExchangeRates mocks base method.
+func (m *MockStorage) GetExchangeRates(arg0 uuid.UUID) ([]goserver.ExchangeRate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllFamilyIDs")
-	ret0, _ := ret[0].([]uuid.UUID)
+	ret := m.ctrl.Call(m, "GetExchangeRates", arg0)
+	ret0, _ := ret[0].([]goserver.ExchangeRate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllFamilyIDs indicates an expected call of GetAllFamilyIDs.
-func (mr *MockStorageMockRecorder) GetAllFamilyIDs() *gomock.Call {
+// GetExchangeRates indicates an expected call of GetExchangeRates.
+func (mr *MockStorageMockRecorder) GetExchangeRates(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFamilyIDs", reflect.TypeOf((*MockStorage)(nil).GetAllFamilyIDs))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRates", reflect.TypeOf((*MockStorage)(nil).GetExchangeRates), arg0)
 }
 
-// GetAuditLogs mocks base method.
-func (m *MockStorage) GetAuditLogs(familyID uuid.UUID, filter database.AuditLogFilter) ([]models.AuditLog, error) {
+// GetExchangeTransactions mocks base method.
+func (m *MockStorage) GetExchangeTransactions(arg0 uuid.UUID, arg1, arg2 time.Time) ([]goserver.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAuditLogs", familyID, filter)
-	ret0, _ := ret[0].([]models.AuditLog)
+	ret := m.ctrl.Call(m, "GetExchangeTransactions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]goserver.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAuditLogs indicates an expected call of GetAuditLogs.
-func (mr *MockStorageMockRecorder) GetAuditLogs(familyID, filter interface{}) *gomock.Call {
+// GetExchangeTransactions indicates an expected call of GetExchangeTransactions.
+func (mr *MockStorageMockRecorder) GetExchangeTransactions(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogs", reflect.TypeOf((*MockStorage)(nil).GetAuditLogs), familyID, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeTransactions", reflect.TypeOf((*MockStorage)(nil).GetExchangeTransactions), arg0, arg1, arg2)
 }
 
-// GetBankImporter mocks base method.
-func (m *MockStorage) GetBankImporter(familyID uuid.UUID, id string) (goserver.BankImporter, error) {
+// GetFamily mocks base method.
+func (m *MockStorage) GetFamily(arg0 uuid.UUID) (*models.Family, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBankImporter", familyID, id)
-	ret0, _ := ret[0].(goserver.BankImporter)
+	ret := m.ctrl.Call(m, "GetFamily", arg0)
+	ret0, _ := ret[0].(*models.Family)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBankImporter indicates an expected call of GetBankImporter.
-func (mr *MockStorageMockRecorder) GetBankImporter(familyID, id interface{}) *gomock.Call {
+// GetFamily indicates an expected call of GetFamily.
+func (mr *MockStorageMockRecorder) GetFamily(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankImporter", reflect.TypeOf((*MockStorage)(nil).GetBankImporter), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFamily", reflect.TypeOf((*MockStorage)(nil).GetFamily), arg0)
 }
 
-// GetBankImporterFile mocks base method.
-func (m *MockStorage) GetBankImporterFile(familyID uuid.UUID, id string) (models.BankImporterFile, error) {
+// GetFamilyByName mocks base method.
+func (m *MockStorage) GetFamilyByName(arg0 string) (*models.Family, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBankImporterFile", familyID, id)
-	ret0, _ := ret[0].(models.BankImporterFile)
+	ret := m.ctrl.Call(m, "GetFamilyByName", arg0)
+	ret0, _ := ret[0].(*models.Family)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBankImporterFile indicates an expected call of GetBankImporterFile.
-func (mr *MockStorageMockRecorder) GetBankImporterFile(familyID, id interface{}) *gomock.Call {
+// GetFamilyByName indicates an expected call of GetFamilyByName.
+func (mr *MockStorageMockRecorder) GetFamilyByName(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankImporterFile", reflect.TypeOf((*MockStorage)(nil).GetBankImporterFile), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFamilyByName", reflect.TypeOf((*MockStorage)(nil).GetFamilyByName), arg0)
 }
 
-// GetBankImporterFiles mocks base method.
-func (m *MockStorage) GetBankImporterFiles(familyID uuid.UUID) ([]goserver.BankImporterFile, error) {
+// GetGoal mocks base method.
+func (m *MockStorage) GetGoal(arg0 uuid.UUID, arg1 string) (goserver.Goal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBankImporterFiles", familyID)
-	ret0, _ := ret[0].([]goserver.BankImporterFile)
+	ret := m.ctrl.Call(m, "GetGoal", arg0, arg1)
+	ret0, _ := ret[0].(goserver.Goal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBankImporterFiles indicates an expected call of GetBankImporterFiles.
-func (mr *MockStorageMockRecorder) GetBankImporterFiles(familyID interface{}) *gomock.Call {
+// GetGoal indicates an expected call of GetGoal.
+func (mr *MockStorageMockRecorder) GetGoal(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankImporterFiles", reflect.TypeOf((*MockStorage)(nil).GetBankImporterFiles), familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoal", reflect.TypeOf((*MockStorage)(nil).GetGoal), arg0, arg1)
 }
 
-// GetBankImporters mocks base method.
-func (m *MockStorage) GetBankImporters(familyID uuid.UUID) ([]goserver.BankImporter, error) {
+// GetGoals mocks base method.
+func (m *MockStorage) GetGoals(arg0 uuid.UUID) ([]goserver.Goal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBankImporters", familyID)
-	ret0, _ := ret[0].([]goserver.BankImporter)
+	ret := m.ctrl.Call(m, "GetGoals", arg0)
+	ret0, _ := ret[0].([]goserver.Goal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBankImporters indicates an expected call of GetBankImporters.
-func (mr *MockStorageMockRecorder) GetBankImporters(familyID interface{}) *gomock.Call {
+// GetGoals indicates an expected call of GetGoals.
+func (mr *MockStorageMockRecorder) GetGoals(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankImporters", reflect.TypeOf((*MockStorage)(nil).GetBankImporters), familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoals", reflect.TypeOf((*MockStorage)(nil).GetGoals), arg0)
 }
 
-// GetBudgetItem mocks base method.
-func (m *MockStorage) GetBudgetItem(familyID uuid.UUID, id string) (goserver.BudgetItem, error) {
+// GetImage mocks base method.
+func (m *MockStorage) GetImage(arg0 string) (models.Image, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBudgetItem", familyID, id)
-	ret0, _ := ret[0].(goserver.BudgetItem)
+	ret := m.ctrl.Call(m, "GetImage", arg0)
+	ret0, _ := ret[0].(models.Image)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBudgetItem indicates an expected call of GetBudgetItem.
-func (mr *MockStorageMockRecorder) GetBudgetItem(familyID, id interface{}) *gomock.Call {
+// GetImage indicates an expected call of GetImage.
+func (mr *MockStorageMockRecorder) GetImage(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetItem", reflect.TypeOf((*MockStorage)(nil).GetBudgetItem), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockStorage)(nil).GetImage), arg0)
 }
 
-// GetBudgetItems mocks base method.
-func (m *MockStorage) GetBudgetItems(familyID uuid.UUID) ([]goserver.BudgetItem, error) {
+// GetLatestReconciliation mocks base method.
+func (m *MockStorage) GetLatestReconciliation(arg0 uuid.UUID, arg1, arg2 string) (*goserver.Reconciliation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBudgetItems", familyID)
-	ret0, _ := ret[0].([]goserver.BudgetItem)
+	ret := m.ctrl.Call(m, "GetLatestReconciliation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*goserver.Reconciliation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBudgetItems indicates an expected call of GetBudgetItems.
-func (mr *MockStorageMockRecorder) GetBudgetItems(familyID interface{}) *gomock.Call {
+// GetLatestReconciliation indicates an expected call of GetLatestReconciliation.
+func (mr *MockStorageMockRecorder) GetLatestReconciliation(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgetItems", reflect.TypeOf((*MockStorage)(nil).GetBudgetItems), familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestReconciliation", reflect.TypeOf((*MockStorage)(nil).GetLatestReconciliation), arg0, arg1, arg2)
 }
 
-// GetBulkReconciliationData mocks base method.
-func (m *MockStorage) GetBulkReconciliationData(familyID uuid.UUID) (*database.BulkReconciliationData, error) {
+// GetMatcher mocks base method.
+func (m *MockStorage) GetMatcher(arg0 uuid.UUID, arg1 string) (goserver.Matcher, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBulkReconciliationData", familyID)
-	ret0, _ := ret[0].(*database.BulkReconciliationData)
+	ret := m.ctrl.Call(m, "GetMatcher", arg0, arg1)
+	ret0, _ := ret[0].(goserver.Matcher)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBulkReconciliationData indicates an expected call of GetBulkReconciliationData.
-func (mr *MockStorageMockRecorder) GetBulkReconciliationData(familyID interface{}) *gomock.Call {
+// GetMatcher indicates an expected call of GetMatcher.
+func (mr *MockStorageMockRecorder) GetMatcher(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBulkReconciliationData", reflect.TypeOf((*MockStorage)(nil).GetBulkReconciliationData), familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatcher", reflect.TypeOf((*MockStorage)(nil).GetMatcher), arg0, arg1)
 }
 
-// GetCNBRates mocks base method.
-func (m *MockStorage) GetCNBRates(day time.Time) (map[string]decimal.Decimal, error) {
+// GetMatcherRuntime mocks base method.
+func (m *MockStorage) GetMatcherRuntime(arg0 uuid.UUID, arg1 string) (database.MatcherRuntime, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCNBRates", day)
-	ret0, _ := ret[0].(map[string]decimal.Decimal)
+	ret := m.ctrl.Call(m, "GetMatcherRuntime", arg0, arg1)
+	ret0, _ := ret[0].(database.MatcherRuntime)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCNBRates indicates an expected call of GetCNBRates.
-func (mr *MockStorageMockRecorder) GetCNBRates(day interface{}) *gomock.Call {
+// GetMatcherRuntime indicates an expected call of GetMatcherRuntime.
+func (mr *MockStorageMockRecorder) GetMatcherRuntime(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCNBRates", reflect.TypeOf((*MockStorage)(nil).GetCNBRates), day)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatcherRuntime", reflect.TypeOf((*MockStorage)(nil).GetMatcherRuntime), arg0, arg1)
 }
 
-// GetCurrencies mocks base method.
-func (m *MockStorage) GetCurrencies(familyID uuid.UUID) ([]goserver.Currency, error) {
+// GetMatchers mocks base method.
+func (m *MockStorage) GetMatchers(arg0 uuid.UUID) ([]goserver.Matcher, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCurrencies", familyID)
-	ret0, _ := ret[0].([]goserver.Currency)
+	ret := m.ctrl.Call(m, "GetMatchers", arg0)
+	ret0, _ := ret[0].([]goserver.Matcher)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCurrencies indicates an expected call of GetCurrencies.
-func (mr *MockStorageMockRecorder) GetCurrencies(familyID interface{}) *gomock.Call {
+// GetMatchers indicates an expected call of GetMatchers.
+func (mr *MockStorageMockRecorder) GetMatchers(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrencies", reflect.TypeOf((*MockStorage)(nil).GetCurrencies), familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchers", reflect.TypeOf((*MockStorage)(nil).GetMatchers), arg0)
 }
 
-// GetCurrency mocks base method.
-func (m *MockStorage) GetCurrency(familyID uuid.UUID, id string) (goserver.Currency, error) {
+// GetMatchersRuntime mocks base method.
+func (m *MockStorage) GetMatchersRuntime(arg0 uuid.UUID) ([]database.MatcherRuntime, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCurrency", familyID, id)
-	ret0, _ := ret[0].(goserver.Currency)
+	ret := m.ctrl.Call(m, "GetMatchersRuntime", arg0)
+	ret0, _ := ret[0].([]database.MatcherRuntime)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCurrency indicates an expected call of GetCurrency.
-func (mr *MockStorageMockRecorder) GetCurrency(familyID, id interface{}) *gomock.Call {
+// GetMatchersRuntime indicates an expected call of GetMatchersRuntime.
+func (mr *MockStorageMockRecorder) GetMatchersRuntime(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrency", reflect.TypeOf((*MockStorage)(nil).GetCurrency), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchersRuntime", reflect.TypeOf((*MockStorage)(nil).GetMatchersRuntime), arg0)
 }
 
-// GetDB mocks base method.
-func (m *MockStorage) GetDB() *gorm.DB {
+// GetMergedTransaction mocks base method.
+func (m *MockStorage) GetMergedTransaction(arg0 uuid.UUID, arg1 string) (goserver.MergedTransaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDB")
-	ret0, _ := ret[0].(*gorm.DB)
-	return ret0
+	ret := m.ctrl.Call(m, "GetMergedTransaction", arg0, arg1)
+	ret0, _ := ret[0].(goserver.MergedTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetDB indicates an expected call of GetDB.
-func (mr *MockStorageMockRecorder) GetDB() *gomock.Call {
+// GetMergedTransaction indicates an expected call of GetMergedTransaction.
+func (mr *MockStorageMockRecorder) GetMergedTransaction(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDB", reflect.TypeOf((*MockStorage)(nil).GetDB))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergedTransaction", reflect.TypeOf((*MockStorage)(nil).GetMergedTransaction), arg0, arg1)
 }
 
-// GetDuplicateTransactionIDs mocks base method.
-func (m *MockStorage) GetDuplicateTransactionIDs(familyID uuid.UUID, transactionID string) ([]string, error) {
+// GetMergedTransactions mocks base method.
+func (m *MockStorage) GetMergedTransactions(arg0 uuid.UUID) ([]goserver.MergedTransaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDuplicateTransactionIDs", familyID, transactionID)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetMergedTransactions", arg0)
+	ret0, _ := ret[0].([]goserver.MergedTransaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDuplicateTransactionIDs indicates an expected call of GetDuplicateTransactionIDs.
-func (mr *MockStorageMockRecorder) GetDuplicateTransactionIDs(familyID, transactionID interface{}) *gomock.Call {
+// GetMergedTransactions indicates an expected call of GetMergedTransactions.
+func (mr *MockStorageMockRecorder) GetMergedTransactions(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDuplicateTransactionIDs", reflect.TypeOf((*MockStorage)(nil).GetDuplicateTransactionIDs), familyID, transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergedTransactions", reflect.TypeOf((*MockStorage)(nil).GetMergedTransactions), arg0)
 }
 
-// GetFamilyByName mocks base method.
-func (m *MockStorage) GetFamilyByName(name string) (*models.Family, error) {
+// GetMonthlyRollups mocks base method.
+func (m *MockStorage) GetMonthlyRollups(arg0 uuid.UUID, arg1, arg2 time.Time) ([]models.MonthlyRollup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFamilyByName", name)
-	ret0, _ := ret[0].(*models.Family)
+	ret := m.ctrl.Call(m, "GetMonthlyRollups", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.MonthlyRollup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFamilyByName indicates an expected call of GetFamilyByName.
-func (mr *MockStorageMockRecorder) GetFamilyByName(name interface{}) *gomock.Call {
+// GetMonthlyRollups indicates an expected call of GetMonthlyRollups.
+func (mr *MockStorageMockRecorder) GetMonthlyRollups(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFamilyByName", reflect.TypeOf((*MockStorage)(nil).GetFamilyByName), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthlyRollups", reflect.TypeOf((*MockStorage)(nil).GetMonthlyRollups), arg0, arg1, arg2)
 }
 
-// GetImage mocks base method.
-func (m *MockStorage) GetImage(id string) (models.Image, error) {
+// GetNotifications mocks base method.
+func (m *MockStorage) GetNotifications(arg0 uuid.UUID) ([]goserver.Notification, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetImage", id)
-	ret0, _ := ret[0].(models.Image)
+	ret := m.ctrl.Call(m, "GetNotifications", arg0)
+	ret0, _ := ret[0].([]goserver.Notification)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetImage indicates an expected call of GetImage.
-func (mr *MockStorageMockRecorder) GetImage(id interface{}) *gomock.Call {
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockStorageMockRecorder) GetNotifications(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockStorage)(nil).GetImage), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockStorage)(nil).GetNotifications), arg0)
 }
 
-// GetLatestReconciliation mocks base method.
-func (m *MockStorage) GetLatestReconciliation(familyID uuid.UUID, accountID, currencyID string) (*goserver.Reconciliation, error) {
+// GetProviderRateDates mocks base method.
+func (m *MockStorage) GetProviderRateDates(arg0 string, arg1, arg2 time.Time) ([]time.Time, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLatestReconciliation", familyID, accountID, currencyID)
-	ret0, _ := ret[0].(*goserver.Reconciliation)
+	ret := m.ctrl.Call(m, "GetProviderRateDates", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]time.Time)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLatestReconciliation indicates an expected call of GetLatestReconciliation.
-func (mr *MockStorageMockRecorder) GetLatestReconciliation(familyID, accountID, currencyID interface{}) *gomock.Call {
+// GetProviderRateDates indicates an expected call of GetProviderRateDates.
+func (mr *MockStorageMockRecorder) GetProviderRateDates(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestReconciliation", reflect.TypeOf((*MockStorage)(nil).GetLatestReconciliation), familyID, accountID, currencyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProviderRateDates", reflect.TypeOf((*MockStorage)(nil).GetProviderRateDates), arg0, arg1, arg2)
 }
 
-// GetMatcher mocks base method.
-func (m *MockStorage) GetMatcher(familyID uuid.UUID, id string) (goserver.Matcher, error) {
+// GetProviderRates mocks base method.
+func (m *MockStorage) GetProviderRates(arg0 string, arg1 time.Time) (map[string]decimal.Decimal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMatcher", familyID, id)
-	ret0, _ := ret[0].(goserver.Matcher)
+	ret := m.ctrl.Call(m, "GetProviderRates", arg0, arg1)
+	ret0, _ := ret[0].(map[string]decimal.Decimal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMatcher indicates an expected call of GetMatcher.
-func (mr *MockStorageMockRecorder) GetMatcher(familyID, id interface{}) *gomock.Call {
+// GetProviderRates indicates an expected call of GetProviderRates.
+func (mr *MockStorageMockRecorder) GetProviderRates(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatcher", reflect.TypeOf((*MockStorage)(nil).GetMatcher), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProviderRates", reflect.TypeOf((*MockStorage)(nil).GetProviderRates), arg0, arg1)
 }
 
-// GetMatcherRuntime mocks base method.
-func (m *MockStorage) GetMatcherRuntime(familyID uuid.UUID, id string) (database.MatcherRuntime, error) {
+// GetProviderRatesHistory mocks base method.
+func (m *MockStorage) GetProviderRatesHistory(arg0, arg1 string, arg2, arg3 time.Time) ([]models.ProviderCurrencyRate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMatcherRuntime", familyID, id)
-	ret0, _ := ret[0].(database.MatcherRuntime)
+	ret := m.ctrl.Call(m, "GetProviderRatesHistory", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.ProviderCurrencyRate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMatcherRuntime indicates an expected call of GetMatcherRuntime.
-func (mr *MockStorageMockRecorder) GetMatcherRuntime(familyID, id interface{}) *gomock.Call {
+// GetProviderRatesHistory indicates an expected call of GetProviderRatesHistory.
+func (mr *MockStorageMockRecorder) GetProviderRatesHistory(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatcherRuntime", reflect.TypeOf((*MockStorage)(nil).GetMatcherRuntime), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProviderRatesHistory", reflect.TypeOf((*MockStorage)(nil).GetProviderRatesHistory), arg0, arg1, arg2, arg3)
 }
 
-// GetMatchers mocks base method.
-func (m *MockStorage) GetMatchers(familyID uuid.UUID) ([]goserver.Matcher, error) {
+// GetReconciliationsForAccount mocks base method.
+func (m *MockStorage) GetReconciliationsForAccount(arg0 uuid.UUID, arg1 string) ([]goserver.Reconciliation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMatchers", familyID)
-	ret0, _ := ret[0].([]goserver.Matcher)
+	ret := m.ctrl.Call(m, "GetReconciliationsForAccount", arg0, arg1)
+	ret0, _ := ret[0].([]goserver.Reconciliation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMatchers indicates an expected call of GetMatchers.
-func (mr *MockStorageMockRecorder) GetMatchers(familyID interface{}) *gomock.Call {
+// GetReconciliationsForAccount indicates an expected call of GetReconciliationsForAccount.
+func (mr *MockStorageMockRecorder) GetReconciliationsForAccount(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchers", reflect.TypeOf((*MockStorage)(nil).GetMatchers), familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconciliationsForAccount", reflect.TypeOf((*MockStorage)(nil).GetReconciliationsForAccount), arg0, arg1)
 }
 
-// GetMatchersRuntime mocks base method.
-func (m *MockStorage) GetMatchersRuntime(familyID uuid.UUID) ([]database.MatcherRuntime, error) {
+// GetReconciliationsForAccountAndCurrency mocks base method.
+func (m *MockStorage) GetReconciliationsForAccountAndCurrency(arg0 uuid.UUID, arg1, arg2 string) ([]goserver.Reconciliation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMatchersRuntime", familyID)
-	ret0, _ := ret[0].([]database.MatcherRuntime)
+	ret := m.ctrl.Call(m, "GetReconciliationsForAccountAndCurrency", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]goserver.Reconciliation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMatchersRuntime indicates an expected call of GetMatchersRuntime.
-func (mr *MockStorageMockRecorder) GetMatchersRuntime(familyID interface{}) *gomock.Call {
+// GetReconciliationsForAccountAndCurrency indicates an expected call of GetReconciliationsForAccountAndCurrency.
+func (mr *MockStorageMockRecorder) GetReconciliationsForAccountAndCurrency(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchersRuntime", reflect.TypeOf((*MockStorage)(nil).GetMatchersRuntime), familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconciliationsForAccountAndCurrency", reflect.TypeOf((*MockStorage)(nil).GetReconciliationsForAccountAndCurrency), arg0, arg1, arg2)
 }
 
-// GetMergedTransaction mocks base method.
-func (m *MockStorage) GetMergedTransaction(familyID uuid.UUID, originalTransactionID string) (goserver.MergedTransaction, error) {
+// GetRefunds mocks base method.
+func (m *MockStorage) GetRefunds(arg0 uuid.UUID, arg1, arg2 time.Time) ([]goserver.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMergedTransaction", familyID, originalTransactionID)
-	ret0, _ := ret[0].(goserver.MergedTransaction)
+	ret := m.ctrl.Call(m, "GetRefunds", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]goserver.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMergedTransaction indicates an expected call of GetMergedTransaction.
-func (mr *MockStorageMockRecorder) GetMergedTransaction(familyID, originalTransactionID interface{}) *gomock.Call {
+// GetRefunds indicates an expected call of GetRefunds.
+func (mr *MockStorageMockRecorder) GetRefunds(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergedTransaction", reflect.TypeOf((*MockStorage)(nil).GetMergedTransaction), familyID, originalTransactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefunds", reflect.TypeOf((*MockStorage)(nil).GetRefunds), arg0, arg1, arg2)
 }
 
-// GetMergedTransactions mocks base method.
-func (m *MockStorage) GetMergedTransactions(familyID uuid.UUID) ([]goserver.MergedTransaction, error) {
+// GetSecurities mocks base method.
+func (m *MockStorage) GetSecurities(arg0 uuid.UUID) ([]goserver.Security, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMergedTransactions", familyID)
-	ret0, _ := ret[0].([]goserver.MergedTransaction)
+	ret := m.ctrl.Call(m, "GetSecurities", arg0)
+	ret0, _ := ret[0].([]goserver.Security)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMergedTransactions indicates an expected call of GetMergedTransactions.
-func (mr *MockStorageMockRecorder) GetMergedTransactions(familyID interface{}) *gomock.Call {
+// GetSecurities indicates an expected call of GetSecurities.
+func (mr *MockStorageMockRecorder) GetSecurities(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergedTransactions", reflect.TypeOf((*MockStorage)(nil).GetMergedTransactions), familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurities", reflect.TypeOf((*MockStorage)(nil).GetSecurities), arg0)
 }
 
-// GetNotifications mocks base method.
-func (m *MockStorage) GetNotifications(familyID uuid.UUID) ([]goserver.Notification, error) {
+// GetSecurity mocks base method.
+func (m *MockStorage) GetSecurity(arg0 uuid.UUID, arg1 string) (goserver.Security, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNotifications", familyID)
-	ret0, _ := ret[0].([]goserver.Notification)
+	ret := m.ctrl.Call(m, "GetSecurity", arg0, arg1)
+	ret0, _ := ret[0].(goserver.Security)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNotifications indicates an expected call of GetNotifications.
-func (mr *MockStorageMockRecorder) GetNotifications(familyID interface{}) *gomock.Call {
+// GetSecurity indicates an expected call of GetSecurity.
+func (mr *MockStorageMockRecorder) GetSecurity(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockStorage)(nil).GetNotifications), familyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurity", reflect.TypeOf((*MockStorage)(nil).GetSecurity), arg0, arg1)
 }
 
-// GetReconciliationsForAccount mocks base method.
-func (m *MockStorage) GetReconciliationsForAccount(familyID uuid.UUID, accountID string) ([]goserver.Reconciliation, error) {
+// GetSecurityPrices mocks base method.
+func (m *MockStorage) GetSecurityPrices(arg0 uuid.UUID, arg1 string, arg2, arg3 time.Time) ([]goserver.SecurityPrice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReconciliationsForAccount", familyID, accountID)
-	ret0, _ := ret[0].([]goserver.Reconciliation)
+	ret := m.ctrl.Call(m, "GetSecurityPrices", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]goserver.SecurityPrice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetReconciliationsForAccount indicates an expected call of GetReconciliationsForAccount.
-func (mr *MockStorageMockRecorder) GetReconciliationsForAccount(familyID, accountID interface{}) *gomock.Call {
+// GetSecurityPrices indicates an expected call of GetSecurityPrices.
+func (mr *MockStorageMockRecorder) GetSecurityPrices(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconciliationsForAccount", reflect.TypeOf((*MockStorage)(nil).GetReconciliationsForAccount), familyID, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityPrices", reflect.TypeOf((*MockStorage)(nil).GetSecurityPrices), arg0, arg1, arg2, arg3)
 }
 
-// GetReconciliationsForAccountAndCurrency mocks base method.
-func (m *MockStorage) GetReconciliationsForAccountAndCurrency(familyID uuid.UUID, accountID, currencyID string) ([]goserver.Reconciliation, error) {
+// GetTags mocks base method.
+func (m *MockStorage) GetTags(arg0 uuid.UUID) ([]goserver.TagInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReconciliationsForAccountAndCurrency", familyID, accountID, currencyID)
-	ret0, _ := ret[0].([]goserver.Reconciliation)
+	ret := m.ctrl.Call(m, "GetTags", arg0)
+	ret0, _ := ret[0].([]goserver.TagInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetReconciliationsForAccountAndCurrency indicates an expected call of GetReconciliationsForAccountAndCurrency.
-func (mr *MockStorageMockRecorder) GetReconciliationsForAccountAndCurrency(familyID, accountID, currencyID interface{}) *gomock.Call {
+// GetTags indicates an expected call of GetTags.
+func (mr *MockStorageMockRecorder) GetTags(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconciliationsForAccountAndCurrency", reflect.TypeOf((*MockStorage)(nil).GetReconciliationsForAccountAndCurrency), familyID, accountID, currencyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockStorage)(nil).GetTags), arg0)
 }
 
 // GetTemplates mocks base method.
-func (m *MockStorage) GetTemplates(familyID uuid.UUID, accountID *string) ([]goserver.TransactionTemplate, error) {
+func (m *MockStorage) GetTemplates(arg0 uuid.UUID, arg1 *string) ([]goserver.TransactionTemplate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTemplates", familyID, accountID)
+	ret := m.ctrl.Call(m, "GetTemplates", arg0, arg1)
 	ret0, _ := ret[0].([]goserver.TransactionTemplate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTemplates indicates an expected call of GetTemplates.
-func (mr *MockStorageMockRecorder) GetTemplates(familyID, accountID interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) GetTemplates(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplates", reflect.TypeOf((*MockStorage)(nil).GetTemplates), familyID, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplates", reflect.TypeOf((*MockStorage)(nil).GetTemplates), arg0, arg1)
 }
 
 // GetTransaction mocks base method.
-func (m *MockStorage) GetTransaction(familyID uuid.UUID, id string) (goserver.Transaction, error) {
+func (m *MockStorage) GetTransaction(arg0 uuid.UUID, arg1 string) (goserver.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransaction", familyID, id)
+	ret := m.ctrl.Call(m, "GetTransaction", arg0, arg1)
 	ret0, _ := ret[0].(goserver.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTransaction indicates an expected call of GetTransaction.
-func (mr *MockStorageMockRecorder) GetTransaction(familyID, id interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) GetTransaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockStorage)(nil).GetTransaction), arg0, arg1)
+}
+
+// GetTransactionDateRange mocks base method.
+func (m *MockStorage) GetTransactionDateRange(arg0 uuid.UUID) (time.Time, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionDateRange", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTransactionDateRange indicates an expected call of GetTransactionDateRange.
+func (mr *MockStorageMockRecorder) GetTransactionDateRange(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockStorage)(nil).GetTransaction), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionDateRange", reflect.TypeOf((*MockStorage)(nil).GetTransactionDateRange), arg0)
 }
 
 // GetTransactions mocks base method.
-func (m *MockStorage) GetTransactions(familyID uuid.UUID, dateFrom, dateTo time.Time, onlySuspicious bool) ([]goserver.Transaction, error) {
+func (m *MockStorage) GetTransactions(arg0 uuid.UUID, arg1, arg2 time.Time, arg3 bool) ([]goserver.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransactions", familyID, dateFrom, dateTo, onlySuspicious)
+	ret := m.ctrl.Call(m, "GetTransactions", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]goserver.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTransactions indicates an expected call of GetTransactions.
-func (mr *MockStorageMockRecorder) GetTransactions(familyID, dateFrom, dateTo, onlySuspicious interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) GetTransactions(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactions", reflect.TypeOf((*MockStorage)(nil).GetTransactions), familyID, dateFrom, dateTo, onlySuspicious)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactions", reflect.TypeOf((*MockStorage)(nil).GetTransactions), arg0, arg1, arg2, arg3)
 }
 
 // GetTransactionsIncludingDeleted mocks base method.
-func (m *MockStorage) GetTransactionsIncludingDeleted(familyID uuid.UUID, dateFrom, dateTo time.Time) ([]goserver.Transaction, error) {
+func (m *MockStorage) GetTransactionsIncludingDeleted(arg0 uuid.UUID, arg1, arg2 time.Time) ([]goserver.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransactionsIncludingDeleted", familyID, dateFrom, dateTo)
+	ret := m.ctrl.Call(m, "GetTransactionsIncludingDeleted", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]goserver.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTransactionsIncludingDeleted indicates an expected call of GetTransactionsIncludingDeleted.
-func (mr *MockStorageMockRecorder) GetTransactionsIncludingDeleted(familyID, dateFrom, dateTo interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) GetTransactionsIncludingDeleted(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionsIncludingDeleted", reflect.TypeOf((*MockStorage)(nil).GetTransactionsIncludingDeleted), arg0, arg1, arg2)
+}
+
+// GetTransferRules mocks base method.
+func (m *MockStorage) GetTransferRules(arg0 uuid.UUID) ([]goserver.TransferRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferRules", arg0)
+	ret0, _ := ret[0].([]goserver.TransferRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferRules indicates an expected call of GetTransferRules.
+func (mr *MockStorageMockRecorder) GetTransferRules(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionsIncludingDeleted", reflect.TypeOf((*MockStorage)(nil).GetTransactionsIncludingDeleted), familyID, dateFrom, dateTo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferRules", reflect.TypeOf((*MockStorage)(nil).GetTransferRules), arg0)
 }
 
 // GetUser mocks base method.
-func (m *MockStorage) GetUser(userID uuid.UUID) (*models.User, error) {
+func (m *MockStorage) GetUser(arg0 uuid.UUID) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", userID)
+	ret := m.ctrl.Call(m, "GetUser", arg0)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUser indicates an expected call of GetUser.
-func (mr *MockStorageMockRecorder) GetUser(userID interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) GetUser(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStorage)(nil).GetUser), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStorage)(nil).GetUser), arg0)
 }
 
 // GetUserByUsername mocks base method.
-func (m *MockStorage) GetUserByUsername(username string) (*models.User, error) {
+func (m *MockStorage) GetUserByUsername(arg0 string) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByUsername", username)
+	ret := m.ctrl.Call(m, "GetUserByUsername", arg0)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserByUsername indicates an expected call of GetUserByUsername.
-func (mr *MockStorageMockRecorder) GetUserByUsername(username interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) GetUserByUsername(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockStorage)(nil).GetUserByUsername), arg0)
+}
+
+// HasNotification mocks base method.
+func (m *MockStorage) HasNotification(arg0 uuid.UUID, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasNotification", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasNotification indicates an expected call of HasNotification.
+func (mr *MockStorageMockRecorder) HasNotification(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockStorage)(nil).GetUserByUsername), username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasNotification", reflect.TypeOf((*MockStorage)(nil).HasNotification), arg0, arg1, arg2)
 }
 
 // HasTransactionsAfterDate mocks base method.
-func (m *MockStorage) HasTransactionsAfterDate(familyID uuid.UUID, accountID string, date time.Time) (bool, error) {
+func (m *MockStorage) HasTransactionsAfterDate(arg0 uuid.UUID, arg1 string, arg2 time.Time) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasTransactionsAfterDate", familyID, accountID, date)
+	ret := m.ctrl.Call(m, "HasTransactionsAfterDate", arg0, arg1, arg2)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // HasTransactionsAfterDate indicates an expected call of HasTransactionsAfterDate.
-func (mr *MockStorageMockRecorder) HasTransactionsAfterDate(familyID, accountID, date interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) HasTransactionsAfterDate(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasTransactionsAfterDate", reflect.TypeOf((*MockStorage)(nil).HasTransactionsAfterDate), familyID, accountID, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasTransactionsAfterDate", reflect.TypeOf((*MockStorage)(nil).HasTransactionsAfterDate), arg0, arg1, arg2)
 }
 
 // InvalidateReconciliation mocks base method.
-func (m *MockStorage) InvalidateReconciliation(familyID uuid.UUID, accountID, currencyID string, fromDate time.Time) error {
+func (m *MockStorage) InvalidateReconciliation(arg0 uuid.UUID, arg1, arg2 string, arg3 time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InvalidateReconciliation", familyID, accountID, currencyID, fromDate)
+	ret := m.ctrl.Call(m, "InvalidateReconciliation", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // InvalidateReconciliation indicates an expected call of InvalidateReconciliation.
-func (mr *MockStorageMockRecorder) InvalidateReconciliation(familyID, accountID, currencyID, fromDate interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) InvalidateReconciliation(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateReconciliation", reflect.TypeOf((*MockStorage)(nil).InvalidateReconciliation), arg0, arg1, arg2, arg3)
+}
+
+// LinkRefund mocks base method.
+func (m *MockStorage) LinkRefund(arg0 uuid.UUID, arg1, arg2, arg3 string) (goserver.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkRefund", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(goserver.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkRefund indicates an expected call of LinkRefund.
+func (mr *MockStorageMockRecorder) LinkRefund(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkRefund", reflect.TypeOf((*MockStorage)(nil).LinkRefund), arg0, arg1, arg2, arg3)
+}
+
+// MergeTags mocks base method.
+func (m *MockStorage) MergeTags(arg0 uuid.UUID, arg1 []string, arg2 string) (goserver.TagChangeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(goserver.TagChangeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockStorageMockRecorder) MergeTags(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateReconciliation", reflect.TypeOf((*MockStorage)(nil).InvalidateReconciliation), familyID, accountID, currencyID, fromDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockStorage)(nil).MergeTags), arg0, arg1, arg2)
 }
 
 // MergeTransactions mocks base method.
-func (m *MockStorage) MergeTransactions(familyID uuid.UUID, keepID, mergeID string) (goserver.Transaction, error) {
+func (m *MockStorage) MergeTransactions(arg0 uuid.UUID, arg1, arg2 string) (goserver.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MergeTransactions", familyID, keepID, mergeID)
+	ret := m.ctrl.Call(m, "MergeTransactions", arg0, arg1, arg2)
 	ret0, _ := ret[0].(goserver.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MergeTransactions indicates an expected call of MergeTransactions.
-func (mr *MockStorageMockRecorder) MergeTransactions(familyID, keepID, mergeID interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) MergeTransactions(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTransactions", reflect.TypeOf((*MockStorage)(nil).MergeTransactions), familyID, keepID, mergeID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTransactions", reflect.TypeOf((*MockStorage)(nil).MergeTransactions), arg0, arg1, arg2)
 }
 
 // Open mocks base method.
@@ -2663,192 +1698,353 @@ func (mr *MockStorageMockRecorder) Open() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockStorage)(nil).Open))
 }
 
+// PutFamily mocks base method.
+func (m *MockStorage) PutFamily(arg0 *models.Family) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutFamily", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutFamily indicates an expected call of PutFamily.
+func (mr *MockStorageMockRecorder) PutFamily(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutFamily", reflect.TypeOf((*MockStorage)(nil).PutFamily), arg0)
+}
+
 // PutUser mocks base method.
-func (m *MockStorage) PutUser(user *models.User) error {
+func (m *MockStorage) PutUser(arg0 *models.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutUser", user)
+	ret := m.ctrl.Call(m, "PutUser", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PutUser indicates an expected call of PutUser.
-func (mr *MockStorageMockRecorder) PutUser(user interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) PutUser(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutUser", reflect.TypeOf((*MockStorage)(nil).PutUser), arg0)
+}
+
+// RebuildMonthlyRollups mocks base method.
+func (m *MockStorage) RebuildMonthlyRollups(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildMonthlyRollups", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildMonthlyRollups indicates an expected call of RebuildMonthlyRollups.
+func (mr *MockStorageMockRecorder) RebuildMonthlyRollups(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutUser", reflect.TypeOf((*MockStorage)(nil).PutUser), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMonthlyRollups", reflect.TypeOf((*MockStorage)(nil).RebuildMonthlyRollups), arg0)
 }
 
 // RemoveDuplicateRelationship mocks base method.
-func (m *MockStorage) RemoveDuplicateRelationship(familyID uuid.UUID, transactionID1, transactionID2 string) error {
+func (m *MockStorage) RemoveDuplicateRelationship(arg0 uuid.UUID, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveDuplicateRelationship", familyID, transactionID1, transactionID2)
+	ret := m.ctrl.Call(m, "RemoveDuplicateRelationship", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveDuplicateRelationship indicates an expected call of RemoveDuplicateRelationship.
-func (mr *MockStorageMockRecorder) RemoveDuplicateRelationship(familyID, transactionID1, transactionID2 interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) RemoveDuplicateRelationship(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDuplicateRelationship", reflect.TypeOf((*MockStorage)(nil).RemoveDuplicateRelationship), arg0, arg1, arg2)
+}
+
+// RenameTag mocks base method.
+func (m *MockStorage) RenameTag(arg0 uuid.UUID, arg1, arg2 string) (goserver.TagChangeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTag", arg0, arg1, arg2)
+	ret0, _ := ret[0].(goserver.TagChangeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockStorageMockRecorder) RenameTag(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDuplicateRelationship", reflect.TypeOf((*MockStorage)(nil).RemoveDuplicateRelationship), familyID, transactionID1, transactionID2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockStorage)(nil).RenameTag), arg0, arg1, arg2)
 }
 
 // SaveCNBRates mocks base method.
-func (m *MockStorage) SaveCNBRates(rates map[string]decimal.Decimal, day time.Time) error {
+func (m *MockStorage) SaveCNBRates(arg0 map[string]decimal.Decimal, arg1 time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveCNBRates", rates, day)
+	ret := m.ctrl.Call(m, "SaveCNBRates", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SaveCNBRates indicates an expected call of SaveCNBRates.
-func (mr *MockStorageMockRecorder) SaveCNBRates(rates, day interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) SaveCNBRates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCNBRates", reflect.TypeOf((*MockStorage)(nil).SaveCNBRates), arg0, arg1)
+}
+
+// SaveProviderRates mocks base method.
+func (m *MockStorage) SaveProviderRates(arg0 string, arg1 map[string]decimal.Decimal, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProviderRates", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveProviderRates indicates an expected call of SaveProviderRates.
+func (mr *MockStorageMockRecorder) SaveProviderRates(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProviderRates", reflect.TypeOf((*MockStorage)(nil).SaveProviderRates), arg0, arg1, arg2)
+}
+
+// SetSecurityPrices mocks base method.
+func (m *MockStorage) SetSecurityPrices(arg0 uuid.UUID, arg1 string, arg2 []goserver.SecurityPrice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSecurityPrices", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSecurityPrices indicates an expected call of SetSecurityPrices.
+func (mr *MockStorageMockRecorder) SetSecurityPrices(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecurityPrices", reflect.TypeOf((*MockStorage)(nil).SetSecurityPrices), arg0, arg1, arg2)
+}
+
+// UnlinkRefund mocks base method.
+func (m *MockStorage) UnlinkRefund(arg0 uuid.UUID, arg1 string) (goserver.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlinkRefund", arg0, arg1)
+	ret0, _ := ret[0].(goserver.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlinkRefund indicates an expected call of UnlinkRefund.
+func (mr *MockStorageMockRecorder) UnlinkRefund(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCNBRates", reflect.TypeOf((*MockStorage)(nil).SaveCNBRates), rates, day)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkRefund", reflect.TypeOf((*MockStorage)(nil).UnlinkRefund), arg0, arg1)
 }
 
 // UnmergeTransaction mocks base method.
-func (m *MockStorage) UnmergeTransaction(familyID uuid.UUID, id string) error {
+func (m *MockStorage) UnmergeTransaction(arg0 uuid.UUID, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UnmergeTransaction", familyID, id)
+	ret := m.ctrl.Call(m, "UnmergeTransaction", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UnmergeTransaction indicates an expected call of UnmergeTransaction.
-func (mr *MockStorageMockRecorder) UnmergeTransaction(familyID, id interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) UnmergeTransaction(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmergeTransaction", reflect.TypeOf((*MockStorage)(nil).UnmergeTransaction), familyID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmergeTransaction", reflect.TypeOf((*MockStorage)(nil).UnmergeTransaction), arg0, arg1)
 }
 
 // UpdateAccount mocks base method.
-func (m *MockStorage) UpdateAccount(familyID uuid.UUID, id string, account *goserver.AccountNoId) (goserver.Account, error) {
+func (m *MockStorage) UpdateAccount(arg0 uuid.UUID, arg1 string, arg2 *goserver.AccountNoId) (goserver.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAccount", familyID, id, account)
+	ret := m.ctrl.Call(m, "UpdateAccount", arg0, arg1, arg2)
 	ret0, _ := ret[0].(goserver.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateAccount indicates an expected call of UpdateAccount.
-func (mr *MockStorageMockRecorder) UpdateAccount(familyID, id, account interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) UpdateAccount(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockStorage)(nil).UpdateAccount), familyID, id, account)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockStorage)(nil).UpdateAccount), arg0, arg1, arg2)
 }
 
 // UpdateBankImporter mocks base method.
-func (m *MockStorage) UpdateBankImporter(familyID uuid.UUID, id string, bankImporter goserver.BankImporterNoIdInterface) (goserver.BankImporter, error) {
+func (m *MockStorage) UpdateBankImporter(arg0 uuid.UUID, arg1 string, arg2 goserver.BankImporterNoIdInterface) (goserver.BankImporter, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBankImporter", familyID, id, bankImporter)
+	ret := m.ctrl.Call(m, "UpdateBankImporter", arg0, arg1, arg2)
 	ret0, _ := ret[0].(goserver.BankImporter)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateBankImporter indicates an expected call of UpdateBankImporter.
-func (mr *MockStorageMockRecorder) UpdateBankImporter(familyID, id, bankImporter interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) UpdateBankImporter(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBankImporter", reflect.TypeOf((*MockStorage)(nil).UpdateBankImporter), familyID, id, bankImporter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBankImporter", reflect.TypeOf((*MockStorage)(nil).UpdateBankImporter), arg0, arg1, arg2)
 }
 
 // UpdateBudgetItem mocks base method.
-func (m *MockStorage) UpdateBudgetItem(familyID uuid.UUID, id string, budgetItem *goserver.BudgetItemNoId) (goserver.BudgetItem, error) {
+func (m *MockStorage) UpdateBudgetItem(arg0 uuid.UUID, arg1 string, arg2 *goserver.BudgetItemNoId) (goserver.BudgetItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBudgetItem", familyID, id, budgetItem)
+	ret := m.ctrl.Call(m, "UpdateBudgetItem", arg0, arg1, arg2)
 	ret0, _ := ret[0].(goserver.BudgetItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateBudgetItem indicates an expected call of UpdateBudgetItem.
-func (mr *MockStorageMockRecorder) UpdateBudgetItem(familyID, id, budgetItem interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) UpdateBudgetItem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBudgetItem", reflect.TypeOf((*MockStorage)(nil).UpdateBudgetItem), arg0, arg1, arg2)
+}
+
+// UpdateBudgetPlan mocks base method.
+func (m *MockStorage) UpdateBudgetPlan(arg0 uuid.UUID, arg1 string, arg2 *goserver.BudgetPlanNoId) (goserver.BudgetPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBudgetPlan", arg0, arg1, arg2)
+	ret0, _ := ret[0].(goserver.BudgetPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBudgetPlan indicates an expected call of UpdateBudgetPlan.
+func (mr *MockStorageMockRecorder) UpdateBudgetPlan(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBudgetItem", reflect.TypeOf((*MockStorage)(nil).UpdateBudgetItem), familyID, id, budgetItem)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBudgetPlan", reflect.TypeOf((*MockStorage)(nil).UpdateBudgetPlan), arg0, arg1, arg2)
+}
+
+// UpdateBudgetTransfer mocks base method.
+func (m *MockStorage) UpdateBudgetTransfer(arg0 uuid.UUID, arg1 string, arg2 *goserver.BudgetTransferNoId) (goserver.BudgetTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBudgetTransfer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(goserver.BudgetTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBudgetTransfer indicates an expected call of UpdateBudgetTransfer.
+func (mr *MockStorageMockRecorder) UpdateBudgetTransfer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBudgetTransfer", reflect.TypeOf((*MockStorage)(nil).UpdateBudgetTransfer), arg0, arg1, arg2)
 }
 
 // UpdateCurrency mocks base method.
-func (m *MockStorage) UpdateCurrency(familyID uuid.UUID, id string, currency *goserver.CurrencyNoId) (goserver.Currency, error) {
+func (m *MockStorage) UpdateCurrency(arg0 uuid.UUID, arg1 string, arg2 *goserver.CurrencyNoId) (goserver.Currency, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCurrency", familyID, id, currency)
+	ret := m.ctrl.Call(m, "UpdateCurrency", arg0, arg1, arg2)
 	ret0, _ := ret[0].(goserver.Currency)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateCurrency indicates an expected call of UpdateCurrency.
-func (mr *MockStorageMockRecorder) UpdateCurrency(familyID, id, currency interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) UpdateCurrency(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCurrency", reflect.TypeOf((*MockStorage)(nil).UpdateCurrency), arg0, arg1, arg2)
+}
+
+// UpdateExchangeRate mocks base method.
+func (m *MockStorage) UpdateExchangeRate(arg0 uuid.UUID, arg1 string, arg2 *goserver.ExchangeRateNoId) (goserver.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExchangeRate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(goserver.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateExchangeRate indicates an expected call of UpdateExchangeRate.
+func (mr *MockStorageMockRecorder) UpdateExchangeRate(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCurrency", reflect.TypeOf((*MockStorage)(nil).UpdateCurrency), familyID, id, currency)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExchangeRate", reflect.TypeOf((*MockStorage)(nil).UpdateExchangeRate), arg0, arg1, arg2)
+}
+
+// UpdateGoal mocks base method.
+func (m *MockStorage) UpdateGoal(arg0 uuid.UUID, arg1 string, arg2 *goserver.GoalNoId) (goserver.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGoal", arg0, arg1, arg2)
+	ret0, _ := ret[0].(goserver.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGoal indicates an expected call of UpdateGoal.
+func (mr *MockStorageMockRecorder) UpdateGoal(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoal", reflect.TypeOf((*MockStorage)(nil).UpdateGoal), arg0, arg1, arg2)
 }
 
 // UpdateMatcher mocks base method.
-func (m *MockStorage) UpdateMatcher(familyID uuid.UUID, id string, matcher goserver.MatcherNoIdInterface) (goserver.Matcher, error) {
+func (m *MockStorage) UpdateMatcher(arg0 uuid.UUID, arg1 string, arg2 goserver.MatcherNoIdInterface) (goserver.Matcher, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateMatcher", familyID, id, matcher)
+	ret := m.ctrl.Call(m, "UpdateMatcher", arg0, arg1, arg2)
 	ret0, _ := ret[0].(goserver.Matcher)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateMatcher indicates an expected call of UpdateMatcher.
-func (mr *MockStorageMockRecorder) UpdateMatcher(familyID, id, matcher interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) UpdateMatcher(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMatcher", reflect.TypeOf((*MockStorage)(nil).UpdateMatcher), arg0, arg1, arg2)
+}
+
+// UpdateSecurity mocks base method.
+func (m *MockStorage) UpdateSecurity(arg0 uuid.UUID, arg1 string, arg2 *goserver.SecurityNoId) (goserver.Security, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecurity", arg0, arg1, arg2)
+	ret0, _ := ret[0].(goserver.Security)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSecurity indicates an expected call of UpdateSecurity.
+func (mr *MockStorageMockRecorder) UpdateSecurity(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMatcher", reflect.TypeOf((*MockStorage)(nil).UpdateMatcher), familyID, id, matcher)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecurity", reflect.TypeOf((*MockStorage)(nil).UpdateSecurity), arg0, arg1, arg2)
 }
 
 // UpdateTemplate mocks base method.
-func (m *MockStorage) UpdateTemplate(familyID uuid.UUID, id string, t *goserver.TransactionTemplateNoId) (goserver.TransactionTemplate, error) {
+func (m *MockStorage) UpdateTemplate(arg0 uuid.UUID, arg1 string, arg2 *goserver.TransactionTemplateNoId) (goserver.TransactionTemplate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTemplate", familyID, id, t)
+	ret := m.ctrl.Call(m, "UpdateTemplate", arg0, arg1, arg2)
 	ret0, _ := ret[0].(goserver.TransactionTemplate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateTemplate indicates an expected call of UpdateTemplate.
-func (mr *MockStorageMockRecorder) UpdateTemplate(familyID, id, t interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) UpdateTemplate(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplate", reflect.TypeOf((*MockStorage)(nil).UpdateTemplate), familyID, id, t)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplate", reflect.TypeOf((*MockStorage)(nil).UpdateTemplate), arg0, arg1, arg2)
 }
 
 // UpdateTransaction mocks base method.
-func (m *MockStorage) UpdateTransaction(familyID uuid.UUID, id string, transaction goserver.TransactionNoIdInterface) (goserver.Transaction, error) {
+func (m *MockStorage) UpdateTransaction(arg0 uuid.UUID, arg1 string, arg2 goserver.TransactionNoIdInterface) (goserver.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTransaction", familyID, id, transaction)
+	ret := m.ctrl.Call(m, "UpdateTransaction", arg0, arg1, arg2)
 	ret0, _ := ret[0].(goserver.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateTransaction indicates an expected call of UpdateTransaction.
-func (mr *MockStorageMockRecorder) UpdateTransaction(familyID, id, transaction interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) UpdateTransaction(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockStorage)(nil).UpdateTransaction), familyID, id, transaction)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockStorage)(nil).UpdateTransaction), arg0, arg1, arg2)
 }
 
 // UpdateTransactionInternal mocks base method.
-func (m *MockStorage) UpdateTransactionInternal(familyID uuid.UUID, id string, transaction goserver.TransactionNoIdInterface) (goserver.Transaction, error) {
+func (m *MockStorage) UpdateTransactionInternal(arg0 uuid.UUID, arg1 string, arg2 goserver.TransactionNoIdInterface) (goserver.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTransactionInternal", familyID, id, transaction)
+	ret := m.ctrl.Call(m, "UpdateTransactionInternal", arg0, arg1, arg2)
 	ret0, _ := ret[0].(goserver.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateTransactionInternal indicates an expected call of UpdateTransactionInternal.
-func (mr *MockStorageMockRecorder) UpdateTransactionInternal(familyID, id, transaction interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) UpdateTransactionInternal(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransactionInternal", reflect.TypeOf((*MockStorage)(nil).UpdateTransactionInternal), familyID, id, transaction)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransactionInternal", reflect.TypeOf((*MockStorage)(nil).UpdateTransactionInternal), arg0, arg1, arg2)
 }
 
 // WithContext mocks base method.
-func (m *MockStorage) WithContext(ctx context.Context) database.Storage {
+func (m *MockStorage) WithContext(arg0 context.Context) database.Storage {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WithContext", ctx)
+	ret := m.ctrl.Call(m, "WithContext", arg0)
 	ret0, _ := ret[0].(database.Storage)
 	return ret0
 }
 
 // WithContext indicates an expected call of WithContext.
-func (mr *MockStorageMockRecorder) WithContext(ctx interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) WithContext(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithContext", reflect.TypeOf((*MockStorage)(nil).WithContext), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithContext", reflect.TypeOf((*MockStorage)(nil).WithContext), arg0)
 }
@@ -19,8 +19,15 @@ type Account struct {
 	ShowInReconciliation   bool `gorm:"default:false"`
 	HideFromReports        bool `gorm:"default:false"`
 	Image                  string
+	// ParentID is the ID of the parent account, empty for top-level accounts
+	ParentID string `gorm:"index"`
 
-	BankInfo goserver.BankAccountInfo `gorm:"serializer:json"`
+	BankInfo  goserver.BankAccountInfo `gorm:"serializer:json"`
+	LoanTerms goserver.LoanTerms       `gorm:"serializer:json"`
+	// BudgetAlerts are the budget notifications enabled for the account
+	BudgetAlerts goserver.BudgetAlerts `gorm:"serializer:json"`
+	// BudgetRollover is how the budget remainder of the account carries over, unlimited when empty
+	BudgetRollover goserver.BudgetRollover `gorm:"serializer:json"`
 
 	FamilyID uuid.UUID `gorm:"type:uuid;index;not null"`
 	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
@@ -41,6 +48,10 @@ func (a *Account) FromDB() goserver.Account {
 		ShowInReconciliation:   a.ShowInReconciliation,
 		HideFromReports:        a.HideFromReports,
 		Image:                  a.Image,
+		ParentId:               a.ParentID,
+		LoanTerms:              a.LoanTerms,
+		BudgetAlerts:           a.BudgetAlerts,
+		BudgetRollover:         a.BudgetRollover,
 	}
 
 	if a.IgnoreUnprocessedBefore != nil {
@@ -66,6 +77,10 @@ func AccountToDB(m goserver.AccountNoIdInterface, familyID uuid.UUID) *Account {
 		ShowInReconciliation:   m.GetShowInReconciliation(),
 		HideFromReports:        m.GetHideFromReports(),
 		Image:                  m.GetImage(),
+		ParentID:               m.GetParentId(),
+		LoanTerms:              m.GetLoanTerms(),
+		BudgetAlerts:           m.GetBudgetAlerts(),
+		BudgetRollover:         m.GetBudgetRollover(),
 	}
 
 	ignoreBefore := m.GetIgnoreUnprocessedBefore()
@@ -99,5 +114,9 @@ func AccountWithoutID(account *goserver.Account) *goserver.AccountNoId {
 		IgnoreUnprocessedBefore: account.IgnoreUnprocessedBefore,
 		OpeningDate:             account.OpeningDate,
 		ClosingDate:             account.ClosingDate,
+		ParentId:                account.ParentId,
+		LoanTerms:               account.LoanTerms,
+		BudgetAlerts:            account.BudgetAlerts,
+		BudgetRollover:          account.BudgetRollover,
 	}
 }
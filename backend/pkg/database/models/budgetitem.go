@@ -15,7 +15,10 @@ type BudgetItem struct {
 
 	Date        time.Time
 	AccountID   string
+	Tag         string
+	Partner     string
 	Amount      decimal.Decimal `gorm:"type:decimal(20,8)"`
+	CurrencyID  string
 	Description string
 
 	FamilyID uuid.UUID `gorm:"type:uuid;index;not null"`
@@ -27,7 +30,10 @@ func (a *BudgetItem) FromDB() goserver.BudgetItem {
 		Id:          a.ID.String(),
 		Date:        a.Date,
 		AccountId:   a.AccountID,
+		Tag:         a.Tag,
+		Partner:     a.Partner,
 		Amount:      a.Amount,
+		CurrencyId:  a.CurrencyID,
 		Description: a.Description,
 	}
 }
@@ -37,7 +43,10 @@ func BudgetItemToDB(m goserver.BudgetItemNoIdInterface, familyID uuid.UUID) *Bud
 		FamilyID:    familyID,
 		Date:        m.GetDate(),
 		AccountID:   m.GetAccountId(),
+		Tag:         m.GetTag(),
+		Partner:     m.GetPartner(),
 		Amount:      m.GetAmount(),
+		CurrencyID:  m.GetCurrencyId(),
 		Description: m.GetDescription(),
 	}
 }
@@ -46,7 +55,10 @@ func BudgetItemAccountWithoutID(budgetItem *goserver.BudgetItem) *goserver.Budge
 	return &goserver.BudgetItemNoId{
 		Date:        budgetItem.Date,
 		AccountId:   budgetItem.AccountId,
+		Tag:         budgetItem.Tag,
+		Partner:     budgetItem.Partner,
 		Amount:      budgetItem.Amount,
+		CurrencyId:  budgetItem.CurrencyId,
 		Description: budgetItem.Description,
 	}
 }
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+type BudgetPlan struct {
+	gorm.Model
+
+	AccountID     string
+	Amount        decimal.Decimal `gorm:"type:decimal(20,8)"`
+	Recurrence    string
+	Months        []int32 `gorm:"serializer:json"`
+	EffectiveFrom time.Time
+	EffectiveTo   time.Time
+	Accrue        bool
+	Description   string
+
+	FamilyID uuid.UUID `gorm:"type:uuid;index;not null"`
+	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
+}
+
+func (p *BudgetPlan) FromDB() goserver.BudgetPlan {
+	return goserver.BudgetPlan{
+		Id:            p.ID.String(),
+		AccountId:     p.AccountID,
+		Amount:        p.Amount,
+		Recurrence:    p.Recurrence,
+		Months:        p.Months,
+		EffectiveFrom: p.EffectiveFrom,
+		EffectiveTo:   p.EffectiveTo,
+		Accrue:        p.Accrue,
+		Description:   p.Description,
+	}
+}
+
+func BudgetPlanToDB(m goserver.BudgetPlanNoIdInterface, familyID uuid.UUID) *BudgetPlan {
+	return &BudgetPlan{
+		FamilyID:      familyID,
+		AccountID:     m.GetAccountId(),
+		Amount:        m.GetAmount(),
+		Recurrence:    m.GetRecurrence(),
+		Months:        m.GetMonths(),
+		EffectiveFrom: m.GetEffectiveFrom(),
+		EffectiveTo:   m.GetEffectiveTo(),
+		Accrue:        m.GetAccrue(),
+		Description:   m.GetDescription(),
+	}
+}
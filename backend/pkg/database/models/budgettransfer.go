@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+// BudgetTransfer moves budget between two envelopes, an empty account is ready to assign.
+type BudgetTransfer struct {
+	gorm.Model
+
+	Date          time.Time
+	FromAccountID string
+	ToAccountID   string
+	Amount        decimal.Decimal `gorm:"type:decimal(20,8)"`
+	Description   string
+
+	FamilyID uuid.UUID `gorm:"type:uuid;index;not null"`
+	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
+}
+
+func (t *BudgetTransfer) FromDB() goserver.BudgetTransfer {
+	return goserver.BudgetTransfer{
+		Id:            t.ID.String(),
+		Date:          t.Date,
+		FromAccountId: t.FromAccountID,
+		ToAccountId:   t.ToAccountID,
+		Amount:        t.Amount,
+		Description:   t.Description,
+	}
+}
+
+func BudgetTransferToDB(m goserver.BudgetTransferNoIdInterface, familyID uuid.UUID) *BudgetTransfer {
+	return &BudgetTransfer{
+		FamilyID:      familyID,
+		Date:          m.GetDate(),
+		FromAccountID: m.GetFromAccountId(),
+		ToAccountID:   m.GetToAccountId(),
+		Amount:        m.GetAmount(),
+		Description:   m.GetDescription(),
+	}
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+// ExchangeRate is a manually managed rate of a currency in the rate base currency of the family,
+// valid from Date till DateTo or till the next rate of the currency if DateTo is zero.
+type ExchangeRate struct {
+	gorm.Model
+
+	CurrencyID  string
+	Date        time.Time
+	DateTo      time.Time
+	Rate        decimal.Decimal `gorm:"type:decimal(20,8)"`
+	Description string
+
+	FamilyID uuid.UUID `gorm:"type:uuid;index;not null"`
+	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
+}
+
+func (r *ExchangeRate) FromDB() goserver.ExchangeRate {
+	return goserver.ExchangeRate{
+		Id:          r.ID.String(),
+		CurrencyId:  r.CurrencyID,
+		Date:        r.Date,
+		DateTo:      r.DateTo,
+		Rate:        r.Rate,
+		Description: r.Description,
+	}
+}
+
+func ExchangeRateToDB(m goserver.ExchangeRateNoIdInterface, familyID uuid.UUID) *ExchangeRate {
+	return &ExchangeRate{
+		FamilyID:    familyID,
+		CurrencyID:  m.GetCurrencyId(),
+		Date:        m.GetDate(),
+		DateTo:      m.GetDateTo(),
+		Rate:        m.GetRate(),
+		Description: m.GetDescription(),
+	}
+}
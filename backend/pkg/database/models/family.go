@@ -1,10 +1,46 @@
 package models
 
 import (
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
 	coremodels "github.com/ya-breeze/kin-core/models"
 )
 
+// Family holds the settings shared by all its users. Reports, budgets and background tasks
+// work with a family ID only, so their settings can't be stored per user.
 type Family struct {
 	coremodels.Family
 	Users []User
+	// MonthStartDay is the day monthly reports and budgets start on, 0 or 1 means calendar months
+	MonthStartDay int
+	// AnomalySensitivity is how unusual spending has to be to notify about it, empty means medium
+	AnomalySensitivity string
+	// AnomalyMutedAccountIDs are accounts which are never reported as spending anomalies
+	AnomalyMutedAccountIDs []string `gorm:"serializer:json"`
+	// BudgetOverspending is how overspent budgets are handled, empty means rollover
+	BudgetOverspending string
+	// RateProviders is the chain of exchange rate providers, empty means CNB only
+	RateProviders []string `gorm:"serializer:json"`
+	// RateBaseCurrencyID is the currency of manual exchange rates, empty means CZK
+	RateBaseCurrencyID string
+}
+
+// FillUser sets the family settings in the user returned by the API
+func (f Family) FillUser(user *goserver.User) {
+	user.MonthStartDay = int32(max(f.MonthStartDay, 1))
+	user.AnomalySensitivity = f.AnomalySensitivity
+	user.AnomalyMutedAccountIds = f.AnomalyMutedAccountIDs
+	user.BudgetOverspending = f.BudgetOverspending
+	user.RateProviders = f.RateProviders
+	user.RateBaseCurrencyId = f.RateBaseCurrencyID
+}
+
+// FavoriteCurrencyID returns the currency the family reports in when no user is known, e.g. in
+// background tasks. It's the favorite currency of the first user who set one, Users must be loaded.
+func (f Family) FavoriteCurrencyID() string {
+	for _, u := range f.Users {
+		if u.FavoriteCurrencyID != "" {
+			return u.FavoriteCurrencyID
+		}
+	}
+	return ""
 }
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+// Goal is a savings goal tracked by the balance of its accounts or by transactions with its tag.
+type Goal struct {
+	gorm.Model
+
+	Name         string
+	Description  string
+	TargetAmount decimal.Decimal `gorm:"type:decimal(20,8)"`
+	CurrencyID   string
+	TargetDate   time.Time
+	AccountIDs   []string `gorm:"serializer:json"`
+	Tag          string
+	Priority     int32
+
+	FamilyID uuid.UUID `gorm:"type:uuid;index;not null"`
+	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
+}
+
+func (g *Goal) FromDB() goserver.Goal {
+	return goserver.Goal{
+		Id:           g.ID.String(),
+		Name:         g.Name,
+		Description:  g.Description,
+		TargetAmount: g.TargetAmount,
+		CurrencyId:   g.CurrencyID,
+		TargetDate:   g.TargetDate,
+		AccountIds:   g.AccountIDs,
+		Tag:          g.Tag,
+		Priority:     g.Priority,
+	}
+}
+
+func GoalToDB(m goserver.GoalNoIdInterface, familyID uuid.UUID) *Goal {
+	return &Goal{
+		FamilyID:     familyID,
+		Name:         m.GetName(),
+		Description:  m.GetDescription(),
+		TargetAmount: m.GetTargetAmount(),
+		CurrencyID:   m.GetCurrencyId(),
+		TargetDate:   m.GetTargetDate(),
+		AccountIDs:   m.GetAccountIds(),
+		Tag:          m.GetTag(),
+		Priority:     m.GetPriority(),
+	}
+}
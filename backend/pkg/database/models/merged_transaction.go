@@ -29,6 +29,11 @@ type MergedTransaction struct {
 	IsAuto             bool
 	SuspiciousReasons  []string `gorm:"serializer:json"`
 
+	// KeptMovements and KeptTags are the movements and tags of the kept transaction before a transfer
+	// was confirmed, which replaced them. They are restored on unmerge and empty for other merges.
+	KeptMovements []goserver.Movement `gorm:"serializer:json"`
+	KeptTags      []string            `gorm:"serializer:json"`
+
 	MergedAt  time.Time `gorm:"index"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
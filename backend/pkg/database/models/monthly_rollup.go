@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// MonthlyRollup is the sum of movements of an account in a currency during a calendar month. It's
+// maintained together with transactions, so reports don't have to load all of them.
+type MonthlyRollup struct {
+	FamilyID   uuid.UUID `gorm:"type:uuid;primaryKey"`
+	AccountID  string    `gorm:"primaryKey"`
+	CurrencyID string    `gorm:"primaryKey"`
+	// Month is the first day of the calendar month in UTC
+	Month  time.Time       `gorm:"primaryKey"`
+	Amount decimal.Decimal `gorm:"type:decimal(20,8)"`
+	// Count is the number of movements in the sum
+	Count int
+}
+
+// RollupMonth returns the calendar month of the date in UTC as stored in MonthlyRollup.Month.
+func RollupMonth(date time.Time) time.Time {
+	date = date.UTC()
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// IsCountedInRollups checks if the transaction is part of reports, i.e. it's neither deleted nor
+// merged into another one.
+func (t *Transaction) IsCountedInRollups() bool {
+	return t.MergedIntoID == nil && !t.DeletedAt.Valid
+}
+
+// AddToRollups adds (sign 1) or removes (sign -1) movements of the transaction to the sums.
+func (t *Transaction) AddToRollups(sums map[MonthlyRollupKey]MonthlyRollup, sign int) {
+	if t == nil || !t.IsCountedInRollups() {
+		return
+	}
+
+	month := RollupMonth(t.Date)
+	for _, m := range t.Movements {
+		key := MonthlyRollupKey{AccountID: m.AccountId, CurrencyID: m.CurrencyId, Month: month}
+		sum := sums[key]
+		sum.Amount = sum.Amount.Add(m.Amount.Mul(decimal.NewFromInt(int64(sign))))
+		sum.Count += sign
+		sums[key] = sum
+	}
+}
+
+// MonthlyRollupKey identifies a MonthlyRollup of a family.
+type MonthlyRollupKey struct {
+	AccountID  string
+	CurrencyID string
+	Month      time.Time
+}
+
+func (r *MonthlyRollup) Key() MonthlyRollupKey {
+	return MonthlyRollupKey{AccountID: r.AccountID, CurrencyID: r.CurrencyID, Month: r.Month.UTC()}
+}
@@ -17,6 +17,9 @@ const (
 	NotificationTypeError              NotificationType = "error"
 	NotificationTypeInfo               NotificationType = "info"
 	NotificationTypeDuplicateDetected  NotificationType = "duplicateDetected"
+	NotificationTypeTransferPaired     NotificationType = "transferPaired"
+	NotificationTypeSpendingAnomaly    NotificationType = "spendingAnomaly"
+	NotificationTypeBudgetAlert        NotificationType = "budgetAlert"
 )
 
 const DuplicateReason = "Potential duplicate from different importer"
@@ -30,6 +33,9 @@ type Notification struct {
 	Title       string
 	Description string
 
+	// Key identifies notifications of a family which are sent once, it's empty for the other ones
+	Key *string `gorm:"uniqueIndex"`
+
 	FamilyID uuid.UUID `gorm:"type:uuid;index;not null;index:idx_notifications_family_date,priority:1"`
 	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
 }
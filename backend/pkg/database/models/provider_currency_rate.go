@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ProviderCurrencyRate represents currency rate for a specific date published by an exchange rate
+// provider, the rate is the value of one unit of the currency in the base currency of the provider
+type ProviderCurrencyRate struct {
+	Provider     string          `gorm:"not null;index"`
+	CurrencyCode string          `gorm:"not null"`
+	Rate         decimal.Decimal `gorm:"not null"`
+	RateDate     time.Time       `gorm:"not null;index"`
+}
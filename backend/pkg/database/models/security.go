@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"gorm.io/gorm"
+)
+
+type Security struct {
+	gorm.Model
+
+	goserver.SecurityNoId
+
+	FamilyID uuid.UUID `gorm:"type:uuid;index;not null"`
+	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
+}
+
+func (s *Security) FromDB() goserver.Security {
+	return goserver.Security{
+		Id:          s.ID.String(),
+		Name:        s.Name,
+		Ticker:      s.Ticker,
+		Description: s.Description,
+		CurrencyId:  s.CurrencyId,
+	}
+}
+
+// SecurityPrice is the price of one unit of the security on a day, in the currency of the security.
+type SecurityPrice struct {
+	FamilyID   uuid.UUID `gorm:"type:uuid;index;not null"`
+	SecurityID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	// Date is the day of the price in UTC
+	Date  time.Time       `gorm:"primaryKey"`
+	Price decimal.Decimal `gorm:"type:decimal(20,8)"`
+}
+
+func (p *SecurityPrice) FromDB() goserver.SecurityPrice {
+	return goserver.SecurityPrice{
+		Date:  p.Date,
+		Price: p.Price,
+	}
+}
+
+// SecurityPriceDay returns the day of the date in UTC as stored in SecurityPrice.Date.
+func SecurityPriceDay(date time.Time) time.Time {
+	date = date.UTC()
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
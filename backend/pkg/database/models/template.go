@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
 	"gorm.io/gorm"
@@ -16,6 +18,9 @@ type TransactionTemplate struct {
 	PartnerName string
 	Extra       string
 	Movements   []goserver.Movement `gorm:"serializer:json"`
+	// Repeat and NextDate make the template a scheduled payment for the balance forecast
+	Repeat   string
+	NextDate time.Time
 
 	FamilyID uuid.UUID `gorm:"type:uuid;index;not null"`
 	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
@@ -31,6 +36,8 @@ func (t *TransactionTemplate) FromDB() goserver.TransactionTemplate {
 		PartnerName: t.PartnerName,
 		Extra:       t.Extra,
 		Movements:   t.Movements,
+		Repeat:      t.Repeat,
+		NextDate:    t.NextDate,
 	}
 }
 
@@ -54,5 +61,7 @@ func TemplateToDB(t goserver.TransactionTemplateNoIdInterface, familyID uuid.UUI
 		PartnerName: t.GetPartnerName(),
 		Extra:       t.GetExtra(),
 		Movements:   movements,
+		Repeat:      t.GetRepeat(),
+		NextDate:    t.GetNextDate(),
 	}
 }
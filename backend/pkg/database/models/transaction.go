@@ -48,6 +48,14 @@ type Transaction struct {
 	// DuplicateDismissed is set to true when user marks duplicate detection as false positive
 	DuplicateDismissed bool `gorm:"default:false"`
 
+	// RefundOfID links a refund or reimbursement to the original expense transaction
+	RefundOfID *uuid.UUID `gorm:"type:uuid;index"`
+	// RefundKind is either "refund" or "reimbursement", set together with RefundOfID
+	RefundKind string
+
+	// ExchangeRates are effective rates of a multi-currency transaction, calculated from its movements
+	ExchangeRates []goserver.TransactionRate `gorm:"serializer:json"`
+
 	FamilyID uuid.UUID `gorm:"type:uuid;index;not null;index:idx_transactions_family_merged_date,priority:1"`
 	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
 }
@@ -68,6 +76,11 @@ func (t *Transaction) FromDB() goserver.Transaction {
 		mergedAt = *t.MergedAt
 	}
 
+	var refundOfID string
+	if t.RefundOfID != nil {
+		refundOfID = t.RefundOfID.String()
+	}
+
 	return goserver.Transaction{
 		Id:                      t.ID.String(),
 		Date:                    t.Date,
@@ -88,6 +101,9 @@ func (t *Transaction) FromDB() goserver.Transaction {
 		MergedAt:                mergedAt,
 		AutoMatchSkipReason:     t.AutoMatchSkipReason,
 		DuplicateDismissed:      t.DuplicateDismissed,
+		RefundOfId:              refundOfID,
+		RefundKind:              t.RefundKind,
+		ExchangeRates:           t.ExchangeRates,
 		MergedTransactionIds:    []string{}, // Populated by storage
 		DuplicateTransactionIds: []string{}, // Populated by storage
 	}
@@ -109,6 +125,11 @@ func (t *Transaction) WithoutID() *goserver.TransactionNoId {
 		mergedAt = *t.MergedAt
 	}
 
+	var refundOfID string
+	if t.RefundOfID != nil {
+		refundOfID = t.RefundOfID.String()
+	}
+
 	return &goserver.TransactionNoId{
 		Date:                    t.Date,
 		Description:             t.Description,
@@ -127,6 +148,9 @@ func (t *Transaction) WithoutID() *goserver.TransactionNoId {
 		MergedIntoId:            mergedIntoID,
 		MergedAt:                mergedAt,
 		DuplicateDismissed:      t.DuplicateDismissed,
+		RefundOfId:              refundOfID,
+		RefundKind:              t.RefundKind,
+		ExchangeRates:           t.ExchangeRates,
 		MergedTransactionIds:    []string{}, // Populated by storage
 		DuplicateTransactionIds: []string{}, // Managed via junction table
 	}
@@ -155,6 +179,14 @@ func TransactionToDB(transaction goserver.TransactionNoIdInterface, familyID uui
 		mergedAt = &t
 	}
 
+	var refundOfID *uuid.UUID
+	if transaction.GetRefundOfId() != "" {
+		id, err := uuid.Parse(transaction.GetRefundOfId())
+		if err == nil {
+			refundOfID = &id
+		}
+	}
+
 	return &Transaction{
 		Date:                transaction.GetDate(),
 		Description:         transaction.GetDescription(),
@@ -174,6 +206,8 @@ func TransactionToDB(transaction goserver.TransactionNoIdInterface, familyID uui
 		MergedAt:            mergedAt,
 		AutoMatchSkipReason: transaction.GetAutoMatchSkipReason(),
 		DuplicateDismissed:  transaction.GetDuplicateDismissed(),
+		RefundOfID:          refundOfID,
+		RefundKind:          transaction.GetRefundKind(),
 		FamilyID:            familyID,
 	}
 }
@@ -198,6 +232,9 @@ func TransactionWithoutID(transaction *goserver.Transaction) *goserver.Transacti
 		MergedAt:                transaction.MergedAt,
 		AutoMatchSkipReason:     transaction.AutoMatchSkipReason,
 		DuplicateDismissed:      transaction.DuplicateDismissed,
+		RefundOfId:              transaction.RefundOfId,
+		RefundKind:              transaction.RefundKind,
+		ExchangeRates:           transaction.ExchangeRates,
 		DuplicateTransactionIds: transaction.DuplicateTransactionIds,
 	}
 }
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+// TransferRule is learned when user confirms a transfer between two own accounts.
+// Later transfers between the same accounts are paired automatically.
+type TransferRule struct {
+	gorm.Model
+
+	FromAccountID   string `gorm:"index:idx_transfer_rules_family_accounts,priority:2"`
+	ToAccountID     string `gorm:"index:idx_transfer_rules_family_accounts,priority:3"`
+	Confirmations   int32
+	LastConfirmedAt time.Time
+
+	FamilyID uuid.UUID `gorm:"type:uuid;not null;index:idx_transfer_rules_family_accounts,priority:1"`
+	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
+}
+
+func (r *TransferRule) FromDB() goserver.TransferRule {
+	return goserver.TransferRule{
+		Id:              r.ID.String(),
+		FromAccountId:   r.FromAccountID,
+		ToAccountId:     r.ToAccountID,
+		Confirmations:   r.Confirmations,
+		LastConfirmedAt: r.LastConfirmedAt,
+	}
+}
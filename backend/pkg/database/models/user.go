@@ -11,12 +11,15 @@ type User struct {
 	coremodels.User
 	StartDate          time.Time
 	FavoriteCurrencyID string
+	// QuickEntryAccountID is the default source account of quick entry transactions
+	QuickEntryAccountID string
 }
 
 func (u User) FromDB() goserver.User {
 	return goserver.User{
-		Email:              u.Username,
-		StartDate:          u.StartDate,
-		FavoriteCurrencyId: u.FavoriteCurrencyID,
+		Email:                  u.Username,
+		StartDate:              u.StartDate,
+		FavoriteCurrencyId:     u.FavoriteCurrencyID,
+		QuickEntryAccountId:    u.QuickEntryAccountID,
 	}
 }
@@ -24,6 +24,19 @@ var (
 	ErrAccountInUse                       = errors.New("account is in use")
 	ErrImportedTransactionCannotBeDeleted = errors.New("imported transaction cannot be deleted")
 	ErrCurrencyInUse                      = errors.New("currency is in use")
+	ErrNotTransferPair                    = errors.New("transactions are not two halves of a transfer")
+	ErrInvalidRefundLink                  = errors.New("transaction cannot be linked as refund")
+	ErrInvalidAccountParent               = errors.New("account can't have this parent")
+	ErrInvalidLoanTerms                   = errors.New("invalid loan terms")
+	ErrInvalidBudgetAlerts                = errors.New("invalid budget alerts")
+	ErrInvalidBudgetRollover              = errors.New("invalid budget rollover")
+	ErrInvalidSecurity                    = errors.New("invalid security")
+	ErrSecurityInUse                      = errors.New("security is in use")
+	ErrInvalidBudgetItem                  = errors.New("invalid budget item")
+	ErrInvalidBudgetPlan                  = errors.New("invalid budget plan")
+	ErrInvalidBudgetTransfer              = errors.New("invalid budget transfer")
+	ErrInvalidGoal                        = errors.New("invalid goal")
+	ErrInvalidExchangeRate                = errors.New("invalid exchange rate")
 )
 
 type ImportInfo struct {
@@ -48,6 +61,9 @@ type UserStorage interface {
 	CreateUser(username, passwordHash string, familyID uuid.UUID) (*models.User, error)
 	PutUser(user *models.User) error
 	GetFamilyByName(name string) (*models.Family, error)
+	// GetFamily returns the family with its users, the oldest user first
+	GetFamily(familyID uuid.UUID) (*models.Family, error)
+	PutFamily(family *models.Family) error
 	CreateFamily(name string) (*models.Family, error)
 	GetAllFamilyIDs() ([]uuid.UUID, error)
 }
@@ -70,6 +86,21 @@ type CurrencyStorage interface {
 	DeleteCurrency(familyID uuid.UUID, id string, replaceWithCurrencyID *string) error
 }
 
+// SecurityStorage manages securities held in accounts and their price history.
+type SecurityStorage interface {
+	CreateSecurity(familyID uuid.UUID, security *goserver.SecurityNoId) (goserver.Security, error)
+	GetSecurities(familyID uuid.UUID) ([]goserver.Security, error)
+	GetSecurity(familyID uuid.UUID, id string) (goserver.Security, error)
+	UpdateSecurity(familyID uuid.UUID, id string, security *goserver.SecurityNoId) (goserver.Security, error)
+	// DeleteSecurity deletes the security with its prices, fails if movements use it
+	DeleteSecurity(familyID uuid.UUID, id string) error
+	// GetSecurityPrices returns prices of the security in [dateFrom, dateTo) sorted by date, zero
+	// dates don't limit the range
+	GetSecurityPrices(familyID uuid.UUID, securityID string, dateFrom, dateTo time.Time) ([]goserver.SecurityPrice, error)
+	// SetSecurityPrices stores prices of the security, prices of the same day are replaced
+	SetSecurityPrices(familyID uuid.UUID, securityID string, prices []goserver.SecurityPrice) error
+}
+
 type TransactionStorage interface {
 	GetTransactions(familyID uuid.UUID, dateFrom, dateTo time.Time, onlySuspicious bool) ([]goserver.Transaction, error)
 	CreateTransaction(familyID uuid.UUID, transaction goserver.TransactionNoIdInterface) (goserver.Transaction, error)
@@ -97,6 +128,46 @@ type TransactionStorage interface {
 	ClearDuplicateRelationships(familyID uuid.UUID, transactionID string) error
 	CountUnprocessedTransactionsForAccount(familyID uuid.UUID, accountID string, ignoreUnprocessedBefore time.Time) (int, error)
 	HasTransactionsAfterDate(familyID uuid.UUID, accountID string, date time.Time) (bool, error)
+	// LinkRefund marks transaction as refund or reimbursement of the original transaction
+	LinkRefund(familyID uuid.UUID, id, originalID, kind string) (goserver.Transaction, error)
+	UnlinkRefund(familyID uuid.UUID, id string) (goserver.Transaction, error)
+	// GetRefunds returns transactions in the date range linked as refunds of other transactions
+	GetRefunds(familyID uuid.UUID, dateFrom, dateTo time.Time) ([]goserver.Transaction, error)
+	// GetExchangeTransactions returns transactions in the date range which have effective exchange rates
+	GetExchangeTransactions(familyID uuid.UUID, dateFrom, dateTo time.Time) ([]goserver.Transaction, error)
+	// GetTransactionDateRange returns dates of the first and the last transaction of the family, zero
+	// dates if there are no transactions
+	GetTransactionDateRange(familyID uuid.UUID) (time.Time, time.Time, error)
+}
+
+// RollupStorage gives access to monthly sums of movements which are maintained together with
+// transactions.
+type RollupStorage interface {
+	// GetMonthlyRollups returns rollups of calendar months in [monthFrom, monthTo), zero dates
+	// don't limit the range
+	GetMonthlyRollups(familyID uuid.UUID, monthFrom, monthTo time.Time) ([]models.MonthlyRollup, error)
+	// RebuildMonthlyRollups recalculates all rollups of the family from its transactions
+	RebuildMonthlyRollups(familyID uuid.UUID) error
+	// CheckMonthlyRollups compares stored rollups with sums of transactions and returns differences
+	CheckMonthlyRollups(familyID uuid.UUID) ([]RollupMismatch, error)
+}
+
+// TagStorage manages hierarchical tags of transactions, matchers and templates.
+type TagStorage interface {
+	// GetTags returns used tags and their parents ordered so sub-tags follow their parent
+	GetTags(familyID uuid.UUID) ([]goserver.TagInfo, error)
+	// RenameTag renames the tag and its sub-tags everywhere, tags which become equal are merged
+	RenameTag(familyID uuid.UUID, from, to string) (goserver.TagChangeResult, error)
+	// MergeTags renames all the sources and their sub-tags to the target at once
+	MergeTags(familyID uuid.UUID, sources []string, target string) (goserver.TagChangeResult, error)
+}
+
+type TransferStorage interface {
+	// ConfirmTransfer merges one-sided outgoing and incoming transactions into one transfer
+	// transaction and learns a transfer rule for the pair of accounts.
+	ConfirmTransfer(familyID uuid.UUID, outgoingID, incomingID string) (goserver.Transaction, error)
+	GetTransferRules(familyID uuid.UUID) ([]goserver.TransferRule, error)
+	DeleteTransferRule(familyID uuid.UUID, id string) error
 }
 
 type BankImporterStorage interface {
@@ -139,6 +210,20 @@ type TemplateStorage interface {
 type RateStorage interface {
 	SaveCNBRates(rates map[string]decimal.Decimal, day time.Time) error
 	GetCNBRates(day time.Time) (map[string]decimal.Decimal, error)
+	SaveProviderRates(provider string, rates map[string]decimal.Decimal, day time.Time) error
+	GetProviderRates(provider string, day time.Time) (map[string]decimal.Decimal, error)
+	GetCNBRateDates(dateFrom, dateTo time.Time) ([]time.Time, error)
+	GetCNBRatesHistory(currencyCode string, dateFrom, dateTo time.Time) ([]models.CNBCurrencyRate, error)
+	GetProviderRateDates(provider string, dateFrom, dateTo time.Time) ([]time.Time, error)
+	GetProviderRatesHistory(
+		provider, currencyCode string, dateFrom, dateTo time.Time,
+	) ([]models.ProviderCurrencyRate, error)
+
+	CreateExchangeRate(familyID uuid.UUID, rate *goserver.ExchangeRateNoId) (goserver.ExchangeRate, error)
+	GetExchangeRates(familyID uuid.UUID) ([]goserver.ExchangeRate, error)
+	GetExchangeRate(familyID uuid.UUID, id string) (goserver.ExchangeRate, error)
+	UpdateExchangeRate(familyID uuid.UUID, id string, rate *goserver.ExchangeRateNoId) (goserver.ExchangeRate, error)
+	DeleteExchangeRate(familyID uuid.UUID, id string) error
 }
 
 type BudgetItemStorage interface {
@@ -147,6 +232,24 @@ type BudgetItemStorage interface {
 	GetBudgetItem(familyID uuid.UUID, id string) (goserver.BudgetItem, error)
 	UpdateBudgetItem(familyID uuid.UUID, id string, budgetItem *goserver.BudgetItemNoId) (goserver.BudgetItem, error)
 	DeleteBudgetItem(familyID uuid.UUID, id string) error
+
+	CreateBudgetPlan(familyID uuid.UUID, plan *goserver.BudgetPlanNoId) (goserver.BudgetPlan, error)
+	GetBudgetPlans(familyID uuid.UUID) ([]goserver.BudgetPlan, error)
+	GetBudgetPlan(familyID uuid.UUID, id string) (goserver.BudgetPlan, error)
+	UpdateBudgetPlan(familyID uuid.UUID, id string, plan *goserver.BudgetPlanNoId) (goserver.BudgetPlan, error)
+	DeleteBudgetPlan(familyID uuid.UUID, id string) error
+
+	CreateBudgetTransfer(familyID uuid.UUID, transfer *goserver.BudgetTransferNoId) (goserver.BudgetTransfer, error)
+	GetBudgetTransfers(familyID uuid.UUID) ([]goserver.BudgetTransfer, error)
+	GetBudgetTransfer(familyID uuid.UUID, id string) (goserver.BudgetTransfer, error)
+	UpdateBudgetTransfer(familyID uuid.UUID, id string, transfer *goserver.BudgetTransferNoId) (goserver.BudgetTransfer, error)
+	DeleteBudgetTransfer(familyID uuid.UUID, id string) error
+
+	CreateGoal(familyID uuid.UUID, goal *goserver.GoalNoId) (goserver.Goal, error)
+	GetGoals(familyID uuid.UUID) ([]goserver.Goal, error)
+	GetGoal(familyID uuid.UUID, id string) (goserver.Goal, error)
+	UpdateGoal(familyID uuid.UUID, id string, goal *goserver.GoalNoId) (goserver.Goal, error)
+	DeleteGoal(familyID uuid.UUID, id string) error
 }
 
 type ImageStorage interface {
@@ -158,6 +261,12 @@ type ImageStorage interface {
 type NotificationStorage interface {
 	CreateNotification(familyID uuid.UUID, notification *goserver.Notification) (goserver.Notification, error)
 	GetNotifications(familyID uuid.UUID) ([]goserver.Notification, error)
+	// HasNotification checks if a notification with the type and URL was ever created, including
+	// the deleted (dismissed) ones
+	HasNotification(familyID uuid.UUID, notificationType, url string) (bool, error)
+	// CreateNotificationOnce creates the notification unless one with the same type and URL was
+	// ever created. It's safe to call concurrently, false means the notification already existed.
+	CreateNotificationOnce(familyID uuid.UUID, notification *goserver.Notification) (bool, error)
 	DeleteNotification(familyID uuid.UUID, id string) error
 }
 
@@ -186,7 +295,11 @@ type Storage interface {
 	UserStorage
 	AccountStorage
 	CurrencyStorage
+	SecurityStorage
 	TransactionStorage
+	TransferStorage
+	RollupStorage
+	TagStorage
 	BankImporterStorage
 	MatcherStorage
 	TemplateStorage
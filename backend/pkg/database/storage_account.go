@@ -3,11 +3,14 @@ package database
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
 	"gorm.io/gorm"
 )
 
@@ -32,6 +35,10 @@ func (s *storage) GetAccounts(familyID uuid.UUID) ([]goserver.Account, error) {
 }
 
 func (s *storage) CreateAccount(familyID uuid.UUID, account *goserver.AccountNoId) (goserver.Account, error) {
+	if err := s.validateAccount(familyID, "", account); err != nil {
+		return goserver.Account{}, err
+	}
+
 	acc := models.AccountToDB(account, familyID)
 	acc.ID = uuid.New()
 	if err := s.db.Create(&acc).Error; err != nil {
@@ -46,6 +53,10 @@ func (s *storage) CreateAccount(familyID uuid.UUID, account *goserver.AccountNoI
 }
 
 func (s *storage) UpdateAccount(familyID uuid.UUID, id string, account *goserver.AccountNoId) (goserver.Account, error) {
+	if err := s.validateAccount(familyID, id, account); err != nil {
+		return goserver.Account{}, err
+	}
+
 	return performUpdate[models.Account, goserver.AccountNoIdInterface, goserver.Account](s, familyID, "Account", id, account,
 		models.AccountToDB,
 		func(m *models.Account) goserver.Account { return m.FromDB() },
@@ -53,6 +64,95 @@ func (s *storage) UpdateAccount(familyID uuid.UUID, id string, account *goserver
 	)
 }
 
+// validateAccount checks the parent, the loan terms and the budget settings of the account before it's saved and
+// sets the opening balance of new loans.
+func (s *storage) validateAccount(familyID uuid.UUID, id string, account *goserver.AccountNoId) error {
+	accounts, err := s.GetAccounts(familyID)
+	if err != nil {
+		return err
+	}
+	if err := validateAccountParent(accounts, id, account); err != nil {
+		return err
+	}
+	if err := validateLoanTerms(accounts, account); err != nil {
+		return err
+	}
+	if account.BudgetAlerts.ConsumedPercent < 0 || account.BudgetAlerts.ConsumedPercent > 100 {
+		return ErrInvalidBudgetAlerts
+	}
+	if !utils.IsValidBudgetRollover(account.BudgetRollover) {
+		return ErrInvalidBudgetRollover
+	}
+	setLoanOpeningBalance(account)
+	return nil
+}
+
+// setLoanOpeningBalance makes a new loan start owing its principal, unless the opening balance in
+// the loan currency is already set.
+func setLoanOpeningBalance(account *goserver.AccountNoId) {
+	terms := account.LoanTerms
+	if !utils.HasLoanTerms(terms) || slices.ContainsFunc(account.BankInfo.Balances,
+		func(b goserver.BankAccountInfoBalancesInner) bool { return b.CurrencyId == terms.CurrencyId },
+	) {
+		return
+	}
+	account.BankInfo.Balances = append(account.BankInfo.Balances, goserver.BankAccountInfoBalancesInner{
+		CurrencyId:     terms.CurrencyId,
+		OpeningBalance: terms.Principal.Neg(),
+	})
+	if account.OpeningDate.IsZero() {
+		account.OpeningDate = terms.StartDate
+	}
+}
+
+// validateAccountParent checks that the parent exists, has the same type and isn't the account
+// itself or one of its sub-accounts, which would make a cycle. The type of an account with
+// sub-accounts can't be changed.
+func validateAccountParent(accounts []goserver.Account, id string, account *goserver.AccountNoId) error {
+	if account.ParentId != "" && account.ParentId == id {
+		return ErrInvalidAccountParent
+	}
+	if id != "" && slices.ContainsFunc(accounts, func(a goserver.Account) bool {
+		return a.ParentId == id && a.Type != account.Type
+	}) {
+		return ErrInvalidAccountParent
+	}
+	if account.ParentId == "" {
+		return nil
+	}
+
+	idx := slices.IndexFunc(accounts, func(a goserver.Account) bool { return a.Id == account.ParentId })
+	if idx == -1 || accounts[idx].Type != account.Type {
+		return ErrInvalidAccountParent
+	}
+	if id != "" && utils.IsSubAccount(accounts, account.ParentId, id) {
+		return ErrInvalidAccountParent
+	}
+	return nil
+}
+
+// validateLoanTerms allows complete loan terms on liability accounts only. Interest is booked to
+// an expense account.
+func validateLoanTerms(accounts []goserver.Account, account *goserver.AccountNoId) error {
+	// Clients send back zero terms of accounts without a loan
+	terms := account.LoanTerms
+	if terms.Principal.IsZero() && terms.AnnualRate.IsZero() && terms.TermMonths == 0 && terms.PaymentDay == 0 &&
+		terms.StartDate.IsZero() && terms.CurrencyId == "" && terms.InterestAccountId == "" {
+		return nil
+	}
+	if account.Type != constants.AccountLiability || !utils.HasLoanTerms(terms) ||
+		terms.AnnualRate.IsNegative() || terms.PaymentDay < 0 || terms.PaymentDay > 31 ||
+		terms.StartDate.IsZero() || terms.CurrencyId == "" {
+		return ErrInvalidLoanTerms
+	}
+	if terms.InterestAccountId != "" && !slices.ContainsFunc(accounts, func(a goserver.Account) bool {
+		return a.Id == terms.InterestAccountId && a.Type == constants.AccountExpense
+	}) {
+		return ErrInvalidLoanTerms
+	}
+	return nil
+}
+
 func (s *storage) DeleteAccount(familyID uuid.UUID, id string, replaceWithAccountID *string) error {
 	var acc models.Account
 	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).First(&acc).Error; err != nil {
@@ -101,6 +201,8 @@ func (s *storage) DeleteAccount(familyID uuid.UUID, id string, replaceWithAccoun
 				return fmt.Errorf("failed to find transactions for reassignment: %w", err)
 			}
 
+			oldTransactions := []*models.Transaction{}
+			newTransactions := []*models.Transaction{}
 			for _, t := range transactions {
 				updated := false
 				newMovements := make([]goserver.Movement, len(t.Movements))
@@ -113,12 +215,23 @@ func (s *storage) DeleteAccount(familyID uuid.UUID, id string, replaceWithAccoun
 				}
 
 				if updated {
+					oldT := t
 					t.Movements = newMovements
 					if err := tx.Save(&t).Error; err != nil {
 						return fmt.Errorf("failed to save reassigned transaction %s: %w", t.ID, err)
 					}
+					oldTransactions = append(oldTransactions, &oldT)
+					newTransactions = append(newTransactions, &t)
 				}
 			}
+			if err := updateMonthlyRollupsWithTx(tx, familyID, oldTransactions, newTransactions); err != nil {
+				return err
+			}
+
+			// 5. Reassign budget plans, budget transfers, goals, transfer rules and loans
+			if err := reassignAccountReferencesWithTx(tx, familyID, id, newAccountID); err != nil {
+				return err
+			}
 		} else {
 			// User chose NOT to reassign.
 			// Check if account is in use by any entity
@@ -148,6 +261,11 @@ func (s *storage) DeleteAccount(familyID uuid.UUID, id string, replaceWithAccoun
 				return ErrAccountInUse
 			}
 
+			// Check budget plans, budget transfers, goals, transfer rules and loans
+			if err := checkAccountReferencesWithTx(tx, familyID, id); err != nil {
+				return err
+			}
+
 			// Check Transactions (Movements)
 			// Using SQLite's JSON functions for accurate checking
 			if err := tx.Table("transactions").
@@ -161,6 +279,35 @@ func (s *storage) DeleteAccount(familyID uuid.UUID, id string, replaceWithAccoun
 			}
 		}
 
+		// Quick entry default of the users of the family follows the replacement account or is cleared
+		quickEntryAccountID := ""
+		if replaceWithAccountID != nil {
+			quickEntryAccountID = *replaceWithAccountID
+		}
+		if err := tx.Model(&models.User{}).Where("family_id = ? AND quick_entry_account_id = ?", familyID, id).
+			Update("quick_entry_account_id", quickEntryAccountID).Error; err != nil {
+			return fmt.Errorf("failed to reassign user quick entry account: %w", err)
+		}
+
+		// Spending anomalies of the deleted account aren't muted anymore
+		var family models.Family
+		if err := tx.Where("id = ?", familyID).Limit(1).Find(&family).Error; err != nil {
+			return fmt.Errorf("failed to get family: %w", err)
+		}
+		if slices.Contains(family.AnomalyMutedAccountIDs, id) {
+			family.AnomalyMutedAccountIDs = slices.DeleteFunc(family.AnomalyMutedAccountIDs,
+				func(accountID string) bool { return accountID == id })
+			if err := tx.Omit("Users").Save(&family).Error; err != nil {
+				return fmt.Errorf("failed to unmute anomalies of the account: %w", err)
+			}
+		}
+
+		// Sub-accounts move one level up to the parent of the deleted account
+		if err := tx.Model(&models.Account{}).Where("parent_id = ? AND family_id = ?", id, familyID).
+			Update("parent_id", acc.ParentID).Error; err != nil {
+			return fmt.Errorf("failed to re-parent sub-accounts: %w", err)
+		}
+
 		if err := tx.Where("id = ? AND family_id = ?", id, familyID).Delete(&models.Account{}).Error; err != nil {
 			return fmt.Errorf(StorageError, err)
 		}
@@ -168,6 +315,107 @@ func (s *storage) DeleteAccount(familyID uuid.UUID, id string, replaceWithAccoun
 	})
 }
 
+// accountReferences are columns of other entities which refer to accounts
+var accountReferences = []struct {
+	model  any
+	column string
+}{
+	{&models.BudgetPlan{}, "account_id"},
+	{&models.BudgetTransfer{}, "from_account_id"},
+	{&models.BudgetTransfer{}, "to_account_id"},
+	{&models.TransferRule{}, "from_account_id"},
+	{&models.TransferRule{}, "to_account_id"},
+}
+
+// reassignAccountReferencesWithTx replaces the account with the new one in other entities
+// which refer to it, except of transactions, bank importers, matchers and budget items.
+func reassignAccountReferencesWithTx(tx *gorm.DB, familyID uuid.UUID, id, newAccountID string) error {
+	for _, ref := range accountReferences {
+		if err := tx.Model(ref.model).Where(ref.column+" = ? AND family_id = ?", id, familyID).
+			Update(ref.column, newAccountID).Error; err != nil {
+			return fmt.Errorf("failed to reassign %s: %w", ref.column, err)
+		}
+	}
+	// Transfers between the deleted account and its replacement don't move anything anymore
+	for _, model := range []any{&models.BudgetTransfer{}, &models.TransferRule{}} {
+		if err := tx.Where("family_id = ? AND from_account_id = ? AND to_account_id = ?",
+			familyID, newAccountID, newAccountID).Delete(model).Error; err != nil {
+			return fmt.Errorf("failed to delete transfers to the same account: %w", err)
+		}
+	}
+
+	var goals []models.Goal
+	if err := tx.Joins("CROSS JOIN json_each(goals.account_ids)").
+		Where("goals.family_id = ? AND json_each.value = ?", familyID, id).
+		Group("goals.id").
+		Find(&goals).Error; err != nil {
+		return fmt.Errorf("failed to find goals for reassignment: %w", err)
+	}
+	for _, g := range goals {
+		accountIDs := make([]string, 0, len(g.AccountIDs))
+		for _, accountID := range g.AccountIDs {
+			if accountID == id {
+				accountID = newAccountID
+			}
+			if !slices.Contains(accountIDs, accountID) {
+				accountIDs = append(accountIDs, accountID)
+			}
+		}
+		g.AccountIDs = accountIDs
+		if err := tx.Save(&g).Error; err != nil {
+			return fmt.Errorf("failed to save reassigned goal %s: %w", g.ID, err)
+		}
+	}
+
+	var loans []models.Account
+	if err := tx.Where("family_id = ? AND json_extract(loan_terms, '$.interestAccountId') = ?", familyID, id).
+		Find(&loans).Error; err != nil {
+		return fmt.Errorf("failed to find loans for reassignment: %w", err)
+	}
+	for _, loan := range loans {
+		loan.LoanTerms.InterestAccountId = newAccountID
+		if err := tx.Save(&loan).Error; err != nil {
+			return fmt.Errorf("failed to save reassigned loan %s: %w", loan.ID, err)
+		}
+	}
+	return nil
+}
+
+// checkAccountReferencesWithTx returns ErrAccountInUse if other entities than transactions, bank
+// importers, matchers and budget items refer to the account.
+func checkAccountReferencesWithTx(tx *gorm.DB, familyID uuid.UUID, id string) error {
+	var count int64
+	for _, ref := range accountReferences {
+		if err := tx.Model(ref.model).Where(ref.column+" = ? AND family_id = ?", id, familyID).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check %s: %w", ref.column, err)
+		}
+		if count > 0 {
+			return ErrAccountInUse
+		}
+	}
+
+	if err := tx.Table("goals").
+		Joins("CROSS JOIN json_each(goals.account_ids)").
+		Where("goals.family_id = ? AND goals.deleted_at IS NULL AND json_each.value = ?", familyID, id).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check goals: %w", err)
+	}
+	if count > 0 {
+		return ErrAccountInUse
+	}
+
+	if err := tx.Model(&models.Account{}).
+		Where("family_id = ? AND json_extract(loan_terms, '$.interestAccountId') = ?", familyID, id).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check loans: %w", err)
+	}
+	if count > 0 {
+		return ErrAccountInUse
+	}
+	return nil
+}
+
 func (s *storage) GetAccountHistory(familyID uuid.UUID, accountID string) ([]goserver.Transaction, error) {
 	// result, err := s.db.Model(&models.Transaction{}).Where("family_id = ? AND account_id = ?", familyID, accountID).Rows()
 	// if err != nil {
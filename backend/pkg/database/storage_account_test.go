@@ -0,0 +1,289 @@
+package database_test
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Account hierarchy", func() {
+	var (
+		db       database.Storage
+		familyID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	)
+
+	create := func(name, accountType, parentID string) goserver.Account {
+		acc, err := db.CreateAccount(familyID, &goserver.AccountNoId{Name: name, Type: accountType, ParentId: parentID})
+		Expect(err).NotTo(HaveOccurred())
+		return acc
+	}
+
+	BeforeEach(func() {
+		cfg := &config.Config{DBPath: ":memory:", Verbose: false}
+		db = database.NewStorage(slog.Default(), cfg)
+		Expect(db.Open()).To(Succeed())
+		DeferCleanup(db.Close)
+	})
+
+	It("stores the parent account", func() {
+		food := create("Food", "expense", "")
+		groceries := create("Groceries", "expense", food.Id)
+
+		acc, err := db.GetAccount(familyID, groceries.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(acc.ParentId).To(Equal(food.Id))
+	})
+
+	It("refuses invalid parents", func() {
+		food := create("Food", "expense", "")
+		groceries := create("Groceries", "expense", food.Id)
+		bank := create("Bank", "asset", "")
+
+		_, err := db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Cash", Type: "asset", ParentId: food.Id})
+		Expect(err).To(MatchError(database.ErrInvalidAccountParent))
+		_, err = db.CreateAccount(familyID, &goserver.AccountNoId{Name: "X", Type: "expense", ParentId: uuid.NewString()})
+		Expect(err).To(MatchError(database.ErrInvalidAccountParent))
+
+		// Cycles
+		_, err = db.UpdateAccount(familyID, food.Id, &goserver.AccountNoId{Name: "Food", Type: "expense", ParentId: groceries.Id})
+		Expect(err).To(MatchError(database.ErrInvalidAccountParent))
+		_, err = db.UpdateAccount(familyID, food.Id, &goserver.AccountNoId{Name: "Food", Type: "expense", ParentId: food.Id})
+		Expect(err).To(MatchError(database.ErrInvalidAccountParent))
+
+		// Type of a parent can't diverge from its sub-accounts
+		_, err = db.UpdateAccount(familyID, food.Id, &goserver.AccountNoId{Name: "Food", Type: "income"})
+		Expect(err).To(MatchError(database.ErrInvalidAccountParent))
+
+		_, err = db.UpdateAccount(familyID, bank.Id, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("moves sub-accounts to the parent of a deleted account", func() {
+		food := create("Food", "expense", "")
+		groceries := create("Groceries", "expense", food.Id)
+		bakery := create("Bakery", "expense", groceries.Id)
+
+		Expect(db.DeleteAccount(familyID, groceries.Id, nil)).To(Succeed())
+
+		acc, err := db.GetAccount(familyID, bakery.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(acc.ParentId).To(Equal(food.Id))
+
+		Expect(db.DeleteAccount(familyID, food.Id, nil)).To(Succeed())
+		acc, err = db.GetAccount(familyID, bakery.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(acc.ParentId).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Loan accounts", func() {
+	var (
+		db       database.Storage
+		familyID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		interest goserver.Account
+		terms    goserver.LoanTerms
+	)
+
+	BeforeEach(func() {
+		cfg := &config.Config{DBPath: ":memory:", Verbose: false}
+		db = database.NewStorage(slog.Default(), cfg)
+		Expect(db.Open()).To(Succeed())
+		DeferCleanup(db.Close)
+
+		var err error
+		interest, err = db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Interest", Type: "expense"})
+		Expect(err).NotTo(HaveOccurred())
+		terms = goserver.LoanTerms{
+			Principal:         decimal.NewFromInt(12000),
+			AnnualRate:        decimal.NewFromInt(6),
+			TermMonths:        12,
+			StartDate:         time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+			CurrencyId:        "usd",
+			InterestAccountId: interest.Id,
+		}
+	})
+
+	It("opens a loan owing its principal", func() {
+		loan, err := db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Mortgage", Type: "liability", LoanTerms: terms})
+		Expect(err).NotTo(HaveOccurred())
+
+		acc, err := db.GetAccount(familyID, loan.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(acc.LoanTerms.Principal.Equal(terms.Principal)).To(BeTrue())
+		Expect(acc.OpeningDate).To(Equal(terms.StartDate))
+		Expect(acc.BankInfo.Balances).To(HaveLen(1))
+		Expect(acc.BankInfo.Balances[0].CurrencyId).To(Equal("usd"))
+		Expect(acc.BankInfo.Balances[0].OpeningBalance.Equal(decimal.NewFromInt(-12000))).To(BeTrue())
+	})
+
+	It("refuses invalid loan terms", func() {
+		_, err := db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Bank", Type: "asset", LoanTerms: terms})
+		Expect(err).To(MatchError(database.ErrInvalidLoanTerms))
+
+		invalid := terms
+		invalid.TermMonths = 0
+		_, err = db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Loan", Type: "liability", LoanTerms: invalid})
+		Expect(err).To(MatchError(database.ErrInvalidLoanTerms))
+
+		invalid = terms
+		invalid.InterestAccountId = uuid.NewString()
+		_, err = db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Loan", Type: "liability", LoanTerms: invalid})
+		Expect(err).To(MatchError(database.ErrInvalidLoanTerms))
+
+		// Liabilities without a loan, e.g. credit cards, don't need terms
+		_, err = db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Card", Type: "liability"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
+
+var _ = Describe("Account deletion", func() {
+	var (
+		db       database.Storage
+		familyID uuid.UUID
+		bank     goserver.Account
+		cash     goserver.Account
+	)
+
+	create := func(name, accountType string) goserver.Account {
+		acc, err := db.CreateAccount(familyID, &goserver.AccountNoId{Name: name, Type: accountType})
+		Expect(err).NotTo(HaveOccurred())
+		return acc
+	}
+
+	BeforeEach(func() {
+		cfg := &config.Config{DBPath: ":memory:", Verbose: false}
+		db = database.NewStorage(slog.Default(), cfg)
+		Expect(db.Open()).To(Succeed())
+		DeferCleanup(db.Close)
+
+		family, err := db.CreateFamily("family")
+		Expect(err).NotTo(HaveOccurred())
+		familyID = family.ID
+		bank = create("Bank", "asset")
+		cash = create("Cash", "asset")
+	})
+
+	It("moves the quick entry account of users to the replacement", func() {
+		user, err := db.CreateUser("user@test.com", "hash", familyID)
+		Expect(err).NotTo(HaveOccurred())
+		user.QuickEntryAccountID = bank.Id
+		Expect(db.PutUser(user)).To(Succeed())
+
+		Expect(db.DeleteAccount(familyID, bank.Id, &cash.Id)).To(Succeed())
+
+		user, err = db.GetUser(user.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(user.QuickEntryAccountID).To(Equal(cash.Id))
+	})
+
+	It("unmutes anomalies of the deleted account", func() {
+		family, err := db.GetFamily(familyID)
+		Expect(err).NotTo(HaveOccurred())
+		family.AnomalyMutedAccountIDs = []string{bank.Id, cash.Id}
+		Expect(db.PutFamily(family)).To(Succeed())
+
+		Expect(db.DeleteAccount(familyID, bank.Id, nil)).To(Succeed())
+
+		family, err = db.GetFamily(familyID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(family.AnomalyMutedAccountIDs).To(Equal([]string{cash.Id}))
+	})
+
+	Context("with budgets, goals, transfer rules and loans", func() {
+		var (
+			czk       goserver.Currency
+			food, fun goserver.Account
+			loan      goserver.Account
+		)
+
+		BeforeEach(func() {
+			var err error
+			czk, err = db.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "CZK"})
+			Expect(err).NotTo(HaveOccurred())
+			food = create("Food", "expense")
+			fun = create("Fun", "expense")
+			loan, err = db.CreateAccount(familyID, &goserver.AccountNoId{
+				Name: "Mortgage", Type: "liability",
+				LoanTerms: goserver.LoanTerms{
+					Principal: decimal.NewFromInt(12000), AnnualRate: decimal.NewFromInt(6), TermMonths: 12,
+					StartDate: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), CurrencyId: czk.Id,
+					InterestAccountId: food.Id,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = db.CreateBudgetPlan(familyID, &goserver.BudgetPlanNoId{
+				AccountId: food.Id, Amount: decimal.NewFromInt(100), Recurrence: "monthly",
+				EffectiveFrom: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = db.CreateBudgetTransfer(familyID, &goserver.BudgetTransferNoId{
+				Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), FromAccountId: food.Id, ToAccountId: fun.Id,
+				Amount: decimal.NewFromInt(10),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = db.CreateGoal(familyID, &goserver.GoalNoId{
+				Name: "Savings", TargetAmount: decimal.NewFromInt(1000), CurrencyId: czk.Id,
+				TargetDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), AccountIds: []string{bank.Id, cash.Id},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			oneSided := func(account goserver.Account, amount int64) string {
+				t, err := db.CreateTransaction(familyID, &goserver.TransactionNoId{
+					Date: time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC),
+					Movements: []goserver.Movement{
+						{Amount: decimal.NewFromInt(-amount), CurrencyId: czk.Id},
+						{AccountId: account.Id, Amount: decimal.NewFromInt(amount), CurrencyId: czk.Id},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				return t.Id
+			}
+			_, err = db.ConfirmTransfer(familyID, oneSided(bank, -50), oneSided(loan, 50))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("refuses to delete accounts which are referred to", func() {
+			for _, account := range []goserver.Account{food, fun, bank, cash, loan} {
+				Expect(db.DeleteAccount(familyID, account.Id, nil)).To(MatchError(database.ErrAccountInUse))
+			}
+		})
+
+		It("moves references to the replacement", func() {
+			Expect(db.DeleteAccount(familyID, food.Id, &fun.Id)).To(Succeed())
+			Expect(db.DeleteAccount(familyID, bank.Id, &cash.Id)).To(Succeed())
+
+			plans, err := db.GetBudgetPlans(familyID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plans).To(HaveLen(1))
+			Expect(plans[0].AccountId).To(Equal(fun.Id))
+
+			// The transfer between the deleted account and its replacement is gone
+			transfers, err := db.GetBudgetTransfers(familyID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(transfers).To(BeEmpty())
+
+			goals, err := db.GetGoals(familyID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(goals).To(HaveLen(1))
+			Expect(goals[0].AccountIds).To(Equal([]string{cash.Id}))
+
+			rules, err := db.GetTransferRules(familyID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rules).To(HaveLen(1))
+			Expect(rules[0].FromAccountId).To(Equal(cash.Id))
+			Expect(rules[0].ToAccountId).To(Equal(loan.Id))
+
+			loan, err = db.GetAccount(familyID, loan.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loan.LoanTerms.InterestAccountId).To(Equal(fun.Id))
+		})
+	})
+})
@@ -7,10 +7,15 @@ import (
 	"github.com/google/uuid"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
 	"gorm.io/gorm"
 )
 
 func (s *storage) CreateBudgetItem(familyID uuid.UUID, budgetItem *goserver.BudgetItemNoId) (goserver.BudgetItem, error) {
+	if err := validateBudgetItem(budgetItem); err != nil {
+		return goserver.BudgetItem{}, err
+	}
+
 	data := models.BudgetItemToDB(budgetItem, familyID)
 	data.ID = uuid.New()
 	if err := s.db.Create(data).Error; err != nil {
@@ -62,6 +67,10 @@ func (s *storage) GetBudgetItem(familyID uuid.UUID, id string) (goserver.BudgetI
 func (s *storage) UpdateBudgetItem(
 	familyID uuid.UUID, id string, budgetItem *goserver.BudgetItemNoId,
 ) (goserver.BudgetItem, error) {
+	if err := validateBudgetItem(budgetItem); err != nil {
+		return goserver.BudgetItem{}, err
+	}
+
 	return performUpdate[models.BudgetItem, goserver.BudgetItemNoIdInterface, goserver.BudgetItem](s, familyID, "BudgetItem", id, budgetItem,
 		models.BudgetItemToDB,
 		func(m *models.BudgetItem) goserver.BudgetItem { return m.FromDB() },
@@ -83,3 +92,13 @@ func (s *storage) DeleteBudgetItem(familyID uuid.UUID, id string) error {
 
 	return nil
 }
+
+// validateBudgetItem checks that the item budgets exactly one account, tag or partner. The tag
+// is normalized.
+func validateBudgetItem(budgetItem *goserver.BudgetItemNoId) error {
+	budgetItem.Tag = utils.NormalizeTag(budgetItem.Tag)
+	if !utils.NewBudgetSelector(budgetItem.AccountId, budgetItem.Tag, budgetItem.Partner).IsValid() {
+		return fmt.Errorf("%w: exactly one of account, tag or partner must be set", ErrInvalidBudgetItem)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+	"gorm.io/gorm"
+)
+
+func (s *storage) CreateBudgetPlan(familyID uuid.UUID, plan *goserver.BudgetPlanNoId) (goserver.BudgetPlan, error) {
+	if err := s.validateBudgetPlan(familyID, plan); err != nil {
+		return goserver.BudgetPlan{}, err
+	}
+
+	data := models.BudgetPlanToDB(plan, familyID)
+	data.ID = uuid.New()
+	if err := s.db.Create(data).Error; err != nil {
+		return goserver.BudgetPlan{}, fmt.Errorf(StorageError, err)
+	}
+
+	if err := s.recordAuditLog(s.db, familyID, "BudgetPlan", data.ID.String(), "CREATED", nil, data); err != nil {
+		s.log.Error("Failed to record audit log", "error", err)
+	}
+
+	return data.FromDB(), nil
+}
+
+func (s *storage) GetBudgetPlans(familyID uuid.UUID) ([]goserver.BudgetPlan, error) {
+	var plans []models.BudgetPlan
+	if err := s.db.Where("family_id = ?", familyID).Order("effective_from").Find(&plans).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+
+	res := make([]goserver.BudgetPlan, 0, len(plans))
+	for _, p := range plans {
+		res = append(res, p.FromDB())
+	}
+	return res, nil
+}
+
+func (s *storage) GetBudgetPlan(familyID uuid.UUID, id string) (goserver.BudgetPlan, error) {
+	var data models.BudgetPlan
+	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return goserver.BudgetPlan{}, ErrNotFound
+		}
+
+		return goserver.BudgetPlan{}, fmt.Errorf(StorageError, err)
+	}
+
+	return data.FromDB(), nil
+}
+
+func (s *storage) UpdateBudgetPlan(familyID uuid.UUID, id string, plan *goserver.BudgetPlanNoId) (goserver.BudgetPlan, error) {
+	if err := s.validateBudgetPlan(familyID, plan); err != nil {
+		return goserver.BudgetPlan{}, err
+	}
+
+	return performUpdate[models.BudgetPlan, goserver.BudgetPlanNoIdInterface, goserver.BudgetPlan](s, familyID, "BudgetPlan", id, plan,
+		models.BudgetPlanToDB,
+		func(m *models.BudgetPlan) goserver.BudgetPlan { return m.FromDB() },
+		func(m *models.BudgetPlan, id uuid.UUID) { m.ID = id },
+	)
+}
+
+func (s *storage) DeleteBudgetPlan(familyID uuid.UUID, id string) error {
+	var data models.BudgetPlan
+	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf(StorageError, err)
+	}
+
+	if err := s.recordAuditLog(s.db, familyID, "BudgetPlan", id, "DELETED", &data, nil); err != nil {
+		s.log.Error("Failed to record audit log", "error", err)
+	}
+
+	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).Delete(&models.BudgetPlan{}).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+
+	return nil
+}
+
+// validateBudgetPlan checks the account, the recurrence and the effective dates of the plan.
+func (s *storage) validateBudgetPlan(familyID uuid.UUID, plan *goserver.BudgetPlanNoId) error {
+	switch plan.Recurrence {
+	case utils.BudgetRecurrenceMonthly, utils.BudgetRecurrenceQuarterly, utils.BudgetRecurrenceYearly:
+	case utils.BudgetRecurrenceCustom:
+		if len(plan.Months) == 0 || slices.ContainsFunc(plan.Months, func(m int32) bool { return m < 1 || m > 12 }) {
+			return fmt.Errorf("%w: custom plans need months between 1 and 12", ErrInvalidBudgetPlan)
+		}
+	default:
+		return fmt.Errorf("%w: unknown recurrence %q", ErrInvalidBudgetPlan, plan.Recurrence)
+	}
+	if plan.Accrue && plan.Recurrence != utils.BudgetRecurrenceQuarterly && plan.Recurrence != utils.BudgetRecurrenceYearly {
+		return fmt.Errorf("%w: only quarterly and yearly plans accrue", ErrInvalidBudgetPlan)
+	}
+
+	if plan.EffectiveFrom.IsZero() {
+		return fmt.Errorf("%w: effective date is missing", ErrInvalidBudgetPlan)
+	}
+	if !plan.EffectiveTo.IsZero() && !plan.EffectiveTo.After(plan.EffectiveFrom) {
+		return fmt.Errorf("%w: plan ends before it starts", ErrInvalidBudgetPlan)
+	}
+
+	var count int64
+	if err := s.db.Model(&models.Account{}).Where("family_id = ? AND id = ?", familyID, plan.AccountId).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%w: account %s not found", ErrInvalidBudgetPlan, plan.AccountId)
+	}
+	return nil
+}
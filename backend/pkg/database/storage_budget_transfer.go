@@ -0,0 +1,115 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"gorm.io/gorm"
+)
+
+func (s *storage) CreateBudgetTransfer(familyID uuid.UUID, transfer *goserver.BudgetTransferNoId) (goserver.BudgetTransfer, error) {
+	if err := s.validateBudgetTransfer(familyID, transfer); err != nil {
+		return goserver.BudgetTransfer{}, err
+	}
+
+	data := models.BudgetTransferToDB(transfer, familyID)
+	data.ID = uuid.New()
+	if err := s.db.Create(data).Error; err != nil {
+		return goserver.BudgetTransfer{}, fmt.Errorf(StorageError, err)
+	}
+
+	if err := s.recordAuditLog(s.db, familyID, "BudgetTransfer", data.ID.String(), "CREATED", nil, data); err != nil {
+		s.log.Error("Failed to record audit log", "error", err)
+	}
+
+	return data.FromDB(), nil
+}
+
+func (s *storage) GetBudgetTransfers(familyID uuid.UUID) ([]goserver.BudgetTransfer, error) {
+	var transfers []models.BudgetTransfer
+	if err := s.db.Where("family_id = ?", familyID).Order("date").Find(&transfers).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+
+	res := make([]goserver.BudgetTransfer, 0, len(transfers))
+	for _, p := range transfers {
+		res = append(res, p.FromDB())
+	}
+	return res, nil
+}
+
+func (s *storage) GetBudgetTransfer(familyID uuid.UUID, id string) (goserver.BudgetTransfer, error) {
+	var data models.BudgetTransfer
+	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return goserver.BudgetTransfer{}, ErrNotFound
+		}
+
+		return goserver.BudgetTransfer{}, fmt.Errorf(StorageError, err)
+	}
+
+	return data.FromDB(), nil
+}
+
+func (s *storage) UpdateBudgetTransfer(familyID uuid.UUID, id string, transfer *goserver.BudgetTransferNoId) (goserver.BudgetTransfer, error) {
+	if err := s.validateBudgetTransfer(familyID, transfer); err != nil {
+		return goserver.BudgetTransfer{}, err
+	}
+
+	return performUpdate[models.BudgetTransfer, goserver.BudgetTransferNoIdInterface, goserver.BudgetTransfer](s, familyID, "BudgetTransfer", id, transfer,
+		models.BudgetTransferToDB,
+		func(m *models.BudgetTransfer) goserver.BudgetTransfer { return m.FromDB() },
+		func(m *models.BudgetTransfer, id uuid.UUID) { m.ID = id },
+	)
+}
+
+func (s *storage) DeleteBudgetTransfer(familyID uuid.UUID, id string) error {
+	var data models.BudgetTransfer
+	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf(StorageError, err)
+	}
+
+	if err := s.recordAuditLog(s.db, familyID, "BudgetTransfer", id, "DELETED", &data, nil); err != nil {
+		s.log.Error("Failed to record audit log", "error", err)
+	}
+
+	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).Delete(&models.BudgetTransfer{}).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+
+	return nil
+}
+
+// validateBudgetTransfer checks the amount, the date and the accounts of the transfer.
+func (s *storage) validateBudgetTransfer(familyID uuid.UUID, transfer *goserver.BudgetTransferNoId) error {
+	if !transfer.Amount.IsPositive() {
+		return fmt.Errorf("%w: amount must be positive", ErrInvalidBudgetTransfer)
+	}
+	if transfer.Date.IsZero() {
+		return fmt.Errorf("%w: date is missing", ErrInvalidBudgetTransfer)
+	}
+	if transfer.FromAccountId == transfer.ToAccountId {
+		return fmt.Errorf("%w: budget must move between different envelopes", ErrInvalidBudgetTransfer)
+	}
+
+	for _, accountID := range []string{transfer.FromAccountId, transfer.ToAccountId} {
+		if accountID == "" {
+			continue
+		}
+		var count int64
+		if err := s.db.Model(&models.Account{}).Where("family_id = ? AND id = ?", familyID, accountID).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf(StorageError, err)
+		}
+		if count == 0 {
+			return fmt.Errorf("%w: account %s not found", ErrInvalidBudgetTransfer, accountID)
+		}
+	}
+	return nil
+}
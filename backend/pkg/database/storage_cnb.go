@@ -69,3 +69,86 @@ func (s *storage) GetCNBRates(date time.Time) (map[string]decimal.Decimal, error
 
 	return result, nil
 }
+
+func (s *storage) SaveProviderRates(provider string, rates map[string]decimal.Decimal, date time.Time) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("provider = ? AND rate_date = ?", provider, date).
+			Delete(&models.ProviderCurrencyRate{}).Error; err != nil {
+			return fmt.Errorf(StorageError, err)
+		}
+
+		for currencyCode, rate := range rates {
+			currencyRate := models.ProviderCurrencyRate{
+				Provider:     provider,
+				CurrencyCode: currencyCode,
+				Rate:         rate,
+				RateDate:     date,
+			}
+
+			if err := tx.Create(&currencyRate).Error; err != nil {
+				return fmt.Errorf(StorageError, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *storage) GetProviderRates(provider string, date time.Time) (map[string]decimal.Decimal, error) {
+	var rates []models.ProviderCurrencyRate
+	if err := s.db.Where("provider = ? AND rate_date = ?", provider, date).Find(&rates).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+
+	result := make(map[string]decimal.Decimal, len(rates))
+	for _, rate := range rates {
+		result[rate.CurrencyCode] = rate.Rate
+	}
+
+	return result, nil
+}
+
+func (s *storage) GetCNBRateDates(dateFrom, dateTo time.Time) ([]time.Time, error) {
+	var dates []time.Time
+	if err := s.db.Model(&models.CNBCurrencyRate{}).
+		Where("rate_date >= ? AND rate_date <= ?", dateFrom, dateTo).
+		Distinct("rate_date").Order("rate_date").
+		Pluck("rate_date", &dates).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return dates, nil
+}
+
+func (s *storage) GetCNBRatesHistory(
+	currencyCode string, dateFrom, dateTo time.Time,
+) ([]models.CNBCurrencyRate, error) {
+	var rates []models.CNBCurrencyRate
+	if err := s.db.Where("currency_code = ? AND rate_date >= ? AND rate_date <= ?", currencyCode, dateFrom, dateTo).
+		Order("rate_date").Find(&rates).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return rates, nil
+}
+
+func (s *storage) GetProviderRateDates(provider string, dateFrom, dateTo time.Time) ([]time.Time, error) {
+	var dates []time.Time
+	if err := s.db.Model(&models.ProviderCurrencyRate{}).
+		Where("provider = ? AND rate_date >= ? AND rate_date <= ?", provider, dateFrom, dateTo).
+		Distinct("rate_date").Order("rate_date").
+		Pluck("rate_date", &dates).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return dates, nil
+}
+
+func (s *storage) GetProviderRatesHistory(
+	provider, currencyCode string, dateFrom, dateTo time.Time,
+) ([]models.ProviderCurrencyRate, error) {
+	var rates []models.ProviderCurrencyRate
+	if err := s.db.Where("provider = ? AND currency_code = ? AND rate_date >= ? AND rate_date <= ?",
+		provider, currencyCode, dateFrom, dateTo).
+		Order("rate_date").Find(&rates).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return rates, nil
+}
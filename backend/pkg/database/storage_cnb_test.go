@@ -99,4 +99,23 @@ func TestCNBRatesStorage(t *testing.T) {
 			t.Errorf("expected USD rate %v, got %v", newRates1["USD"], retrieved["USD"])
 		}
 	})
+
+	t.Run("Rate Dates and History", func(t *testing.T) {
+		dates, err := st.GetCNBRateDates(date1, date2)
+		if err != nil {
+			t.Fatalf("failed to get rate dates: %v", err)
+		}
+		if len(dates) != 2 || !dates[0].Equal(date1) || !dates[1].Equal(date2) {
+			t.Errorf("expected dates %v and %v, got %v", date1, date2, dates)
+		}
+
+		history, err := st.GetCNBRatesHistory("EUR", date1, date2)
+		if err != nil {
+			t.Fatalf("failed to get rates history: %v", err)
+		}
+		// EUR rate of date1 was overwritten
+		if len(history) != 1 || !history[0].RateDate.Equal(date2) || !history[0].RateToCZK.Equal(rates2["EUR"]) {
+			t.Errorf("expected EUR rate %v of %v, got %v", rates2["EUR"], date2, history)
+		}
+	})
 }
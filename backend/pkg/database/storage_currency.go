@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
 	"gorm.io/gorm"
 )
 
@@ -118,6 +119,8 @@ func (s *storage) DeleteCurrency(familyID uuid.UUID, id string, replaceWithCurre
 				return fmt.Errorf("failed to find transactions for currency reassignment: %w", err)
 			}
 
+			oldTransactions := []*models.Transaction{}
+			newTransactions := []*models.Transaction{}
 			for _, t := range transactions {
 				updated := false
 				newMovements := make([]goserver.Movement, len(t.Movements))
@@ -130,12 +133,25 @@ func (s *storage) DeleteCurrency(familyID uuid.UUID, id string, replaceWithCurre
 				}
 
 				if updated {
+					oldT := t
 					t.Movements = newMovements
+					t.ExchangeRates = utils.TransactionExchangeRates(newMovements)
 					if err := tx.Save(&t).Error; err != nil {
 						return fmt.Errorf("failed to save reassigned transaction %s: %w", t.ID, err)
 					}
+					oldTransactions = append(oldTransactions, &oldT)
+					newTransactions = append(newTransactions, &t)
 				}
 			}
+			if err := updateMonthlyRollupsWithTx(tx, familyID, oldTransactions, newTransactions); err != nil {
+				return err
+			}
+
+			// 4. Reassign in Budget items of tags and partners
+			if err := tx.Model(&models.BudgetItem{}).Where("family_id = ? AND currency_id = ?", familyID, id).
+				Update("currency_id", newCurrencyID).Error; err != nil {
+				return fmt.Errorf("failed to reassign budget item currency: %w", err)
+			}
 		} else {
 			// Check if currency is in use
 			var count int64
@@ -171,6 +187,15 @@ func (s *storage) DeleteCurrency(familyID uuid.UUID, id string, replaceWithCurre
 			if count > 0 {
 				return ErrCurrencyInUse
 			}
+
+			// Check Budget items
+			if err := tx.Model(&models.BudgetItem{}).Where("family_id = ? AND currency_id = ?", familyID, id).
+				Count(&count).Error; err != nil {
+				return fmt.Errorf("failed to check budget items for currency usage: %w", err)
+			}
+			if count > 0 {
+				return ErrCurrencyInUse
+			}
 		}
 
 		var cur models.Currency
@@ -0,0 +1,110 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"gorm.io/gorm"
+)
+
+func (s *storage) CreateExchangeRate(familyID uuid.UUID, rate *goserver.ExchangeRateNoId) (goserver.ExchangeRate, error) {
+	if err := s.validateExchangeRate(familyID, rate); err != nil {
+		return goserver.ExchangeRate{}, err
+	}
+
+	data := models.ExchangeRateToDB(rate, familyID)
+	data.ID = uuid.New()
+	if err := s.db.Create(data).Error; err != nil {
+		return goserver.ExchangeRate{}, fmt.Errorf(StorageError, err)
+	}
+
+	if err := s.recordAuditLog(s.db, familyID, "ExchangeRate", data.ID.String(), "CREATED", nil, data); err != nil {
+		s.log.Error("Failed to record audit log", "error", err)
+	}
+
+	return data.FromDB(), nil
+}
+
+func (s *storage) GetExchangeRates(familyID uuid.UUID) ([]goserver.ExchangeRate, error) {
+	var rates []models.ExchangeRate
+	if err := s.db.Where("family_id = ?", familyID).Order("date").Find(&rates).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+
+	res := make([]goserver.ExchangeRate, 0, len(rates))
+	for _, r := range rates {
+		res = append(res, r.FromDB())
+	}
+	return res, nil
+}
+
+func (s *storage) GetExchangeRate(familyID uuid.UUID, id string) (goserver.ExchangeRate, error) {
+	var data models.ExchangeRate
+	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return goserver.ExchangeRate{}, ErrNotFound
+		}
+
+		return goserver.ExchangeRate{}, fmt.Errorf(StorageError, err)
+	}
+
+	return data.FromDB(), nil
+}
+
+func (s *storage) UpdateExchangeRate(familyID uuid.UUID, id string, rate *goserver.ExchangeRateNoId) (goserver.ExchangeRate, error) {
+	if err := s.validateExchangeRate(familyID, rate); err != nil {
+		return goserver.ExchangeRate{}, err
+	}
+
+	return performUpdate[models.ExchangeRate, goserver.ExchangeRateNoIdInterface, goserver.ExchangeRate](s, familyID, "ExchangeRate", id, rate,
+		models.ExchangeRateToDB,
+		func(m *models.ExchangeRate) goserver.ExchangeRate { return m.FromDB() },
+		func(m *models.ExchangeRate, id uuid.UUID) { m.ID = id },
+	)
+}
+
+func (s *storage) DeleteExchangeRate(familyID uuid.UUID, id string) error {
+	var data models.ExchangeRate
+	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf(StorageError, err)
+	}
+
+	if err := s.recordAuditLog(s.db, familyID, "ExchangeRate", id, "DELETED", &data, nil); err != nil {
+		s.log.Error("Failed to record audit log", "error", err)
+	}
+
+	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).Delete(&models.ExchangeRate{}).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+
+	return nil
+}
+
+// validateExchangeRate checks the rate, the date range and the currency of the exchange rate.
+func (s *storage) validateExchangeRate(familyID uuid.UUID, rate *goserver.ExchangeRateNoId) error {
+	if !rate.Rate.IsPositive() {
+		return fmt.Errorf("%w: rate must be positive", ErrInvalidExchangeRate)
+	}
+	if rate.Date.IsZero() {
+		return fmt.Errorf("%w: date is missing", ErrInvalidExchangeRate)
+	}
+	if !rate.DateTo.IsZero() && rate.DateTo.Before(rate.Date) {
+		return fmt.Errorf("%w: end date is before the start date", ErrInvalidExchangeRate)
+	}
+
+	var count int64
+	if err := s.db.Model(&models.Currency{}).Where("family_id = ? AND id = ?", familyID, rate.CurrencyId).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%w: currency %s not found", ErrInvalidExchangeRate, rate.CurrencyId)
+	}
+	return nil
+}
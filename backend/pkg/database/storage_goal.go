@@ -0,0 +1,133 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+	"gorm.io/gorm"
+)
+
+func (s *storage) CreateGoal(familyID uuid.UUID, goal *goserver.GoalNoId) (goserver.Goal, error) {
+	if err := s.validateGoal(familyID, goal); err != nil {
+		return goserver.Goal{}, err
+	}
+
+	data := models.GoalToDB(goal, familyID)
+	data.ID = uuid.New()
+	if err := s.db.Create(data).Error; err != nil {
+		return goserver.Goal{}, fmt.Errorf(StorageError, err)
+	}
+
+	if err := s.recordAuditLog(s.db, familyID, "Goal", data.ID.String(), "CREATED", nil, data); err != nil {
+		s.log.Error("Failed to record audit log", "error", err)
+	}
+
+	return data.FromDB(), nil
+}
+
+func (s *storage) GetGoals(familyID uuid.UUID) ([]goserver.Goal, error) {
+	var goals []models.Goal
+	if err := s.db.Where("family_id = ?", familyID).Order("target_date").Find(&goals).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+
+	res := make([]goserver.Goal, 0, len(goals))
+	for _, g := range goals {
+		res = append(res, g.FromDB())
+	}
+	return res, nil
+}
+
+func (s *storage) GetGoal(familyID uuid.UUID, id string) (goserver.Goal, error) {
+	var data models.Goal
+	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return goserver.Goal{}, ErrNotFound
+		}
+
+		return goserver.Goal{}, fmt.Errorf(StorageError, err)
+	}
+
+	return data.FromDB(), nil
+}
+
+func (s *storage) UpdateGoal(familyID uuid.UUID, id string, goal *goserver.GoalNoId) (goserver.Goal, error) {
+	if err := s.validateGoal(familyID, goal); err != nil {
+		return goserver.Goal{}, err
+	}
+
+	return performUpdate[models.Goal, goserver.GoalNoIdInterface, goserver.Goal](s, familyID, "Goal", id, goal,
+		models.GoalToDB,
+		func(m *models.Goal) goserver.Goal { return m.FromDB() },
+		func(m *models.Goal, id uuid.UUID) { m.ID = id },
+	)
+}
+
+func (s *storage) DeleteGoal(familyID uuid.UUID, id string) error {
+	var data models.Goal
+	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf(StorageError, err)
+	}
+
+	if err := s.recordAuditLog(s.db, familyID, "Goal", id, "DELETED", &data, nil); err != nil {
+		s.log.Error("Failed to record audit log", "error", err)
+	}
+
+	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).Delete(&models.Goal{}).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+
+	return nil
+}
+
+// validateGoal checks the target of the goal and that its savings are tracked either by asset
+// accounts or by a tag. The tag is normalized.
+func (s *storage) validateGoal(familyID uuid.UUID, goal *goserver.GoalNoId) error {
+	if goal.Name == "" {
+		return fmt.Errorf("%w: name is missing", ErrInvalidGoal)
+	}
+	if !goal.TargetAmount.IsPositive() {
+		return fmt.Errorf("%w: target amount must be positive", ErrInvalidGoal)
+	}
+	if goal.TargetDate.IsZero() {
+		return fmt.Errorf("%w: target date is missing", ErrInvalidGoal)
+	}
+	if goal.Priority < 0 {
+		return fmt.Errorf("%w: priority can't be negative", ErrInvalidGoal)
+	}
+
+	var count int64
+	if err := s.db.Model(&models.Currency{}).Where("family_id = ? AND id = ?", familyID, goal.CurrencyId).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%w: currency %s not found", ErrInvalidGoal, goal.CurrencyId)
+	}
+
+	goal.Tag = utils.NormalizeTag(goal.Tag)
+	if (len(goal.AccountIds) == 0) == (goal.Tag == "") {
+		return fmt.Errorf("%w: either accounts or a tag must be set", ErrInvalidGoal)
+	}
+	for _, accountID := range goal.AccountIds {
+		var account models.Account
+		if err := s.db.Where("family_id = ? AND id = ?", familyID, accountID).First(&account).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: account %s not found", ErrInvalidGoal, accountID)
+			}
+			return fmt.Errorf(StorageError, err)
+		}
+		if account.Type != constants.AccountAsset {
+			return fmt.Errorf("%w: account %s is not an asset account", ErrInvalidGoal, account.Name)
+		}
+	}
+	return nil
+}
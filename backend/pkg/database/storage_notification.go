@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"gorm.io/gorm/clause"
 )
 
 func (s *storage) CreateNotification(familyID uuid.UUID, notification *goserver.Notification) (goserver.Notification, error) {
@@ -45,6 +46,43 @@ func (s *storage) GetNotifications(familyID uuid.UUID) ([]goserver.Notification,
 	return notifications, nil
 }
 
+func (s *storage) HasNotification(familyID uuid.UUID, notificationType, url string) (bool, error) {
+	var count int64
+	if err := s.db.Unscoped().Model(&models.Notification{}).
+		Where("family_id = ? AND type = ? AND url = ?", familyID, notificationType, url).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf(StorageError, err)
+	}
+
+	return count > 0, nil
+}
+
+func (s *storage) CreateNotificationOnce(familyID uuid.UUID, notification *goserver.Notification) (bool, error) {
+	n, err := models.NotificationToDB(notification, familyID)
+	if err != nil {
+		return false, fmt.Errorf(StorageError, err)
+	}
+	if n.ID == uuid.Nil {
+		n.ID = uuid.New()
+	}
+	key := familyID.String() + "|" + string(n.Type) + "|" + n.URL
+	n.Key = &key
+
+	// Notifications created before keys existed have none
+	reported, err := s.HasNotification(familyID, string(n.Type), n.URL)
+	if err != nil || reported {
+		return false, err
+	}
+
+	// The unique key makes concurrent callers create the notification only once
+	res := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(n)
+	if res.Error != nil {
+		return false, fmt.Errorf(StorageError, res.Error)
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
 func (s *storage) DeleteNotification(familyID uuid.UUID, id string) error {
 	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).Delete(&models.Notification{}).Error; err != nil {
 		return fmt.Errorf(StorageError, err)
@@ -71,4 +71,66 @@ func TestNotificationStorage(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("Has Notification including deleted ones", func(t *testing.T) {
+		created, err := st.CreateNotification(userID, &goserver.Notification{
+			Date:  time.Now(),
+			Type:  "spendingAnomaly",
+			Url:   "/transactions/123",
+			Title: "Unusual transaction",
+		})
+		if err != nil {
+			t.Fatalf("failed to create notification: %v", err)
+		}
+
+		has, err := st.HasNotification(userID, "spendingAnomaly", "/transactions/123")
+		if err != nil || !has {
+			t.Fatalf("expected notification to exist, got %v, %v", has, err)
+		}
+		has, err = st.HasNotification(userID, "spendingAnomaly", "/transactions/456")
+		if err != nil || has {
+			t.Fatalf("expected no notification for other URL, got %v, %v", has, err)
+		}
+
+		if err := st.DeleteNotification(userID, created.Id); err != nil {
+			t.Fatalf("failed to delete notification: %v", err)
+		}
+		has, err = st.HasNotification(userID, "spendingAnomaly", "/transactions/123")
+		if err != nil || !has {
+			t.Fatalf("expected deleted notification to be found, got %v, %v", has, err)
+		}
+	})
+	t.Run("Create Notification once", func(t *testing.T) {
+		n := goserver.Notification{
+			Date:  time.Now(),
+			Type:  "budgetAlert",
+			Url:   "/budget?alert=exceeded",
+			Title: "Budget Exceeded",
+		}
+		for i, expected := range []bool{true, false} {
+			created, err := st.CreateNotificationOnce(userID, &n)
+			if err != nil {
+				t.Fatalf("failed to create notification: %v", err)
+			}
+			if created != expected {
+				t.Fatalf("call %d: expected created %v, got %v", i, expected, created)
+			}
+		}
+
+		// Other families get their own notification
+		created, err := st.CreateNotificationOnce(uuid.New(), &n)
+		if err != nil || !created {
+			t.Fatalf("expected notification of other family to be created, got %v, %v", created, err)
+		}
+
+		// Notifications created without a key are respected too
+		n.Url = "/budget?alert=consumed"
+		if _, err := st.CreateNotification(userID, &n); err != nil {
+			t.Fatalf("failed to create notification: %v", err)
+		}
+		created, err = st.CreateNotificationOnce(userID, &n)
+		if err != nil || created {
+			t.Fatalf("expected existing notification to be kept, got %v, %v", created, err)
+		}
+	})
 }
@@ -0,0 +1,117 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// LinkRefund marks transaction as refund or reimbursement of the original transaction. The
+// original must not be a refund itself, so links never form chains.
+func (s *storage) LinkRefund(familyID uuid.UUID, id, originalID, kind string) (goserver.Transaction, error) {
+	if id == originalID || !utils.IsValidRefundKind(kind) {
+		return goserver.Transaction{}, ErrInvalidRefundLink
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var t, original models.Transaction
+		if err := tx.Where("family_id = ? AND id = ?", familyID, id).First(&t).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNotFound
+			}
+			return fmt.Errorf("failed to find transaction: %w", err)
+		}
+		if err := tx.Where("family_id = ? AND id = ?", familyID, originalID).First(&original).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNotFound
+			}
+			return fmt.Errorf("failed to find original transaction: %w", err)
+		}
+		if original.RefundOfID != nil {
+			return ErrInvalidRefundLink
+		}
+
+		var refundsCount int64
+		if err := tx.Model(&models.Transaction{}).
+			Where("family_id = ? AND refund_of_id = ?", familyID, t.ID).
+			Count(&refundsCount).Error; err != nil {
+			return fmt.Errorf("failed to count refunds of transaction: %w", err)
+		}
+		if refundsCount > 0 {
+			return ErrInvalidRefundLink
+		}
+
+		oldT := t
+		t.RefundOfID = &original.ID
+		t.RefundKind = kind
+		if err := tx.Save(&t).Error; err != nil {
+			return fmt.Errorf("failed to update transaction: %w", err)
+		}
+
+		if err := s.recordAuditLog(tx, familyID, "Transaction", t.ID.String(), "UPDATED", &oldT, &t); err != nil {
+			s.log.Error("Failed to record audit log", "error", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrNotFound) || errors.Is(err, ErrInvalidRefundLink) {
+			return goserver.Transaction{}, err
+		}
+		return goserver.Transaction{}, fmt.Errorf(StorageError, err)
+	}
+
+	return s.GetTransaction(familyID, id)
+}
+
+func (s *storage) UnlinkRefund(familyID uuid.UUID, id string) (goserver.Transaction, error) {
+	var t models.Transaction
+	if err := s.db.Where("family_id = ? AND id = ?", familyID, id).First(&t).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return goserver.Transaction{}, ErrNotFound
+		}
+		return goserver.Transaction{}, fmt.Errorf(StorageError, err)
+	}
+
+	if t.RefundOfID != nil {
+		oldT := t
+		t.RefundOfID = nil
+		t.RefundKind = ""
+		if err := s.db.Save(&t).Error; err != nil {
+			return goserver.Transaction{}, fmt.Errorf(StorageError, err)
+		}
+
+		if err := s.recordAuditLog(s.db, familyID, "Transaction", t.ID.String(), "UPDATED", &oldT, &t); err != nil {
+			s.log.Error("Failed to record audit log", "error", err)
+		}
+	}
+
+	return s.GetTransaction(familyID, id)
+}
+
+func (s *storage) GetRefunds(familyID uuid.UUID, dateFrom, dateTo time.Time) ([]goserver.Transaction, error) {
+	req := s.db.Where("family_id = ? AND merged_into_id IS NULL AND refund_of_id IS NOT NULL", familyID)
+	if !dateFrom.IsZero() {
+		req = req.Where("date >= ?", dateFrom)
+	}
+	if !dateTo.IsZero() {
+		req = req.Where("date < ?", dateTo)
+	}
+
+	var transactions []models.Transaction
+	if err := req.Order("date").Find(&transactions).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+
+	res := make([]goserver.Transaction, 0, len(transactions))
+	for _, t := range transactions {
+		res = append(res, t.FromDB())
+	}
+	return res, nil
+}
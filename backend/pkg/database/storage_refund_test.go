@@ -0,0 +1,153 @@
+package database_test
+
+import (
+	"log/slog"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Refund links", func() {
+	var (
+		db       database.Storage
+		bank     goserver.Account
+		shopping goserver.Account
+		czk      goserver.Currency
+		familyID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	)
+
+	create := func(from, to string, amount int64) goserver.Transaction {
+		t, err := db.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date: time.Now(),
+			Movements: []goserver.Movement{
+				{AccountId: from, Amount: decimal.NewFromInt(-amount), CurrencyId: czk.Id},
+				{AccountId: to, Amount: decimal.NewFromInt(amount), CurrencyId: czk.Id},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return t
+	}
+
+	BeforeEach(func() {
+		// Batches run on their own connection, which doesn't see in-memory databases
+		cfg := &config.Config{DBPath: filepath.Join(GinkgoT().TempDir(), "test.db"), Verbose: false}
+		db = database.NewStorage(slog.Default(), cfg)
+		Expect(db.Open()).To(Succeed())
+		DeferCleanup(db.Close)
+
+		var err error
+		czk, err = db.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "CZK"})
+		Expect(err).NotTo(HaveOccurred())
+		bank, err = db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).NotTo(HaveOccurred())
+		shopping, err = db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Shopping", Type: "expense"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("links and unlinks a refund", func() {
+		original := create(bank.Id, shopping.Id, 100)
+		refund := create(shopping.Id, bank.Id, 40)
+
+		res, err := db.LinkRefund(familyID, refund.Id, original.Id, "refund")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RefundOfId).To(Equal(original.Id))
+		Expect(res.RefundKind).To(Equal("refund"))
+
+		res, err = db.UnlinkRefund(familyID, refund.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RefundOfId).To(BeEmpty())
+		Expect(res.RefundKind).To(BeEmpty())
+	})
+
+	It("keeps the link on regular updates", func() {
+		original := create(bank.Id, shopping.Id, 100)
+		refund := create(shopping.Id, bank.Id, 40)
+		_, err := db.LinkRefund(familyID, refund.Id, original.Id, "reimbursement")
+		Expect(err).NotTo(HaveOccurred())
+
+		input := refund
+		input.Description = "Returned item"
+		res, err := db.UpdateTransaction(familyID, refund.Id, &input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Description).To(Equal("Returned item"))
+		Expect(res.RefundOfId).To(Equal(original.Id))
+		Expect(res.RefundKind).To(Equal("reimbursement"))
+	})
+
+	It("ignores links on create", func() {
+		original := create(bank.Id, shopping.Id, 100)
+		input := &goserver.TransactionNoId{
+			Date:       time.Now(),
+			RefundOfId: original.Id,
+			RefundKind: "refund",
+			Movements: []goserver.Movement{
+				{AccountId: shopping.Id, Amount: decimal.NewFromInt(-40), CurrencyId: czk.Id},
+				{AccountId: bank.Id, Amount: decimal.NewFromInt(40), CurrencyId: czk.Id},
+			},
+		}
+
+		res, err := db.CreateTransaction(familyID, input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RefundOfId).To(BeEmpty())
+		Expect(res.RefundKind).To(BeEmpty())
+
+		batch, err := db.CreateTransactionsBatch(familyID, []goserver.TransactionNoIdInterface{input})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(batch[0].RefundOfId).To(BeEmpty())
+		Expect(batch[0].RefundKind).To(BeEmpty())
+	})
+
+	It("rejects invalid links", func() {
+		original := create(bank.Id, shopping.Id, 100)
+		refund := create(shopping.Id, bank.Id, 40)
+		other := create(shopping.Id, bank.Id, 10)
+
+		_, err := db.LinkRefund(familyID, refund.Id, refund.Id, "refund")
+		Expect(err).To(MatchError(database.ErrInvalidRefundLink))
+		_, err = db.LinkRefund(familyID, refund.Id, original.Id, "gift")
+		Expect(err).To(MatchError(database.ErrInvalidRefundLink))
+		_, err = db.LinkRefund(familyID, refund.Id, uuid.New().String(), "refund")
+		Expect(err).To(MatchError(database.ErrNotFound))
+
+		// No chains: neither a refund of a refund nor linking an original which has refunds
+		_, err = db.LinkRefund(familyID, refund.Id, original.Id, "refund")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = db.LinkRefund(familyID, other.Id, refund.Id, "refund")
+		Expect(err).To(MatchError(database.ErrInvalidRefundLink))
+		_, err = db.LinkRefund(familyID, original.Id, other.Id, "refund")
+		Expect(err).To(MatchError(database.ErrInvalidRefundLink))
+	})
+
+	It("clears links when the original is deleted", func() {
+		original := create(bank.Id, shopping.Id, 100)
+		refund := create(shopping.Id, bank.Id, 40)
+		_, err := db.LinkRefund(familyID, refund.Id, original.Id, "refund")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(db.DeleteTransaction(familyID, original.Id)).To(Succeed())
+		res, err := db.GetTransaction(familyID, refund.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RefundOfId).To(BeEmpty())
+	})
+
+	It("moves links to the kept transaction on merge", func() {
+		original := create(bank.Id, shopping.Id, 100)
+		duplicate := create(bank.Id, shopping.Id, 100)
+		refund := create(shopping.Id, bank.Id, 40)
+		_, err := db.LinkRefund(familyID, refund.Id, duplicate.Id, "refund")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = db.MergeTransactions(familyID, original.Id, duplicate.Id)
+		Expect(err).NotTo(HaveOccurred())
+		res, err := db.GetTransaction(familyID, refund.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RefundOfId).To(Equal(original.Id))
+	})
+})
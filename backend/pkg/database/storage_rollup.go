@@ -0,0 +1,205 @@
+package database
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RollupMismatch is a difference between a stored monthly rollup and the sum of transactions.
+type RollupMismatch struct {
+	models.MonthlyRollupKey
+	StoredAmount   decimal.Decimal
+	ExpectedAmount decimal.Decimal
+	StoredCount    int
+	ExpectedCount  int
+}
+
+func (s *storage) GetMonthlyRollups(familyID uuid.UUID, monthFrom, monthTo time.Time) ([]models.MonthlyRollup, error) {
+	req := s.db.Where("family_id = ?", familyID)
+	if !monthFrom.IsZero() {
+		req = req.Where("month >= ?", models.RollupMonth(monthFrom))
+	}
+	if !monthTo.IsZero() {
+		req = req.Where("month < ?", models.RollupMonth(monthTo))
+	}
+
+	var rollups []models.MonthlyRollup
+	if err := req.Order("month, account_id, currency_id").Find(&rollups).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	for i := range rollups {
+		rollups[i].Month = rollups[i].Month.UTC()
+	}
+
+	return rollups, nil
+}
+
+func (s *storage) RebuildMonthlyRollups(familyID uuid.UUID) error {
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		return rebuildMonthlyRollupsWithTx(tx, familyID)
+	}); err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+
+	s.log.Info("Monthly rollups rebuilt", "familyID", familyID)
+	return nil
+}
+
+func (s *storage) CheckMonthlyRollups(familyID uuid.UUID) ([]RollupMismatch, error) {
+	expected, err := calculateMonthlyRollups(s.db, familyID)
+	if err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+
+	var stored []models.MonthlyRollup
+	if err := s.db.Where("family_id = ?", familyID).Find(&stored).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+
+	res := []RollupMismatch{}
+	for _, r := range stored {
+		key := r.Key()
+		e := expected[key]
+		delete(expected, key)
+		if !r.Amount.Equal(e.Amount) || r.Count != e.Count {
+			res = append(res, RollupMismatch{
+				MonthlyRollupKey: key,
+				StoredAmount:     r.Amount,
+				ExpectedAmount:   e.Amount,
+				StoredCount:      r.Count,
+				ExpectedCount:    e.Count,
+			})
+		}
+	}
+	for key, e := range expected {
+		if e.Count != 0 {
+			res = append(res, RollupMismatch{
+				MonthlyRollupKey: key,
+				ExpectedAmount:   e.Amount,
+				ExpectedCount:    e.Count,
+			})
+		}
+	}
+	slices.SortFunc(res, func(a, b RollupMismatch) int {
+		return cmp.Or(
+			a.Month.Compare(b.Month),
+			cmp.Compare(a.AccountID, b.AccountID),
+			cmp.Compare(a.CurrencyID, b.CurrencyID))
+	})
+
+	return res, nil
+}
+
+// updateMonthlyRollupsWithTx replaces movements of the old version of transactions with the new
+// ones in rollups. Nil, deleted or merged transactions don't contribute.
+func updateMonthlyRollupsWithTx(tx *gorm.DB, familyID uuid.UUID, old, updated []*models.Transaction) error {
+	sums := make(map[models.MonthlyRollupKey]models.MonthlyRollup)
+	for _, t := range old {
+		t.AddToRollups(sums, -1)
+	}
+	for _, t := range updated {
+		t.AddToRollups(sums, 1)
+	}
+
+	for key, delta := range sums {
+		if delta.Count == 0 && delta.Amount.IsZero() {
+			continue
+		}
+
+		var rollup models.MonthlyRollup
+		err := tx.Where("family_id = ? AND account_id = ? AND currency_id = ? AND month = ?",
+			familyID, key.AccountID, key.CurrencyID, key.Month).Take(&rollup).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get monthly rollup: %w", err)
+		}
+		rollup.FamilyID = familyID
+		rollup.AccountID = key.AccountID
+		rollup.CurrencyID = key.CurrencyID
+		rollup.Month = key.Month
+		rollup.Amount = rollup.Amount.Add(delta.Amount)
+		rollup.Count += delta.Count
+
+		if rollup.Count <= 0 {
+			if err := tx.Where("family_id = ? AND account_id = ? AND currency_id = ? AND month = ?",
+				familyID, key.AccountID, key.CurrencyID, key.Month).Delete(&models.MonthlyRollup{}).Error; err != nil {
+				return fmt.Errorf("failed to delete monthly rollup: %w", err)
+			}
+			continue
+		}
+		// Account and currency may be empty, so Save can't tell if the row exists
+		if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&rollup).Error; err != nil {
+			return fmt.Errorf("failed to save monthly rollup: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// calculateMonthlyRollups sums movements of all transactions of the family which are part of
+// reports.
+func calculateMonthlyRollups(db *gorm.DB, familyID uuid.UUID) (map[models.MonthlyRollupKey]models.MonthlyRollup, error) {
+	rows, err := db.Model(&models.Transaction{}).
+		Select("date, movements").
+		Where("family_id = ? AND merged_into_id IS NULL", familyID).
+		Rows()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	defer rows.Close()
+
+	sums := make(map[models.MonthlyRollupKey]models.MonthlyRollup)
+	for rows.Next() {
+		var t models.Transaction
+		if err := db.ScanRows(rows, &t); err != nil {
+			return nil, fmt.Errorf("failed to scan transaction: %w", err)
+		}
+		t.AddToRollups(sums, 1)
+	}
+
+	return sums, rows.Err()
+}
+
+func rebuildMonthlyRollupsWithTx(tx *gorm.DB, familyID uuid.UUID) error {
+	sums, err := calculateMonthlyRollups(tx, familyID)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Where("family_id = ?", familyID).Delete(&models.MonthlyRollup{}).Error; err != nil {
+		return fmt.Errorf("failed to delete monthly rollups: %w", err)
+	}
+
+	rollups := make([]models.MonthlyRollup, 0, len(sums))
+	for key, sum := range sums {
+		if sum.Count == 0 {
+			continue
+		}
+		rollups = append(rollups, models.MonthlyRollup{
+			FamilyID:   familyID,
+			AccountID:  key.AccountID,
+			CurrencyID: key.CurrencyID,
+			Month:      key.Month,
+			Amount:     sum.Amount,
+			Count:      sum.Count,
+		})
+	}
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	const batchSize = 100
+	if err := tx.CreateInBatches(rollups, batchSize).Error; err != nil {
+		return fmt.Errorf("failed to create monthly rollups: %w", err)
+	}
+
+	return nil
+}
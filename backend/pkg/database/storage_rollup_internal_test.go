@@ -0,0 +1,79 @@
+package database
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+func TestCheckMonthlyRollupsInternal(t *testing.T) {
+	logger := slog.Default()
+	cfg := &config.Config{DBPath: ":memory:", Verbose: false}
+	st := NewStorage(logger, cfg).(*storage)
+	if err := st.Open(); err != nil {
+		t.Fatalf("failed to open storage: %v", err)
+	}
+	defer st.Close()
+
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	czk, err := st.CreateCurrency(userID, &goserver.CurrencyNoId{Name: "CZK"})
+	if err != nil {
+		t.Fatalf("failed to create currency: %v", err)
+	}
+	bank, err := st.CreateAccount(userID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+	if err != nil {
+		t.Fatalf("failed to create account: %v", err)
+	}
+	shop, err := st.CreateAccount(userID, &goserver.AccountNoId{Name: "Shop", Type: "expense"})
+	if err != nil {
+		t.Fatalf("failed to create account: %v", err)
+	}
+
+	date := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	if _, err := st.CreateTransaction(userID, &goserver.TransactionNoId{
+		Date: date,
+		Movements: []goserver.Movement{
+			{AccountId: bank.Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(-100)},
+			{AccountId: shop.Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(100)},
+		},
+	}); err != nil {
+		t.Fatalf("failed to create transaction: %v", err)
+	}
+
+	// Break the rollup behind the storage's back
+	if err := st.db.Model(&models.MonthlyRollup{}).
+		Where("family_id = ? AND account_id = ?", userID, shop.Id).
+		Update("amount", decimal.NewFromInt(42)).Error; err != nil {
+		t.Fatalf("failed to corrupt rollup: %v", err)
+	}
+
+	mismatches, err := st.CheckMonthlyRollups(userID)
+	if err != nil {
+		t.Fatalf("failed to check rollups: %v", err)
+	}
+	if len(mismatches) != 1 {
+		t.Fatalf("expected 1 mismatch, got %d", len(mismatches))
+	}
+	m := mismatches[0]
+	if m.AccountID != shop.Id || !m.Month.Equal(models.RollupMonth(date)) ||
+		!m.StoredAmount.Equal(decimal.NewFromInt(42)) || !m.ExpectedAmount.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("unexpected mismatch: %+v", m)
+	}
+
+	if err := st.RebuildMonthlyRollups(userID); err != nil {
+		t.Fatalf("failed to rebuild rollups: %v", err)
+	}
+	mismatches, err = st.CheckMonthlyRollups(userID)
+	if err != nil {
+		t.Fatalf("failed to check rollups: %v", err)
+	}
+	if len(mismatches) != 0 {
+		t.Errorf("expected no mismatches after rebuild, got %+v", mismatches)
+	}
+}
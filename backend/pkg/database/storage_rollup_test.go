@@ -0,0 +1,168 @@
+package database_test
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Monthly rollups", func() {
+	var (
+		db       database.Storage
+		bank     goserver.Account
+		shopping goserver.Account
+		czk      goserver.Currency
+		familyID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		january  = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		february = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	create := func(date time.Time, amount int64) goserver.Transaction {
+		t, err := db.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date: date,
+			Movements: []goserver.Movement{
+				{AccountId: bank.Id, Amount: decimal.NewFromInt(-amount), CurrencyId: czk.Id},
+				{AccountId: shopping.Id, Amount: decimal.NewFromInt(amount), CurrencyId: czk.Id},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return t
+	}
+
+	// spent returns the rollup of the shopping account in the month, zero if there is none
+	spent := func(month time.Time) (decimal.Decimal, int) {
+		rollups, err := db.GetMonthlyRollups(familyID, month, month.AddDate(0, 1, 0))
+		Expect(err).NotTo(HaveOccurred())
+		for _, r := range rollups {
+			if r.AccountID == shopping.Id {
+				return r.Amount, r.Count
+			}
+		}
+		return decimal.Zero, 0
+	}
+
+	expectConsistent := func() {
+		mismatches, err := db.CheckMonthlyRollups(familyID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mismatches).To(BeEmpty())
+	}
+
+	BeforeEach(func() {
+		cfg := &config.Config{DBPath: ":memory:", Verbose: false}
+		db = database.NewStorage(slog.Default(), cfg)
+		Expect(db.Open()).To(Succeed())
+		DeferCleanup(db.Close)
+
+		var err error
+		czk, err = db.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "CZK"})
+		Expect(err).NotTo(HaveOccurred())
+		bank, err = db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).NotTo(HaveOccurred())
+		shopping, err = db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Shopping", Type: "expense"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("sums created transactions per account, currency and month", func() {
+		create(january.AddDate(0, 0, 4), 100)
+		create(january.AddDate(0, 0, 20), 50)
+		create(february.AddDate(0, 0, 1), 30)
+
+		rollups, err := db.GetMonthlyRollups(familyID, january, february.AddDate(0, 1, 0))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rollups).To(HaveLen(4))
+
+		amount, count := spent(january)
+		Expect(amount.Equal(decimal.NewFromInt(150))).To(BeTrue())
+		Expect(count).To(Equal(2))
+		amount, count = spent(february)
+		Expect(amount.Equal(decimal.NewFromInt(30))).To(BeTrue())
+		Expect(count).To(Equal(1))
+		expectConsistent()
+	})
+
+	It("moves amounts when a transaction is updated to another month", func() {
+		t := create(january.AddDate(0, 0, 4), 100)
+		create(january.AddDate(0, 0, 20), 50)
+
+		t.Date = february.AddDate(0, 0, 4)
+		t.Movements[0].Amount = decimal.NewFromInt(-80)
+		t.Movements[1].Amount = decimal.NewFromInt(80)
+		_, err := db.UpdateTransaction(familyID, t.Id, &t)
+		Expect(err).NotTo(HaveOccurred())
+
+		amount, count := spent(january)
+		Expect(amount.Equal(decimal.NewFromInt(50))).To(BeTrue())
+		Expect(count).To(Equal(1))
+		amount, count = spent(february)
+		Expect(amount.Equal(decimal.NewFromInt(80))).To(BeTrue())
+		Expect(count).To(Equal(1))
+		expectConsistent()
+	})
+
+	It("removes deleted transactions and empty rollups", func() {
+		t := create(january.AddDate(0, 0, 4), 100)
+		Expect(db.DeleteTransaction(familyID, t.Id)).To(Succeed())
+
+		rollups, err := db.GetMonthlyRollups(familyID, time.Time{}, time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rollups).To(BeEmpty())
+		expectConsistent()
+	})
+
+	It("follows merging and unmerging of transactions", func() {
+		keep := create(january.AddDate(0, 0, 4), 100)
+		merge := create(january.AddDate(0, 0, 5), 100)
+
+		_, err := db.MergeTransactions(familyID, keep.Id, merge.Id)
+		Expect(err).NotTo(HaveOccurred())
+		amount, count := spent(january)
+		Expect(amount.Equal(decimal.NewFromInt(100))).To(BeTrue())
+		Expect(count).To(Equal(1))
+		expectConsistent()
+
+		Expect(db.UnmergeTransaction(familyID, merge.Id)).To(Succeed())
+		amount, count = spent(january)
+		Expect(amount.Equal(decimal.NewFromInt(200))).To(BeTrue())
+		Expect(count).To(Equal(2))
+		expectConsistent()
+	})
+
+	It("follows replacing of a deleted currency", func() {
+		create(january.AddDate(0, 0, 4), 100)
+		eur, err := db.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "EUR"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(db.DeleteCurrency(familyID, czk.Id, &eur.Id)).To(Succeed())
+		rollups, err := db.GetMonthlyRollups(familyID, january, february)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rollups).To(HaveLen(2))
+		for _, r := range rollups {
+			Expect(r.CurrencyID).To(Equal(eur.Id))
+		}
+		expectConsistent()
+	})
+
+	It("rebuilds the same rollups from transactions", func() {
+		create(january.AddDate(0, 0, 4), 100)
+		create(february.AddDate(0, 0, 4), 30)
+		before, err := db.GetMonthlyRollups(familyID, time.Time{}, time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(db.RebuildMonthlyRollups(familyID)).To(Succeed())
+		after, err := db.GetMonthlyRollups(familyID, time.Time{}, time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).To(HaveLen(len(before)))
+		for i := range before {
+			Expect(after[i].Key()).To(Equal(before[i].Key()))
+			Expect(after[i].Amount.Equal(before[i].Amount)).To(BeTrue())
+			Expect(after[i].Count).To(Equal(before[i].Count))
+		}
+	})
+})
@@ -0,0 +1,166 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (s *storage) CreateSecurity(familyID uuid.UUID, security *goserver.SecurityNoId) (goserver.Security, error) {
+	if err := s.validateSecurity(familyID, security); err != nil {
+		return goserver.Security{}, err
+	}
+
+	sec := models.Security{
+		ID:           uuid.New(),
+		FamilyID:     familyID,
+		SecurityNoId: *security,
+	}
+	if err := s.db.Create(&sec).Error; err != nil {
+		return goserver.Security{}, fmt.Errorf(StorageError, err)
+	}
+
+	if err := s.recordAuditLog(s.db, familyID, "Security", sec.ID.String(), "CREATED", nil, &sec); err != nil {
+		s.log.Error("Failed to record audit log", "error", err)
+	}
+
+	return sec.FromDB(), nil
+}
+
+func (s *storage) GetSecurities(familyID uuid.UUID) ([]goserver.Security, error) {
+	var securities []models.Security
+	if err := s.db.Where("family_id = ?", familyID).Order("name").Find(&securities).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+
+	res := make([]goserver.Security, 0, len(securities))
+	for _, sec := range securities {
+		res = append(res, sec.FromDB())
+	}
+	return res, nil
+}
+
+func (s *storage) GetSecurity(familyID uuid.UUID, id string) (goserver.Security, error) {
+	var sec models.Security
+	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).First(&sec).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return goserver.Security{}, ErrNotFound
+		}
+
+		return goserver.Security{}, fmt.Errorf(StorageError, err)
+	}
+
+	return sec.FromDB(), nil
+}
+
+func (s *storage) UpdateSecurity(familyID uuid.UUID, id string, security *goserver.SecurityNoId) (goserver.Security, error) {
+	if err := s.validateSecurity(familyID, security); err != nil {
+		return goserver.Security{}, err
+	}
+
+	return performUpdate[models.Security, *goserver.SecurityNoId, goserver.Security](s, familyID, "Security", id, security,
+		func(i *goserver.SecurityNoId, familyID uuid.UUID) *models.Security {
+			return &models.Security{FamilyID: familyID, SecurityNoId: *i}
+		},
+		func(m *models.Security) goserver.Security { return m.FromDB() },
+		func(m *models.Security, id uuid.UUID) { m.ID = id },
+	)
+}
+
+// validateSecurity checks that the currency of prices exists.
+func (s *storage) validateSecurity(familyID uuid.UUID, security *goserver.SecurityNoId) error {
+	var count int64
+	if err := s.db.Model(&models.Currency{}).Where("family_id = ? AND id = ?", familyID, security.CurrencyId).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%w: currency %s not found", ErrInvalidSecurity, security.CurrencyId)
+	}
+	return nil
+}
+
+func (s *storage) DeleteSecurity(familyID uuid.UUID, id string) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Table("transactions").
+			Joins("CROSS JOIN json_each(transactions.movements)").
+			Where("transactions.family_id = ? AND json_extract(json_each.value, '$.securityId') = ?", familyID, id).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check transactions for security usage: %w", err)
+		}
+		if count > 0 {
+			return ErrSecurityInUse
+		}
+
+		var sec models.Security
+		if err := tx.Where("id = ? AND family_id = ?", id, familyID).First(&sec).Error; err == nil {
+			if err := s.recordAuditLog(tx, familyID, "Security", id, "DELETED", &sec, nil); err != nil {
+				s.log.Error("Failed to record audit log", "error", err)
+			}
+		}
+
+		if err := tx.Where("security_id = ? AND family_id = ?", id, familyID).Delete(&models.SecurityPrice{}).Error; err != nil {
+			return fmt.Errorf(StorageError, err)
+		}
+		if err := tx.Where("id = ? AND family_id = ?", id, familyID).Delete(&models.Security{}).Error; err != nil {
+			return fmt.Errorf(StorageError, err)
+		}
+		return nil
+	})
+}
+
+func (s *storage) GetSecurityPrices(
+	familyID uuid.UUID, securityID string, dateFrom, dateTo time.Time,
+) ([]goserver.SecurityPrice, error) {
+	query := s.db.Where("family_id = ? AND security_id = ?", familyID, securityID)
+	if !dateFrom.IsZero() {
+		query = query.Where("date >= ?", dateFrom)
+	}
+	if !dateTo.IsZero() {
+		query = query.Where("date < ?", dateTo)
+	}
+
+	var prices []models.SecurityPrice
+	if err := query.Order("date").Find(&prices).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+
+	res := make([]goserver.SecurityPrice, 0, len(prices))
+	for _, p := range prices {
+		res = append(res, p.FromDB())
+	}
+	return res, nil
+}
+
+func (s *storage) SetSecurityPrices(familyID uuid.UUID, securityID string, prices []goserver.SecurityPrice) error {
+	securityUUID, err := uuid.Parse(securityID)
+	if err != nil {
+		return fmt.Errorf(StorageError+"; id is not UUID", err)
+	}
+	if len(prices) == 0 {
+		return nil
+	}
+
+	// The last price of a day wins
+	byDay := make(map[time.Time]models.SecurityPrice, len(prices))
+	for _, p := range prices {
+		day := models.SecurityPriceDay(p.Date)
+		byDay[day] = models.SecurityPrice{FamilyID: familyID, SecurityID: securityUUID, Date: day, Price: p.Price}
+	}
+	rows := make([]models.SecurityPrice, 0, len(byDay))
+	for _, p := range byDay {
+		rows = append(rows, p)
+	}
+
+	if err := s.db.Clauses(clause.OnConflict{UpdateAll: true}).CreateInBatches(rows, 500).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
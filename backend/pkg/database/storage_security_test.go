@@ -0,0 +1,98 @@
+package database_test
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Securities", func() {
+	var (
+		db       database.Storage
+		familyID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		usd      goserver.Currency
+	)
+
+	BeforeEach(func() {
+		cfg := &config.Config{DBPath: ":memory:", Verbose: false}
+		db = database.NewStorage(slog.Default(), cfg)
+		Expect(db.Open()).To(Succeed())
+		DeferCleanup(db.Close)
+
+		var err error
+		usd, err = db.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "USD"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("stores securities and refuses unknown currencies", func() {
+		etf, err := db.CreateSecurity(familyID, &goserver.SecurityNoId{Name: "World ETF", Ticker: "VWCE", CurrencyId: usd.Id})
+		Expect(err).NotTo(HaveOccurred())
+
+		got, err := db.GetSecurity(familyID, etf.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Ticker).To(Equal("VWCE"))
+
+		_, err = db.CreateSecurity(familyID, &goserver.SecurityNoId{Name: "BTC", CurrencyId: uuid.NewString()})
+		Expect(err).To(MatchError(database.ErrInvalidSecurity))
+	})
+
+	It("replaces prices of the same day", func() {
+		etf, err := db.CreateSecurity(familyID, &goserver.SecurityNoId{Name: "World ETF", CurrencyId: usd.Id})
+		Expect(err).NotTo(HaveOccurred())
+
+		day := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+		Expect(db.SetSecurityPrices(familyID, etf.Id, []goserver.SecurityPrice{
+			{Date: day, Price: decimal.NewFromInt(100)},
+			{Date: day.AddDate(0, 0, 1), Price: decimal.NewFromInt(101)},
+		})).To(Succeed())
+		Expect(db.SetSecurityPrices(familyID, etf.Id, []goserver.SecurityPrice{
+			{Date: day.Add(15 * time.Hour), Price: decimal.NewFromInt(105)},
+		})).To(Succeed())
+
+		prices, err := db.GetSecurityPrices(familyID, etf.Id, time.Time{}, time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prices).To(HaveLen(2))
+		Expect(prices[0].Date.Equal(day)).To(BeTrue())
+		Expect(prices[0].Price.String()).To(Equal("105"))
+
+		prices, err = db.GetSecurityPrices(familyID, etf.Id, day.AddDate(0, 0, 1), time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prices).To(HaveLen(1))
+	})
+
+	It("validates movements of securities and refuses deleting used ones", func() {
+		etf, err := db.CreateSecurity(familyID, &goserver.SecurityNoId{Name: "World ETF", CurrencyId: usd.Id})
+		Expect(err).NotTo(HaveOccurred())
+		broker, err := db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Broker", Type: "asset"})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = db.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date: time.Now(),
+			Movements: []goserver.Movement{
+				{AccountId: broker.Id, CurrencyId: usd.Id, Amount: decimal.NewFromInt(100), SecurityId: etf.Id},
+			},
+		})
+		Expect(err).To(HaveOccurred())
+
+		_, err = db.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date: time.Now(),
+			Movements: []goserver.Movement{
+				{
+					AccountId: broker.Id, CurrencyId: usd.Id, Amount: decimal.NewFromInt(100),
+					SecurityId: etf.Id, Quantity: decimal.NewFromInt(1),
+				},
+				{AccountId: broker.Id, CurrencyId: usd.Id, Amount: decimal.NewFromInt(-100)},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(db.DeleteSecurity(familyID, etf.Id)).To(MatchError(database.ErrSecurityInUse))
+	})
+})
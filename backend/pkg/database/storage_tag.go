@@ -0,0 +1,168 @@
+package database
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+	"gorm.io/gorm"
+)
+
+func (s *storage) GetTags(familyID uuid.UUID) ([]goserver.TagInfo, error) {
+	var transactions []models.Transaction
+	if err := s.db.Select("tags").
+		Where("family_id = ? AND merged_into_id IS NULL", familyID).
+		Find(&transactions).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	var matchers []models.Matcher
+	if err := s.db.Select("output_tags").Where("family_id = ?", familyID).Find(&matchers).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	var templates []models.TransactionTemplate
+	if err := s.db.Select("tags").Where("family_id = ?", familyID).Find(&templates).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+
+	infos := make(map[string]*goserver.TagInfo)
+	getInfo := func(tag string) *goserver.TagInfo {
+		tag = utils.NormalizeTag(tag)
+		if tag == "" {
+			return nil
+		}
+		// Parents are part of the catalog even if nothing uses them directly
+		for _, t := range utils.TagWithAncestors(tag) {
+			if _, ok := infos[t]; !ok {
+				infos[t] = &goserver.TagInfo{Name: t, Parent: utils.TagParent(t)}
+			}
+		}
+		return infos[tag]
+	}
+	for _, t := range transactions {
+		for _, tag := range t.Tags {
+			if info := getInfo(tag); info != nil {
+				info.TransactionCount++
+			}
+		}
+	}
+	for _, m := range matchers {
+		for _, tag := range m.OutputTags {
+			if info := getInfo(tag); info != nil {
+				info.MatcherCount++
+			}
+		}
+	}
+	for _, t := range templates {
+		for _, tag := range t.Tags {
+			if info := getInfo(tag); info != nil {
+				info.TemplateCount++
+			}
+		}
+	}
+
+	res := make([]goserver.TagInfo, 0, len(infos))
+	for _, info := range infos {
+		res = append(res, *info)
+	}
+	slices.SortFunc(res, func(a, b goserver.TagInfo) int {
+		return utils.CompareTags(a.Name, b.Name)
+	})
+	return res, nil
+}
+
+func (s *storage) RenameTag(familyID uuid.UUID, from, to string) (goserver.TagChangeResult, error) {
+	res, err := s.renameTags(familyID, []string{from}, to)
+	if err != nil {
+		return goserver.TagChangeResult{}, err
+	}
+
+	s.log.Info("Tag renamed", "familyID", familyID, "from", from, "to", to,
+		"transactions", res.Transactions, "matchers", res.Matchers, "templates", res.Templates)
+	return res, nil
+}
+
+func (s *storage) MergeTags(familyID uuid.UUID, sources []string, target string) (goserver.TagChangeResult, error) {
+	res, err := s.renameTags(familyID, sources, target)
+	if err != nil {
+		return goserver.TagChangeResult{}, err
+	}
+
+	s.log.Info("Tags merged", "familyID", familyID, "sources", sources, "target", target,
+		"transactions", res.Transactions, "matchers", res.Matchers, "templates", res.Templates)
+	return res, nil
+}
+
+// renameTags renames all the sources and their sub-tags to the target in one database transaction
+func (s *storage) renameTags(familyID uuid.UUID, sources []string, target string) (goserver.TagChangeResult, error) {
+	var res goserver.TagChangeResult
+	rename := func(tags []string) ([]string, bool) {
+		renamed := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			renamed = append(renamed, utils.NormalizeTag(tag))
+		}
+		changed := false
+		for _, source := range sources {
+			var ok bool
+			renamed, ok = utils.RenameTag(renamed, source, target)
+			changed = changed || ok
+		}
+		return renamed, changed
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// Only tags change, so rollups and reconciliation are not affected
+		var transactions []models.Transaction
+		if err := tx.Select("id", "tags").Where("family_id = ?", familyID).Find(&transactions).Error; err != nil {
+			return fmt.Errorf("failed to get transactions: %w", err)
+		}
+		for _, t := range transactions {
+			tags, ok := rename(t.Tags)
+			if !ok {
+				continue
+			}
+			if err := tx.Model(&t).Select("Tags").Updates(&models.Transaction{Tags: tags}).Error; err != nil {
+				return fmt.Errorf("failed to rename tag of transaction %s: %w", t.ID, err)
+			}
+			res.Transactions++
+		}
+
+		var matchers []models.Matcher
+		if err := tx.Select("id", "output_tags").Where("family_id = ?", familyID).Find(&matchers).Error; err != nil {
+			return fmt.Errorf("failed to get matchers: %w", err)
+		}
+		for _, m := range matchers {
+			tags, ok := rename(m.OutputTags)
+			if !ok {
+				continue
+			}
+			if err := tx.Model(&m).Select("OutputTags").Updates(&models.Matcher{OutputTags: tags}).Error; err != nil {
+				return fmt.Errorf("failed to rename tag of matcher %s: %w", m.ID, err)
+			}
+			res.Matchers++
+		}
+
+		var templates []models.TransactionTemplate
+		if err := tx.Select("id", "tags").Where("family_id = ?", familyID).Find(&templates).Error; err != nil {
+			return fmt.Errorf("failed to get templates: %w", err)
+		}
+		for _, t := range templates {
+			tags, ok := rename(t.Tags)
+			if !ok {
+				continue
+			}
+			if err := tx.Model(&t).Select("Tags").Updates(&models.TransactionTemplate{Tags: tags}).Error; err != nil {
+				return fmt.Errorf("failed to rename tag of template %s: %w", t.ID, err)
+			}
+			res.Templates++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return goserver.TagChangeResult{}, fmt.Errorf(StorageError, err)
+	}
+	return res, nil
+}
@@ -0,0 +1,88 @@
+package database_test
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Tags", func() {
+	var (
+		db       database.Storage
+		familyID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	)
+
+	create := func(tags ...string) goserver.Transaction {
+		t, err := db.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date: time.Now(),
+			Tags: tags,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return t
+	}
+
+	BeforeEach(func() {
+		cfg := &config.Config{DBPath: ":memory:", Verbose: false}
+		db = database.NewStorage(slog.Default(), cfg)
+		Expect(db.Open()).To(Succeed())
+		DeferCleanup(db.Close)
+	})
+
+	It("lists used tags with their parents", func() {
+		create("travel/2026-italy", "food")
+		create("travel/2026-italy")
+		_, err := db.CreateMatcher(familyID, &goserver.MatcherNoId{
+			OutputDescription: "Shell", OutputTags: []string{"car/fuel"}, DescriptionRegExp: "shell",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = db.CreateTemplate(familyID, &goserver.TransactionTemplateNoId{Name: "Lunch", Tags: []string{"food"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		tags, err := db.GetTags(familyID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tags).To(Equal([]goserver.TagInfo{
+			{Name: "car", Parent: ""},
+			{Name: "car/fuel", Parent: "car", MatcherCount: 1},
+			{Name: "food", Parent: "", TransactionCount: 1, TemplateCount: 1},
+			{Name: "travel", Parent: ""},
+			{Name: "travel/2026-italy", Parent: "travel", TransactionCount: 2},
+		}))
+	})
+
+	It("renames tags with sub-tags and merges duplicates", func() {
+		t := create("car/gas", "car/gas/diesel", "car/fuel")
+		other := create("cargo")
+
+		res, err := db.RenameTag(familyID, "car/gas", "car/fuel")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Transactions).To(Equal(int32(1)))
+
+		t, err = db.GetTransaction(familyID, t.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(t.Tags).To(Equal([]string{"car/fuel", "car/fuel/diesel"}))
+		other, err = db.GetTransaction(familyID, other.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(other.Tags).To(Equal([]string{"cargo"}))
+	})
+	It("merges several tags at once", func() {
+		t := create("car/gas", "petrol")
+		other := create("petrol/diesel")
+
+		res, err := db.MergeTags(familyID, []string{"car/gas", "petrol"}, "car/fuel")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Transactions).To(Equal(int32(2)))
+
+		t, err = db.GetTransaction(familyID, t.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(t.Tags).To(Equal([]string{"car/fuel"}))
+		other, err = db.GetTransaction(familyID, other.Id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(other.Tags).To(Equal([]string{"car/fuel/diesel"}))
+	})
+})
@@ -129,7 +129,16 @@ func (s *storage) CreateTransaction(familyID uuid.UUID, input goserver.Transacti
 
 	t := models.TransactionToDB(input, familyID)
 	t.ID = uuid.New()
-	if err := s.db.Create(t).Error; err != nil {
+	t.ExchangeRates = utils.TransactionExchangeRates(t.Movements)
+	// Refunds are only linked through LinkRefund, which checks the link
+	t.RefundOfID = nil
+	t.RefundKind = ""
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(t).Error; err != nil {
+			return err
+		}
+		return updateMonthlyRollupsWithTx(tx, familyID, nil, []*models.Transaction{t})
+	}); err != nil {
 		return goserver.Transaction{}, fmt.Errorf(StorageError, err)
 	}
 
@@ -181,6 +190,9 @@ func (s *storage) CreateTransactionsBatch(familyID uuid.UUID, inputs []goserver.
 
 		t := models.TransactionToDB(input, familyID)
 		t.ID = uuid.New()
+		t.ExchangeRates = utils.TransactionExchangeRates(t.Movements)
+		t.RefundOfID = nil
+		t.RefundKind = ""
 		transactionModels = append(transactionModels, t)
 	}
 
@@ -190,6 +202,10 @@ func (s *storage) CreateTransactionsBatch(familyID uuid.UUID, inputs []goserver.
 		tx.Rollback()
 		return nil, fmt.Errorf("failed to create transactions batch: %w", err)
 	}
+	if err := updateMonthlyRollupsWithTx(tx, familyID, nil, transactionModels); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 
 	// Build audit log entries and insert them in a single batch.
 	auditLogs := make([]models.AuditLog, 0, len(transactionModels))
@@ -296,6 +312,7 @@ func (s *storage) updateTransaction(
 
 	t = models.TransactionToDB(input, familyID)
 	t.ID = idUUID
+	t.ExchangeRates = utils.TransactionExchangeRates(t.Movements)
 
 	// Preserve fields that are not editable via this endpoint or were not provided
 	if preserveProtected {
@@ -309,9 +326,16 @@ func (s *storage) updateTransaction(
 		t.MergedIntoID = oldT.MergedIntoID
 		t.MergedAt = oldT.MergedAt
 		t.AutoMatchSkipReason = oldT.AutoMatchSkipReason
+		t.RefundOfID = oldT.RefundOfID
+		t.RefundKind = oldT.RefundKind
 	}
 
-	if err := s.db.Save(&t).Error; err != nil {
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&t).Error; err != nil {
+			return err
+		}
+		return updateMonthlyRollupsWithTx(tx, familyID, []*models.Transaction{&oldT}, []*models.Transaction{t})
+	}); err != nil {
 		return goserver.Transaction{}, fmt.Errorf(StorageError, err)
 	}
 
@@ -366,7 +390,20 @@ func (s *storage) DeleteTransaction(familyID uuid.UUID, id string) error {
 		s.log.Error("Failed to record audit log", "error", err)
 	}
 
-	if err := s.db.Delete(&t).Error; err != nil {
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		// Delete marks t as deleted, so rollups are updated from a copy
+		old := t
+		if err := tx.Delete(&t).Error; err != nil {
+			return err
+		}
+		// Refunds of the deleted transaction are not linked to anything anymore
+		if err := tx.Model(&models.Transaction{}).
+			Where("family_id = ? AND refund_of_id = ?", familyID, old.ID).
+			Updates(map[string]any{"refund_of_id": nil, "refund_kind": ""}).Error; err != nil {
+			return fmt.Errorf("failed to clear refund links: %w", err)
+		}
+		return updateMonthlyRollupsWithTx(tx, familyID, []*models.Transaction{&old}, nil)
+	}); err != nil {
 		return fmt.Errorf(StorageError, err)
 	}
 
@@ -401,63 +438,86 @@ func (s *storage) MergeTransactions(familyID uuid.UUID, keepID, mergeID string)
 			return fmt.Errorf("failed to find merge transaction: %w", err)
 		}
 
-		// 2. Transfer external IDs
-		existingIDs := make(map[string]bool)
-		for _, id := range keepT.ExternalIDs {
-			existingIDs[id] = true
-		}
-		for _, id := range mergeT.ExternalIDs {
-			if !existingIDs[id] {
-				keepT.ExternalIDs = append(keepT.ExternalIDs, id)
-			}
-		}
+		return s.absorbTransactionWithTx(tx, familyID, &keepT, &mergeT)
+	})
+	if err != nil {
+		return goserver.Transaction{}, fmt.Errorf(StorageError, err)
+	}
 
-		// 3. Update keepT: remove suspicious reason, inherit external IDs
-		newReasons := make([]string, 0)
-		for _, r := range keepT.SuspiciousReasons {
-			if r != "Potential duplicate from different importer" {
-				newReasons = append(newReasons, r)
-			}
+	return s.GetTransaction(familyID, keepID)
+}
+
+// absorbTransactionWithTx moves external IDs of mergeT into keepT, archives and hard-deletes
+// mergeT and clears duplicate relationships of both transactions.
+func (s *storage) absorbTransactionWithTx(tx *gorm.DB, familyID uuid.UUID, keepT, mergeT *models.Transaction) error {
+	// Transfer external IDs
+	existingIDs := make(map[string]bool)
+	for _, id := range keepT.ExternalIDs {
+		existingIDs[id] = true
+	}
+	for _, id := range mergeT.ExternalIDs {
+		if !existingIDs[id] {
+			keepT.ExternalIDs = append(keepT.ExternalIDs, id)
 		}
-		keepT.SuspiciousReasons = newReasons
-		if err := tx.Save(&keepT).Error; err != nil {
-			return fmt.Errorf("failed to update keep transaction: %w", err)
+	}
+
+	// Update keepT: remove suspicious reason, inherit external IDs
+	newReasons := make([]string, 0)
+	for _, r := range keepT.SuspiciousReasons {
+		if r != "Potential duplicate from different importer" {
+			newReasons = append(newReasons, r)
 		}
+	}
+	keepT.SuspiciousReasons = newReasons
 
-		// 4. Archive and hard-delete mergeT (archive is now source of truth)
-		now := time.Now()
+	// Callers may change movements of keepT, so rollups are updated from the stored version
+	var storedKeepT models.Transaction
+	if err := tx.Where("family_id = ? AND id = ?", familyID, keepT.ID).First(&storedKeepT).Error; err != nil {
+		return fmt.Errorf("failed to find keep transaction: %w", err)
+	}
+	if err := tx.Save(keepT).Error; err != nil {
+		return fmt.Errorf("failed to update keep transaction: %w", err)
+	}
 
-		if err := s.archiveMergedTransaction(tx, familyID, &mergeT, kID, now); err != nil {
-			return err
-		}
+	// Archive and hard-delete mergeT (archive is now source of truth)
+	now := time.Now()
 
-		// Hard-delete the merged transaction
-		if err := tx.Unscoped().Delete(&mergeT).Error; err != nil {
-			return fmt.Errorf("failed to hard-delete merge transaction: %w", err)
-		}
+	if err := s.archiveMergedTransaction(tx, familyID, mergeT, keepT.ID, now); err != nil {
+		return err
+	}
 
-		if err := s.recordAuditLog(tx, familyID, "Transaction", mergeT.ID.String(), "MERGED", &mergeT, nil); err != nil {
-			s.log.Error("Failed to record audit log", "error", err)
-		}
+	// Hard-delete the merged transaction
+	if err := tx.Unscoped().Delete(mergeT).Error; err != nil {
+		return fmt.Errorf("failed to hard-delete merge transaction: %w", err)
+	}
+	if err := updateMonthlyRollupsWithTx(tx, familyID,
+		[]*models.Transaction{&storedKeepT, mergeT}, []*models.Transaction{keepT}); err != nil {
+		return err
+	}
 
-		// 5. Clear duplicate relationships for both (they are resolved now)
-		// We use tx so it's atomic within our transaction
-		if err := s.clearDuplicateRelationshipsWithTx(tx, familyID, mID.String()); err != nil {
-			return fmt.Errorf("failed to clear relationships for merged transaction: %w", err)
-		}
+	if err := s.recordAuditLog(tx, familyID, "Transaction", mergeT.ID.String(), "MERGED", mergeT, nil); err != nil {
+		s.log.Error("Failed to record audit log", "error", err)
+	}
 
-		// Clear specific relationship between keepT and mergeT (the one where keepT was the primary)
-		if err := s.clearDuplicateRelationshipsWithTx(tx, familyID, kID.String()); err != nil {
-			return fmt.Errorf("failed to clear relationships for keep transaction: %w", err)
-		}
+	// Refunds of the merged transaction now refund the kept one
+	if err := tx.Model(&models.Transaction{}).
+		Where("family_id = ? AND refund_of_id = ?", familyID, mergeT.ID).
+		Update("refund_of_id", keepT.ID).Error; err != nil {
+		return fmt.Errorf("failed to relink refunds of merged transaction: %w", err)
+	}
 
-		return nil
-	})
-	if err != nil {
-		return goserver.Transaction{}, fmt.Errorf(StorageError, err)
+	// Clear duplicate relationships for both (they are resolved now)
+	// We use tx so it's atomic within our transaction
+	if err := s.clearDuplicateRelationshipsWithTx(tx, familyID, mergeT.ID.String()); err != nil {
+		return fmt.Errorf("failed to clear relationships for merged transaction: %w", err)
 	}
 
-	return s.GetTransaction(familyID, keepID)
+	// Clear specific relationship between keepT and mergeT (the one where keepT was the primary)
+	if err := s.clearDuplicateRelationshipsWithTx(tx, familyID, keepT.ID.String()); err != nil {
+		return fmt.Errorf("failed to clear relationships for keep transaction: %w", err)
+	}
+
+	return nil
 }
 
 func (s *storage) GetTransaction(familyID uuid.UUID, id string) (goserver.Transaction, error) {
@@ -606,9 +666,22 @@ func (s *storage) UnmergeTransaction(familyID uuid.UUID, id string) error {
 				}
 			}
 			kept.ExternalIDs = newExternalIDs
+
+			// Movements of the incoming leg of a confirmed transfer are removed from the kept transaction
+			oldKept := kept
+			if len(archived.KeptMovements) > 0 {
+				kept.Movements = archived.KeptMovements
+				kept.Tags = archived.KeptTags
+			}
 			if err := tx.Save(&kept).Error; err != nil {
 				return fmt.Errorf("failed to update kept transaction: %w", err)
 			}
+			if len(archived.KeptMovements) > 0 {
+				if err := updateMonthlyRollupsWithTx(tx, familyID,
+					[]*models.Transaction{&oldKept}, []*models.Transaction{&kept}); err != nil {
+					return err
+				}
+			}
 		}
 
 		// 3. Recreate the transaction from archive data (don't rely on soft-deleted record)
@@ -636,6 +709,9 @@ func (s *storage) UnmergeTransaction(familyID uuid.UUID, id string) error {
 		if err := tx.Create(&restoredTransaction).Error; err != nil {
 			return fmt.Errorf("failed to recreate transaction: %w", err)
 		}
+		if err := updateMonthlyRollupsWithTx(tx, familyID, nil, []*models.Transaction{&restoredTransaction}); err != nil {
+			return err
+		}
 
 		// 4. Delete from archive
 		if err := tx.Delete(&archived).Error; err != nil {
@@ -933,6 +1009,60 @@ func (s *storage) validateTransaction(familyID uuid.UUID, transaction goserver.T
 		} else {
 			return errors.New("currency ID is required")
 		}
+
+		// 4. Validate SecurityId, units of a security are bought or sold
+		if m.SecurityId != "" {
+			var count int64
+			if err := s.db.Model(&models.Security{}).Where("family_id = ? AND id = ?", familyID, m.SecurityId).Count(&count).Error; err != nil {
+				return err
+			}
+			if count == 0 {
+				return fmt.Errorf("security %s not found", m.SecurityId)
+			}
+			if m.Quantity.IsZero() {
+				return errors.New("quantity is required for movements of a security")
+			}
+		} else if !m.Quantity.IsZero() {
+			return errors.New("quantity requires a security")
+		}
 	}
 	return nil
 }
+
+func (s *storage) GetExchangeTransactions(familyID uuid.UUID, dateFrom, dateTo time.Time) ([]goserver.Transaction, error) {
+	req := s.db.Where("family_id = ? AND merged_into_id IS NULL", familyID).
+		Where("exchange_rates IS NOT NULL AND exchange_rates NOT IN ('', 'null', '[]')")
+	if !dateFrom.IsZero() {
+		req = req.Where("date >= ?", dateFrom)
+	}
+	if !dateTo.IsZero() {
+		req = req.Where("date < ?", dateTo)
+	}
+
+	var transactions []models.Transaction
+	if err := req.Order("date").Find(&transactions).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+
+	res := make([]goserver.Transaction, 0, len(transactions))
+	for _, t := range transactions {
+		res = append(res, t.FromDB())
+	}
+	return res, nil
+}
+
+func (s *storage) GetTransactionDateRange(familyID uuid.UUID) (time.Time, time.Time, error) {
+	req := s.db.Model(&models.Transaction{}).Where("family_id = ? AND merged_into_id IS NULL", familyID)
+
+	var first, last models.Transaction
+	if err := req.Session(&gorm.Session{}).Order("date").First(&first).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return time.Time{}, time.Time{}, nil
+		}
+		return time.Time{}, time.Time{}, fmt.Errorf(StorageError, err)
+	}
+	if err := req.Session(&gorm.Session{}).Order("date DESC").First(&last).Error; err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf(StorageError, err)
+	}
+	return first.Date, last.Date, nil
+}
@@ -0,0 +1,171 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// ConfirmTransfer merges two one-sided transactions into one transfer transaction. The unknown
+// movement of the outgoing transaction is replaced with the known movements of the incoming
+// one, the incoming transaction is archived as merged and a transfer rule is learned for the
+// pair of accounts. Unmerging the incoming transaction restores the original movements and tags
+// of the outgoing one.
+func (s *storage) ConfirmTransfer(familyID uuid.UUID, outgoingID, incomingID string) (goserver.Transaction, error) {
+	if outgoingID == incomingID {
+		return goserver.Transaction{}, ErrNotTransferPair
+	}
+
+	var outT models.Transaction
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var inT models.Transaction
+		if err := tx.Where("family_id = ? AND id = ?", familyID, outgoingID).First(&outT).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNotFound
+			}
+			return fmt.Errorf("failed to find outgoing transaction: %w", err)
+		}
+		if err := tx.Where("family_id = ? AND id = ?", familyID, incomingID).First(&inT).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNotFound
+			}
+			return fmt.Errorf("failed to find incoming transaction: %w", err)
+		}
+
+		outLeg, ok := utils.GetOneSidedLeg(outT.Movements)
+		if !ok || !outLeg.Amount.IsNegative() {
+			return ErrNotTransferPair
+		}
+		inLeg, ok := utils.GetOneSidedLeg(inT.Movements)
+		if !ok || !inLeg.Amount.IsPositive() || inLeg.AccountId == outLeg.AccountId {
+			return ErrNotTransferPair
+		}
+
+		oldT := outT
+		movements := make([]goserver.Movement, 0, len(outT.Movements)+len(inT.Movements))
+		for _, m := range outT.Movements {
+			if m.AccountId != "" {
+				movements = append(movements, m)
+				continue
+			}
+			for _, im := range inT.Movements {
+				if im.AccountId != "" {
+					movements = append(movements, im)
+				}
+			}
+		}
+		outT.Movements = movements
+
+		tags := slices.Clone(outT.Tags)
+		for _, tag := range inT.Tags {
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		outT.Tags = tags
+
+		if err := s.absorbTransactionWithTx(tx, familyID, &outT, &inT); err != nil {
+			return err
+		}
+		// Unmerging the incoming transaction restores the outgoing one as it was
+		if err := tx.Model(&models.MergedTransaction{}).
+			Where("family_id = ? AND original_transaction_id = ?", familyID, inT.ID).
+			Select("KeptMovements", "KeptTags").
+			Updates(&models.MergedTransaction{KeptMovements: oldT.Movements, KeptTags: oldT.Tags}).Error; err != nil {
+			return fmt.Errorf("failed to archive outgoing transaction: %w", err)
+		}
+
+		if err := s.recordAuditLog(tx, familyID, "Transaction", outT.ID.String(), "UPDATED", &oldT, &outT); err != nil {
+			s.log.Error("Failed to record audit log", "error", err)
+		}
+
+		return s.learnTransferRuleWithTx(tx, familyID, outLeg.AccountId, inLeg.AccountId)
+	})
+	if err != nil {
+		if errors.Is(err, ErrNotFound) || errors.Is(err, ErrNotTransferPair) {
+			return goserver.Transaction{}, err
+		}
+		return goserver.Transaction{}, fmt.Errorf(StorageError, err)
+	}
+
+	return s.GetTransaction(familyID, outgoingID)
+}
+
+func (s *storage) learnTransferRuleWithTx(tx *gorm.DB, familyID uuid.UUID, fromAccountID, toAccountID string) error {
+	var rule models.TransferRule
+	err := tx.Where("family_id = ? AND from_account_id = ? AND to_account_id = ?", familyID, fromAccountID, toAccountID).
+		First(&rule).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		rule = models.TransferRule{
+			ID:              uuid.New(),
+			FamilyID:        familyID,
+			FromAccountID:   fromAccountID,
+			ToAccountID:     toAccountID,
+			Confirmations:   1,
+			LastConfirmedAt: time.Now(),
+		}
+		if err := tx.Create(&rule).Error; err != nil {
+			return fmt.Errorf("failed to create transfer rule: %w", err)
+		}
+		if err := s.recordAuditLog(tx, familyID, "TransferRule", rule.ID.String(), "CREATED", nil, &rule); err != nil {
+			s.log.Error("Failed to record audit log", "error", err)
+		}
+	case err != nil:
+		return fmt.Errorf("failed to find transfer rule: %w", err)
+	default:
+		oldRule := rule
+		rule.Confirmations++
+		rule.LastConfirmedAt = time.Now()
+		if err := tx.Save(&rule).Error; err != nil {
+			return fmt.Errorf("failed to update transfer rule: %w", err)
+		}
+		if err := s.recordAuditLog(tx, familyID, "TransferRule", rule.ID.String(), "UPDATED", &oldRule, &rule); err != nil {
+			s.log.Error("Failed to record audit log", "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *storage) GetTransferRules(familyID uuid.UUID) ([]goserver.TransferRule, error) {
+	var records []models.TransferRule
+	if err := s.db.Where("family_id = ?", familyID).Order("last_confirmed_at DESC").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf(StorageError, err)
+	}
+
+	result := make([]goserver.TransferRule, 0, len(records))
+	for _, r := range records {
+		result = append(result, r.FromDB())
+	}
+
+	return result, nil
+}
+
+func (s *storage) DeleteTransferRule(familyID uuid.UUID, id string) error {
+	var rule models.TransferRule
+	if err := s.db.Where("id = ? AND family_id = ?", id, familyID).First(&rule).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf(StorageError, err)
+	}
+
+	if err := s.recordAuditLog(s.db, familyID, "TransferRule", id, "DELETED", &rule, nil); err != nil {
+		s.log.Error("Failed to record audit log", "error", err)
+	}
+
+	if err := s.db.Delete(&rule).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+
+	return nil
+}
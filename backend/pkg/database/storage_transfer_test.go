@@ -0,0 +1,184 @@
+package database_test
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("TransferStorage", func() {
+	var (
+		db       database.Storage
+		fio      goserver.Account
+		revolut  goserver.Account
+		czk      goserver.Currency
+		familyID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	)
+
+	oneSided := func(accountID string, amount int64, externalID, tag string) goserver.Transaction {
+		t, err := db.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date:        time.Now(),
+			Description: "Transfer " + tag,
+			Tags:        []string{tag},
+			ExternalIds: []string{externalID},
+			Movements: []goserver.Movement{
+				{Amount: decimal.NewFromInt(-amount), CurrencyId: czk.Id},
+				{AccountId: accountID, Amount: decimal.NewFromInt(amount), CurrencyId: czk.Id},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return t
+	}
+
+	BeforeEach(func() {
+		cfg := &config.Config{DBPath: ":memory:", Verbose: false}
+		db = database.NewStorage(slog.Default(), cfg)
+		Expect(db.Open()).To(Succeed())
+		DeferCleanup(db.Close)
+
+		var err error
+		czk, err = db.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "CZK"})
+		Expect(err).NotTo(HaveOccurred())
+		fio, err = db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Fio", Type: "asset"})
+		Expect(err).NotTo(HaveOccurred())
+		revolut, err = db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Revolut", Type: "asset"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("ConfirmTransfer", func() {
+		It("merges two one-sided transactions into one transfer", func() {
+			out := oneSided(fio.Id, -100, "fio-1", "fio")
+			in := oneSided(revolut.Id, 100, "revolut-1", "revolut")
+
+			res, err := db.ConfirmTransfer(familyID, out.Id, in.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Id).To(Equal(out.Id))
+			Expect(res.Movements).To(HaveLen(2))
+			for _, m := range res.Movements {
+				Expect(m.AccountId).NotTo(BeEmpty())
+				if m.AccountId == fio.Id {
+					Expect(m.Amount.Equal(decimal.NewFromInt(-100))).To(BeTrue())
+				} else {
+					Expect(m.AccountId).To(Equal(revolut.Id))
+					Expect(m.Amount.Equal(decimal.NewFromInt(100))).To(BeTrue())
+				}
+			}
+			Expect(res.ExternalIds).To(ConsistOf("fio-1", "revolut-1"))
+			Expect(res.Tags).To(ConsistOf("fio", "revolut"))
+			Expect(res.MergedTransactionIds).To(ConsistOf(in.Id))
+
+			_, err = db.GetTransaction(familyID, in.Id)
+			Expect(err).To(MatchError(database.ErrNotFound))
+
+			balance, err := db.GetAccountBalance(familyID, revolut.Id, czk.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(balance.Equal(decimal.NewFromInt(100))).To(BeTrue())
+		})
+
+		It("keeps fee movements of the incoming transaction", func() {
+			fees, err := db.CreateAccount(familyID, &goserver.AccountNoId{Name: "Fees", Type: "expense"})
+			Expect(err).NotTo(HaveOccurred())
+			out := oneSided(fio.Id, -100, "fio-1", "fio")
+			in, err := db.CreateTransaction(familyID, &goserver.TransactionNoId{
+				Date:        time.Now(),
+				ExternalIds: []string{"revolut-1"},
+				Movements: []goserver.Movement{
+					{Amount: decimal.NewFromInt(-100), CurrencyId: czk.Id},
+					{AccountId: revolut.Id, Amount: decimal.NewFromInt(99), CurrencyId: czk.Id},
+					{AccountId: fees.Id, Amount: decimal.NewFromInt(1), CurrencyId: czk.Id},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := db.ConfirmTransfer(familyID, out.Id, in.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Movements).To(HaveLen(3))
+			total := decimal.Zero
+			for _, m := range res.Movements {
+				Expect(m.AccountId).NotTo(BeEmpty())
+				total = total.Add(m.Amount)
+			}
+			Expect(total.IsZero()).To(BeTrue())
+		})
+
+		It("learns a transfer rule and counts confirmations", func() {
+			_, err := db.ConfirmTransfer(familyID,
+				oneSided(fio.Id, -100, "fio-1", "fio").Id, oneSided(revolut.Id, 100, "revolut-1", "revolut").Id)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = db.ConfirmTransfer(familyID,
+				oneSided(fio.Id, -200, "fio-2", "fio").Id, oneSided(revolut.Id, 200, "revolut-2", "revolut").Id)
+			Expect(err).NotTo(HaveOccurred())
+
+			rules, err := db.GetTransferRules(familyID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rules).To(HaveLen(1))
+			Expect(rules[0].FromAccountId).To(Equal(fio.Id))
+			Expect(rules[0].ToAccountId).To(Equal(revolut.Id))
+			Expect(rules[0].Confirmations).To(Equal(int32(2)))
+		})
+
+		It("restores both transactions on unmerge", func() {
+			out := oneSided(fio.Id, -100, "fio-1", "fio")
+			in := oneSided(revolut.Id, 100, "revolut-1", "revolut")
+			_, err := db.ConfirmTransfer(familyID, out.Id, in.Id)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(db.UnmergeTransaction(familyID, in.Id)).To(Succeed())
+			restored, err := db.GetTransaction(familyID, out.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored.Movements).To(HaveLen(2))
+			Expect(restored.Tags).To(ConsistOf("fio"))
+			Expect(restored.ExternalIds).To(ConsistOf("fio-1"))
+
+			// The transfer is counted once
+			for account, expected := range map[string]int64{fio.Id: -100, revolut.Id: 100} {
+				balance, err := db.GetAccountBalance(familyID, account, czk.Id)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(balance.Equal(decimal.NewFromInt(expected))).To(BeTrue())
+			}
+			mismatches, err := db.CheckMonthlyRollups(familyID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mismatches).To(BeEmpty())
+		})
+
+		It("rejects transactions which are not two halves of a transfer", func() {
+			out := oneSided(fio.Id, -100, "fio-1", "fio")
+			sameDirection := oneSided(revolut.Id, -100, "revolut-1", "revolut")
+
+			_, err := db.ConfirmTransfer(familyID, out.Id, sameDirection.Id)
+			Expect(err).To(MatchError(database.ErrNotTransferPair))
+
+			_, err = db.ConfirmTransfer(familyID, out.Id, uuid.New().String())
+			Expect(err).To(MatchError(database.ErrNotFound))
+
+			rules, err := db.GetTransferRules(familyID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rules).To(BeEmpty())
+		})
+	})
+
+	Describe("DeleteTransferRule", func() {
+		It("deletes a learned rule", func() {
+			_, err := db.ConfirmTransfer(familyID,
+				oneSided(fio.Id, -100, "fio-1", "fio").Id, oneSided(revolut.Id, 100, "revolut-1", "revolut").Id)
+			Expect(err).NotTo(HaveOccurred())
+			rules, err := db.GetTransferRules(familyID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rules).To(HaveLen(1))
+
+			Expect(db.DeleteTransferRule(familyID, rules[0].Id)).To(Succeed())
+			rules, err = db.GetTransferRules(familyID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rules).To(BeEmpty())
+
+			Expect(db.DeleteTransferRule(familyID, uuid.New().String())).To(MatchError(database.ErrNotFound))
+		})
+	})
+})
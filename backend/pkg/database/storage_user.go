@@ -63,6 +63,27 @@ func (s *storage) GetFamilyByName(name string) (*models.Family, error) {
 	return &family, nil
 }
 
+func (s *storage) GetFamily(familyID uuid.UUID) (*models.Family, error) {
+	var family models.Family
+	err := s.db.Preload("Users", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at")
+	}).Where("id = ?", familyID).First(&family).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf(StorageError, err)
+	}
+	return &family, nil
+}
+
+func (s *storage) PutFamily(family *models.Family) error {
+	if err := s.db.Omit("Users").Save(family).Error; err != nil {
+		return fmt.Errorf(StorageError, err)
+	}
+	return nil
+}
+
 func (s *storage) CreateFamily(name string) (*models.Family, error) {
 	family := models.Family{}
 	family.ID = uuid.New()
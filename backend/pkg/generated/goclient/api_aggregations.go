@@ -31,6 +31,7 @@ type ApiGetBalancesRequest struct {
 	to               *time.Time
 	outputCurrencyId *string
 	includeHidden    *bool
+	depth            *int32
 }
 
 // Uses transactions from this date
@@ -57,6 +58,12 @@ func (r ApiGetBalancesRequest) IncludeHidden(includeHidden bool) ApiGetBalancesR
 	return r
 }
 
+// Rolls accounts nested deeper than this level up into their parent on this level, 1 reports only top-level accounts. Zero reports every account separately
+func (r ApiGetBalancesRequest) Depth(depth int32) ApiGetBalancesRequest {
+	r.depth = &depth
+	return r
+}
+
 func (r ApiGetBalancesRequest) Execute() (*Aggregation, *http.Response, error) {
 	return r.ApiService.GetBalancesExecute(r)
 }
@@ -111,6 +118,166 @@ func (a *AggregationsAPIService) GetBalancesExecute(r ApiGetBalancesRequest) (*A
 		var defaultValue bool = false
 		r.includeHidden = &defaultValue
 	}
+	if r.depth != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "depth", r.depth, "")
+	} else {
+		var defaultValue int32 = 0
+		r.depth = &defaultValue
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetCashFlowRequest struct {
+	ctx              context.Context
+	ApiService       *AggregationsAPIService
+	from             *time.Time
+	to               *time.Time
+	outputCurrencyId *string
+	granularity      *string
+	includeHidden    *bool
+}
+
+// Uses transactions from this date
+func (r ApiGetCashFlowRequest) From(from time.Time) ApiGetCashFlowRequest {
+	r.from = &from
+	return r
+}
+
+// Uses transactions to this date
+func (r ApiGetCashFlowRequest) To(to time.Time) ApiGetCashFlowRequest {
+	r.to = &to
+	return r
+}
+
+// Converts all transactions to this currency, defaults to the user&#39;s favorite currency
+func (r ApiGetCashFlowRequest) OutputCurrencyId(outputCurrencyId string) ApiGetCashFlowRequest {
+	r.outputCurrencyId = &outputCurrencyId
+	return r
+}
+
+// Granularity of the report. Months start on the user&#39;s month start day, weeks are ISO weeks
+func (r ApiGetCashFlowRequest) Granularity(granularity string) ApiGetCashFlowRequest {
+	r.granularity = &granularity
+	return r
+}
+
+// If true, include hidden accounts
+func (r ApiGetCashFlowRequest) IncludeHidden(includeHidden bool) ApiGetCashFlowRequest {
+	r.includeHidden = &includeHidden
+	return r
+}
+
+func (r ApiGetCashFlowRequest) Execute() (*CashFlowReport, *http.Response, error) {
+	return r.ApiService.GetCashFlowExecute(r)
+}
+
+/*
+GetCashFlow get income statement / cash-flow report with comparison to previous periods
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiGetCashFlowRequest
+*/
+func (a *AggregationsAPIService) GetCashFlow(ctx context.Context) ApiGetCashFlowRequest {
+	return ApiGetCashFlowRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CashFlowReport
+func (a *AggregationsAPIService) GetCashFlowExecute(r ApiGetCashFlowRequest) (*CashFlowReport, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CashFlowReport
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AggregationsAPIService.GetCashFlow")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/cashflow"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.from != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "from", r.from, "")
+	}
+	if r.to != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "to", r.to, "")
+	}
+	if r.outputCurrencyId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "outputCurrencyId", r.outputCurrencyId, "")
+	}
+	if r.granularity != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "granularity", r.granularity, "")
+	} else {
+		var defaultValue string = "month"
+		r.granularity = &defaultValue
+	}
+	if r.includeHidden != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "includeHidden", r.includeHidden, "")
+	} else {
+		var defaultValue bool = false
+		r.includeHidden = &defaultValue
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -176,6 +343,7 @@ type ApiGetExpensesRequest struct {
 	groupBy          *string
 	tags             *[]string
 	accounts         *[]string
+	depth            *int32
 }
 
 // Uses transactions from this date
@@ -196,7 +364,7 @@ func (r ApiGetExpensesRequest) OutputCurrencyId(outputCurrencyId string) ApiGetE
 	return r
 }
 
-// Granularity of expenses (month or year)
+// Granularity of expenses. Months start on the user&#39;s month start day, weeks are ISO weeks
 func (r ApiGetExpensesRequest) Granularity(granularity string) ApiGetExpensesRequest {
 	r.granularity = &granularity
 	return r
@@ -226,6 +394,12 @@ func (r ApiGetExpensesRequest) Accounts(accounts []string) ApiGetExpensesRequest
 	return r
 }
 
+// Rolls accounts nested deeper than this level up into their parent on this level, 1 reports only top-level accounts. Zero reports every account separately
+func (r ApiGetExpensesRequest) Depth(depth int32) ApiGetExpensesRequest {
+	r.depth = &depth
+	return r
+}
+
 func (r ApiGetExpensesRequest) Execute() (*Aggregation, *http.Response, error) {
 	return r.ApiService.GetExpensesExecute(r)
 }
@@ -314,6 +488,12 @@ func (a *AggregationsAPIService) GetExpensesExecute(r ApiGetExpensesRequest) (*A
 			parameterAddToHeaderOrQuery(localVarQueryParams, "accounts", t, "multi")
 		}
 	}
+	if r.depth != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "depth", r.depth, "")
+	} else {
+		var defaultValue int32 = 0
+		r.depth = &defaultValue
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -368,7 +548,7 @@ func (a *AggregationsAPIService) GetExpensesExecute(r ApiGetExpensesRequest) (*A
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGetIncomesRequest struct {
+type ApiGetFlowsRequest struct {
 	ctx              context.Context
 	ApiService       *AggregationsAPIService
 	from             *time.Time
@@ -377,42 +557,44 @@ type ApiGetIncomesRequest struct {
 	includeHidden    *bool
 }
 
-// Uses transactions from this date
-func (r ApiGetIncomesRequest) From(from time.Time) ApiGetIncomesRequest {
+// Uses transactions from this date, defaults to the start of the current year
+func (r ApiGetFlowsRequest) From(from time.Time) ApiGetFlowsRequest {
 	r.from = &from
 	return r
 }
 
-// Uses transactions to this date
-func (r ApiGetIncomesRequest) To(to time.Time) ApiGetIncomesRequest {
+// Uses transactions to this date, defaults to the end of the current year
+func (r ApiGetFlowsRequest) To(to time.Time) ApiGetFlowsRequest {
 	r.to = &to
 	return r
 }
 
-// Converts all transactions to this currency
-func (r ApiGetIncomesRequest) OutputCurrencyId(outputCurrencyId string) ApiGetIncomesRequest {
+// Converts all movements to this currency, defaults to the user&#39;s favorite currency
+func (r ApiGetFlowsRequest) OutputCurrencyId(outputCurrencyId string) ApiGetFlowsRequest {
 	r.outputCurrencyId = &outputCurrencyId
 	return r
 }
 
 // If true, include hidden accounts
-func (r ApiGetIncomesRequest) IncludeHidden(includeHidden bool) ApiGetIncomesRequest {
+func (r ApiGetFlowsRequest) IncludeHidden(includeHidden bool) ApiGetFlowsRequest {
 	r.includeHidden = &includeHidden
 	return r
 }
 
-func (r ApiGetIncomesRequest) Execute() (*Aggregation, *http.Response, error) {
-	return r.ApiService.GetIncomesExecute(r)
+func (r ApiGetFlowsRequest) Execute() (*FlowReport, *http.Response, error) {
+	return r.ApiService.GetFlowsExecute(r)
 }
 
 /*
-GetIncomes get incomes for filtered transactions
+GetFlows get money flows between accounts for a Sankey diagram
+
+Nodes are accounts, links are the amounts which flowed from income accounts to asset and liability accounts, between them and from them to expense accounts.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiGetIncomesRequest
+	@return ApiGetFlowsRequest
 */
-func (a *AggregationsAPIService) GetIncomes(ctx context.Context) ApiGetIncomesRequest {
-	return ApiGetIncomesRequest{
+func (a *AggregationsAPIService) GetFlows(ctx context.Context) ApiGetFlowsRequest {
+	return ApiGetFlowsRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -420,21 +602,21 @@ func (a *AggregationsAPIService) GetIncomes(ctx context.Context) ApiGetIncomesRe
 
 // Execute executes the request
 //
-//	@return Aggregation
-func (a *AggregationsAPIService) GetIncomesExecute(r ApiGetIncomesRequest) (*Aggregation, *http.Response, error) {
+//	@return FlowReport
+func (a *AggregationsAPIService) GetFlowsExecute(r ApiGetFlowsRequest) (*FlowReport, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *Aggregation
+		localVarReturnValue *FlowReport
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AggregationsAPIService.GetIncomes")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AggregationsAPIService.GetFlows")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/v1/incomes"
+	localVarPath := localBasePath + "/v1/flows"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -508,3 +690,467 @@ func (a *AggregationsAPIService) GetIncomesExecute(r ApiGetIncomesRequest) (*Agg
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiGetIncomesRequest struct {
+	ctx              context.Context
+	ApiService       *AggregationsAPIService
+	from             *time.Time
+	to               *time.Time
+	outputCurrencyId *string
+	includeHidden    *bool
+	depth            *int32
+}
+
+// Uses transactions from this date
+func (r ApiGetIncomesRequest) From(from time.Time) ApiGetIncomesRequest {
+	r.from = &from
+	return r
+}
+
+// Uses transactions to this date
+func (r ApiGetIncomesRequest) To(to time.Time) ApiGetIncomesRequest {
+	r.to = &to
+	return r
+}
+
+// Converts all transactions to this currency
+func (r ApiGetIncomesRequest) OutputCurrencyId(outputCurrencyId string) ApiGetIncomesRequest {
+	r.outputCurrencyId = &outputCurrencyId
+	return r
+}
+
+// If true, include hidden accounts
+func (r ApiGetIncomesRequest) IncludeHidden(includeHidden bool) ApiGetIncomesRequest {
+	r.includeHidden = &includeHidden
+	return r
+}
+
+// Rolls accounts nested deeper than this level up into their parent on this level, 1 reports only top-level accounts. Zero reports every account separately
+func (r ApiGetIncomesRequest) Depth(depth int32) ApiGetIncomesRequest {
+	r.depth = &depth
+	return r
+}
+
+func (r ApiGetIncomesRequest) Execute() (*Aggregation, *http.Response, error) {
+	return r.ApiService.GetIncomesExecute(r)
+}
+
+/*
+GetIncomes get incomes for filtered transactions
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiGetIncomesRequest
+*/
+func (a *AggregationsAPIService) GetIncomes(ctx context.Context) ApiGetIncomesRequest {
+	return ApiGetIncomesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return Aggregation
+func (a *AggregationsAPIService) GetIncomesExecute(r ApiGetIncomesRequest) (*Aggregation, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *Aggregation
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AggregationsAPIService.GetIncomes")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/incomes"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.from != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "from", r.from, "")
+	}
+	if r.to != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "to", r.to, "")
+	}
+	if r.outputCurrencyId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "outputCurrencyId", r.outputCurrencyId, "")
+	}
+	if r.includeHidden != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "includeHidden", r.includeHidden, "")
+	} else {
+		var defaultValue bool = false
+		r.includeHidden = &defaultValue
+	}
+	if r.depth != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "depth", r.depth, "")
+	} else {
+		var defaultValue int32 = 0
+		r.depth = &defaultValue
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetNetWorthRequest struct {
+	ctx              context.Context
+	ApiService       *AggregationsAPIService
+	from             *time.Time
+	to               *time.Time
+	outputCurrencyId *string
+	granularity      *string
+	includeHidden    *bool
+}
+
+// Start of the first interval, defaults to a year before the end of the current month
+func (r ApiGetNetWorthRequest) From(from time.Time) ApiGetNetWorthRequest {
+	r.from = &from
+	return r
+}
+
+// End of the last interval, defaults to the end of the current month
+func (r ApiGetNetWorthRequest) To(to time.Time) ApiGetNetWorthRequest {
+	r.to = &to
+	return r
+}
+
+// Converts all balances to this currency, defaults to the user&#39;s favorite currency
+func (r ApiGetNetWorthRequest) OutputCurrencyId(outputCurrencyId string) ApiGetNetWorthRequest {
+	r.outputCurrencyId = &outputCurrencyId
+	return r
+}
+
+// Granularity of the report. Months start on the user&#39;s month start day, weeks are ISO weeks
+func (r ApiGetNetWorthRequest) Granularity(granularity string) ApiGetNetWorthRequest {
+	r.granularity = &granularity
+	return r
+}
+
+// If true, include hidden accounts
+func (r ApiGetNetWorthRequest) IncludeHidden(includeHidden bool) ApiGetNetWorthRequest {
+	r.includeHidden = &includeHidden
+	return r
+}
+
+func (r ApiGetNetWorthRequest) Execute() (*NetWorthReport, *http.Response, error) {
+	return r.ApiService.GetNetWorthExecute(r)
+}
+
+/*
+GetNetWorth get net worth over time
+
+Balances of asset and liability accounts at the end of each interval, converted to the output currency at the rate of that date.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiGetNetWorthRequest
+*/
+func (a *AggregationsAPIService) GetNetWorth(ctx context.Context) ApiGetNetWorthRequest {
+	return ApiGetNetWorthRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return NetWorthReport
+func (a *AggregationsAPIService) GetNetWorthExecute(r ApiGetNetWorthRequest) (*NetWorthReport, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *NetWorthReport
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AggregationsAPIService.GetNetWorth")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/networth"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.from != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "from", r.from, "")
+	}
+	if r.to != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "to", r.to, "")
+	}
+	if r.outputCurrencyId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "outputCurrencyId", r.outputCurrencyId, "")
+	}
+	if r.granularity != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "granularity", r.granularity, "")
+	} else {
+		var defaultValue string = "month"
+		r.granularity = &defaultValue
+	}
+	if r.includeHidden != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "includeHidden", r.includeHidden, "")
+	} else {
+		var defaultValue bool = false
+		r.includeHidden = &defaultValue
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetPartnersRequest struct {
+	ctx              context.Context
+	ApiService       *AggregationsAPIService
+	from             *time.Time
+	to               *time.Time
+	outputCurrencyId *string
+	sortBy           *string
+	top              *int32
+}
+
+// Uses transactions from this date, defaults to the start of the current month
+func (r ApiGetPartnersRequest) From(from time.Time) ApiGetPartnersRequest {
+	r.from = &from
+	return r
+}
+
+// Uses transactions to this date, defaults to the end of the current month
+func (r ApiGetPartnersRequest) To(to time.Time) ApiGetPartnersRequest {
+	r.to = &to
+	return r
+}
+
+// Converts all transactions to this currency, defaults to the user&#39;s favorite currency
+func (r ApiGetPartnersRequest) OutputCurrencyId(outputCurrencyId string) ApiGetPartnersRequest {
+	r.outputCurrencyId = &outputCurrencyId
+	return r
+}
+
+// Partners are sorted by this value in descending order
+func (r ApiGetPartnersRequest) SortBy(sortBy string) ApiGetPartnersRequest {
+	r.sortBy = &sortBy
+	return r
+}
+
+// Returns only this number of first partners, all partners if zero
+func (r ApiGetPartnersRequest) Top(top int32) ApiGetPartnersRequest {
+	r.top = &top
+	return r
+}
+
+func (r ApiGetPartnersRequest) Execute() (*PartnerReport, *http.Response, error) {
+	return r.ApiService.GetPartnersExecute(r)
+}
+
+/*
+GetPartners get spendings and receipts grouped by partner with comparison to the previous period
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiGetPartnersRequest
+*/
+func (a *AggregationsAPIService) GetPartners(ctx context.Context) ApiGetPartnersRequest {
+	return ApiGetPartnersRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return PartnerReport
+func (a *AggregationsAPIService) GetPartnersExecute(r ApiGetPartnersRequest) (*PartnerReport, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *PartnerReport
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AggregationsAPIService.GetPartners")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/partners"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.from != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "from", r.from, "")
+	}
+	if r.to != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "to", r.to, "")
+	}
+	if r.outputCurrencyId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "outputCurrencyId", r.outputCurrencyId, "")
+	}
+	if r.sortBy != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "sortBy", r.sortBy, "")
+	} else {
+		var defaultValue string = "spent"
+		r.sortBy = &defaultValue
+	}
+	if r.top != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "top", r.top, "")
+	} else {
+		var defaultValue int32 = 0
+		r.top = &defaultValue
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
@@ -24,6 +24,116 @@ import (
 // BudgetItemsAPIService BudgetItemsAPI service
 type BudgetItemsAPIService service
 
+type ApiCopyBudgetRequest struct {
+	ctx        context.Context
+	ApiService *BudgetItemsAPIService
+	month      *time.Time
+}
+
+// Any date of the month to copy the budget to, defaults to the current month
+func (r ApiCopyBudgetRequest) Month(month time.Time) ApiCopyBudgetRequest {
+	r.month = &month
+	return r
+}
+
+func (r ApiCopyBudgetRequest) Execute() ([]BudgetItem, *http.Response, error) {
+	return r.ApiService.CopyBudgetExecute(r)
+}
+
+/*
+CopyBudget copy the budget of the previous month
+
+Creates budget items in the month for every account whose budget of the previous month, from plans or budget items, differs from its budget in the month. Accounts which already have budget items in the month are left unchanged.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiCopyBudgetRequest
+*/
+func (a *BudgetItemsAPIService) CopyBudget(ctx context.Context) ApiCopyBudgetRequest {
+	return ApiCopyBudgetRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []BudgetItem
+func (a *BudgetItemsAPIService) CopyBudgetExecute(r ApiCopyBudgetRequest) ([]BudgetItem, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []BudgetItem
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.CopyBudget")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/budgets/copy"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.month != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "month", r.month, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCreateBudgetItemRequest struct {
 	ctx            context.Context
 	ApiService     *BudgetItemsAPIService
@@ -57,28 +167,1187 @@ func (a *BudgetItemsAPIService) CreateBudgetItem(ctx context.Context) ApiCreateB
 //	@return BudgetItem
 func (a *BudgetItemsAPIService) CreateBudgetItemExecute(r ApiCreateBudgetItemRequest) (*BudgetItem, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPost
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *BudgetItem
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.CreateBudgetItem")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/budgetItems"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.budgetItemNoID == nil {
+		return localVarReturnValue, nil, reportError("budgetItemNoID is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.budgetItemNoID
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCreateBudgetPlanRequest struct {
+	ctx            context.Context
+	ApiService     *BudgetItemsAPIService
+	budgetPlanNoID *BudgetPlanNoID
+}
+
+func (r ApiCreateBudgetPlanRequest) BudgetPlanNoID(budgetPlanNoID BudgetPlanNoID) ApiCreateBudgetPlanRequest {
+	r.budgetPlanNoID = &budgetPlanNoID
+	return r
+}
+
+func (r ApiCreateBudgetPlanRequest) Execute() (*BudgetPlan, *http.Response, error) {
+	return r.ApiService.CreateBudgetPlanExecute(r)
+}
+
+/*
+CreateBudgetPlan create new budget plan
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiCreateBudgetPlanRequest
+*/
+func (a *BudgetItemsAPIService) CreateBudgetPlan(ctx context.Context) ApiCreateBudgetPlanRequest {
+	return ApiCreateBudgetPlanRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return BudgetPlan
+func (a *BudgetItemsAPIService) CreateBudgetPlanExecute(r ApiCreateBudgetPlanRequest) (*BudgetPlan, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *BudgetPlan
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.CreateBudgetPlan")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/budgetPlans"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.budgetPlanNoID == nil {
+		return localVarReturnValue, nil, reportError("budgetPlanNoID is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.budgetPlanNoID
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCreateBudgetTransferRequest struct {
+	ctx                context.Context
+	ApiService         *BudgetItemsAPIService
+	budgetTransferNoID *BudgetTransferNoID
+}
+
+func (r ApiCreateBudgetTransferRequest) BudgetTransferNoID(budgetTransferNoID BudgetTransferNoID) ApiCreateBudgetTransferRequest {
+	r.budgetTransferNoID = &budgetTransferNoID
+	return r
+}
+
+func (r ApiCreateBudgetTransferRequest) Execute() (*BudgetTransfer, *http.Response, error) {
+	return r.ApiService.CreateBudgetTransferExecute(r)
+}
+
+/*
+CreateBudgetTransfer move budget between envelopes
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiCreateBudgetTransferRequest
+*/
+func (a *BudgetItemsAPIService) CreateBudgetTransfer(ctx context.Context) ApiCreateBudgetTransferRequest {
+	return ApiCreateBudgetTransferRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return BudgetTransfer
+func (a *BudgetItemsAPIService) CreateBudgetTransferExecute(r ApiCreateBudgetTransferRequest) (*BudgetTransfer, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *BudgetTransfer
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.CreateBudgetTransfer")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/budgetTransfers"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.budgetTransferNoID == nil {
+		return localVarReturnValue, nil, reportError("budgetTransferNoID is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.budgetTransferNoID
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiDeleteBudgetItemRequest struct {
+	ctx        context.Context
+	ApiService *BudgetItemsAPIService
+	id         string
+}
+
+func (r ApiDeleteBudgetItemRequest) Execute() (*http.Response, error) {
+	return r.ApiService.DeleteBudgetItemExecute(r)
+}
+
+/*
+DeleteBudgetItem delete budgetItem
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id ID of the budgetItem
+	@return ApiDeleteBudgetItemRequest
+*/
+func (a *BudgetItemsAPIService) DeleteBudgetItem(ctx context.Context, id string) ApiDeleteBudgetItemRequest {
+	return ApiDeleteBudgetItemRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+func (a *BudgetItemsAPIService) DeleteBudgetItemExecute(r ApiDeleteBudgetItemRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodDelete
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.DeleteBudgetItem")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/budgetItems/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiDeleteBudgetPlanRequest struct {
+	ctx        context.Context
+	ApiService *BudgetItemsAPIService
+	id         string
+}
+
+func (r ApiDeleteBudgetPlanRequest) Execute() (*http.Response, error) {
+	return r.ApiService.DeleteBudgetPlanExecute(r)
+}
+
+/*
+DeleteBudgetPlan delete budget plan
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id ID of the budget plan
+	@return ApiDeleteBudgetPlanRequest
+*/
+func (a *BudgetItemsAPIService) DeleteBudgetPlan(ctx context.Context, id string) ApiDeleteBudgetPlanRequest {
+	return ApiDeleteBudgetPlanRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+func (a *BudgetItemsAPIService) DeleteBudgetPlanExecute(r ApiDeleteBudgetPlanRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodDelete
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.DeleteBudgetPlan")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/budgetPlans/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiDeleteBudgetTransferRequest struct {
+	ctx        context.Context
+	ApiService *BudgetItemsAPIService
+	id         string
+}
+
+func (r ApiDeleteBudgetTransferRequest) Execute() (*http.Response, error) {
+	return r.ApiService.DeleteBudgetTransferExecute(r)
+}
+
+/*
+DeleteBudgetTransfer delete budget transfer
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id ID of the budget transfer
+	@return ApiDeleteBudgetTransferRequest
+*/
+func (a *BudgetItemsAPIService) DeleteBudgetTransfer(ctx context.Context, id string) ApiDeleteBudgetTransferRequest {
+	return ApiDeleteBudgetTransferRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+func (a *BudgetItemsAPIService) DeleteBudgetTransferExecute(r ApiDeleteBudgetTransferRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodDelete
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.DeleteBudgetTransfer")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/budgetTransfers/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiGetBudgetItemRequest struct {
+	ctx        context.Context
+	ApiService *BudgetItemsAPIService
+	id         string
+}
+
+func (r ApiGetBudgetItemRequest) Execute() (*BudgetItem, *http.Response, error) {
+	return r.ApiService.GetBudgetItemExecute(r)
+}
+
+/*
+GetBudgetItem get budgetItem
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id ID of the budgetItem
+	@return ApiGetBudgetItemRequest
+*/
+func (a *BudgetItemsAPIService) GetBudgetItem(ctx context.Context, id string) ApiGetBudgetItemRequest {
+	return ApiGetBudgetItemRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return BudgetItem
+func (a *BudgetItemsAPIService) GetBudgetItemExecute(r ApiGetBudgetItemRequest) (*BudgetItem, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *BudgetItem
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.GetBudgetItem")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/budgetItems/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetBudgetItemsRequest struct {
+	ctx        context.Context
+	ApiService *BudgetItemsAPIService
+}
+
+func (r ApiGetBudgetItemsRequest) Execute() ([]BudgetItem, *http.Response, error) {
+	return r.ApiService.GetBudgetItemsExecute(r)
+}
+
+/*
+GetBudgetItems get all budgetItems
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiGetBudgetItemsRequest
+*/
+func (a *BudgetItemsAPIService) GetBudgetItems(ctx context.Context) ApiGetBudgetItemsRequest {
+	return ApiGetBudgetItemsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []BudgetItem
+func (a *BudgetItemsAPIService) GetBudgetItemsExecute(r ApiGetBudgetItemsRequest) ([]BudgetItem, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []BudgetItem
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.GetBudgetItems")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/budgetItems"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetBudgetPlanRequest struct {
+	ctx        context.Context
+	ApiService *BudgetItemsAPIService
+	id         string
+}
+
+func (r ApiGetBudgetPlanRequest) Execute() (*BudgetPlan, *http.Response, error) {
+	return r.ApiService.GetBudgetPlanExecute(r)
+}
+
+/*
+GetBudgetPlan get budget plan
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id ID of the budget plan
+	@return ApiGetBudgetPlanRequest
+*/
+func (a *BudgetItemsAPIService) GetBudgetPlan(ctx context.Context, id string) ApiGetBudgetPlanRequest {
+	return ApiGetBudgetPlanRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return BudgetPlan
+func (a *BudgetItemsAPIService) GetBudgetPlanExecute(r ApiGetBudgetPlanRequest) (*BudgetPlan, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *BudgetPlan
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.GetBudgetPlan")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/budgetPlans/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetBudgetPlansRequest struct {
+	ctx        context.Context
+	ApiService *BudgetItemsAPIService
+}
+
+func (r ApiGetBudgetPlansRequest) Execute() ([]BudgetPlan, *http.Response, error) {
+	return r.ApiService.GetBudgetPlansExecute(r)
+}
+
+/*
+GetBudgetPlans get all budget plans
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiGetBudgetPlansRequest
+*/
+func (a *BudgetItemsAPIService) GetBudgetPlans(ctx context.Context) ApiGetBudgetPlansRequest {
+	return ApiGetBudgetPlansRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []BudgetPlan
+func (a *BudgetItemsAPIService) GetBudgetPlansExecute(r ApiGetBudgetPlansRequest) ([]BudgetPlan, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []BudgetPlan
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.GetBudgetPlans")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/budgetPlans"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetBudgetStatusRequest struct {
+	ctx              context.Context
+	ApiService       *BudgetItemsAPIService
+	from             *time.Time
+	to               *time.Time
+	outputCurrencyId *string
+	granularity      *string
+	includeHidden    *bool
+	depth            *int32
+}
+
+// Start date (inclusive)
+func (r ApiGetBudgetStatusRequest) From(from time.Time) ApiGetBudgetStatusRequest {
+	r.from = &from
+	return r
+}
+
+// End date (exclusive)
+func (r ApiGetBudgetStatusRequest) To(to time.Time) ApiGetBudgetStatusRequest {
+	r.to = &to
+	return r
+}
+
+// Converts all amounts to this currency
+func (r ApiGetBudgetStatusRequest) OutputCurrencyId(outputCurrencyId string) ApiGetBudgetStatusRequest {
+	r.outputCurrencyId = &outputCurrencyId
+	return r
+}
+
+// Period of budget status. Months start on the user&#39;s month start day, weeks are ISO weeks
+func (r ApiGetBudgetStatusRequest) Granularity(granularity string) ApiGetBudgetStatusRequest {
+	r.granularity = &granularity
+	return r
+}
+
+// If true, include hidden accounts
+func (r ApiGetBudgetStatusRequest) IncludeHidden(includeHidden bool) ApiGetBudgetStatusRequest {
+	r.includeHidden = &includeHidden
+	return r
+}
+
+// Rolls accounts nested deeper than this level up into their parent on this level, 1 reports only top-level accounts. Zero reports every account separately
+func (r ApiGetBudgetStatusRequest) Depth(depth int32) ApiGetBudgetStatusRequest {
+	r.depth = &depth
+	return r
+}
+
+func (r ApiGetBudgetStatusRequest) Execute() ([]BudgetStatus, *http.Response, error) {
+	return r.ApiService.GetBudgetStatusExecute(r)
+}
+
+/*
+GetBudgetStatus get budget status with rollover
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiGetBudgetStatusRequest
+*/
+func (a *BudgetItemsAPIService) GetBudgetStatus(ctx context.Context) ApiGetBudgetStatusRequest {
+	return ApiGetBudgetStatusRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []BudgetStatus
+func (a *BudgetItemsAPIService) GetBudgetStatusExecute(r ApiGetBudgetStatusRequest) ([]BudgetStatus, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []BudgetStatus
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.GetBudgetStatus")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/budgets/status"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.from != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "from", r.from, "")
+	}
+	if r.to != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "to", r.to, "")
+	}
+	if r.outputCurrencyId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "outputCurrencyId", r.outputCurrencyId, "")
+	}
+	if r.granularity != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "granularity", r.granularity, "")
+	} else {
+		var defaultValue string = "month"
+		r.granularity = &defaultValue
+	}
+	if r.includeHidden != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "includeHidden", r.includeHidden, "")
+	} else {
+		var defaultValue bool = false
+		r.includeHidden = &defaultValue
+	}
+	if r.depth != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "depth", r.depth, "")
+	} else {
+		var defaultValue int32 = 0
+		r.depth = &defaultValue
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetBudgetTransferRequest struct {
+	ctx        context.Context
+	ApiService *BudgetItemsAPIService
+	id         string
+}
+
+func (r ApiGetBudgetTransferRequest) Execute() (*BudgetTransfer, *http.Response, error) {
+	return r.ApiService.GetBudgetTransferExecute(r)
+}
+
+/*
+GetBudgetTransfer get budget transfer
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id ID of the budget transfer
+	@return ApiGetBudgetTransferRequest
+*/
+func (a *BudgetItemsAPIService) GetBudgetTransfer(ctx context.Context, id string) ApiGetBudgetTransferRequest {
+	return ApiGetBudgetTransferRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return BudgetTransfer
+func (a *BudgetItemsAPIService) GetBudgetTransferExecute(r ApiGetBudgetTransferRequest) (*BudgetTransfer, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *BudgetItem
+		localVarReturnValue *BudgetTransfer
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.CreateBudgetItem")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.GetBudgetTransfer")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/v1/budgetItems"
+	localVarPath := localBasePath + "/v1/budgetTransfers/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.budgetItemNoID == nil {
-		return localVarReturnValue, nil, reportError("budgetItemNoID is required and must be specified")
-	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{"application/json"}
+	localVarHTTPContentTypes := []string{}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -94,8 +1363,6 @@ func (a *BudgetItemsAPIService) CreateBudgetItemExecute(r ApiCreateBudgetItemReq
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	// body params
-	localVarPostBody = r.budgetItemNoID
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -133,46 +1400,45 @@ func (a *BudgetItemsAPIService) CreateBudgetItemExecute(r ApiCreateBudgetItemReq
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiDeleteBudgetItemRequest struct {
+type ApiGetBudgetTransfersRequest struct {
 	ctx        context.Context
 	ApiService *BudgetItemsAPIService
-	id         string
 }
 
-func (r ApiDeleteBudgetItemRequest) Execute() (*http.Response, error) {
-	return r.ApiService.DeleteBudgetItemExecute(r)
+func (r ApiGetBudgetTransfersRequest) Execute() ([]BudgetTransfer, *http.Response, error) {
+	return r.ApiService.GetBudgetTransfersExecute(r)
 }
 
 /*
-DeleteBudgetItem delete budgetItem
+GetBudgetTransfers get all budget transfers
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param id ID of the budgetItem
-	@return ApiDeleteBudgetItemRequest
+	@return ApiGetBudgetTransfersRequest
 */
-func (a *BudgetItemsAPIService) DeleteBudgetItem(ctx context.Context, id string) ApiDeleteBudgetItemRequest {
-	return ApiDeleteBudgetItemRequest{
+func (a *BudgetItemsAPIService) GetBudgetTransfers(ctx context.Context) ApiGetBudgetTransfersRequest {
+	return ApiGetBudgetTransfersRequest{
 		ApiService: a,
 		ctx:        ctx,
-		id:         id,
 	}
 }
 
 // Execute executes the request
-func (a *BudgetItemsAPIService) DeleteBudgetItemExecute(r ApiDeleteBudgetItemRequest) (*http.Response, error) {
+//
+//	@return []BudgetTransfer
+func (a *BudgetItemsAPIService) GetBudgetTransfersExecute(r ApiGetBudgetTransfersRequest) ([]BudgetTransfer, *http.Response, error) {
 	var (
-		localVarHTTPMethod = http.MethodDelete
-		localVarPostBody   interface{}
-		formFiles          []formFile
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []BudgetTransfer
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.DeleteBudgetItem")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.GetBudgetTransfers")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/v1/budgetItems/{id}"
-	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath := localBasePath + "/v1/budgetTransfers"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -188,7 +1454,7 @@ func (a *BudgetItemsAPIService) DeleteBudgetItemExecute(r ApiDeleteBudgetItemReq
 	}
 
 	// to determine the Accept header
-	localVarHTTPHeaderAccepts := []string{}
+	localVarHTTPHeaderAccepts := []string{"application/json"}
 
 	// set Accept header
 	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
@@ -197,19 +1463,19 @@ func (a *BudgetItemsAPIService) DeleteBudgetItemExecute(r ApiDeleteBudgetItemReq
 	}
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
-		return nil, err
+		return localVarReturnValue, nil, err
 	}
 
 	localVarHTTPResponse, err := a.client.callAPI(req)
 	if err != nil || localVarHTTPResponse == nil {
-		return localVarHTTPResponse, err
+		return localVarReturnValue, localVarHTTPResponse, err
 	}
 
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
 	if err != nil {
-		return localVarHTTPResponse, err
+		return localVarReturnValue, localVarHTTPResponse, err
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
@@ -217,60 +1483,121 @@ func (a *BudgetItemsAPIService) DeleteBudgetItemExecute(r ApiDeleteBudgetItemReq
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		return localVarHTTPResponse, newErr
+		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
 
-	return localVarHTTPResponse, nil
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGetBudgetItemRequest struct {
-	ctx        context.Context
-	ApiService *BudgetItemsAPIService
-	id         string
+type ApiGetReadyToAssignRequest struct {
+	ctx              context.Context
+	ApiService       *BudgetItemsAPIService
+	from             *time.Time
+	to               *time.Time
+	outputCurrencyId *string
+	granularity      *string
+	includeHidden    *bool
 }
 
-func (r ApiGetBudgetItemRequest) Execute() (*BudgetItem, *http.Response, error) {
-	return r.ApiService.GetBudgetItemExecute(r)
+// Start date (inclusive)
+func (r ApiGetReadyToAssignRequest) From(from time.Time) ApiGetReadyToAssignRequest {
+	r.from = &from
+	return r
+}
+
+// End date (exclusive)
+func (r ApiGetReadyToAssignRequest) To(to time.Time) ApiGetReadyToAssignRequest {
+	r.to = &to
+	return r
+}
+
+// Converts all amounts to this currency
+func (r ApiGetReadyToAssignRequest) OutputCurrencyId(outputCurrencyId string) ApiGetReadyToAssignRequest {
+	r.outputCurrencyId = &outputCurrencyId
+	return r
+}
+
+// Period of budget status. Months start on the user&#39;s month start day, weeks are ISO weeks
+func (r ApiGetReadyToAssignRequest) Granularity(granularity string) ApiGetReadyToAssignRequest {
+	r.granularity = &granularity
+	return r
+}
+
+// If true, include hidden accounts
+func (r ApiGetReadyToAssignRequest) IncludeHidden(includeHidden bool) ApiGetReadyToAssignRequest {
+	r.includeHidden = &includeHidden
+	return r
+}
+
+func (r ApiGetReadyToAssignRequest) Execute() ([]ReadyToAssign, *http.Response, error) {
+	return r.ApiService.GetReadyToAssignExecute(r)
 }
 
 /*
-GetBudgetItem get budgetItem
+GetReadyToAssign get income which is not assigned to envelopes yet
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param id ID of the budgetItem
-	@return ApiGetBudgetItemRequest
+	@return ApiGetReadyToAssignRequest
 */
-func (a *BudgetItemsAPIService) GetBudgetItem(ctx context.Context, id string) ApiGetBudgetItemRequest {
-	return ApiGetBudgetItemRequest{
+func (a *BudgetItemsAPIService) GetReadyToAssign(ctx context.Context) ApiGetReadyToAssignRequest {
+	return ApiGetReadyToAssignRequest{
 		ApiService: a,
 		ctx:        ctx,
-		id:         id,
 	}
 }
 
 // Execute executes the request
 //
-//	@return BudgetItem
-func (a *BudgetItemsAPIService) GetBudgetItemExecute(r ApiGetBudgetItemRequest) (*BudgetItem, *http.Response, error) {
+//	@return []ReadyToAssign
+func (a *BudgetItemsAPIService) GetReadyToAssignExecute(r ApiGetReadyToAssignRequest) ([]ReadyToAssign, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *BudgetItem
+		localVarReturnValue []ReadyToAssign
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.GetBudgetItem")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.GetReadyToAssign")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/v1/budgetItems/{id}"
-	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath := localBasePath + "/v1/budgets/readyToAssign"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.from != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "from", r.from, "")
+	}
+	if r.to != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "to", r.to, "")
+	}
+	if r.outputCurrencyId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "outputCurrencyId", r.outputCurrencyId, "")
+	}
+	if r.granularity != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "granularity", r.granularity, "")
+	} else {
+		var defaultValue string = "month"
+		r.granularity = &defaultValue
+	}
+	if r.includeHidden != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "includeHidden", r.includeHidden, "")
+	} else {
+		var defaultValue bool = false
+		r.includeHidden = &defaultValue
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -325,52 +1652,65 @@ func (a *BudgetItemsAPIService) GetBudgetItemExecute(r ApiGetBudgetItemRequest)
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGetBudgetItemsRequest struct {
-	ctx        context.Context
-	ApiService *BudgetItemsAPIService
+type ApiUpdateBudgetItemRequest struct {
+	ctx            context.Context
+	ApiService     *BudgetItemsAPIService
+	id             string
+	budgetItemNoID *BudgetItemNoID
 }
 
-func (r ApiGetBudgetItemsRequest) Execute() ([]BudgetItem, *http.Response, error) {
-	return r.ApiService.GetBudgetItemsExecute(r)
+func (r ApiUpdateBudgetItemRequest) BudgetItemNoID(budgetItemNoID BudgetItemNoID) ApiUpdateBudgetItemRequest {
+	r.budgetItemNoID = &budgetItemNoID
+	return r
+}
+
+func (r ApiUpdateBudgetItemRequest) Execute() (*BudgetItem, *http.Response, error) {
+	return r.ApiService.UpdateBudgetItemExecute(r)
 }
 
 /*
-GetBudgetItems get all budgetItems
+UpdateBudgetItem update budgetItem
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiGetBudgetItemsRequest
+	@param id ID of the budgetItem
+	@return ApiUpdateBudgetItemRequest
 */
-func (a *BudgetItemsAPIService) GetBudgetItems(ctx context.Context) ApiGetBudgetItemsRequest {
-	return ApiGetBudgetItemsRequest{
+func (a *BudgetItemsAPIService) UpdateBudgetItem(ctx context.Context, id string) ApiUpdateBudgetItemRequest {
+	return ApiUpdateBudgetItemRequest{
 		ApiService: a,
 		ctx:        ctx,
+		id:         id,
 	}
 }
 
 // Execute executes the request
 //
-//	@return []BudgetItem
-func (a *BudgetItemsAPIService) GetBudgetItemsExecute(r ApiGetBudgetItemsRequest) ([]BudgetItem, *http.Response, error) {
+//	@return BudgetItem
+func (a *BudgetItemsAPIService) UpdateBudgetItemExecute(r ApiUpdateBudgetItemRequest) (*BudgetItem, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []BudgetItem
+		localVarReturnValue *BudgetItem
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.GetBudgetItems")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.UpdateBudgetItem")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/v1/budgetItems"
+	localVarPath := localBasePath + "/v1/budgetItems/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.budgetItemNoID == nil {
+		return localVarReturnValue, nil, reportError("budgetItemNoID is required and must be specified")
+	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -386,6 +1726,8 @@ func (a *BudgetItemsAPIService) GetBudgetItemsExecute(r ApiGetBudgetItemsRequest
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.budgetItemNoID
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -423,95 +1765,65 @@ func (a *BudgetItemsAPIService) GetBudgetItemsExecute(r ApiGetBudgetItemsRequest
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGetBudgetStatusRequest struct {
-	ctx              context.Context
-	ApiService       *BudgetItemsAPIService
-	from             *time.Time
-	to               *time.Time
-	outputCurrencyId *string
-	includeHidden    *bool
-}
-
-// Start date (inclusive)
-func (r ApiGetBudgetStatusRequest) From(from time.Time) ApiGetBudgetStatusRequest {
-	r.from = &from
-	return r
-}
-
-// End date (exclusive)
-func (r ApiGetBudgetStatusRequest) To(to time.Time) ApiGetBudgetStatusRequest {
-	r.to = &to
-	return r
-}
-
-// Converts all amounts to this currency
-func (r ApiGetBudgetStatusRequest) OutputCurrencyId(outputCurrencyId string) ApiGetBudgetStatusRequest {
-	r.outputCurrencyId = &outputCurrencyId
-	return r
+type ApiUpdateBudgetPlanRequest struct {
+	ctx            context.Context
+	ApiService     *BudgetItemsAPIService
+	id             string
+	budgetPlanNoID *BudgetPlanNoID
 }
 
-// If true, include hidden accounts
-func (r ApiGetBudgetStatusRequest) IncludeHidden(includeHidden bool) ApiGetBudgetStatusRequest {
-	r.includeHidden = &includeHidden
+func (r ApiUpdateBudgetPlanRequest) BudgetPlanNoID(budgetPlanNoID BudgetPlanNoID) ApiUpdateBudgetPlanRequest {
+	r.budgetPlanNoID = &budgetPlanNoID
 	return r
 }
 
-func (r ApiGetBudgetStatusRequest) Execute() ([]BudgetStatus, *http.Response, error) {
-	return r.ApiService.GetBudgetStatusExecute(r)
+func (r ApiUpdateBudgetPlanRequest) Execute() (*BudgetPlan, *http.Response, error) {
+	return r.ApiService.UpdateBudgetPlanExecute(r)
 }
 
 /*
-GetBudgetStatus get budget status with rollover
+UpdateBudgetPlan update budget plan
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiGetBudgetStatusRequest
+	@param id ID of the budget plan
+	@return ApiUpdateBudgetPlanRequest
 */
-func (a *BudgetItemsAPIService) GetBudgetStatus(ctx context.Context) ApiGetBudgetStatusRequest {
-	return ApiGetBudgetStatusRequest{
+func (a *BudgetItemsAPIService) UpdateBudgetPlan(ctx context.Context, id string) ApiUpdateBudgetPlanRequest {
+	return ApiUpdateBudgetPlanRequest{
 		ApiService: a,
 		ctx:        ctx,
+		id:         id,
 	}
 }
 
 // Execute executes the request
 //
-//	@return []BudgetStatus
-func (a *BudgetItemsAPIService) GetBudgetStatusExecute(r ApiGetBudgetStatusRequest) ([]BudgetStatus, *http.Response, error) {
+//	@return BudgetPlan
+func (a *BudgetItemsAPIService) UpdateBudgetPlanExecute(r ApiUpdateBudgetPlanRequest) (*BudgetPlan, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []BudgetStatus
+		localVarReturnValue *BudgetPlan
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.GetBudgetStatus")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.UpdateBudgetPlan")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/v1/budgets/status"
+	localVarPath := localBasePath + "/v1/budgetPlans/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-
-	if r.from != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "from", r.from, "")
-	}
-	if r.to != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "to", r.to, "")
-	}
-	if r.outputCurrencyId != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "outputCurrencyId", r.outputCurrencyId, "")
-	}
-	if r.includeHidden != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "includeHidden", r.includeHidden, "")
-	} else {
-		var defaultValue bool = false
-		r.includeHidden = &defaultValue
+	if r.budgetPlanNoID == nil {
+		return localVarReturnValue, nil, reportError("budgetPlanNoID is required and must be specified")
 	}
+
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -527,6 +1839,8 @@ func (a *BudgetItemsAPIService) GetBudgetStatusExecute(r ApiGetBudgetStatusReque
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.budgetPlanNoID
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -564,31 +1878,31 @@ func (a *BudgetItemsAPIService) GetBudgetStatusExecute(r ApiGetBudgetStatusReque
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiUpdateBudgetItemRequest struct {
-	ctx            context.Context
-	ApiService     *BudgetItemsAPIService
-	id             string
-	budgetItemNoID *BudgetItemNoID
+type ApiUpdateBudgetTransferRequest struct {
+	ctx                context.Context
+	ApiService         *BudgetItemsAPIService
+	id                 string
+	budgetTransferNoID *BudgetTransferNoID
 }
 
-func (r ApiUpdateBudgetItemRequest) BudgetItemNoID(budgetItemNoID BudgetItemNoID) ApiUpdateBudgetItemRequest {
-	r.budgetItemNoID = &budgetItemNoID
+func (r ApiUpdateBudgetTransferRequest) BudgetTransferNoID(budgetTransferNoID BudgetTransferNoID) ApiUpdateBudgetTransferRequest {
+	r.budgetTransferNoID = &budgetTransferNoID
 	return r
 }
 
-func (r ApiUpdateBudgetItemRequest) Execute() (*BudgetItem, *http.Response, error) {
-	return r.ApiService.UpdateBudgetItemExecute(r)
+func (r ApiUpdateBudgetTransferRequest) Execute() (*BudgetTransfer, *http.Response, error) {
+	return r.ApiService.UpdateBudgetTransferExecute(r)
 }
 
 /*
-UpdateBudgetItem update budgetItem
+UpdateBudgetTransfer update budget transfer
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param id ID of the budgetItem
-	@return ApiUpdateBudgetItemRequest
+	@param id ID of the budget transfer
+	@return ApiUpdateBudgetTransferRequest
 */
-func (a *BudgetItemsAPIService) UpdateBudgetItem(ctx context.Context, id string) ApiUpdateBudgetItemRequest {
-	return ApiUpdateBudgetItemRequest{
+func (a *BudgetItemsAPIService) UpdateBudgetTransfer(ctx context.Context, id string) ApiUpdateBudgetTransferRequest {
+	return ApiUpdateBudgetTransferRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -597,28 +1911,28 @@ func (a *BudgetItemsAPIService) UpdateBudgetItem(ctx context.Context, id string)
 
 // Execute executes the request
 //
-//	@return BudgetItem
-func (a *BudgetItemsAPIService) UpdateBudgetItemExecute(r ApiUpdateBudgetItemRequest) (*BudgetItem, *http.Response, error) {
+//	@return BudgetTransfer
+func (a *BudgetItemsAPIService) UpdateBudgetTransferExecute(r ApiUpdateBudgetTransferRequest) (*BudgetTransfer, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *BudgetItem
+		localVarReturnValue *BudgetTransfer
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.UpdateBudgetItem")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BudgetItemsAPIService.UpdateBudgetTransfer")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/v1/budgetItems/{id}"
+	localVarPath := localBasePath + "/v1/budgetTransfers/{id}"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.budgetItemNoID == nil {
-		return localVarReturnValue, nil, reportError("budgetItemNoID is required and must be specified")
+	if r.budgetTransferNoID == nil {
+		return localVarReturnValue, nil, reportError("budgetTransferNoID is required and must be specified")
 	}
 
 	// to determine the Content-Type header
@@ -639,7 +1953,7 @@ func (a *BudgetItemsAPIService) UpdateBudgetItemExecute(r ApiUpdateBudgetItemReq
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
 	// body params
-	localVarPostBody = r.budgetItemNoID
+	localVarPostBody = r.budgetTransferNoID
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
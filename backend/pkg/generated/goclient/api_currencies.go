@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // CurrenciesAPIService CurrenciesAPI service
@@ -132,6 +133,115 @@ func (a *CurrenciesAPIService) CreateCurrencyExecute(r ApiCreateCurrencyRequest)
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCreateExchangeRateRequest struct {
+	ctx              context.Context
+	ApiService       *CurrenciesAPIService
+	exchangeRateNoID *ExchangeRateNoID
+}
+
+func (r ApiCreateExchangeRateRequest) ExchangeRateNoID(exchangeRateNoID ExchangeRateNoID) ApiCreateExchangeRateRequest {
+	r.exchangeRateNoID = &exchangeRateNoID
+	return r
+}
+
+func (r ApiCreateExchangeRateRequest) Execute() (*ExchangeRate, *http.Response, error) {
+	return r.ApiService.CreateExchangeRateExecute(r)
+}
+
+/*
+CreateExchangeRate create new manual exchange rate
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiCreateExchangeRateRequest
+*/
+func (a *CurrenciesAPIService) CreateExchangeRate(ctx context.Context) ApiCreateExchangeRateRequest {
+	return ApiCreateExchangeRateRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return ExchangeRate
+func (a *CurrenciesAPIService) CreateExchangeRateExecute(r ApiCreateExchangeRateRequest) (*ExchangeRate, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *ExchangeRate
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CurrenciesAPIService.CreateExchangeRate")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/exchangeRates"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.exchangeRateNoID == nil {
+		return localVarReturnValue, nil, reportError("exchangeRateNoID is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.exchangeRateNoID
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiDeleteCurrencyRequest struct {
 	ctx                   context.Context
 	ApiService            *CurrenciesAPIService
@@ -232,6 +342,96 @@ func (a *CurrenciesAPIService) DeleteCurrencyExecute(r ApiDeleteCurrencyRequest)
 	return localVarHTTPResponse, nil
 }
 
+type ApiDeleteExchangeRateRequest struct {
+	ctx        context.Context
+	ApiService *CurrenciesAPIService
+	id         string
+}
+
+func (r ApiDeleteExchangeRateRequest) Execute() (*http.Response, error) {
+	return r.ApiService.DeleteExchangeRateExecute(r)
+}
+
+/*
+DeleteExchangeRate delete manual exchange rate
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id ID of the exchange rate
+	@return ApiDeleteExchangeRateRequest
+*/
+func (a *CurrenciesAPIService) DeleteExchangeRate(ctx context.Context, id string) ApiDeleteExchangeRateRequest {
+	return ApiDeleteExchangeRateRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+func (a *CurrenciesAPIService) DeleteExchangeRateExecute(r ApiDeleteExchangeRateRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodDelete
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CurrenciesAPIService.DeleteExchangeRate")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/exchangeRates/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
 type ApiGetCurrenciesRequest struct {
 	ctx        context.Context
 	ApiService *CurrenciesAPIService
@@ -330,31 +530,25 @@ func (a *CurrenciesAPIService) GetCurrenciesExecute(r ApiGetCurrenciesRequest) (
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiUpdateCurrencyRequest struct {
-	ctx          context.Context
-	ApiService   *CurrenciesAPIService
-	id           string
-	currencyNoID *CurrencyNoID
-}
-
-func (r ApiUpdateCurrencyRequest) CurrencyNoID(currencyNoID CurrencyNoID) ApiUpdateCurrencyRequest {
-	r.currencyNoID = &currencyNoID
-	return r
+type ApiGetExchangeRateRequest struct {
+	ctx        context.Context
+	ApiService *CurrenciesAPIService
+	id         string
 }
 
-func (r ApiUpdateCurrencyRequest) Execute() (*Currency, *http.Response, error) {
-	return r.ApiService.UpdateCurrencyExecute(r)
+func (r ApiGetExchangeRateRequest) Execute() (*ExchangeRate, *http.Response, error) {
+	return r.ApiService.GetExchangeRateExecute(r)
 }
 
 /*
-UpdateCurrency update currency
+GetExchangeRate get manual exchange rate
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param id ID of the currency
-	@return ApiUpdateCurrencyRequest
+	@param id ID of the exchange rate
+	@return ApiGetExchangeRateRequest
 */
-func (a *CurrenciesAPIService) UpdateCurrency(ctx context.Context, id string) ApiUpdateCurrencyRequest {
-	return ApiUpdateCurrencyRequest{
+func (a *CurrenciesAPIService) GetExchangeRate(ctx context.Context, id string) ApiGetExchangeRateRequest {
+	return ApiGetExchangeRateRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -363,32 +557,29 @@ func (a *CurrenciesAPIService) UpdateCurrency(ctx context.Context, id string) Ap
 
 // Execute executes the request
 //
-//	@return Currency
-func (a *CurrenciesAPIService) UpdateCurrencyExecute(r ApiUpdateCurrencyRequest) (*Currency, *http.Response, error) {
+//	@return ExchangeRate
+func (a *CurrenciesAPIService) GetExchangeRateExecute(r ApiGetExchangeRateRequest) (*ExchangeRate, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPut
+		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *Currency
+		localVarReturnValue *ExchangeRate
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CurrenciesAPIService.UpdateCurrency")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CurrenciesAPIService.GetExchangeRate")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/v1/currencies/{id}"
+	localVarPath := localBasePath + "/v1/exchangeRates/{id}"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.currencyNoID == nil {
-		return localVarReturnValue, nil, reportError("currencyNoID is required and must be specified")
-	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{"application/json"}
+	localVarHTTPContentTypes := []string{}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -404,8 +595,470 @@ func (a *CurrenciesAPIService) UpdateCurrencyExecute(r ApiUpdateCurrencyRequest)
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	// body params
-	localVarPostBody = r.currencyNoID
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetExchangeRateHistoryRequest struct {
+	ctx            context.Context
+	ApiService     *CurrenciesAPIService
+	fromCurrencyId *string
+	toCurrencyId   *string
+	dateFrom       *time.Time
+	dateTo         *time.Time
+}
+
+func (r ApiGetExchangeRateHistoryRequest) FromCurrencyId(fromCurrencyId string) ApiGetExchangeRateHistoryRequest {
+	r.fromCurrencyId = &fromCurrencyId
+	return r
+}
+
+func (r ApiGetExchangeRateHistoryRequest) ToCurrencyId(toCurrencyId string) ApiGetExchangeRateHistoryRequest {
+	r.toCurrencyId = &toCurrencyId
+	return r
+}
+
+// Don&#39;t return rates with date before this
+func (r ApiGetExchangeRateHistoryRequest) DateFrom(dateFrom time.Time) ApiGetExchangeRateHistoryRequest {
+	r.dateFrom = &dateFrom
+	return r
+}
+
+// Don&#39;t return rates with date after this
+func (r ApiGetExchangeRateHistoryRequest) DateTo(dateTo time.Time) ApiGetExchangeRateHistoryRequest {
+	r.dateTo = &dateTo
+	return r
+}
+
+func (r ApiGetExchangeRateHistoryRequest) Execute() ([]ExchangeRateHistoryPoint, *http.Response, error) {
+	return r.ApiService.GetExchangeRateHistoryExecute(r)
+}
+
+/*
+GetExchangeRateHistory get stored exchange rate history of a currency pair
+
+Returns rates of one unit of the "from" currency in the "to" currency published by the rate providers of the family and stored by the server, one point per published day. If several providers published rates for a day the first provider of the family chain wins.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiGetExchangeRateHistoryRequest
+*/
+func (a *CurrenciesAPIService) GetExchangeRateHistory(ctx context.Context) ApiGetExchangeRateHistoryRequest {
+	return ApiGetExchangeRateHistoryRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []ExchangeRateHistoryPoint
+func (a *CurrenciesAPIService) GetExchangeRateHistoryExecute(r ApiGetExchangeRateHistoryRequest) ([]ExchangeRateHistoryPoint, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []ExchangeRateHistoryPoint
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CurrenciesAPIService.GetExchangeRateHistory")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/exchangeRateHistory"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.fromCurrencyId == nil {
+		return localVarReturnValue, nil, reportError("fromCurrencyId is required and must be specified")
+	}
+	if r.toCurrencyId == nil {
+		return localVarReturnValue, nil, reportError("toCurrencyId is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "fromCurrencyId", r.fromCurrencyId, "")
+	parameterAddToHeaderOrQuery(localVarQueryParams, "toCurrencyId", r.toCurrencyId, "")
+	if r.dateFrom != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "dateFrom", r.dateFrom, "")
+	}
+	if r.dateTo != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "dateTo", r.dateTo, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetExchangeRatesRequest struct {
+	ctx        context.Context
+	ApiService *CurrenciesAPIService
+}
+
+func (r ApiGetExchangeRatesRequest) Execute() ([]ExchangeRate, *http.Response, error) {
+	return r.ApiService.GetExchangeRatesExecute(r)
+}
+
+/*
+GetExchangeRates get manual exchange rates of the family
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiGetExchangeRatesRequest
+*/
+func (a *CurrenciesAPIService) GetExchangeRates(ctx context.Context) ApiGetExchangeRatesRequest {
+	return ApiGetExchangeRatesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []ExchangeRate
+func (a *CurrenciesAPIService) GetExchangeRatesExecute(r ApiGetExchangeRatesRequest) ([]ExchangeRate, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []ExchangeRate
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CurrenciesAPIService.GetExchangeRates")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/exchangeRates"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiUpdateCurrencyRequest struct {
+	ctx          context.Context
+	ApiService   *CurrenciesAPIService
+	id           string
+	currencyNoID *CurrencyNoID
+}
+
+func (r ApiUpdateCurrencyRequest) CurrencyNoID(currencyNoID CurrencyNoID) ApiUpdateCurrencyRequest {
+	r.currencyNoID = &currencyNoID
+	return r
+}
+
+func (r ApiUpdateCurrencyRequest) Execute() (*Currency, *http.Response, error) {
+	return r.ApiService.UpdateCurrencyExecute(r)
+}
+
+/*
+UpdateCurrency update currency
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id ID of the currency
+	@return ApiUpdateCurrencyRequest
+*/
+func (a *CurrenciesAPIService) UpdateCurrency(ctx context.Context, id string) ApiUpdateCurrencyRequest {
+	return ApiUpdateCurrencyRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return Currency
+func (a *CurrenciesAPIService) UpdateCurrencyExecute(r ApiUpdateCurrencyRequest) (*Currency, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *Currency
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CurrenciesAPIService.UpdateCurrency")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/currencies/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.currencyNoID == nil {
+		return localVarReturnValue, nil, reportError("currencyNoID is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.currencyNoID
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiUpdateExchangeRateRequest struct {
+	ctx              context.Context
+	ApiService       *CurrenciesAPIService
+	id               string
+	exchangeRateNoID *ExchangeRateNoID
+}
+
+func (r ApiUpdateExchangeRateRequest) ExchangeRateNoID(exchangeRateNoID ExchangeRateNoID) ApiUpdateExchangeRateRequest {
+	r.exchangeRateNoID = &exchangeRateNoID
+	return r
+}
+
+func (r ApiUpdateExchangeRateRequest) Execute() (*ExchangeRate, *http.Response, error) {
+	return r.ApiService.UpdateExchangeRateExecute(r)
+}
+
+/*
+UpdateExchangeRate update manual exchange rate
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id ID of the exchange rate
+	@return ApiUpdateExchangeRateRequest
+*/
+func (a *CurrenciesAPIService) UpdateExchangeRate(ctx context.Context, id string) ApiUpdateExchangeRateRequest {
+	return ApiUpdateExchangeRateRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return ExchangeRate
+func (a *CurrenciesAPIService) UpdateExchangeRateExecute(r ApiUpdateExchangeRateRequest) (*ExchangeRate, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *ExchangeRate
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CurrenciesAPIService.UpdateExchangeRate")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/exchangeRates/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.exchangeRateNoID == nil {
+		return localVarReturnValue, nil, reportError("exchangeRateNoID is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.exchangeRateNoID
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
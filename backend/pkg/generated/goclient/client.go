@@ -65,6 +65,10 @@ type APIClient struct {
 
 	ExportAPI *ExportAPIService
 
+	ForecastAPI *ForecastAPIService
+
+	GoalsAPI *GoalsAPIService
+
 	ImportAPI *ImportAPIService
 
 	MatchersAPI *MatchersAPIService
@@ -75,10 +79,16 @@ type APIClient struct {
 
 	ReconciliationAPI *ReconciliationAPIService
 
+	SecuritiesAPI *SecuritiesAPIService
+
+	TagsAPI *TagsAPIService
+
 	TemplatesAPI *TemplatesAPIService
 
 	TransactionsAPI *TransactionsAPIService
 
+	TransfersAPI *TransfersAPIService
+
 	UnprocessedTransactionsAPI *UnprocessedTransactionsAPIService
 
 	UserAPI *UserAPIService
@@ -108,13 +118,18 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.BudgetItemsAPI = (*BudgetItemsAPIService)(&c.common)
 	c.CurrenciesAPI = (*CurrenciesAPIService)(&c.common)
 	c.ExportAPI = (*ExportAPIService)(&c.common)
+	c.ForecastAPI = (*ForecastAPIService)(&c.common)
+	c.GoalsAPI = (*GoalsAPIService)(&c.common)
 	c.ImportAPI = (*ImportAPIService)(&c.common)
 	c.MatchersAPI = (*MatchersAPIService)(&c.common)
 	c.MergedTransactionsAPI = (*MergedTransactionsAPIService)(&c.common)
 	c.NotificationsAPI = (*NotificationsAPIService)(&c.common)
 	c.ReconciliationAPI = (*ReconciliationAPIService)(&c.common)
+	c.SecuritiesAPI = (*SecuritiesAPIService)(&c.common)
+	c.TagsAPI = (*TagsAPIService)(&c.common)
 	c.TemplatesAPI = (*TemplatesAPIService)(&c.common)
 	c.TransactionsAPI = (*TransactionsAPIService)(&c.common)
+	c.TransfersAPI = (*TransfersAPIService)(&c.common)
 	c.UnprocessedTransactionsAPI = (*UnprocessedTransactionsAPIService)(&c.common)
 	c.UserAPI = (*UserAPIService)(&c.common)
 
@@ -41,6 +41,11 @@ type AccountNoID struct {
 	ClosingDate *time.Time `json:"closingDate,omitempty"`
 	// If true, this account is shown on the reconciliation page even if it has no bank importer.
 	ShowInReconciliation *bool `json:"showInReconciliation,omitempty"`
+	// ID of the parent account of the same type, e.g. \"Food\" for \"Groceries\". Empty for top-level accounts.
+	ParentId       *string         `json:"parentId,omitempty"`
+	LoanTerms      *LoanTerms      `json:"loanTerms,omitempty"`
+	BudgetAlerts   *BudgetAlerts   `json:"budgetAlerts,omitempty"`
+	BudgetRollover *BudgetRollover `json:"budgetRollover,omitempty"`
 }
 
 type _AccountNoID AccountNoID
@@ -412,6 +417,134 @@ func (o *AccountNoID) SetShowInReconciliation(v bool) {
 	o.ShowInReconciliation = &v
 }
 
+// GetParentId returns the ParentId field value if set, zero value otherwise.
+func (o *AccountNoID) GetParentId() string {
+	if o == nil || IsNil(o.ParentId) {
+		var ret string
+		return ret
+	}
+	return *o.ParentId
+}
+
+// GetParentIdOk returns a tuple with the ParentId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountNoID) GetParentIdOk() (*string, bool) {
+	if o == nil || IsNil(o.ParentId) {
+		return nil, false
+	}
+	return o.ParentId, true
+}
+
+// HasParentId returns a boolean if a field has been set.
+func (o *AccountNoID) HasParentId() bool {
+	if o != nil && !IsNil(o.ParentId) {
+		return true
+	}
+
+	return false
+}
+
+// SetParentId gets a reference to the given string and assigns it to the ParentId field.
+func (o *AccountNoID) SetParentId(v string) {
+	o.ParentId = &v
+}
+
+// GetLoanTerms returns the LoanTerms field value if set, zero value otherwise.
+func (o *AccountNoID) GetLoanTerms() LoanTerms {
+	if o == nil || IsNil(o.LoanTerms) {
+		var ret LoanTerms
+		return ret
+	}
+	return *o.LoanTerms
+}
+
+// GetLoanTermsOk returns a tuple with the LoanTerms field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountNoID) GetLoanTermsOk() (*LoanTerms, bool) {
+	if o == nil || IsNil(o.LoanTerms) {
+		return nil, false
+	}
+	return o.LoanTerms, true
+}
+
+// HasLoanTerms returns a boolean if a field has been set.
+func (o *AccountNoID) HasLoanTerms() bool {
+	if o != nil && !IsNil(o.LoanTerms) {
+		return true
+	}
+
+	return false
+}
+
+// SetLoanTerms gets a reference to the given LoanTerms and assigns it to the LoanTerms field.
+func (o *AccountNoID) SetLoanTerms(v LoanTerms) {
+	o.LoanTerms = &v
+}
+
+// GetBudgetAlerts returns the BudgetAlerts field value if set, zero value otherwise.
+func (o *AccountNoID) GetBudgetAlerts() BudgetAlerts {
+	if o == nil || IsNil(o.BudgetAlerts) {
+		var ret BudgetAlerts
+		return ret
+	}
+	return *o.BudgetAlerts
+}
+
+// GetBudgetAlertsOk returns a tuple with the BudgetAlerts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountNoID) GetBudgetAlertsOk() (*BudgetAlerts, bool) {
+	if o == nil || IsNil(o.BudgetAlerts) {
+		return nil, false
+	}
+	return o.BudgetAlerts, true
+}
+
+// HasBudgetAlerts returns a boolean if a field has been set.
+func (o *AccountNoID) HasBudgetAlerts() bool {
+	if o != nil && !IsNil(o.BudgetAlerts) {
+		return true
+	}
+
+	return false
+}
+
+// SetBudgetAlerts gets a reference to the given BudgetAlerts and assigns it to the BudgetAlerts field.
+func (o *AccountNoID) SetBudgetAlerts(v BudgetAlerts) {
+	o.BudgetAlerts = &v
+}
+
+// GetBudgetRollover returns the BudgetRollover field value if set, zero value otherwise.
+func (o *AccountNoID) GetBudgetRollover() BudgetRollover {
+	if o == nil || IsNil(o.BudgetRollover) {
+		var ret BudgetRollover
+		return ret
+	}
+	return *o.BudgetRollover
+}
+
+// GetBudgetRolloverOk returns a tuple with the BudgetRollover field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountNoID) GetBudgetRolloverOk() (*BudgetRollover, bool) {
+	if o == nil || IsNil(o.BudgetRollover) {
+		return nil, false
+	}
+	return o.BudgetRollover, true
+}
+
+// HasBudgetRollover returns a boolean if a field has been set.
+func (o *AccountNoID) HasBudgetRollover() bool {
+	if o != nil && !IsNil(o.BudgetRollover) {
+		return true
+	}
+
+	return false
+}
+
+// SetBudgetRollover gets a reference to the given BudgetRollover and assigns it to the BudgetRollover field.
+func (o *AccountNoID) SetBudgetRollover(v BudgetRollover) {
+	o.BudgetRollover = &v
+}
+
 func (o AccountNoID) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -451,6 +584,18 @@ func (o AccountNoID) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ShowInReconciliation) {
 		toSerialize["showInReconciliation"] = o.ShowInReconciliation
 	}
+	if !IsNil(o.ParentId) {
+		toSerialize["parentId"] = o.ParentId
+	}
+	if !IsNil(o.LoanTerms) {
+		toSerialize["loanTerms"] = o.LoanTerms
+	}
+	if !IsNil(o.BudgetAlerts) {
+		toSerialize["budgetAlerts"] = o.BudgetAlerts
+	}
+	if !IsNil(o.BudgetRollover) {
+		toSerialize["budgetRollover"] = o.BudgetRollover
+	}
 	return toSerialize, nil
 }
 
@@ -28,6 +28,8 @@ type Aggregation struct {
 	Granularity string                `json:"granularity"`
 	Intervals   []time.Time           `json:"intervals"`
 	Currencies  []CurrencyAggregation `json:"currencies"`
+	// Sources of the exchange rates used to convert amounts to the output currency: rates the transactions were executed at, manual rates of the family or rate providers.
+	RateSources []string `json:"rateSources,omitempty"`
 }
 
 type _Aggregation Aggregation
@@ -174,6 +176,38 @@ func (o *Aggregation) SetCurrencies(v []CurrencyAggregation) {
 	o.Currencies = v
 }
 
+// GetRateSources returns the RateSources field value if set, zero value otherwise.
+func (o *Aggregation) GetRateSources() []string {
+	if o == nil || IsNil(o.RateSources) {
+		var ret []string
+		return ret
+	}
+	return o.RateSources
+}
+
+// GetRateSourcesOk returns a tuple with the RateSources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Aggregation) GetRateSourcesOk() ([]string, bool) {
+	if o == nil || IsNil(o.RateSources) {
+		return nil, false
+	}
+	return o.RateSources, true
+}
+
+// HasRateSources returns a boolean if a field has been set.
+func (o *Aggregation) HasRateSources() bool {
+	if o != nil && !IsNil(o.RateSources) {
+		return true
+	}
+
+	return false
+}
+
+// SetRateSources gets a reference to the given []string and assigns it to the RateSources field.
+func (o *Aggregation) SetRateSources(v []string) {
+	o.RateSources = v
+}
+
 func (o Aggregation) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -189,6 +223,9 @@ func (o Aggregation) ToMap() (map[string]interface{}, error) {
 	toSerialize["granularity"] = o.Granularity
 	toSerialize["intervals"] = o.Intervals
 	toSerialize["currencies"] = o.Currencies
+	if !IsNil(o.RateSources) {
+		toSerialize["rateSources"] = o.RateSources
+	}
 	return toSerialize, nil
 }
 
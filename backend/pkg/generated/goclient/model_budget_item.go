@@ -25,11 +25,17 @@ var _ MappedNullable = &BudgetItem{}
 
 // BudgetItem struct for BudgetItem
 type BudgetItem struct {
-	Id          string          `json:"id"`
-	Date        time.Time       `json:"date"`
-	AccountId   string          `json:"accountId"`
-	Amount      decimal.Decimal `json:"amount"`
-	Description *string         `json:"description,omitempty"`
+	Id        string    `json:"id"`
+	Date      time.Time `json:"date"`
+	AccountId *string   `json:"accountId,omitempty"`
+	// Budgets transactions with this tag or its sub-tags
+	Tag *string `json:"tag,omitempty"`
+	// Budgets transactions with this partner name or place, compared ignoring case and punctuation
+	Partner *string         `json:"partner,omitempty"`
+	Amount  decimal.Decimal `json:"amount"`
+	// Currency of the amount of tag and partner budgets, account budgets are in the currency of their account
+	CurrencyId  *string `json:"currencyId,omitempty"`
+	Description *string `json:"description,omitempty"`
 }
 
 type _BudgetItem BudgetItem
@@ -38,11 +44,10 @@ type _BudgetItem BudgetItem
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewBudgetItem(id string, date time.Time, accountId string, amount decimal.Decimal) *BudgetItem {
+func NewBudgetItem(id string, date time.Time, amount decimal.Decimal) *BudgetItem {
 	this := BudgetItem{}
 	this.Id = id
 	this.Date = date
-	this.AccountId = accountId
 	this.Amount = amount
 	return &this
 }
@@ -103,28 +108,100 @@ func (o *BudgetItem) SetDate(v time.Time) {
 	o.Date = v
 }
 
-// GetAccountId returns the AccountId field value
+// GetAccountId returns the AccountId field value if set, zero value otherwise.
 func (o *BudgetItem) GetAccountId() string {
-	if o == nil {
+	if o == nil || IsNil(o.AccountId) {
 		var ret string
 		return ret
 	}
-
-	return o.AccountId
+	return *o.AccountId
 }
 
-// GetAccountIdOk returns a tuple with the AccountId field value
+// GetAccountIdOk returns a tuple with the AccountId field value if set, nil otherwise
 // and a boolean to check if the value has been set.
 func (o *BudgetItem) GetAccountIdOk() (*string, bool) {
-	if o == nil {
+	if o == nil || IsNil(o.AccountId) {
 		return nil, false
 	}
-	return &o.AccountId, true
+	return o.AccountId, true
+}
+
+// HasAccountId returns a boolean if a field has been set.
+func (o *BudgetItem) HasAccountId() bool {
+	if o != nil && !IsNil(o.AccountId) {
+		return true
+	}
+
+	return false
 }
 
-// SetAccountId sets field value
+// SetAccountId gets a reference to the given string and assigns it to the AccountId field.
 func (o *BudgetItem) SetAccountId(v string) {
-	o.AccountId = v
+	o.AccountId = &v
+}
+
+// GetTag returns the Tag field value if set, zero value otherwise.
+func (o *BudgetItem) GetTag() string {
+	if o == nil || IsNil(o.Tag) {
+		var ret string
+		return ret
+	}
+	return *o.Tag
+}
+
+// GetTagOk returns a tuple with the Tag field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BudgetItem) GetTagOk() (*string, bool) {
+	if o == nil || IsNil(o.Tag) {
+		return nil, false
+	}
+	return o.Tag, true
+}
+
+// HasTag returns a boolean if a field has been set.
+func (o *BudgetItem) HasTag() bool {
+	if o != nil && !IsNil(o.Tag) {
+		return true
+	}
+
+	return false
+}
+
+// SetTag gets a reference to the given string and assigns it to the Tag field.
+func (o *BudgetItem) SetTag(v string) {
+	o.Tag = &v
+}
+
+// GetPartner returns the Partner field value if set, zero value otherwise.
+func (o *BudgetItem) GetPartner() string {
+	if o == nil || IsNil(o.Partner) {
+		var ret string
+		return ret
+	}
+	return *o.Partner
+}
+
+// GetPartnerOk returns a tuple with the Partner field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BudgetItem) GetPartnerOk() (*string, bool) {
+	if o == nil || IsNil(o.Partner) {
+		return nil, false
+	}
+	return o.Partner, true
+}
+
+// HasPartner returns a boolean if a field has been set.
+func (o *BudgetItem) HasPartner() bool {
+	if o != nil && !IsNil(o.Partner) {
+		return true
+	}
+
+	return false
+}
+
+// SetPartner gets a reference to the given string and assigns it to the Partner field.
+func (o *BudgetItem) SetPartner(v string) {
+	o.Partner = &v
 }
 
 // GetAmount returns the Amount field value
@@ -151,6 +228,38 @@ func (o *BudgetItem) SetAmount(v decimal.Decimal) {
 	o.Amount = v
 }
 
+// GetCurrencyId returns the CurrencyId field value if set, zero value otherwise.
+func (o *BudgetItem) GetCurrencyId() string {
+	if o == nil || IsNil(o.CurrencyId) {
+		var ret string
+		return ret
+	}
+	return *o.CurrencyId
+}
+
+// GetCurrencyIdOk returns a tuple with the CurrencyId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BudgetItem) GetCurrencyIdOk() (*string, bool) {
+	if o == nil || IsNil(o.CurrencyId) {
+		return nil, false
+	}
+	return o.CurrencyId, true
+}
+
+// HasCurrencyId returns a boolean if a field has been set.
+func (o *BudgetItem) HasCurrencyId() bool {
+	if o != nil && !IsNil(o.CurrencyId) {
+		return true
+	}
+
+	return false
+}
+
+// SetCurrencyId gets a reference to the given string and assigns it to the CurrencyId field.
+func (o *BudgetItem) SetCurrencyId(v string) {
+	o.CurrencyId = &v
+}
+
 // GetDescription returns the Description field value if set, zero value otherwise.
 func (o *BudgetItem) GetDescription() string {
 	if o == nil || IsNil(o.Description) {
@@ -195,8 +304,19 @@ func (o BudgetItem) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["id"] = o.Id
 	toSerialize["date"] = o.Date
-	toSerialize["accountId"] = o.AccountId
+	if !IsNil(o.AccountId) {
+		toSerialize["accountId"] = o.AccountId
+	}
+	if !IsNil(o.Tag) {
+		toSerialize["tag"] = o.Tag
+	}
+	if !IsNil(o.Partner) {
+		toSerialize["partner"] = o.Partner
+	}
 	toSerialize["amount"] = o.Amount
+	if !IsNil(o.CurrencyId) {
+		toSerialize["currencyId"] = o.CurrencyId
+	}
 	if !IsNil(o.Description) {
 		toSerialize["description"] = o.Description
 	}
@@ -210,7 +330,6 @@ func (o *BudgetItem) UnmarshalJSON(data []byte) (err error) {
 	requiredProperties := []string{
 		"id",
 		"date",
-		"accountId",
 		"amount",
 	}
 
@@ -23,12 +23,18 @@ import (
 // checks if the BudgetItemNoID type satisfies the MappedNullable interface at compile time
 var _ MappedNullable = &BudgetItemNoID{}
 
-// BudgetItemNoID struct for BudgetItemNoID
+// BudgetItemNoID Budget of a month for exactly one target: an account, a tag or a partner. Tags and partners cut across accounts, their spending is what transactions with the tag (or its sub-tags) or with the partner bring to expense accounts.
 type BudgetItemNoID struct {
-	Date        time.Time       `json:"date"`
-	AccountId   string          `json:"accountId"`
-	Amount      decimal.Decimal `json:"amount"`
-	Description *string         `json:"description,omitempty"`
+	Date      time.Time `json:"date"`
+	AccountId *string   `json:"accountId,omitempty"`
+	// Budgets transactions with this tag or its sub-tags
+	Tag *string `json:"tag,omitempty"`
+	// Budgets transactions with this partner name or place, compared ignoring case and punctuation
+	Partner *string         `json:"partner,omitempty"`
+	Amount  decimal.Decimal `json:"amount"`
+	// Currency of the amount of tag and partner budgets, account budgets are in the currency of their account
+	CurrencyId  *string `json:"currencyId,omitempty"`
+	Description *string `json:"description,omitempty"`
 }
 
 type _BudgetItemNoID BudgetItemNoID
@@ -37,10 +43,9 @@ type _BudgetItemNoID BudgetItemNoID
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewBudgetItemNoID(date time.Time, accountId string, amount decimal.Decimal) *BudgetItemNoID {
+func NewBudgetItemNoID(date time.Time, amount decimal.Decimal) *BudgetItemNoID {
 	this := BudgetItemNoID{}
 	this.Date = date
-	this.AccountId = accountId
 	this.Amount = amount
 	return &this
 }
@@ -77,28 +82,100 @@ func (o *BudgetItemNoID) SetDate(v time.Time) {
 	o.Date = v
 }
 
-// GetAccountId returns the AccountId field value
+// GetAccountId returns the AccountId field value if set, zero value otherwise.
 func (o *BudgetItemNoID) GetAccountId() string {
-	if o == nil {
+	if o == nil || IsNil(o.AccountId) {
 		var ret string
 		return ret
 	}
-
-	return o.AccountId
+	return *o.AccountId
 }
 
-// GetAccountIdOk returns a tuple with the AccountId field value
+// GetAccountIdOk returns a tuple with the AccountId field value if set, nil otherwise
 // and a boolean to check if the value has been set.
 func (o *BudgetItemNoID) GetAccountIdOk() (*string, bool) {
-	if o == nil {
+	if o == nil || IsNil(o.AccountId) {
 		return nil, false
 	}
-	return &o.AccountId, true
+	return o.AccountId, true
+}
+
+// HasAccountId returns a boolean if a field has been set.
+func (o *BudgetItemNoID) HasAccountId() bool {
+	if o != nil && !IsNil(o.AccountId) {
+		return true
+	}
+
+	return false
 }
 
-// SetAccountId sets field value
+// SetAccountId gets a reference to the given string and assigns it to the AccountId field.
 func (o *BudgetItemNoID) SetAccountId(v string) {
-	o.AccountId = v
+	o.AccountId = &v
+}
+
+// GetTag returns the Tag field value if set, zero value otherwise.
+func (o *BudgetItemNoID) GetTag() string {
+	if o == nil || IsNil(o.Tag) {
+		var ret string
+		return ret
+	}
+	return *o.Tag
+}
+
+// GetTagOk returns a tuple with the Tag field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BudgetItemNoID) GetTagOk() (*string, bool) {
+	if o == nil || IsNil(o.Tag) {
+		return nil, false
+	}
+	return o.Tag, true
+}
+
+// HasTag returns a boolean if a field has been set.
+func (o *BudgetItemNoID) HasTag() bool {
+	if o != nil && !IsNil(o.Tag) {
+		return true
+	}
+
+	return false
+}
+
+// SetTag gets a reference to the given string and assigns it to the Tag field.
+func (o *BudgetItemNoID) SetTag(v string) {
+	o.Tag = &v
+}
+
+// GetPartner returns the Partner field value if set, zero value otherwise.
+func (o *BudgetItemNoID) GetPartner() string {
+	if o == nil || IsNil(o.Partner) {
+		var ret string
+		return ret
+	}
+	return *o.Partner
+}
+
+// GetPartnerOk returns a tuple with the Partner field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BudgetItemNoID) GetPartnerOk() (*string, bool) {
+	if o == nil || IsNil(o.Partner) {
+		return nil, false
+	}
+	return o.Partner, true
+}
+
+// HasPartner returns a boolean if a field has been set.
+func (o *BudgetItemNoID) HasPartner() bool {
+	if o != nil && !IsNil(o.Partner) {
+		return true
+	}
+
+	return false
+}
+
+// SetPartner gets a reference to the given string and assigns it to the Partner field.
+func (o *BudgetItemNoID) SetPartner(v string) {
+	o.Partner = &v
 }
 
 // GetAmount returns the Amount field value
@@ -125,6 +202,38 @@ func (o *BudgetItemNoID) SetAmount(v decimal.Decimal) {
 	o.Amount = v
 }
 
+// GetCurrencyId returns the CurrencyId field value if set, zero value otherwise.
+func (o *BudgetItemNoID) GetCurrencyId() string {
+	if o == nil || IsNil(o.CurrencyId) {
+		var ret string
+		return ret
+	}
+	return *o.CurrencyId
+}
+
+// GetCurrencyIdOk returns a tuple with the CurrencyId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BudgetItemNoID) GetCurrencyIdOk() (*string, bool) {
+	if o == nil || IsNil(o.CurrencyId) {
+		return nil, false
+	}
+	return o.CurrencyId, true
+}
+
+// HasCurrencyId returns a boolean if a field has been set.
+func (o *BudgetItemNoID) HasCurrencyId() bool {
+	if o != nil && !IsNil(o.CurrencyId) {
+		return true
+	}
+
+	return false
+}
+
+// SetCurrencyId gets a reference to the given string and assigns it to the CurrencyId field.
+func (o *BudgetItemNoID) SetCurrencyId(v string) {
+	o.CurrencyId = &v
+}
+
 // GetDescription returns the Description field value if set, zero value otherwise.
 func (o *BudgetItemNoID) GetDescription() string {
 	if o == nil || IsNil(o.Description) {
@@ -168,8 +277,19 @@ func (o BudgetItemNoID) MarshalJSON() ([]byte, error) {
 func (o BudgetItemNoID) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["date"] = o.Date
-	toSerialize["accountId"] = o.AccountId
+	if !IsNil(o.AccountId) {
+		toSerialize["accountId"] = o.AccountId
+	}
+	if !IsNil(o.Tag) {
+		toSerialize["tag"] = o.Tag
+	}
+	if !IsNil(o.Partner) {
+		toSerialize["partner"] = o.Partner
+	}
 	toSerialize["amount"] = o.Amount
+	if !IsNil(o.CurrencyId) {
+		toSerialize["currencyId"] = o.CurrencyId
+	}
 	if !IsNil(o.Description) {
 		toSerialize["description"] = o.Description
 	}
@@ -182,7 +302,6 @@ func (o *BudgetItemNoID) UnmarshalJSON(data []byte) (err error) {
 	// that every required field exists as a key in the generic map.
 	requiredProperties := []string{
 		"date",
-		"accountId",
 		"amount",
 	}
 
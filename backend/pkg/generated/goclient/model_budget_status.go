@@ -29,8 +29,16 @@ type BudgetStatus struct {
 	AccountId string          `json:"accountId"`
 	Budgeted  decimal.Decimal `json:"budgeted"`
 	Spent     decimal.Decimal `json:"spent"`
-	Rollover  decimal.Decimal `json:"rollover"`
-	Available decimal.Decimal `json:"available"`
+	// Budget moved into the envelope in the period, negative if moved out
+	Transferred *decimal.Decimal `json:"transferred,omitempty"`
+	Rollover    decimal.Decimal  `json:"rollover"`
+	Available   decimal.Decimal  `json:"available"`
+	// Set for budgets of a tag, the account is empty then
+	Tag *string `json:"tag,omitempty"`
+	// Set for budgets of a partner, the account is empty then
+	Partner *string `json:"partner,omitempty"`
+	// Set for savings goals. Budgeted is then the contribution needed in the period to reach the goal in time, spent is the amount saved in the period and available is what is still to be saved. The account is the first account of the goal
+	GoalId *string `json:"goalId,omitempty"`
 }
 
 type _BudgetStatus BudgetStatus
@@ -154,6 +162,38 @@ func (o *BudgetStatus) SetSpent(v decimal.Decimal) {
 	o.Spent = v
 }
 
+// GetTransferred returns the Transferred field value if set, zero value otherwise.
+func (o *BudgetStatus) GetTransferred() decimal.Decimal {
+	if o == nil || IsNil(o.Transferred) {
+		var ret decimal.Decimal
+		return ret
+	}
+	return *o.Transferred
+}
+
+// GetTransferredOk returns a tuple with the Transferred field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BudgetStatus) GetTransferredOk() (*decimal.Decimal, bool) {
+	if o == nil || IsNil(o.Transferred) {
+		return nil, false
+	}
+	return o.Transferred, true
+}
+
+// HasTransferred returns a boolean if a field has been set.
+func (o *BudgetStatus) HasTransferred() bool {
+	if o != nil && !IsNil(o.Transferred) {
+		return true
+	}
+
+	return false
+}
+
+// SetTransferred gets a reference to the given decimal.Decimal and assigns it to the Transferred field.
+func (o *BudgetStatus) SetTransferred(v decimal.Decimal) {
+	o.Transferred = &v
+}
+
 // GetRollover returns the Rollover field value
 func (o *BudgetStatus) GetRollover() decimal.Decimal {
 	if o == nil {
@@ -202,6 +242,102 @@ func (o *BudgetStatus) SetAvailable(v decimal.Decimal) {
 	o.Available = v
 }
 
+// GetTag returns the Tag field value if set, zero value otherwise.
+func (o *BudgetStatus) GetTag() string {
+	if o == nil || IsNil(o.Tag) {
+		var ret string
+		return ret
+	}
+	return *o.Tag
+}
+
+// GetTagOk returns a tuple with the Tag field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BudgetStatus) GetTagOk() (*string, bool) {
+	if o == nil || IsNil(o.Tag) {
+		return nil, false
+	}
+	return o.Tag, true
+}
+
+// HasTag returns a boolean if a field has been set.
+func (o *BudgetStatus) HasTag() bool {
+	if o != nil && !IsNil(o.Tag) {
+		return true
+	}
+
+	return false
+}
+
+// SetTag gets a reference to the given string and assigns it to the Tag field.
+func (o *BudgetStatus) SetTag(v string) {
+	o.Tag = &v
+}
+
+// GetPartner returns the Partner field value if set, zero value otherwise.
+func (o *BudgetStatus) GetPartner() string {
+	if o == nil || IsNil(o.Partner) {
+		var ret string
+		return ret
+	}
+	return *o.Partner
+}
+
+// GetPartnerOk returns a tuple with the Partner field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BudgetStatus) GetPartnerOk() (*string, bool) {
+	if o == nil || IsNil(o.Partner) {
+		return nil, false
+	}
+	return o.Partner, true
+}
+
+// HasPartner returns a boolean if a field has been set.
+func (o *BudgetStatus) HasPartner() bool {
+	if o != nil && !IsNil(o.Partner) {
+		return true
+	}
+
+	return false
+}
+
+// SetPartner gets a reference to the given string and assigns it to the Partner field.
+func (o *BudgetStatus) SetPartner(v string) {
+	o.Partner = &v
+}
+
+// GetGoalId returns the GoalId field value if set, zero value otherwise.
+func (o *BudgetStatus) GetGoalId() string {
+	if o == nil || IsNil(o.GoalId) {
+		var ret string
+		return ret
+	}
+	return *o.GoalId
+}
+
+// GetGoalIdOk returns a tuple with the GoalId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BudgetStatus) GetGoalIdOk() (*string, bool) {
+	if o == nil || IsNil(o.GoalId) {
+		return nil, false
+	}
+	return o.GoalId, true
+}
+
+// HasGoalId returns a boolean if a field has been set.
+func (o *BudgetStatus) HasGoalId() bool {
+	if o != nil && !IsNil(o.GoalId) {
+		return true
+	}
+
+	return false
+}
+
+// SetGoalId gets a reference to the given string and assigns it to the GoalId field.
+func (o *BudgetStatus) SetGoalId(v string) {
+	o.GoalId = &v
+}
+
 func (o BudgetStatus) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -216,8 +352,20 @@ func (o BudgetStatus) ToMap() (map[string]interface{}, error) {
 	toSerialize["accountId"] = o.AccountId
 	toSerialize["budgeted"] = o.Budgeted
 	toSerialize["spent"] = o.Spent
+	if !IsNil(o.Transferred) {
+		toSerialize["transferred"] = o.Transferred
+	}
 	toSerialize["rollover"] = o.Rollover
 	toSerialize["available"] = o.Available
+	if !IsNil(o.Tag) {
+		toSerialize["tag"] = o.Tag
+	}
+	if !IsNil(o.Partner) {
+		toSerialize["partner"] = o.Partner
+	}
+	if !IsNil(o.GoalId) {
+		toSerialize["goalId"] = o.GoalId
+	}
 	return toSerialize, nil
 }
 
@@ -28,6 +28,10 @@ type Movement struct {
 	CurrencyId  string          `json:"currencyId"`
 	AccountId   *string         `json:"accountId,omitempty"`
 	Description *string         `json:"description,omitempty"`
+	// Security bought (positive quantity) or sold (negative quantity), the amount is the cost or the proceeds
+	SecurityId *string `json:"securityId,omitempty"`
+	// Units of the security
+	Quantity *decimal.Decimal `json:"quantity,omitempty"`
 }
 
 type _Movement Movement
@@ -163,6 +167,70 @@ func (o *Movement) SetDescription(v string) {
 	o.Description = &v
 }
 
+// GetSecurityId returns the SecurityId field value if set, zero value otherwise.
+func (o *Movement) GetSecurityId() string {
+	if o == nil || IsNil(o.SecurityId) {
+		var ret string
+		return ret
+	}
+	return *o.SecurityId
+}
+
+// GetSecurityIdOk returns a tuple with the SecurityId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Movement) GetSecurityIdOk() (*string, bool) {
+	if o == nil || IsNil(o.SecurityId) {
+		return nil, false
+	}
+	return o.SecurityId, true
+}
+
+// HasSecurityId returns a boolean if a field has been set.
+func (o *Movement) HasSecurityId() bool {
+	if o != nil && !IsNil(o.SecurityId) {
+		return true
+	}
+
+	return false
+}
+
+// SetSecurityId gets a reference to the given string and assigns it to the SecurityId field.
+func (o *Movement) SetSecurityId(v string) {
+	o.SecurityId = &v
+}
+
+// GetQuantity returns the Quantity field value if set, zero value otherwise.
+func (o *Movement) GetQuantity() decimal.Decimal {
+	if o == nil || IsNil(o.Quantity) {
+		var ret decimal.Decimal
+		return ret
+	}
+	return *o.Quantity
+}
+
+// GetQuantityOk returns a tuple with the Quantity field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Movement) GetQuantityOk() (*decimal.Decimal, bool) {
+	if o == nil || IsNil(o.Quantity) {
+		return nil, false
+	}
+	return o.Quantity, true
+}
+
+// HasQuantity returns a boolean if a field has been set.
+func (o *Movement) HasQuantity() bool {
+	if o != nil && !IsNil(o.Quantity) {
+		return true
+	}
+
+	return false
+}
+
+// SetQuantity gets a reference to the given decimal.Decimal and assigns it to the Quantity field.
+func (o *Movement) SetQuantity(v decimal.Decimal) {
+	o.Quantity = &v
+}
+
 func (o Movement) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -181,6 +249,12 @@ func (o Movement) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Description) {
 		toSerialize["description"] = o.Description
 	}
+	if !IsNil(o.SecurityId) {
+		toSerialize["securityId"] = o.SecurityId
+	}
+	if !IsNil(o.Quantity) {
+		toSerialize["quantity"] = o.Quantity
+	}
 	return toSerialize, nil
 }
 
@@ -56,6 +56,12 @@ type Transaction struct {
 	MergedTransactionIds []string `json:"mergedTransactionIds,omitempty"`
 	// List of transaction IDs that are potential duplicates of this one (from separate junction table)
 	DuplicateTransactionIds []string `json:"duplicateTransactionIds,omitempty"`
+	// ID of the original expense transaction this one refunds or reimburses (if any), set through the refund link endpoints only
+	RefundOfId *string `json:"refundOfId,omitempty"`
+	// Kind of the link to the original transaction, set together with refundOfId
+	RefundKind *string `json:"refundKind,omitempty"`
+	// Effective exchange rates of a transaction with movements in two currencies, calculated from its movements when the transaction is saved. Ignored on input.
+	ExchangeRates []TransactionRate `json:"exchangeRates,omitempty"`
 }
 
 type _Transaction Transaction
@@ -732,6 +738,102 @@ func (o *Transaction) SetDuplicateTransactionIds(v []string) {
 	o.DuplicateTransactionIds = v
 }
 
+// GetRefundOfId returns the RefundOfId field value if set, zero value otherwise.
+func (o *Transaction) GetRefundOfId() string {
+	if o == nil || IsNil(o.RefundOfId) {
+		var ret string
+		return ret
+	}
+	return *o.RefundOfId
+}
+
+// GetRefundOfIdOk returns a tuple with the RefundOfId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Transaction) GetRefundOfIdOk() (*string, bool) {
+	if o == nil || IsNil(o.RefundOfId) {
+		return nil, false
+	}
+	return o.RefundOfId, true
+}
+
+// HasRefundOfId returns a boolean if a field has been set.
+func (o *Transaction) HasRefundOfId() bool {
+	if o != nil && !IsNil(o.RefundOfId) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefundOfId gets a reference to the given string and assigns it to the RefundOfId field.
+func (o *Transaction) SetRefundOfId(v string) {
+	o.RefundOfId = &v
+}
+
+// GetRefundKind returns the RefundKind field value if set, zero value otherwise.
+func (o *Transaction) GetRefundKind() string {
+	if o == nil || IsNil(o.RefundKind) {
+		var ret string
+		return ret
+	}
+	return *o.RefundKind
+}
+
+// GetRefundKindOk returns a tuple with the RefundKind field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Transaction) GetRefundKindOk() (*string, bool) {
+	if o == nil || IsNil(o.RefundKind) {
+		return nil, false
+	}
+	return o.RefundKind, true
+}
+
+// HasRefundKind returns a boolean if a field has been set.
+func (o *Transaction) HasRefundKind() bool {
+	if o != nil && !IsNil(o.RefundKind) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefundKind gets a reference to the given string and assigns it to the RefundKind field.
+func (o *Transaction) SetRefundKind(v string) {
+	o.RefundKind = &v
+}
+
+// GetExchangeRates returns the ExchangeRates field value if set, zero value otherwise.
+func (o *Transaction) GetExchangeRates() []TransactionRate {
+	if o == nil || IsNil(o.ExchangeRates) {
+		var ret []TransactionRate
+		return ret
+	}
+	return o.ExchangeRates
+}
+
+// GetExchangeRatesOk returns a tuple with the ExchangeRates field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Transaction) GetExchangeRatesOk() ([]TransactionRate, bool) {
+	if o == nil || IsNil(o.ExchangeRates) {
+		return nil, false
+	}
+	return o.ExchangeRates, true
+}
+
+// HasExchangeRates returns a boolean if a field has been set.
+func (o *Transaction) HasExchangeRates() bool {
+	if o != nil && !IsNil(o.ExchangeRates) {
+		return true
+	}
+
+	return false
+}
+
+// SetExchangeRates gets a reference to the given []TransactionRate and assigns it to the ExchangeRates field.
+func (o *Transaction) SetExchangeRates(v []TransactionRate) {
+	o.ExchangeRates = v
+}
+
 func (o Transaction) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -799,6 +901,15 @@ func (o Transaction) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.DuplicateTransactionIds) {
 		toSerialize["duplicateTransactionIds"] = o.DuplicateTransactionIds
 	}
+	if !IsNil(o.RefundOfId) {
+		toSerialize["refundOfId"] = o.RefundOfId
+	}
+	if !IsNil(o.RefundKind) {
+		toSerialize["refundKind"] = o.RefundKind
+	}
+	if !IsNil(o.ExchangeRates) {
+		toSerialize["exchangeRates"] = o.ExchangeRates
+	}
 	return toSerialize, nil
 }
 
@@ -25,6 +25,8 @@ type TransactionParseResponse struct {
 	Transaction TransactionNoID `json:"transaction"`
 	// Human-readable warnings for fields that could not be parsed or were ambiguously matched
 	Warnings []string `json:"warnings"`
+	// Structured form of warnings, in the same order
+	Details []TransactionParseWarning `json:"details,omitempty"`
 }
 
 type _TransactionParseResponse TransactionParseResponse
@@ -96,6 +98,38 @@ func (o *TransactionParseResponse) SetWarnings(v []string) {
 	o.Warnings = v
 }
 
+// GetDetails returns the Details field value if set, zero value otherwise.
+func (o *TransactionParseResponse) GetDetails() []TransactionParseWarning {
+	if o == nil || IsNil(o.Details) {
+		var ret []TransactionParseWarning
+		return ret
+	}
+	return o.Details
+}
+
+// GetDetailsOk returns a tuple with the Details field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TransactionParseResponse) GetDetailsOk() ([]TransactionParseWarning, bool) {
+	if o == nil || IsNil(o.Details) {
+		return nil, false
+	}
+	return o.Details, true
+}
+
+// HasDetails returns a boolean if a field has been set.
+func (o *TransactionParseResponse) HasDetails() bool {
+	if o != nil && !IsNil(o.Details) {
+		return true
+	}
+
+	return false
+}
+
+// SetDetails gets a reference to the given []TransactionParseWarning and assigns it to the Details field.
+func (o *TransactionParseResponse) SetDetails(v []TransactionParseWarning) {
+	o.Details = v
+}
+
 func (o TransactionParseResponse) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -108,6 +142,9 @@ func (o TransactionParseResponse) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["transaction"] = o.Transaction
 	toSerialize["warnings"] = o.Warnings
+	if !IsNil(o.Details) {
+		toSerialize["details"] = o.Details
+	}
 	return toSerialize, nil
 }
 
@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // checks if the TransactionTemplate type satisfies the MappedNullable interface at compile time
@@ -31,6 +32,10 @@ type TransactionTemplate struct {
 	PartnerName *string    `json:"partnerName,omitempty"`
 	Extra       *string    `json:"extra,omitempty"`
 	Movements   []Movement `json:"movements"`
+	// Makes the template a scheduled payment repeating with this period, used by the balance forecast
+	Repeat *string `json:"repeat,omitempty"`
+	// Date of the next scheduled payment, required with repeat
+	NextDate *time.Time `json:"nextDate,omitempty"`
 }
 
 type _TransactionTemplate TransactionTemplate
@@ -287,6 +292,70 @@ func (o *TransactionTemplate) SetMovements(v []Movement) {
 	o.Movements = v
 }
 
+// GetRepeat returns the Repeat field value if set, zero value otherwise.
+func (o *TransactionTemplate) GetRepeat() string {
+	if o == nil || IsNil(o.Repeat) {
+		var ret string
+		return ret
+	}
+	return *o.Repeat
+}
+
+// GetRepeatOk returns a tuple with the Repeat field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TransactionTemplate) GetRepeatOk() (*string, bool) {
+	if o == nil || IsNil(o.Repeat) {
+		return nil, false
+	}
+	return o.Repeat, true
+}
+
+// HasRepeat returns a boolean if a field has been set.
+func (o *TransactionTemplate) HasRepeat() bool {
+	if o != nil && !IsNil(o.Repeat) {
+		return true
+	}
+
+	return false
+}
+
+// SetRepeat gets a reference to the given string and assigns it to the Repeat field.
+func (o *TransactionTemplate) SetRepeat(v string) {
+	o.Repeat = &v
+}
+
+// GetNextDate returns the NextDate field value if set, zero value otherwise.
+func (o *TransactionTemplate) GetNextDate() time.Time {
+	if o == nil || IsNil(o.NextDate) {
+		var ret time.Time
+		return ret
+	}
+	return *o.NextDate
+}
+
+// GetNextDateOk returns a tuple with the NextDate field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TransactionTemplate) GetNextDateOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.NextDate) {
+		return nil, false
+	}
+	return o.NextDate, true
+}
+
+// HasNextDate returns a boolean if a field has been set.
+func (o *TransactionTemplate) HasNextDate() bool {
+	if o != nil && !IsNil(o.NextDate) {
+		return true
+	}
+
+	return false
+}
+
+// SetNextDate gets a reference to the given time.Time and assigns it to the NextDate field.
+func (o *TransactionTemplate) SetNextDate(v time.Time) {
+	o.NextDate = &v
+}
+
 func (o TransactionTemplate) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -315,6 +384,12 @@ func (o TransactionTemplate) ToMap() (map[string]interface{}, error) {
 		toSerialize["extra"] = o.Extra
 	}
 	toSerialize["movements"] = o.Movements
+	if !IsNil(o.Repeat) {
+		toSerialize["repeat"] = o.Repeat
+	}
+	if !IsNil(o.NextDate) {
+		toSerialize["nextDate"] = o.NextDate
+	}
 	return toSerialize, nil
 }
 
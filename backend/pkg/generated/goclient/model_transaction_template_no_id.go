@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // checks if the TransactionTemplateNoId type satisfies the MappedNullable interface at compile time
@@ -30,6 +31,10 @@ type TransactionTemplateNoId struct {
 	PartnerName *string    `json:"partnerName,omitempty"`
 	Extra       *string    `json:"extra,omitempty"`
 	Movements   []Movement `json:"movements"`
+	// Makes the template a scheduled payment repeating with this period, used by the balance forecast
+	Repeat *string `json:"repeat,omitempty"`
+	// Date of the next scheduled payment, required with repeat
+	NextDate *time.Time `json:"nextDate,omitempty"`
 }
 
 type _TransactionTemplateNoId TransactionTemplateNoId
@@ -261,6 +266,70 @@ func (o *TransactionTemplateNoId) SetMovements(v []Movement) {
 	o.Movements = v
 }
 
+// GetRepeat returns the Repeat field value if set, zero value otherwise.
+func (o *TransactionTemplateNoId) GetRepeat() string {
+	if o == nil || IsNil(o.Repeat) {
+		var ret string
+		return ret
+	}
+	return *o.Repeat
+}
+
+// GetRepeatOk returns a tuple with the Repeat field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TransactionTemplateNoId) GetRepeatOk() (*string, bool) {
+	if o == nil || IsNil(o.Repeat) {
+		return nil, false
+	}
+	return o.Repeat, true
+}
+
+// HasRepeat returns a boolean if a field has been set.
+func (o *TransactionTemplateNoId) HasRepeat() bool {
+	if o != nil && !IsNil(o.Repeat) {
+		return true
+	}
+
+	return false
+}
+
+// SetRepeat gets a reference to the given string and assigns it to the Repeat field.
+func (o *TransactionTemplateNoId) SetRepeat(v string) {
+	o.Repeat = &v
+}
+
+// GetNextDate returns the NextDate field value if set, zero value otherwise.
+func (o *TransactionTemplateNoId) GetNextDate() time.Time {
+	if o == nil || IsNil(o.NextDate) {
+		var ret time.Time
+		return ret
+	}
+	return *o.NextDate
+}
+
+// GetNextDateOk returns a tuple with the NextDate field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TransactionTemplateNoId) GetNextDateOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.NextDate) {
+		return nil, false
+	}
+	return o.NextDate, true
+}
+
+// HasNextDate returns a boolean if a field has been set.
+func (o *TransactionTemplateNoId) HasNextDate() bool {
+	if o != nil && !IsNil(o.NextDate) {
+		return true
+	}
+
+	return false
+}
+
+// SetNextDate gets a reference to the given time.Time and assigns it to the NextDate field.
+func (o *TransactionTemplateNoId) SetNextDate(v time.Time) {
+	o.NextDate = &v
+}
+
 func (o TransactionTemplateNoId) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -288,6 +357,12 @@ func (o TransactionTemplateNoId) ToMap() (map[string]interface{}, error) {
 		toSerialize["extra"] = o.Extra
 	}
 	toSerialize["movements"] = o.Movements
+	if !IsNil(o.Repeat) {
+		toSerialize["repeat"] = o.Repeat
+	}
+	if !IsNil(o.NextDate) {
+		toSerialize["nextDate"] = o.NextDate
+	}
 	return toSerialize, nil
 }
 
@@ -28,6 +28,20 @@ type User struct {
 	StartDate time.Time `json:"startDate"`
 	// ID of the user's favorite currency. By default this currency will be used to convert other currencies.
 	FavoriteCurrencyId *string `json:"favoriteCurrencyId,omitempty"`
+	// ID of the account money is taken from when a quick entry text doesn't specify one.
+	QuickEntryAccountId *string `json:"quickEntryAccountId,omitempty"`
+	// Day of month on which monthly reports and budgets start, for example the salary day. 1 means calendar months. Shared by all users of the family.
+	MonthStartDay *int32 `json:"monthStartDay,omitempty"`
+	// How unusual spending has to be to notify about it. Empty means medium. Shared by all users of the family.
+	AnomalySensitivity *string `json:"anomalySensitivity,omitempty"`
+	// How overspent budgets are handled. \"rollover\" (the default when empty) carries the overspending to the next period of the envelope, \"reset\" covers it from ready to assign. Shared by all users of the family.
+	BudgetOverspending *string `json:"budgetOverspending,omitempty"`
+	// Accounts which are never reported as spending anomalies. Shared by all users of the family.
+	AnomalyMutedAccountIds []string `json:"anomalyMutedAccountIds,omitempty"`
+	// Exchange rate providers asked in order until one knows both currencies of a conversion. Empty means only the Czech National Bank. Manual rates are always preferred to providers, so \"manual\" may be omitted. Shared by all users of the family.
+	RateProviders []string `json:"rateProviders,omitempty"`
+	// Currency the manual exchange rates are expressed in and conversions fall back to when no provider knows both currencies. Empty means CZK. Shared by all users of the family.
+	RateBaseCurrencyId *string `json:"rateBaseCurrencyId,omitempty"`
 }
 
 type _User User
@@ -156,6 +170,230 @@ func (o *User) SetFavoriteCurrencyId(v string) {
 	o.FavoriteCurrencyId = &v
 }
 
+// GetQuickEntryAccountId returns the QuickEntryAccountId field value if set, zero value otherwise.
+func (o *User) GetQuickEntryAccountId() string {
+	if o == nil || IsNil(o.QuickEntryAccountId) {
+		var ret string
+		return ret
+	}
+	return *o.QuickEntryAccountId
+}
+
+// GetQuickEntryAccountIdOk returns a tuple with the QuickEntryAccountId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *User) GetQuickEntryAccountIdOk() (*string, bool) {
+	if o == nil || IsNil(o.QuickEntryAccountId) {
+		return nil, false
+	}
+	return o.QuickEntryAccountId, true
+}
+
+// HasQuickEntryAccountId returns a boolean if a field has been set.
+func (o *User) HasQuickEntryAccountId() bool {
+	if o != nil && !IsNil(o.QuickEntryAccountId) {
+		return true
+	}
+
+	return false
+}
+
+// SetQuickEntryAccountId gets a reference to the given string and assigns it to the QuickEntryAccountId field.
+func (o *User) SetQuickEntryAccountId(v string) {
+	o.QuickEntryAccountId = &v
+}
+
+// GetMonthStartDay returns the MonthStartDay field value if set, zero value otherwise.
+func (o *User) GetMonthStartDay() int32 {
+	if o == nil || IsNil(o.MonthStartDay) {
+		var ret int32
+		return ret
+	}
+	return *o.MonthStartDay
+}
+
+// GetMonthStartDayOk returns a tuple with the MonthStartDay field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *User) GetMonthStartDayOk() (*int32, bool) {
+	if o == nil || IsNil(o.MonthStartDay) {
+		return nil, false
+	}
+	return o.MonthStartDay, true
+}
+
+// HasMonthStartDay returns a boolean if a field has been set.
+func (o *User) HasMonthStartDay() bool {
+	if o != nil && !IsNil(o.MonthStartDay) {
+		return true
+	}
+
+	return false
+}
+
+// SetMonthStartDay gets a reference to the given int32 and assigns it to the MonthStartDay field.
+func (o *User) SetMonthStartDay(v int32) {
+	o.MonthStartDay = &v
+}
+
+// GetAnomalySensitivity returns the AnomalySensitivity field value if set, zero value otherwise.
+func (o *User) GetAnomalySensitivity() string {
+	if o == nil || IsNil(o.AnomalySensitivity) {
+		var ret string
+		return ret
+	}
+	return *o.AnomalySensitivity
+}
+
+// GetAnomalySensitivityOk returns a tuple with the AnomalySensitivity field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *User) GetAnomalySensitivityOk() (*string, bool) {
+	if o == nil || IsNil(o.AnomalySensitivity) {
+		return nil, false
+	}
+	return o.AnomalySensitivity, true
+}
+
+// HasAnomalySensitivity returns a boolean if a field has been set.
+func (o *User) HasAnomalySensitivity() bool {
+	if o != nil && !IsNil(o.AnomalySensitivity) {
+		return true
+	}
+
+	return false
+}
+
+// SetAnomalySensitivity gets a reference to the given string and assigns it to the AnomalySensitivity field.
+func (o *User) SetAnomalySensitivity(v string) {
+	o.AnomalySensitivity = &v
+}
+
+// GetBudgetOverspending returns the BudgetOverspending field value if set, zero value otherwise.
+func (o *User) GetBudgetOverspending() string {
+	if o == nil || IsNil(o.BudgetOverspending) {
+		var ret string
+		return ret
+	}
+	return *o.BudgetOverspending
+}
+
+// GetBudgetOverspendingOk returns a tuple with the BudgetOverspending field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *User) GetBudgetOverspendingOk() (*string, bool) {
+	if o == nil || IsNil(o.BudgetOverspending) {
+		return nil, false
+	}
+	return o.BudgetOverspending, true
+}
+
+// HasBudgetOverspending returns a boolean if a field has been set.
+func (o *User) HasBudgetOverspending() bool {
+	if o != nil && !IsNil(o.BudgetOverspending) {
+		return true
+	}
+
+	return false
+}
+
+// SetBudgetOverspending gets a reference to the given string and assigns it to the BudgetOverspending field.
+func (o *User) SetBudgetOverspending(v string) {
+	o.BudgetOverspending = &v
+}
+
+// GetAnomalyMutedAccountIds returns the AnomalyMutedAccountIds field value if set, zero value otherwise.
+func (o *User) GetAnomalyMutedAccountIds() []string {
+	if o == nil || IsNil(o.AnomalyMutedAccountIds) {
+		var ret []string
+		return ret
+	}
+	return o.AnomalyMutedAccountIds
+}
+
+// GetAnomalyMutedAccountIdsOk returns a tuple with the AnomalyMutedAccountIds field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *User) GetAnomalyMutedAccountIdsOk() ([]string, bool) {
+	if o == nil || IsNil(o.AnomalyMutedAccountIds) {
+		return nil, false
+	}
+	return o.AnomalyMutedAccountIds, true
+}
+
+// HasAnomalyMutedAccountIds returns a boolean if a field has been set.
+func (o *User) HasAnomalyMutedAccountIds() bool {
+	if o != nil && !IsNil(o.AnomalyMutedAccountIds) {
+		return true
+	}
+
+	return false
+}
+
+// SetAnomalyMutedAccountIds gets a reference to the given []string and assigns it to the AnomalyMutedAccountIds field.
+func (o *User) SetAnomalyMutedAccountIds(v []string) {
+	o.AnomalyMutedAccountIds = v
+}
+
+// GetRateProviders returns the RateProviders field value if set, zero value otherwise.
+func (o *User) GetRateProviders() []string {
+	if o == nil || IsNil(o.RateProviders) {
+		var ret []string
+		return ret
+	}
+	return o.RateProviders
+}
+
+// GetRateProvidersOk returns a tuple with the RateProviders field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *User) GetRateProvidersOk() ([]string, bool) {
+	if o == nil || IsNil(o.RateProviders) {
+		return nil, false
+	}
+	return o.RateProviders, true
+}
+
+// HasRateProviders returns a boolean if a field has been set.
+func (o *User) HasRateProviders() bool {
+	if o != nil && !IsNil(o.RateProviders) {
+		return true
+	}
+
+	return false
+}
+
+// SetRateProviders gets a reference to the given []string and assigns it to the RateProviders field.
+func (o *User) SetRateProviders(v []string) {
+	o.RateProviders = v
+}
+
+// GetRateBaseCurrencyId returns the RateBaseCurrencyId field value if set, zero value otherwise.
+func (o *User) GetRateBaseCurrencyId() string {
+	if o == nil || IsNil(o.RateBaseCurrencyId) {
+		var ret string
+		return ret
+	}
+	return *o.RateBaseCurrencyId
+}
+
+// GetRateBaseCurrencyIdOk returns a tuple with the RateBaseCurrencyId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *User) GetRateBaseCurrencyIdOk() (*string, bool) {
+	if o == nil || IsNil(o.RateBaseCurrencyId) {
+		return nil, false
+	}
+	return o.RateBaseCurrencyId, true
+}
+
+// HasRateBaseCurrencyId returns a boolean if a field has been set.
+func (o *User) HasRateBaseCurrencyId() bool {
+	if o != nil && !IsNil(o.RateBaseCurrencyId) {
+		return true
+	}
+
+	return false
+}
+
+// SetRateBaseCurrencyId gets a reference to the given string and assigns it to the RateBaseCurrencyId field.
+func (o *User) SetRateBaseCurrencyId(v string) {
+	o.RateBaseCurrencyId = &v
+}
+
 func (o User) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -172,6 +410,27 @@ func (o User) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.FavoriteCurrencyId) {
 		toSerialize["favoriteCurrencyId"] = o.FavoriteCurrencyId
 	}
+	if !IsNil(o.QuickEntryAccountId) {
+		toSerialize["quickEntryAccountId"] = o.QuickEntryAccountId
+	}
+	if !IsNil(o.MonthStartDay) {
+		toSerialize["monthStartDay"] = o.MonthStartDay
+	}
+	if !IsNil(o.AnomalySensitivity) {
+		toSerialize["anomalySensitivity"] = o.AnomalySensitivity
+	}
+	if !IsNil(o.BudgetOverspending) {
+		toSerialize["budgetOverspending"] = o.BudgetOverspending
+	}
+	if !IsNil(o.AnomalyMutedAccountIds) {
+		toSerialize["anomalyMutedAccountIds"] = o.AnomalyMutedAccountIds
+	}
+	if !IsNil(o.RateProviders) {
+		toSerialize["rateProviders"] = o.RateProviders
+	}
+	if !IsNil(o.RateBaseCurrencyId) {
+		toSerialize["rateBaseCurrencyId"] = o.RateBaseCurrencyId
+	}
 	return toSerialize, nil
 }
 
@@ -22,6 +22,20 @@ var _ MappedNullable = &UserPatchBody{}
 type UserPatchBody struct {
 	// ID of the user's favorite currency. By default this currency will be used to convert other currencies.
 	FavoriteCurrencyId *string `json:"favoriteCurrencyId,omitempty"`
+	// ID of the default account for quick entry, an asset account of the family. Left unchanged when omitted, empty string clears it.
+	QuickEntryAccountId NullableString `json:"quickEntryAccountId,omitempty"`
+	// Day of month on which monthly reports and budgets start, between 1 and 28. Shared by all users of the family. Left unchanged when omitted.
+	MonthStartDay NullableInt32 `json:"monthStartDay,omitempty"`
+	// How unusual spending has to be to notify about it. Left unchanged when omitted.
+	AnomalySensitivity NullableString `json:"anomalySensitivity,omitempty"`
+	// How overspent budgets are handled. Left unchanged when omitted.
+	BudgetOverspending NullableString `json:"budgetOverspending,omitempty"`
+	// Accounts of the family which are never reported as spending anomalies. Left unchanged when omitted.
+	AnomalyMutedAccountIds []string `json:"anomalyMutedAccountIds,omitempty"`
+	// Exchange rate providers asked in order. Left unchanged when omitted.
+	RateProviders []string `json:"rateProviders,omitempty"`
+	// Currency of the family the manual exchange rates are expressed in. Left unchanged when omitted, empty string means CZK.
+	RateBaseCurrencyId NullableString `json:"rateBaseCurrencyId,omitempty"`
 }
 
 // NewUserPatchBody instantiates a new UserPatchBody object
@@ -73,6 +87,287 @@ func (o *UserPatchBody) SetFavoriteCurrencyId(v string) {
 	o.FavoriteCurrencyId = &v
 }
 
+// GetQuickEntryAccountId returns the QuickEntryAccountId field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *UserPatchBody) GetQuickEntryAccountId() string {
+	if o == nil || IsNil(o.QuickEntryAccountId.Get()) {
+		var ret string
+		return ret
+	}
+	return *o.QuickEntryAccountId.Get()
+}
+
+// GetQuickEntryAccountIdOk returns a tuple with the QuickEntryAccountId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *UserPatchBody) GetQuickEntryAccountIdOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return o.QuickEntryAccountId.Get(), o.QuickEntryAccountId.IsSet()
+}
+
+// HasQuickEntryAccountId returns a boolean if a field has been set.
+func (o *UserPatchBody) HasQuickEntryAccountId() bool {
+	if o != nil && o.QuickEntryAccountId.IsSet() {
+		return true
+	}
+
+	return false
+}
+
+// SetQuickEntryAccountId gets a reference to the given NullableString and assigns it to the QuickEntryAccountId field.
+func (o *UserPatchBody) SetQuickEntryAccountId(v string) {
+	o.QuickEntryAccountId.Set(&v)
+}
+
+// SetQuickEntryAccountIdNil sets the value for QuickEntryAccountId to be an explicit nil
+func (o *UserPatchBody) SetQuickEntryAccountIdNil() {
+	o.QuickEntryAccountId.Set(nil)
+}
+
+// UnsetQuickEntryAccountId ensures that no value is present for QuickEntryAccountId, not even an explicit nil
+func (o *UserPatchBody) UnsetQuickEntryAccountId() {
+	o.QuickEntryAccountId.Unset()
+}
+
+// GetMonthStartDay returns the MonthStartDay field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *UserPatchBody) GetMonthStartDay() int32 {
+	if o == nil || IsNil(o.MonthStartDay.Get()) {
+		var ret int32
+		return ret
+	}
+	return *o.MonthStartDay.Get()
+}
+
+// GetMonthStartDayOk returns a tuple with the MonthStartDay field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *UserPatchBody) GetMonthStartDayOk() (*int32, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return o.MonthStartDay.Get(), o.MonthStartDay.IsSet()
+}
+
+// HasMonthStartDay returns a boolean if a field has been set.
+func (o *UserPatchBody) HasMonthStartDay() bool {
+	if o != nil && o.MonthStartDay.IsSet() {
+		return true
+	}
+
+	return false
+}
+
+// SetMonthStartDay gets a reference to the given NullableInt32 and assigns it to the MonthStartDay field.
+func (o *UserPatchBody) SetMonthStartDay(v int32) {
+	o.MonthStartDay.Set(&v)
+}
+
+// SetMonthStartDayNil sets the value for MonthStartDay to be an explicit nil
+func (o *UserPatchBody) SetMonthStartDayNil() {
+	o.MonthStartDay.Set(nil)
+}
+
+// UnsetMonthStartDay ensures that no value is present for MonthStartDay, not even an explicit nil
+func (o *UserPatchBody) UnsetMonthStartDay() {
+	o.MonthStartDay.Unset()
+}
+
+// GetAnomalySensitivity returns the AnomalySensitivity field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *UserPatchBody) GetAnomalySensitivity() string {
+	if o == nil || IsNil(o.AnomalySensitivity.Get()) {
+		var ret string
+		return ret
+	}
+	return *o.AnomalySensitivity.Get()
+}
+
+// GetAnomalySensitivityOk returns a tuple with the AnomalySensitivity field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *UserPatchBody) GetAnomalySensitivityOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return o.AnomalySensitivity.Get(), o.AnomalySensitivity.IsSet()
+}
+
+// HasAnomalySensitivity returns a boolean if a field has been set.
+func (o *UserPatchBody) HasAnomalySensitivity() bool {
+	if o != nil && o.AnomalySensitivity.IsSet() {
+		return true
+	}
+
+	return false
+}
+
+// SetAnomalySensitivity gets a reference to the given NullableString and assigns it to the AnomalySensitivity field.
+func (o *UserPatchBody) SetAnomalySensitivity(v string) {
+	o.AnomalySensitivity.Set(&v)
+}
+
+// SetAnomalySensitivityNil sets the value for AnomalySensitivity to be an explicit nil
+func (o *UserPatchBody) SetAnomalySensitivityNil() {
+	o.AnomalySensitivity.Set(nil)
+}
+
+// UnsetAnomalySensitivity ensures that no value is present for AnomalySensitivity, not even an explicit nil
+func (o *UserPatchBody) UnsetAnomalySensitivity() {
+	o.AnomalySensitivity.Unset()
+}
+
+// GetBudgetOverspending returns the BudgetOverspending field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *UserPatchBody) GetBudgetOverspending() string {
+	if o == nil || IsNil(o.BudgetOverspending.Get()) {
+		var ret string
+		return ret
+	}
+	return *o.BudgetOverspending.Get()
+}
+
+// GetBudgetOverspendingOk returns a tuple with the BudgetOverspending field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *UserPatchBody) GetBudgetOverspendingOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return o.BudgetOverspending.Get(), o.BudgetOverspending.IsSet()
+}
+
+// HasBudgetOverspending returns a boolean if a field has been set.
+func (o *UserPatchBody) HasBudgetOverspending() bool {
+	if o != nil && o.BudgetOverspending.IsSet() {
+		return true
+	}
+
+	return false
+}
+
+// SetBudgetOverspending gets a reference to the given NullableString and assigns it to the BudgetOverspending field.
+func (o *UserPatchBody) SetBudgetOverspending(v string) {
+	o.BudgetOverspending.Set(&v)
+}
+
+// SetBudgetOverspendingNil sets the value for BudgetOverspending to be an explicit nil
+func (o *UserPatchBody) SetBudgetOverspendingNil() {
+	o.BudgetOverspending.Set(nil)
+}
+
+// UnsetBudgetOverspending ensures that no value is present for BudgetOverspending, not even an explicit nil
+func (o *UserPatchBody) UnsetBudgetOverspending() {
+	o.BudgetOverspending.Unset()
+}
+
+// GetAnomalyMutedAccountIds returns the AnomalyMutedAccountIds field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *UserPatchBody) GetAnomalyMutedAccountIds() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.AnomalyMutedAccountIds
+}
+
+// GetAnomalyMutedAccountIdsOk returns a tuple with the AnomalyMutedAccountIds field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *UserPatchBody) GetAnomalyMutedAccountIdsOk() ([]string, bool) {
+	if o == nil || IsNil(o.AnomalyMutedAccountIds) {
+		return nil, false
+	}
+	return o.AnomalyMutedAccountIds, true
+}
+
+// HasAnomalyMutedAccountIds returns a boolean if a field has been set.
+func (o *UserPatchBody) HasAnomalyMutedAccountIds() bool {
+	if o != nil && !IsNil(o.AnomalyMutedAccountIds) {
+		return true
+	}
+
+	return false
+}
+
+// SetAnomalyMutedAccountIds gets a reference to the given []string and assigns it to the AnomalyMutedAccountIds field.
+func (o *UserPatchBody) SetAnomalyMutedAccountIds(v []string) {
+	o.AnomalyMutedAccountIds = v
+}
+
+// GetRateProviders returns the RateProviders field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *UserPatchBody) GetRateProviders() []string {
+	if o == nil {
+		var ret []string
+		return ret
+	}
+	return o.RateProviders
+}
+
+// GetRateProvidersOk returns a tuple with the RateProviders field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *UserPatchBody) GetRateProvidersOk() ([]string, bool) {
+	if o == nil || IsNil(o.RateProviders) {
+		return nil, false
+	}
+	return o.RateProviders, true
+}
+
+// HasRateProviders returns a boolean if a field has been set.
+func (o *UserPatchBody) HasRateProviders() bool {
+	if o != nil && !IsNil(o.RateProviders) {
+		return true
+	}
+
+	return false
+}
+
+// SetRateProviders gets a reference to the given []string and assigns it to the RateProviders field.
+func (o *UserPatchBody) SetRateProviders(v []string) {
+	o.RateProviders = v
+}
+
+// GetRateBaseCurrencyId returns the RateBaseCurrencyId field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *UserPatchBody) GetRateBaseCurrencyId() string {
+	if o == nil || IsNil(o.RateBaseCurrencyId.Get()) {
+		var ret string
+		return ret
+	}
+	return *o.RateBaseCurrencyId.Get()
+}
+
+// GetRateBaseCurrencyIdOk returns a tuple with the RateBaseCurrencyId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *UserPatchBody) GetRateBaseCurrencyIdOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return o.RateBaseCurrencyId.Get(), o.RateBaseCurrencyId.IsSet()
+}
+
+// HasRateBaseCurrencyId returns a boolean if a field has been set.
+func (o *UserPatchBody) HasRateBaseCurrencyId() bool {
+	if o != nil && o.RateBaseCurrencyId.IsSet() {
+		return true
+	}
+
+	return false
+}
+
+// SetRateBaseCurrencyId gets a reference to the given NullableString and assigns it to the RateBaseCurrencyId field.
+func (o *UserPatchBody) SetRateBaseCurrencyId(v string) {
+	o.RateBaseCurrencyId.Set(&v)
+}
+
+// SetRateBaseCurrencyIdNil sets the value for RateBaseCurrencyId to be an explicit nil
+func (o *UserPatchBody) SetRateBaseCurrencyIdNil() {
+	o.RateBaseCurrencyId.Set(nil)
+}
+
+// UnsetRateBaseCurrencyId ensures that no value is present for RateBaseCurrencyId, not even an explicit nil
+func (o *UserPatchBody) UnsetRateBaseCurrencyId() {
+	o.RateBaseCurrencyId.Unset()
+}
+
 func (o UserPatchBody) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -86,6 +381,27 @@ func (o UserPatchBody) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.FavoriteCurrencyId) {
 		toSerialize["favoriteCurrencyId"] = o.FavoriteCurrencyId
 	}
+	if o.QuickEntryAccountId.IsSet() {
+		toSerialize["quickEntryAccountId"] = o.QuickEntryAccountId.Get()
+	}
+	if o.MonthStartDay.IsSet() {
+		toSerialize["monthStartDay"] = o.MonthStartDay.Get()
+	}
+	if o.AnomalySensitivity.IsSet() {
+		toSerialize["anomalySensitivity"] = o.AnomalySensitivity.Get()
+	}
+	if o.BudgetOverspending.IsSet() {
+		toSerialize["budgetOverspending"] = o.BudgetOverspending.Get()
+	}
+	if o.AnomalyMutedAccountIds != nil {
+		toSerialize["anomalyMutedAccountIds"] = o.AnomalyMutedAccountIds
+	}
+	if o.RateProviders != nil {
+		toSerialize["rateProviders"] = o.RateProviders
+	}
+	if o.RateBaseCurrencyId.IsSet() {
+		toSerialize["rateBaseCurrencyId"] = o.RateBaseCurrencyId.Get()
+	}
 	return toSerialize, nil
 }
 
@@ -25,6 +25,7 @@ import (
 // pass the data to a AccountsAPIServicer to perform the required actions, then write the service results to the http response.
 type AccountsAPIRouter interface {
 	GetAccountHistory(http.ResponseWriter, *http.Request)
+	GetAccountAmortization(http.ResponseWriter, *http.Request)
 	GetAccounts(http.ResponseWriter, *http.Request)
 	CreateAccount(http.ResponseWriter, *http.Request)
 	GetAccount(http.ResponseWriter, *http.Request)
@@ -39,6 +40,10 @@ type AccountsAPIRouter interface {
 // pass the data to a AggregationsAPIServicer to perform the required actions, then write the service results to the http response.
 type AggregationsAPIRouter interface {
 	GetBalances(http.ResponseWriter, *http.Request)
+	GetCashFlow(http.ResponseWriter, *http.Request)
+	GetNetWorth(http.ResponseWriter, *http.Request)
+	GetFlows(http.ResponseWriter, *http.Request)
+	GetPartners(http.ResponseWriter, *http.Request)
 	GetExpenses(http.ResponseWriter, *http.Request)
 	GetIncomes(http.ResponseWriter, *http.Request)
 }
@@ -78,6 +83,18 @@ type BankImportersAPIRouter interface {
 type BudgetItemsAPIRouter interface {
 	GetBudgetItems(http.ResponseWriter, *http.Request)
 	CreateBudgetItem(http.ResponseWriter, *http.Request)
+	GetBudgetPlans(http.ResponseWriter, *http.Request)
+	CreateBudgetPlan(http.ResponseWriter, *http.Request)
+	GetBudgetPlan(http.ResponseWriter, *http.Request)
+	UpdateBudgetPlan(http.ResponseWriter, *http.Request)
+	DeleteBudgetPlan(http.ResponseWriter, *http.Request)
+	CopyBudget(http.ResponseWriter, *http.Request)
+	GetBudgetTransfers(http.ResponseWriter, *http.Request)
+	CreateBudgetTransfer(http.ResponseWriter, *http.Request)
+	GetBudgetTransfer(http.ResponseWriter, *http.Request)
+	UpdateBudgetTransfer(http.ResponseWriter, *http.Request)
+	DeleteBudgetTransfer(http.ResponseWriter, *http.Request)
+	GetReadyToAssign(http.ResponseWriter, *http.Request)
 	GetBudgetStatus(http.ResponseWriter, *http.Request)
 	GetBudgetItem(http.ResponseWriter, *http.Request)
 	UpdateBudgetItem(http.ResponseWriter, *http.Request)
@@ -92,6 +109,12 @@ type CurrenciesAPIRouter interface {
 	CreateCurrency(http.ResponseWriter, *http.Request)
 	UpdateCurrency(http.ResponseWriter, *http.Request)
 	DeleteCurrency(http.ResponseWriter, *http.Request)
+	GetExchangeRates(http.ResponseWriter, *http.Request)
+	CreateExchangeRate(http.ResponseWriter, *http.Request)
+	GetExchangeRate(http.ResponseWriter, *http.Request)
+	UpdateExchangeRate(http.ResponseWriter, *http.Request)
+	DeleteExchangeRate(http.ResponseWriter, *http.Request)
+	GetExchangeRateHistory(http.ResponseWriter, *http.Request)
 }
 
 // ExportAPIRouter defines the required methods for binding the api requests to a responses for the ExportAPI
@@ -101,6 +124,26 @@ type ExportAPIRouter interface {
 	Export(http.ResponseWriter, *http.Request)
 }
 
+// ForecastAPIRouter defines the required methods for binding the api requests to a responses for the ForecastAPI
+// The ForecastAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a ForecastAPIServicer to perform the required actions, then write the service results to the http response.
+type ForecastAPIRouter interface {
+	GetBalanceForecast(http.ResponseWriter, *http.Request)
+}
+
+// GoalsAPIRouter defines the required methods for binding the api requests to a responses for the GoalsAPI
+// The GoalsAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a GoalsAPIServicer to perform the required actions, then write the service results to the http response.
+type GoalsAPIRouter interface {
+	GetGoals(http.ResponseWriter, *http.Request)
+	CreateGoal(http.ResponseWriter, *http.Request)
+	GetGoalsProgress(http.ResponseWriter, *http.Request)
+	GetGoal(http.ResponseWriter, *http.Request)
+	UpdateGoal(http.ResponseWriter, *http.Request)
+	DeleteGoal(http.ResponseWriter, *http.Request)
+	GetGoalProgress(http.ResponseWriter, *http.Request)
+}
+
 // ImportAPIRouter defines the required methods for binding the api requests to a responses for the ImportAPI
 // The ImportAPIRouter implementation should parse necessary information from the http request,
 // pass the data to a ImportAPIServicer to perform the required actions, then write the service results to the http response.
@@ -152,6 +195,31 @@ type ReconciliationAPIRouter interface {
 	AnalyzeDisbalance(http.ResponseWriter, *http.Request)
 }
 
+// SecuritiesAPIRouter defines the required methods for binding the api requests to a responses for the SecuritiesAPI
+// The SecuritiesAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a SecuritiesAPIServicer to perform the required actions, then write the service results to the http response.
+type SecuritiesAPIRouter interface {
+	GetSecurities(http.ResponseWriter, *http.Request)
+	CreateSecurity(http.ResponseWriter, *http.Request)
+	GetSecurity(http.ResponseWriter, *http.Request)
+	UpdateSecurity(http.ResponseWriter, *http.Request)
+	DeleteSecurity(http.ResponseWriter, *http.Request)
+	GetSecurityPrices(http.ResponseWriter, *http.Request)
+	SetSecurityPrice(http.ResponseWriter, *http.Request)
+	UploadSecurityPrices(http.ResponseWriter, *http.Request)
+	GetHoldings(http.ResponseWriter, *http.Request)
+}
+
+// TagsAPIRouter defines the required methods for binding the api requests to a responses for the TagsAPI
+// The TagsAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a TagsAPIServicer to perform the required actions, then write the service results to the http response.
+type TagsAPIRouter interface {
+	GetTags(http.ResponseWriter, *http.Request)
+	RenameTag(http.ResponseWriter, *http.Request)
+	MergeTags(http.ResponseWriter, *http.Request)
+	GetTagAggregation(http.ResponseWriter, *http.Request)
+}
+
 // TemplatesAPIRouter defines the required methods for binding the api requests to a responses for the TemplatesAPI
 // The TemplatesAPIRouter implementation should parse necessary information from the http request,
 // pass the data to a TemplatesAPIServicer to perform the required actions, then write the service results to the http response.
@@ -172,9 +240,22 @@ type TransactionsAPIRouter interface {
 	GetTransaction(http.ResponseWriter, *http.Request)
 	UpdateTransaction(http.ResponseWriter, *http.Request)
 	DeleteTransaction(http.ResponseWriter, *http.Request)
+	GetRefundCandidates(http.ResponseWriter, *http.Request)
+	LinkRefund(http.ResponseWriter, *http.Request)
+	UnlinkRefund(http.ResponseWriter, *http.Request)
 	MergeTransactions(http.ResponseWriter, *http.Request)
 }
 
+// TransfersAPIRouter defines the required methods for binding the api requests to a responses for the TransfersAPI
+// The TransfersAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a TransfersAPIServicer to perform the required actions, then write the service results to the http response.
+type TransfersAPIRouter interface {
+	GetTransferCandidates(http.ResponseWriter, *http.Request)
+	ConfirmTransfer(http.ResponseWriter, *http.Request)
+	GetTransferRules(http.ResponseWriter, *http.Request)
+	DeleteTransferRule(http.ResponseWriter, *http.Request)
+}
+
 // UnprocessedTransactionsAPIRouter defines the required methods for binding the api requests to a responses for the UnprocessedTransactionsAPI
 // The UnprocessedTransactionsAPIRouter implementation should parse necessary information from the http request,
 // pass the data to a UnprocessedTransactionsAPIServicer to perform the required actions, then write the service results to the http response.
@@ -198,6 +279,7 @@ type UserAPIRouter interface {
 // and updated with the logic required for the API.
 type AccountsAPIServicer interface {
 	GetAccountHistory(context.Context, string) (ImplResponse, error)
+	GetAccountAmortization(context.Context, string) (ImplResponse, error)
 	GetAccounts(context.Context) (ImplResponse, error)
 	CreateAccount(context.Context, AccountNoId) (ImplResponse, error)
 	GetAccount(context.Context, string) (ImplResponse, error)
@@ -212,9 +294,13 @@ type AccountsAPIServicer interface {
 // while the service implementation can be ignored with the .openapi-generator-ignore file
 // and updated with the logic required for the API.
 type AggregationsAPIServicer interface {
-	GetBalances(context.Context, time.Time, time.Time, string, bool) (ImplResponse, error)
-	GetExpenses(context.Context, time.Time, time.Time, string, string, bool, string, []string, []string) (ImplResponse, error)
-	GetIncomes(context.Context, time.Time, time.Time, string, bool) (ImplResponse, error)
+	GetBalances(context.Context, time.Time, time.Time, string, bool, int32) (ImplResponse, error)
+	GetCashFlow(context.Context, time.Time, time.Time, string, string, bool) (ImplResponse, error)
+	GetNetWorth(context.Context, time.Time, time.Time, string, string, bool) (ImplResponse, error)
+	GetFlows(context.Context, time.Time, time.Time, string, bool) (ImplResponse, error)
+	GetPartners(context.Context, time.Time, time.Time, string, string, int32) (ImplResponse, error)
+	GetExpenses(context.Context, time.Time, time.Time, string, string, bool, string, []string, []string, int32) (ImplResponse, error)
+	GetIncomes(context.Context, time.Time, time.Time, string, bool, int32) (ImplResponse, error)
 }
 
 // AuditLogsAPIServicer defines the api actions for the AuditLogsAPI service
@@ -256,7 +342,19 @@ type BankImportersAPIServicer interface {
 type BudgetItemsAPIServicer interface {
 	GetBudgetItems(context.Context) (ImplResponse, error)
 	CreateBudgetItem(context.Context, BudgetItemNoId) (ImplResponse, error)
-	GetBudgetStatus(context.Context, time.Time, time.Time, string, bool) (ImplResponse, error)
+	GetBudgetPlans(context.Context) (ImplResponse, error)
+	CreateBudgetPlan(context.Context, BudgetPlanNoId) (ImplResponse, error)
+	GetBudgetPlan(context.Context, string) (ImplResponse, error)
+	UpdateBudgetPlan(context.Context, string, BudgetPlanNoId) (ImplResponse, error)
+	DeleteBudgetPlan(context.Context, string) (ImplResponse, error)
+	CopyBudget(context.Context, time.Time) (ImplResponse, error)
+	GetBudgetTransfers(context.Context) (ImplResponse, error)
+	CreateBudgetTransfer(context.Context, BudgetTransferNoId) (ImplResponse, error)
+	GetBudgetTransfer(context.Context, string) (ImplResponse, error)
+	UpdateBudgetTransfer(context.Context, string, BudgetTransferNoId) (ImplResponse, error)
+	DeleteBudgetTransfer(context.Context, string) (ImplResponse, error)
+	GetReadyToAssign(context.Context, time.Time, time.Time, string, string, bool) (ImplResponse, error)
+	GetBudgetStatus(context.Context, time.Time, time.Time, string, string, bool, int32) (ImplResponse, error)
 	GetBudgetItem(context.Context, string) (ImplResponse, error)
 	UpdateBudgetItem(context.Context, string, BudgetItemNoId) (ImplResponse, error)
 	DeleteBudgetItem(context.Context, string) (ImplResponse, error)
@@ -271,6 +369,12 @@ type CurrenciesAPIServicer interface {
 	CreateCurrency(context.Context, CurrencyNoId) (ImplResponse, error)
 	UpdateCurrency(context.Context, string, CurrencyNoId) (ImplResponse, error)
 	DeleteCurrency(context.Context, string, string) (ImplResponse, error)
+	GetExchangeRates(context.Context) (ImplResponse, error)
+	CreateExchangeRate(context.Context, ExchangeRateNoId) (ImplResponse, error)
+	GetExchangeRate(context.Context, string) (ImplResponse, error)
+	UpdateExchangeRate(context.Context, string, ExchangeRateNoId) (ImplResponse, error)
+	DeleteExchangeRate(context.Context, string) (ImplResponse, error)
+	GetExchangeRateHistory(context.Context, string, string, time.Time, time.Time) (ImplResponse, error)
 }
 
 // ExportAPIServicer defines the api actions for the ExportAPI service
@@ -281,6 +385,28 @@ type ExportAPIServicer interface {
 	Export(context.Context) (ImplResponse, error)
 }
 
+// ForecastAPIServicer defines the api actions for the ForecastAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type ForecastAPIServicer interface {
+	GetBalanceForecast(context.Context, int32, int64, string, bool) (ImplResponse, error)
+}
+
+// GoalsAPIServicer defines the api actions for the GoalsAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type GoalsAPIServicer interface {
+	GetGoals(context.Context) (ImplResponse, error)
+	CreateGoal(context.Context, GoalNoId) (ImplResponse, error)
+	GetGoalsProgress(context.Context, time.Time) (ImplResponse, error)
+	GetGoal(context.Context, string) (ImplResponse, error)
+	UpdateGoal(context.Context, string, GoalNoId) (ImplResponse, error)
+	DeleteGoal(context.Context, string) (ImplResponse, error)
+	GetGoalProgress(context.Context, string, time.Time) (ImplResponse, error)
+}
+
 // ImportAPIServicer defines the api actions for the ImportAPI service
 // This interface intended to stay up to date with the openapi yaml used to generate it,
 // while the service implementation can be ignored with the .openapi-generator-ignore file
@@ -337,6 +463,33 @@ type ReconciliationAPIServicer interface {
 	AnalyzeDisbalance(context.Context, string, AnalyzeDisbalanceRequest) (ImplResponse, error)
 }
 
+// SecuritiesAPIServicer defines the api actions for the SecuritiesAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type SecuritiesAPIServicer interface {
+	GetSecurities(context.Context) (ImplResponse, error)
+	CreateSecurity(context.Context, SecurityNoId) (ImplResponse, error)
+	GetSecurity(context.Context, string) (ImplResponse, error)
+	UpdateSecurity(context.Context, string, SecurityNoId) (ImplResponse, error)
+	DeleteSecurity(context.Context, string) (ImplResponse, error)
+	GetSecurityPrices(context.Context, string, time.Time, time.Time) (ImplResponse, error)
+	SetSecurityPrice(context.Context, string, SecurityPrice) (ImplResponse, error)
+	UploadSecurityPrices(context.Context, string, *os.File) (ImplResponse, error)
+	GetHoldings(context.Context, time.Time, string) (ImplResponse, error)
+}
+
+// TagsAPIServicer defines the api actions for the TagsAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type TagsAPIServicer interface {
+	GetTags(context.Context) (ImplResponse, error)
+	RenameTag(context.Context, TagRename) (ImplResponse, error)
+	MergeTags(context.Context, TagMerge) (ImplResponse, error)
+	GetTagAggregation(context.Context, time.Time, time.Time, string, string, string, []string, int32, bool) (ImplResponse, error)
+}
+
 // TemplatesAPIServicer defines the api actions for the TemplatesAPI service
 // This interface intended to stay up to date with the openapi yaml used to generate it,
 // while the service implementation can be ignored with the .openapi-generator-ignore file
@@ -359,9 +512,23 @@ type TransactionsAPIServicer interface {
 	GetTransaction(context.Context, string) (ImplResponse, error)
 	UpdateTransaction(context.Context, string, TransactionNoId) (ImplResponse, error)
 	DeleteTransaction(context.Context, string) (ImplResponse, error)
+	GetRefundCandidates(context.Context, string) (ImplResponse, error)
+	LinkRefund(context.Context, string, LinkRefundRequest) (ImplResponse, error)
+	UnlinkRefund(context.Context, string) (ImplResponse, error)
 	MergeTransactions(context.Context, MergeTransactionsRequest) (ImplResponse, error)
 }
 
+// TransfersAPIServicer defines the api actions for the TransfersAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type TransfersAPIServicer interface {
+	GetTransferCandidates(context.Context, time.Time, time.Time) (ImplResponse, error)
+	ConfirmTransfer(context.Context, ConfirmTransferRequest) (ImplResponse, error)
+	GetTransferRules(context.Context) (ImplResponse, error)
+	DeleteTransferRule(context.Context, string) (ImplResponse, error)
+}
+
 // UnprocessedTransactionsAPIServicer defines the api actions for the UnprocessedTransactionsAPI service
 // This interface intended to stay up to date with the openapi yaml used to generate it,
 // while the service implementation can be ignored with the .openapi-generator-ignore file
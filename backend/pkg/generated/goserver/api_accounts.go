@@ -58,6 +58,11 @@ func (c *AccountsAPIController) Routes() Routes {
 			"/v1/accounts/{accountId}/history",
 			c.GetAccountHistory,
 		},
+		"GetAccountAmortization": Route{
+			strings.ToUpper("Get"),
+			"/v1/accounts/{id}/amortization",
+			c.GetAccountAmortization,
+		},
 		"GetAccounts": Route{
 			strings.ToUpper("Get"),
 			"/v1/accounts",
@@ -114,6 +119,24 @@ func (c *AccountsAPIController) GetAccountHistory(w http.ResponseWriter, r *http
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetAccountAmortization - return amortization schedule of the loan of a liability account
+func (c *AccountsAPIController) GetAccountAmortization(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	idParam := params["id"]
+	if idParam == "" {
+		c.errorHandler(w, r, &RequiredError{"id"}, nil)
+		return
+	}
+	result, err := c.service.GetAccountAmortization(r.Context(), idParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetAccounts - get all accounts
 func (c *AccountsAPIController) GetAccounts(w http.ResponseWriter, r *http.Request) {
 	result, err := c.service.GetAccounts(r.Context())
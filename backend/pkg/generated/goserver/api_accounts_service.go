@@ -22,6 +22,8 @@ import (
 type AccountsAPIService interface {
 	// GetAccountHistory - return list of dates when this account was used in some transaction
 	GetAccountHistory(ctx context.Context, accountId string) (ImplResponse, error)
+	// GetAccountAmortization - return amortization schedule of the loan of a liability account
+	GetAccountAmortization(ctx context.Context, id string) (ImplResponse, error)
 	// GetAccounts - get all accounts
 	GetAccounts(ctx context.Context) (ImplResponse, error)
 	// CreateAccount - create new account
@@ -60,6 +62,23 @@ func (s *AccountsAPIServiceImpl) GetAccountHistory(ctx context.Context, accountI
 	return Response(http.StatusNotImplemented, nil), errors.New("GetAccountHistory method not implemented")
 }
 
+// GetAccountAmortization - return amortization schedule of the loan of a liability account
+func (s *AccountsAPIServiceImpl) GetAccountAmortization(ctx context.Context, id string) (ImplResponse, error) {
+	// TODO - update GetAccountAmortization with the required logic for this service method.
+	// Add api_accounts_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, AmortizationSchedule{}) or use other options such as http.Ok ...
+	// return Response(200, AmortizationSchedule{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetAccountAmortization method not implemented")
+}
+
 // GetAccounts - get all accounts
 func (s *AccountsAPIServiceImpl) GetAccounts(ctx context.Context) (ImplResponse, error) {
 	// TODO - update GetAccounts with the required logic for this service method.
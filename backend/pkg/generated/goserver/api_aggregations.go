@@ -55,6 +55,26 @@ func (c *AggregationsAPIController) Routes() Routes {
 			"/v1/balances",
 			c.GetBalances,
 		},
+		"GetCashFlow": Route{
+			strings.ToUpper("Get"),
+			"/v1/cashflow",
+			c.GetCashFlow,
+		},
+		"GetNetWorth": Route{
+			strings.ToUpper("Get"),
+			"/v1/networth",
+			c.GetNetWorth,
+		},
+		"GetFlows": Route{
+			strings.ToUpper("Get"),
+			"/v1/flows",
+			c.GetFlows,
+		},
+		"GetPartners": Route{
+			strings.ToUpper("Get"),
+			"/v1/partners",
+			c.GetPartners,
+		},
 		"GetExpenses": Route{
 			strings.ToUpper("Get"),
 			"/v1/expenses",
@@ -120,7 +140,298 @@ func (c *AggregationsAPIController) GetBalances(w http.ResponseWriter, r *http.R
 		var param bool = false
 		includeHiddenParam = param
 	}
-	result, err := c.service.GetBalances(r.Context(), fromParam, toParam, outputCurrencyIdParam, includeHiddenParam)
+	var depthParam int32
+	if query.Has("depth") {
+		param, err := parseNumericParameter[int32](
+			query.Get("depth"),
+			WithParse[int32](parseInt32),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "depth", Err: err}, nil)
+			return
+		}
+
+		depthParam = param
+	} else {
+		var param int32 = 0
+		depthParam = param
+	}
+	result, err := c.service.GetBalances(r.Context(), fromParam, toParam, outputCurrencyIdParam, includeHiddenParam, depthParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetCashFlow - get income statement / cash-flow report with comparison to previous periods
+func (c *AggregationsAPIController) GetCashFlow(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var fromParam time.Time
+	if query.Has("from") {
+		param, err := parseTime(query.Get("from"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "from", Err: err}, nil)
+			return
+		}
+
+		fromParam = param
+	} else {
+	}
+	var toParam time.Time
+	if query.Has("to") {
+		param, err := parseTime(query.Get("to"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "to", Err: err}, nil)
+			return
+		}
+
+		toParam = param
+	} else {
+	}
+	var outputCurrencyIdParam string
+	if query.Has("outputCurrencyId") {
+		param := query.Get("outputCurrencyId")
+
+		outputCurrencyIdParam = param
+	} else {
+	}
+	var granularityParam string
+	if query.Has("granularity") {
+		param := query.Get("granularity")
+
+		granularityParam = param
+	} else {
+		param := "month"
+		granularityParam = param
+	}
+	var includeHiddenParam bool
+	if query.Has("includeHidden") {
+		param, err := parseBoolParameter(
+			query.Get("includeHidden"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "includeHidden", Err: err}, nil)
+			return
+		}
+
+		includeHiddenParam = param
+	} else {
+		var param bool = false
+		includeHiddenParam = param
+	}
+	result, err := c.service.GetCashFlow(r.Context(), fromParam, toParam, outputCurrencyIdParam, granularityParam, includeHiddenParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetNetWorth - get net worth over time
+func (c *AggregationsAPIController) GetNetWorth(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var fromParam time.Time
+	if query.Has("from") {
+		param, err := parseTime(query.Get("from"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "from", Err: err}, nil)
+			return
+		}
+
+		fromParam = param
+	} else {
+	}
+	var toParam time.Time
+	if query.Has("to") {
+		param, err := parseTime(query.Get("to"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "to", Err: err}, nil)
+			return
+		}
+
+		toParam = param
+	} else {
+	}
+	var outputCurrencyIdParam string
+	if query.Has("outputCurrencyId") {
+		param := query.Get("outputCurrencyId")
+
+		outputCurrencyIdParam = param
+	} else {
+	}
+	var granularityParam string
+	if query.Has("granularity") {
+		param := query.Get("granularity")
+
+		granularityParam = param
+	} else {
+		param := "month"
+		granularityParam = param
+	}
+	var includeHiddenParam bool
+	if query.Has("includeHidden") {
+		param, err := parseBoolParameter(
+			query.Get("includeHidden"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "includeHidden", Err: err}, nil)
+			return
+		}
+
+		includeHiddenParam = param
+	} else {
+		var param bool = false
+		includeHiddenParam = param
+	}
+	result, err := c.service.GetNetWorth(r.Context(), fromParam, toParam, outputCurrencyIdParam, granularityParam, includeHiddenParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetFlows - get money flows between accounts for a Sankey diagram
+func (c *AggregationsAPIController) GetFlows(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var fromParam time.Time
+	if query.Has("from") {
+		param, err := parseTime(query.Get("from"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "from", Err: err}, nil)
+			return
+		}
+
+		fromParam = param
+	} else {
+	}
+	var toParam time.Time
+	if query.Has("to") {
+		param, err := parseTime(query.Get("to"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "to", Err: err}, nil)
+			return
+		}
+
+		toParam = param
+	} else {
+	}
+	var outputCurrencyIdParam string
+	if query.Has("outputCurrencyId") {
+		param := query.Get("outputCurrencyId")
+
+		outputCurrencyIdParam = param
+	} else {
+	}
+	var includeHiddenParam bool
+	if query.Has("includeHidden") {
+		param, err := parseBoolParameter(
+			query.Get("includeHidden"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "includeHidden", Err: err}, nil)
+			return
+		}
+
+		includeHiddenParam = param
+	} else {
+		var param bool = false
+		includeHiddenParam = param
+	}
+	result, err := c.service.GetFlows(r.Context(), fromParam, toParam, outputCurrencyIdParam, includeHiddenParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetPartners - get spendings and receipts grouped by partner with comparison to the previous period
+func (c *AggregationsAPIController) GetPartners(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var fromParam time.Time
+	if query.Has("from") {
+		param, err := parseTime(query.Get("from"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "from", Err: err}, nil)
+			return
+		}
+
+		fromParam = param
+	} else {
+	}
+	var toParam time.Time
+	if query.Has("to") {
+		param, err := parseTime(query.Get("to"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "to", Err: err}, nil)
+			return
+		}
+
+		toParam = param
+	} else {
+	}
+	var outputCurrencyIdParam string
+	if query.Has("outputCurrencyId") {
+		param := query.Get("outputCurrencyId")
+
+		outputCurrencyIdParam = param
+	} else {
+	}
+	var sortByParam string
+	if query.Has("sortBy") {
+		param := query.Get("sortBy")
+
+		sortByParam = param
+	} else {
+		param := "spent"
+		sortByParam = param
+	}
+	var topParam int32
+	if query.Has("top") {
+		param, err := parseNumericParameter[int32](
+			query.Get("top"),
+			WithParse[int32](parseInt32),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "top", Err: err}, nil)
+			return
+		}
+
+		topParam = param
+	} else {
+		var param int32 = 0
+		topParam = param
+	}
+	result, err := c.service.GetPartners(r.Context(), fromParam, toParam, outputCurrencyIdParam, sortByParam, topParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -208,7 +519,23 @@ func (c *AggregationsAPIController) GetExpenses(w http.ResponseWriter, r *http.R
 	if query.Has("accounts") {
 		accountsParam = strings.Split(query.Get("accounts"), ",")
 	}
-	result, err := c.service.GetExpenses(r.Context(), fromParam, toParam, outputCurrencyIdParam, granularityParam, includeHiddenParam, groupByParam, tagsParam, accountsParam)
+	var depthParam int32
+	if query.Has("depth") {
+		param, err := parseNumericParameter[int32](
+			query.Get("depth"),
+			WithParse[int32](parseInt32),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "depth", Err: err}, nil)
+			return
+		}
+
+		depthParam = param
+	} else {
+		var param int32 = 0
+		depthParam = param
+	}
+	result, err := c.service.GetExpenses(r.Context(), fromParam, toParam, outputCurrencyIdParam, granularityParam, includeHiddenParam, groupByParam, tagsParam, accountsParam, depthParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -270,7 +597,23 @@ func (c *AggregationsAPIController) GetIncomes(w http.ResponseWriter, r *http.Re
 		var param bool = false
 		includeHiddenParam = param
 	}
-	result, err := c.service.GetIncomes(r.Context(), fromParam, toParam, outputCurrencyIdParam, includeHiddenParam)
+	var depthParam int32
+	if query.Has("depth") {
+		param, err := parseNumericParameter[int32](
+			query.Get("depth"),
+			WithParse[int32](parseInt32),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "depth", Err: err}, nil)
+			return
+		}
+
+		depthParam = param
+	} else {
+		var param int32 = 0
+		depthParam = param
+	}
+	result, err := c.service.GetIncomes(r.Context(), fromParam, toParam, outputCurrencyIdParam, includeHiddenParam, depthParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -21,11 +21,19 @@ import (
 // AggregationsAPIService is an interface that defines the logic for the AggregationsAPIServicer
 type AggregationsAPIService interface {
 	// GetBalances - get balance for filtered transactions
-	GetBalances(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, includeHidden bool) (ImplResponse, error)
+	GetBalances(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, includeHidden bool, depth int32) (ImplResponse, error)
+	// GetCashFlow - get income statement / cash-flow report with comparison to previous periods
+	GetCashFlow(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, granularity string, includeHidden bool) (ImplResponse, error)
+	// GetNetWorth - get net worth over time
+	GetNetWorth(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, granularity string, includeHidden bool) (ImplResponse, error)
+	// GetFlows - get money flows between accounts for a Sankey diagram
+	GetFlows(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, includeHidden bool) (ImplResponse, error)
+	// GetPartners - get spendings and receipts grouped by partner with comparison to the previous period
+	GetPartners(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, sortBy string, top int32) (ImplResponse, error)
 	// GetExpenses - get expenses for filtered transactions
-	GetExpenses(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, granularity string, includeHidden bool, groupBy string, tags []string, accounts []string) (ImplResponse, error)
+	GetExpenses(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, granularity string, includeHidden bool, groupBy string, tags []string, accounts []string, depth int32) (ImplResponse, error)
 	// GetIncomes - get incomes for filtered transactions
-	GetIncomes(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, includeHidden bool) (ImplResponse, error)
+	GetIncomes(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, includeHidden bool, depth int32) (ImplResponse, error)
 }
 
 // AggregationsAPIService is a service that implements the logic for the AggregationsAPIServicer
@@ -40,7 +48,7 @@ func NewAggregationsAPIService() AggregationsAPIService {
 }
 
 // GetBalances - get balance for filtered transactions
-func (s *AggregationsAPIServiceImpl) GetBalances(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, includeHidden bool) (ImplResponse, error) {
+func (s *AggregationsAPIServiceImpl) GetBalances(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, includeHidden bool, depth int32) (ImplResponse, error) {
 	// TODO - update GetBalances with the required logic for this service method.
 	// Add api_aggregations_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
 
@@ -50,8 +58,67 @@ func (s *AggregationsAPIServiceImpl) GetBalances(ctx context.Context, from time.
 	return Response(http.StatusNotImplemented, nil), errors.New("GetBalances method not implemented")
 }
 
+// GetCashFlow - get income statement / cash-flow report with comparison to previous periods
+func (s *AggregationsAPIServiceImpl) GetCashFlow(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, granularity string, includeHidden bool) (ImplResponse, error) {
+	// TODO - update GetCashFlow with the required logic for this service method.
+	// Add api_aggregations_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, CashFlowReport{}) or use other options such as http.Ok ...
+	// return Response(200, CashFlowReport{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetCashFlow method not implemented")
+}
+
+// GetNetWorth - get net worth over time
+func (s *AggregationsAPIServiceImpl) GetNetWorth(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, granularity string, includeHidden bool) (ImplResponse, error) {
+	// TODO - update GetNetWorth with the required logic for this service method.
+	// Add api_aggregations_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, NetWorthReport{}) or use other options such as http.Ok ...
+	// return Response(200, NetWorthReport{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetNetWorth method not implemented")
+}
+
+// GetFlows - get money flows between accounts for a Sankey diagram
+func (s *AggregationsAPIServiceImpl) GetFlows(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, includeHidden bool) (ImplResponse, error) {
+	// TODO - update GetFlows with the required logic for this service method.
+	// Add api_aggregations_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, FlowReport{}) or use other options such as http.Ok ...
+	// return Response(200, FlowReport{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetFlows method not implemented")
+}
+
+// GetPartners - get spendings and receipts grouped by partner with comparison to the previous period
+func (s *AggregationsAPIServiceImpl) GetPartners(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, sortBy string, top int32) (ImplResponse, error) {
+	// TODO - update GetPartners with the required logic for this service method.
+	// Add api_aggregations_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, PartnerReport{}) or use other options such as http.Ok ...
+	// return Response(200, PartnerReport{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(500, {}) or use other options such as http.Ok ...
+	// return Response(500, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetPartners method not implemented")
+}
+
 // GetExpenses - get expenses for filtered transactions
-func (s *AggregationsAPIServiceImpl) GetExpenses(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, granularity string, includeHidden bool, groupBy string, tags []string, accounts []string) (ImplResponse, error) {
+func (s *AggregationsAPIServiceImpl) GetExpenses(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, granularity string, includeHidden bool, groupBy string, tags []string, accounts []string, depth int32) (ImplResponse, error) {
 	// TODO - update GetExpenses with the required logic for this service method.
 	// Add api_aggregations_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
 
@@ -62,7 +129,7 @@ func (s *AggregationsAPIServiceImpl) GetExpenses(ctx context.Context, from time.
 }
 
 // GetIncomes - get incomes for filtered transactions
-func (s *AggregationsAPIServiceImpl) GetIncomes(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, includeHidden bool) (ImplResponse, error) {
+func (s *AggregationsAPIServiceImpl) GetIncomes(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, includeHidden bool, depth int32) (ImplResponse, error) {
 	// TODO - update GetIncomes with the required logic for this service method.
 	// Add api_aggregations_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
 
@@ -63,6 +63,66 @@ func (c *BudgetItemsAPIController) Routes() Routes {
 			"/v1/budgetItems",
 			c.CreateBudgetItem,
 		},
+		"GetBudgetPlans": Route{
+			strings.ToUpper("Get"),
+			"/v1/budgetPlans",
+			c.GetBudgetPlans,
+		},
+		"CreateBudgetPlan": Route{
+			strings.ToUpper("Post"),
+			"/v1/budgetPlans",
+			c.CreateBudgetPlan,
+		},
+		"GetBudgetPlan": Route{
+			strings.ToUpper("Get"),
+			"/v1/budgetPlans/{id}",
+			c.GetBudgetPlan,
+		},
+		"UpdateBudgetPlan": Route{
+			strings.ToUpper("Put"),
+			"/v1/budgetPlans/{id}",
+			c.UpdateBudgetPlan,
+		},
+		"DeleteBudgetPlan": Route{
+			strings.ToUpper("Delete"),
+			"/v1/budgetPlans/{id}",
+			c.DeleteBudgetPlan,
+		},
+		"CopyBudget": Route{
+			strings.ToUpper("Post"),
+			"/v1/budgets/copy",
+			c.CopyBudget,
+		},
+		"GetBudgetTransfers": Route{
+			strings.ToUpper("Get"),
+			"/v1/budgetTransfers",
+			c.GetBudgetTransfers,
+		},
+		"CreateBudgetTransfer": Route{
+			strings.ToUpper("Post"),
+			"/v1/budgetTransfers",
+			c.CreateBudgetTransfer,
+		},
+		"GetBudgetTransfer": Route{
+			strings.ToUpper("Get"),
+			"/v1/budgetTransfers/{id}",
+			c.GetBudgetTransfer,
+		},
+		"UpdateBudgetTransfer": Route{
+			strings.ToUpper("Put"),
+			"/v1/budgetTransfers/{id}",
+			c.UpdateBudgetTransfer,
+		},
+		"DeleteBudgetTransfer": Route{
+			strings.ToUpper("Delete"),
+			"/v1/budgetTransfers/{id}",
+			c.DeleteBudgetTransfer,
+		},
+		"GetReadyToAssign": Route{
+			strings.ToUpper("Get"),
+			"/v1/budgets/readyToAssign",
+			c.GetReadyToAssign,
+		},
 		"GetBudgetStatus": Route{
 			strings.ToUpper("Get"),
 			"/v1/budgets/status",
@@ -125,6 +185,321 @@ func (c *BudgetItemsAPIController) CreateBudgetItem(w http.ResponseWriter, r *ht
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetBudgetPlans - get all budget plans
+func (c *BudgetItemsAPIController) GetBudgetPlans(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.GetBudgetPlans(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// CreateBudgetPlan - create new budget plan
+func (c *BudgetItemsAPIController) CreateBudgetPlan(w http.ResponseWriter, r *http.Request) {
+	budgetPlanNoIdParam := BudgetPlanNoId{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&budgetPlanNoIdParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertBudgetPlanNoIdRequired(budgetPlanNoIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertBudgetPlanNoIdConstraints(budgetPlanNoIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.CreateBudgetPlan(r.Context(), budgetPlanNoIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetBudgetPlan - get budget plan
+func (c *BudgetItemsAPIController) GetBudgetPlan(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	idParam := params["id"]
+	if idParam == "" {
+		c.errorHandler(w, r, &RequiredError{"id"}, nil)
+		return
+	}
+	result, err := c.service.GetBudgetPlan(r.Context(), idParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UpdateBudgetPlan - update budget plan
+func (c *BudgetItemsAPIController) UpdateBudgetPlan(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	idParam := params["id"]
+	if idParam == "" {
+		c.errorHandler(w, r, &RequiredError{"id"}, nil)
+		return
+	}
+	budgetPlanNoIdParam := BudgetPlanNoId{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&budgetPlanNoIdParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertBudgetPlanNoIdRequired(budgetPlanNoIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertBudgetPlanNoIdConstraints(budgetPlanNoIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UpdateBudgetPlan(r.Context(), idParam, budgetPlanNoIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// DeleteBudgetPlan - delete budget plan
+func (c *BudgetItemsAPIController) DeleteBudgetPlan(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	idParam := params["id"]
+	if idParam == "" {
+		c.errorHandler(w, r, &RequiredError{"id"}, nil)
+		return
+	}
+	result, err := c.service.DeleteBudgetPlan(r.Context(), idParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// CopyBudget - copy the budget of the previous month
+func (c *BudgetItemsAPIController) CopyBudget(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var monthParam time.Time
+	if query.Has("month") {
+		param, err := parseTime(query.Get("month"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "month", Err: err}, nil)
+			return
+		}
+
+		monthParam = param
+	} else {
+	}
+	result, err := c.service.CopyBudget(r.Context(), monthParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetBudgetTransfers - get all budget transfers
+func (c *BudgetItemsAPIController) GetBudgetTransfers(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.GetBudgetTransfers(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// CreateBudgetTransfer - move budget between envelopes
+func (c *BudgetItemsAPIController) CreateBudgetTransfer(w http.ResponseWriter, r *http.Request) {
+	budgetTransferNoIdParam := BudgetTransferNoId{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&budgetTransferNoIdParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertBudgetTransferNoIdRequired(budgetTransferNoIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertBudgetTransferNoIdConstraints(budgetTransferNoIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.CreateBudgetTransfer(r.Context(), budgetTransferNoIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetBudgetTransfer - get budget transfer
+func (c *BudgetItemsAPIController) GetBudgetTransfer(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	idParam := params["id"]
+	if idParam == "" {
+		c.errorHandler(w, r, &RequiredError{"id"}, nil)
+		return
+	}
+	result, err := c.service.GetBudgetTransfer(r.Context(), idParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UpdateBudgetTransfer - update budget transfer
+func (c *BudgetItemsAPIController) UpdateBudgetTransfer(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	idParam := params["id"]
+	if idParam == "" {
+		c.errorHandler(w, r, &RequiredError{"id"}, nil)
+		return
+	}
+	budgetTransferNoIdParam := BudgetTransferNoId{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&budgetTransferNoIdParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertBudgetTransferNoIdRequired(budgetTransferNoIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertBudgetTransferNoIdConstraints(budgetTransferNoIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UpdateBudgetTransfer(r.Context(), idParam, budgetTransferNoIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// DeleteBudgetTransfer - delete budget transfer
+func (c *BudgetItemsAPIController) DeleteBudgetTransfer(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	idParam := params["id"]
+	if idParam == "" {
+		c.errorHandler(w, r, &RequiredError{"id"}, nil)
+		return
+	}
+	result, err := c.service.DeleteBudgetTransfer(r.Context(), idParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetReadyToAssign - get income which is not assigned to envelopes yet
+func (c *BudgetItemsAPIController) GetReadyToAssign(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var fromParam time.Time
+	if query.Has("from") {
+		param, err := parseTime(query.Get("from"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "from", Err: err}, nil)
+			return
+		}
+
+		fromParam = param
+	} else {
+	}
+	var toParam time.Time
+	if query.Has("to") {
+		param, err := parseTime(query.Get("to"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "to", Err: err}, nil)
+			return
+		}
+
+		toParam = param
+	} else {
+	}
+	var outputCurrencyIdParam string
+	if query.Has("outputCurrencyId") {
+		param := query.Get("outputCurrencyId")
+
+		outputCurrencyIdParam = param
+	} else {
+	}
+	var granularityParam string
+	if query.Has("granularity") {
+		param := query.Get("granularity")
+
+		granularityParam = param
+	} else {
+		param := "month"
+		granularityParam = param
+	}
+	var includeHiddenParam bool
+	if query.Has("includeHidden") {
+		param, err := parseBoolParameter(
+			query.Get("includeHidden"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "includeHidden", Err: err}, nil)
+			return
+		}
+
+		includeHiddenParam = param
+	} else {
+		var param bool = false
+		includeHiddenParam = param
+	}
+	result, err := c.service.GetReadyToAssign(r.Context(), fromParam, toParam, outputCurrencyIdParam, granularityParam, includeHiddenParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetBudgetStatus - get budget status with rollover
 func (c *BudgetItemsAPIController) GetBudgetStatus(w http.ResponseWriter, r *http.Request) {
 	query, err := parseQuery(r.URL.RawQuery)
@@ -161,6 +536,15 @@ func (c *BudgetItemsAPIController) GetBudgetStatus(w http.ResponseWriter, r *htt
 		outputCurrencyIdParam = param
 	} else {
 	}
+	var granularityParam string
+	if query.Has("granularity") {
+		param := query.Get("granularity")
+
+		granularityParam = param
+	} else {
+		param := "month"
+		granularityParam = param
+	}
 	var includeHiddenParam bool
 	if query.Has("includeHidden") {
 		param, err := parseBoolParameter(
@@ -177,7 +561,23 @@ func (c *BudgetItemsAPIController) GetBudgetStatus(w http.ResponseWriter, r *htt
 		var param bool = false
 		includeHiddenParam = param
 	}
-	result, err := c.service.GetBudgetStatus(r.Context(), fromParam, toParam, outputCurrencyIdParam, includeHiddenParam)
+	var depthParam int32
+	if query.Has("depth") {
+		param, err := parseNumericParameter[int32](
+			query.Get("depth"),
+			WithParse[int32](parseInt32),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "depth", Err: err}, nil)
+			return
+		}
+
+		depthParam = param
+	} else {
+		var param int32 = 0
+		depthParam = param
+	}
+	result, err := c.service.GetBudgetStatus(r.Context(), fromParam, toParam, outputCurrencyIdParam, granularityParam, includeHiddenParam, depthParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
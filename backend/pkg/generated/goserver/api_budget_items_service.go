@@ -24,8 +24,32 @@ type BudgetItemsAPIService interface {
 	GetBudgetItems(ctx context.Context) (ImplResponse, error)
 	// CreateBudgetItem - create new budgetItem
 	CreateBudgetItem(ctx context.Context, budgetItemNoId BudgetItemNoId) (ImplResponse, error)
+	// GetBudgetPlans - get all budget plans
+	GetBudgetPlans(ctx context.Context) (ImplResponse, error)
+	// CreateBudgetPlan - create new budget plan
+	CreateBudgetPlan(ctx context.Context, budgetPlanNoId BudgetPlanNoId) (ImplResponse, error)
+	// GetBudgetPlan - get budget plan
+	GetBudgetPlan(ctx context.Context, id string) (ImplResponse, error)
+	// UpdateBudgetPlan - update budget plan
+	UpdateBudgetPlan(ctx context.Context, id string, budgetPlanNoId BudgetPlanNoId) (ImplResponse, error)
+	// DeleteBudgetPlan - delete budget plan
+	DeleteBudgetPlan(ctx context.Context, id string) (ImplResponse, error)
+	// CopyBudget - copy the budget of the previous month
+	CopyBudget(ctx context.Context, month time.Time) (ImplResponse, error)
+	// GetBudgetTransfers - get all budget transfers
+	GetBudgetTransfers(ctx context.Context) (ImplResponse, error)
+	// CreateBudgetTransfer - move budget between envelopes
+	CreateBudgetTransfer(ctx context.Context, budgetTransferNoId BudgetTransferNoId) (ImplResponse, error)
+	// GetBudgetTransfer - get budget transfer
+	GetBudgetTransfer(ctx context.Context, id string) (ImplResponse, error)
+	// UpdateBudgetTransfer - update budget transfer
+	UpdateBudgetTransfer(ctx context.Context, id string, budgetTransferNoId BudgetTransferNoId) (ImplResponse, error)
+	// DeleteBudgetTransfer - delete budget transfer
+	DeleteBudgetTransfer(ctx context.Context, id string) (ImplResponse, error)
+	// GetReadyToAssign - get income which is not assigned to envelopes yet
+	GetReadyToAssign(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, granularity string, includeHidden bool) (ImplResponse, error)
 	// GetBudgetStatus - get budget status with rollover
-	GetBudgetStatus(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, includeHidden bool) (ImplResponse, error)
+	GetBudgetStatus(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, granularity string, includeHidden bool, depth int32) (ImplResponse, error)
 	// GetBudgetItem - get budgetItem
 	GetBudgetItem(ctx context.Context, id string) (ImplResponse, error)
 	// UpdateBudgetItem - update budgetItem
@@ -67,8 +91,170 @@ func (s *BudgetItemsAPIServiceImpl) CreateBudgetItem(ctx context.Context, budget
 	return Response(http.StatusNotImplemented, nil), errors.New("CreateBudgetItem method not implemented")
 }
 
+// GetBudgetPlans - get all budget plans
+func (s *BudgetItemsAPIServiceImpl) GetBudgetPlans(ctx context.Context) (ImplResponse, error) {
+	// TODO - update GetBudgetPlans with the required logic for this service method.
+	// Add api_budget_items_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []BudgetPlan{}) or use other options such as http.Ok ...
+	// return Response(200, []BudgetPlan{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetBudgetPlans method not implemented")
+}
+
+// CreateBudgetPlan - create new budget plan
+func (s *BudgetItemsAPIServiceImpl) CreateBudgetPlan(ctx context.Context, budgetPlanNoId BudgetPlanNoId) (ImplResponse, error) {
+	// TODO - update CreateBudgetPlan with the required logic for this service method.
+	// Add api_budget_items_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, BudgetPlan{}) or use other options such as http.Ok ...
+	// return Response(200, BudgetPlan{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("CreateBudgetPlan method not implemented")
+}
+
+// GetBudgetPlan - get budget plan
+func (s *BudgetItemsAPIServiceImpl) GetBudgetPlan(ctx context.Context, id string) (ImplResponse, error) {
+	// TODO - update GetBudgetPlan with the required logic for this service method.
+	// Add api_budget_items_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, BudgetPlan{}) or use other options such as http.Ok ...
+	// return Response(200, BudgetPlan{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetBudgetPlan method not implemented")
+}
+
+// UpdateBudgetPlan - update budget plan
+func (s *BudgetItemsAPIServiceImpl) UpdateBudgetPlan(ctx context.Context, id string, budgetPlanNoId BudgetPlanNoId) (ImplResponse, error) {
+	// TODO - update UpdateBudgetPlan with the required logic for this service method.
+	// Add api_budget_items_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, BudgetPlan{}) or use other options such as http.Ok ...
+	// return Response(200, BudgetPlan{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UpdateBudgetPlan method not implemented")
+}
+
+// DeleteBudgetPlan - delete budget plan
+func (s *BudgetItemsAPIServiceImpl) DeleteBudgetPlan(ctx context.Context, id string) (ImplResponse, error) {
+	// TODO - update DeleteBudgetPlan with the required logic for this service method.
+	// Add api_budget_items_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, {}) or use other options such as http.Ok ...
+	// return Response(200, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("DeleteBudgetPlan method not implemented")
+}
+
+// CopyBudget - copy the budget of the previous month
+func (s *BudgetItemsAPIServiceImpl) CopyBudget(ctx context.Context, month time.Time) (ImplResponse, error) {
+	// TODO - update CopyBudget with the required logic for this service method.
+	// Add api_budget_items_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []BudgetItem{}) or use other options such as http.Ok ...
+	// return Response(200, []BudgetItem{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("CopyBudget method not implemented")
+}
+
+// GetBudgetTransfers - get all budget transfers
+func (s *BudgetItemsAPIServiceImpl) GetBudgetTransfers(ctx context.Context) (ImplResponse, error) {
+	// TODO - update GetBudgetTransfers with the required logic for this service method.
+	// Add api_budget_items_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []BudgetTransfer{}) or use other options such as http.Ok ...
+	// return Response(200, []BudgetTransfer{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetBudgetTransfers method not implemented")
+}
+
+// CreateBudgetTransfer - move budget between envelopes
+func (s *BudgetItemsAPIServiceImpl) CreateBudgetTransfer(ctx context.Context, budgetTransferNoId BudgetTransferNoId) (ImplResponse, error) {
+	// TODO - update CreateBudgetTransfer with the required logic for this service method.
+	// Add api_budget_items_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, BudgetTransfer{}) or use other options such as http.Ok ...
+	// return Response(200, BudgetTransfer{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("CreateBudgetTransfer method not implemented")
+}
+
+// GetBudgetTransfer - get budget transfer
+func (s *BudgetItemsAPIServiceImpl) GetBudgetTransfer(ctx context.Context, id string) (ImplResponse, error) {
+	// TODO - update GetBudgetTransfer with the required logic for this service method.
+	// Add api_budget_items_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, BudgetTransfer{}) or use other options such as http.Ok ...
+	// return Response(200, BudgetTransfer{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetBudgetTransfer method not implemented")
+}
+
+// UpdateBudgetTransfer - update budget transfer
+func (s *BudgetItemsAPIServiceImpl) UpdateBudgetTransfer(ctx context.Context, id string, budgetTransferNoId BudgetTransferNoId) (ImplResponse, error) {
+	// TODO - update UpdateBudgetTransfer with the required logic for this service method.
+	// Add api_budget_items_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, BudgetTransfer{}) or use other options such as http.Ok ...
+	// return Response(200, BudgetTransfer{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UpdateBudgetTransfer method not implemented")
+}
+
+// DeleteBudgetTransfer - delete budget transfer
+func (s *BudgetItemsAPIServiceImpl) DeleteBudgetTransfer(ctx context.Context, id string) (ImplResponse, error) {
+	// TODO - update DeleteBudgetTransfer with the required logic for this service method.
+	// Add api_budget_items_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, {}) or use other options such as http.Ok ...
+	// return Response(200, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("DeleteBudgetTransfer method not implemented")
+}
+
+// GetReadyToAssign - get income which is not assigned to envelopes yet
+func (s *BudgetItemsAPIServiceImpl) GetReadyToAssign(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, granularity string, includeHidden bool) (ImplResponse, error) {
+	// TODO - update GetReadyToAssign with the required logic for this service method.
+	// Add api_budget_items_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []ReadyToAssign{}) or use other options such as http.Ok ...
+	// return Response(200, []ReadyToAssign{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetReadyToAssign method not implemented")
+}
+
 // GetBudgetStatus - get budget status with rollover
-func (s *BudgetItemsAPIServiceImpl) GetBudgetStatus(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, includeHidden bool) (ImplResponse, error) {
+func (s *BudgetItemsAPIServiceImpl) GetBudgetStatus(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, granularity string, includeHidden bool, depth int32) (ImplResponse, error) {
 	// TODO - update GetBudgetStatus with the required logic for this service method.
 	// Add api_budget_items_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
 
@@ -15,6 +15,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 )
@@ -72,6 +73,36 @@ func (c *CurrenciesAPIController) Routes() Routes {
 			"/v1/currencies/{id}",
 			c.DeleteCurrency,
 		},
+		"GetExchangeRates": Route{
+			strings.ToUpper("Get"),
+			"/v1/exchangeRates",
+			c.GetExchangeRates,
+		},
+		"CreateExchangeRate": Route{
+			strings.ToUpper("Post"),
+			"/v1/exchangeRates",
+			c.CreateExchangeRate,
+		},
+		"GetExchangeRate": Route{
+			strings.ToUpper("Get"),
+			"/v1/exchangeRates/{id}",
+			c.GetExchangeRate,
+		},
+		"UpdateExchangeRate": Route{
+			strings.ToUpper("Put"),
+			"/v1/exchangeRates/{id}",
+			c.UpdateExchangeRate,
+		},
+		"DeleteExchangeRate": Route{
+			strings.ToUpper("Delete"),
+			"/v1/exchangeRates/{id}",
+			c.DeleteExchangeRate,
+		},
+		"GetExchangeRateHistory": Route{
+			strings.ToUpper("Get"),
+			"/v1/exchangeRateHistory",
+			c.GetExchangeRateHistory,
+		},
 	}
 }
 
@@ -176,3 +207,168 @@ func (c *CurrenciesAPIController) DeleteCurrency(w http.ResponseWriter, r *http.
 	// If no error, encode the body and the result code
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
+
+// GetExchangeRates - get manual exchange rates of the family
+func (c *CurrenciesAPIController) GetExchangeRates(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.GetExchangeRates(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// CreateExchangeRate - create new manual exchange rate
+func (c *CurrenciesAPIController) CreateExchangeRate(w http.ResponseWriter, r *http.Request) {
+	exchangeRateNoIdParam := ExchangeRateNoId{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&exchangeRateNoIdParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertExchangeRateNoIdRequired(exchangeRateNoIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertExchangeRateNoIdConstraints(exchangeRateNoIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.CreateExchangeRate(r.Context(), exchangeRateNoIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetExchangeRate - get manual exchange rate
+func (c *CurrenciesAPIController) GetExchangeRate(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	idParam := params["id"]
+	if idParam == "" {
+		c.errorHandler(w, r, &RequiredError{"id"}, nil)
+		return
+	}
+	result, err := c.service.GetExchangeRate(r.Context(), idParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UpdateExchangeRate - update manual exchange rate
+func (c *CurrenciesAPIController) UpdateExchangeRate(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	idParam := params["id"]
+	if idParam == "" {
+		c.errorHandler(w, r, &RequiredError{"id"}, nil)
+		return
+	}
+	exchangeRateNoIdParam := ExchangeRateNoId{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&exchangeRateNoIdParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertExchangeRateNoIdRequired(exchangeRateNoIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertExchangeRateNoIdConstraints(exchangeRateNoIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UpdateExchangeRate(r.Context(), idParam, exchangeRateNoIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// DeleteExchangeRate - delete manual exchange rate
+func (c *CurrenciesAPIController) DeleteExchangeRate(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	idParam := params["id"]
+	if idParam == "" {
+		c.errorHandler(w, r, &RequiredError{"id"}, nil)
+		return
+	}
+	result, err := c.service.DeleteExchangeRate(r.Context(), idParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetExchangeRateHistory - get stored exchange rate history of a currency pair
+func (c *CurrenciesAPIController) GetExchangeRateHistory(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var fromCurrencyIdParam string
+	if query.Has("fromCurrencyId") {
+		param := query.Get("fromCurrencyId")
+
+		fromCurrencyIdParam = param
+	} else {
+		c.errorHandler(w, r, &RequiredError{Field: "fromCurrencyId"}, nil)
+		return
+	}
+	var toCurrencyIdParam string
+	if query.Has("toCurrencyId") {
+		param := query.Get("toCurrencyId")
+
+		toCurrencyIdParam = param
+	} else {
+		c.errorHandler(w, r, &RequiredError{Field: "toCurrencyId"}, nil)
+		return
+	}
+	var dateFromParam time.Time
+	if query.Has("dateFrom") {
+		param, err := parseTime(query.Get("dateFrom"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "dateFrom", Err: err}, nil)
+			return
+		}
+
+		dateFromParam = param
+	} else {
+	}
+	var dateToParam time.Time
+	if query.Has("dateTo") {
+		param, err := parseTime(query.Get("dateTo"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "dateTo", Err: err}, nil)
+			return
+		}
+
+		dateToParam = param
+	} else {
+	}
+	result, err := c.service.GetExchangeRateHistory(r.Context(), fromCurrencyIdParam, toCurrencyIdParam, dateFromParam, dateToParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
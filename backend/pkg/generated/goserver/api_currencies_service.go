@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 )
 
 // CurrenciesAPIService is an interface that defines the logic for the CurrenciesAPIServicer
@@ -27,6 +28,18 @@ type CurrenciesAPIService interface {
 	UpdateCurrency(ctx context.Context, id string, currencyNoId CurrencyNoId) (ImplResponse, error)
 	// DeleteCurrency - delete currency
 	DeleteCurrency(ctx context.Context, id string, replaceWithCurrencyId string) (ImplResponse, error)
+	// GetExchangeRates - get manual exchange rates of the family
+	GetExchangeRates(ctx context.Context) (ImplResponse, error)
+	// CreateExchangeRate - create new manual exchange rate
+	CreateExchangeRate(ctx context.Context, exchangeRateNoId ExchangeRateNoId) (ImplResponse, error)
+	// GetExchangeRate - get manual exchange rate
+	GetExchangeRate(ctx context.Context, id string) (ImplResponse, error)
+	// UpdateExchangeRate - update manual exchange rate
+	UpdateExchangeRate(ctx context.Context, id string, exchangeRateNoId ExchangeRateNoId) (ImplResponse, error)
+	// DeleteExchangeRate - delete manual exchange rate
+	DeleteExchangeRate(ctx context.Context, id string) (ImplResponse, error)
+	// GetExchangeRateHistory - get stored exchange rate history of a currency pair
+	GetExchangeRateHistory(ctx context.Context, fromCurrencyId string, toCurrencyId string, dateFrom time.Time, dateTo time.Time) (ImplResponse, error)
 }
 
 // CurrenciesAPIService is a service that implements the logic for the CurrenciesAPIServicer
@@ -86,3 +99,87 @@ func (s *CurrenciesAPIServiceImpl) DeleteCurrency(ctx context.Context, id string
 
 	return Response(http.StatusNotImplemented, nil), errors.New("DeleteCurrency method not implemented")
 }
+
+// GetExchangeRates - get manual exchange rates of the family
+func (s *CurrenciesAPIServiceImpl) GetExchangeRates(ctx context.Context) (ImplResponse, error) {
+	// TODO - update GetExchangeRates with the required logic for this service method.
+	// Add api_currencies_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []ExchangeRate{}) or use other options such as http.Ok ...
+	// return Response(200, []ExchangeRate{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetExchangeRates method not implemented")
+}
+
+// CreateExchangeRate - create new manual exchange rate
+func (s *CurrenciesAPIServiceImpl) CreateExchangeRate(ctx context.Context, exchangeRateNoId ExchangeRateNoId) (ImplResponse, error) {
+	// TODO - update CreateExchangeRate with the required logic for this service method.
+	// Add api_currencies_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, ExchangeRate{}) or use other options such as http.Ok ...
+	// return Response(200, ExchangeRate{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("CreateExchangeRate method not implemented")
+}
+
+// GetExchangeRate - get manual exchange rate
+func (s *CurrenciesAPIServiceImpl) GetExchangeRate(ctx context.Context, id string) (ImplResponse, error) {
+	// TODO - update GetExchangeRate with the required logic for this service method.
+	// Add api_currencies_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, ExchangeRate{}) or use other options such as http.Ok ...
+	// return Response(200, ExchangeRate{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetExchangeRate method not implemented")
+}
+
+// UpdateExchangeRate - update manual exchange rate
+func (s *CurrenciesAPIServiceImpl) UpdateExchangeRate(ctx context.Context, id string, exchangeRateNoId ExchangeRateNoId) (ImplResponse, error) {
+	// TODO - update UpdateExchangeRate with the required logic for this service method.
+	// Add api_currencies_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, ExchangeRate{}) or use other options such as http.Ok ...
+	// return Response(200, ExchangeRate{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UpdateExchangeRate method not implemented")
+}
+
+// DeleteExchangeRate - delete manual exchange rate
+func (s *CurrenciesAPIServiceImpl) DeleteExchangeRate(ctx context.Context, id string) (ImplResponse, error) {
+	// TODO - update DeleteExchangeRate with the required logic for this service method.
+	// Add api_currencies_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, {}) or use other options such as http.Ok ...
+	// return Response(200, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("DeleteExchangeRate method not implemented")
+}
+
+// GetExchangeRateHistory - get stored exchange rate history of a currency pair
+func (s *CurrenciesAPIServiceImpl) GetExchangeRateHistory(ctx context.Context, fromCurrencyId string, toCurrencyId string, dateFrom time.Time, dateTo time.Time) (ImplResponse, error) {
+	// TODO - update GetExchangeRateHistory with the required logic for this service method.
+	// Add api_currencies_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []ExchangeRateHistoryPoint{}) or use other options such as http.Ok ...
+	// return Response(200, []ExchangeRateHistoryPoint{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetExchangeRateHistory method not implemented")
+}
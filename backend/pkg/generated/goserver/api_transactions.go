@@ -85,6 +85,21 @@ func (c *TransactionsAPIController) Routes() Routes {
 			"/v1/transactions/{id}",
 			c.DeleteTransaction,
 		},
+		"GetRefundCandidates": Route{
+			strings.ToUpper("Get"),
+			"/v1/transactions/{id}/refundCandidates",
+			c.GetRefundCandidates,
+		},
+		"LinkRefund": Route{
+			strings.ToUpper("Post"),
+			"/v1/transactions/{id}/refundLink",
+			c.LinkRefund,
+		},
+		"UnlinkRefund": Route{
+			strings.ToUpper("Delete"),
+			"/v1/transactions/{id}/refundLink",
+			c.UnlinkRefund,
+		},
 		"MergeTransactions": Route{
 			strings.ToUpper("Post"),
 			"/v1/transactions/merge",
@@ -306,6 +321,75 @@ func (c *TransactionsAPIController) DeleteTransaction(w http.ResponseWriter, r *
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetRefundCandidates - suggest original transactions which the given incoming transaction could refund
+func (c *TransactionsAPIController) GetRefundCandidates(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	idParam := params["id"]
+	if idParam == "" {
+		c.errorHandler(w, r, &RequiredError{"id"}, nil)
+		return
+	}
+	result, err := c.service.GetRefundCandidates(r.Context(), idParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// LinkRefund - link transaction as a refund or reimbursement of another transaction
+func (c *TransactionsAPIController) LinkRefund(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	idParam := params["id"]
+	if idParam == "" {
+		c.errorHandler(w, r, &RequiredError{"id"}, nil)
+		return
+	}
+	linkRefundRequestParam := LinkRefundRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&linkRefundRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertLinkRefundRequestRequired(linkRefundRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertLinkRefundRequestConstraints(linkRefundRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.LinkRefund(r.Context(), idParam, linkRefundRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UnlinkRefund - remove refund or reimbursement link of transaction
+func (c *TransactionsAPIController) UnlinkRefund(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	idParam := params["id"]
+	if idParam == "" {
+		c.errorHandler(w, r, &RequiredError{"id"}, nil)
+		return
+	}
+	result, err := c.service.UnlinkRefund(r.Context(), idParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // MergeTransactions - merge two transactions
 func (c *TransactionsAPIController) MergeTransactions(w http.ResponseWriter, r *http.Request) {
 	mergeTransactionsRequestParam := MergeTransactionsRequest{}
@@ -34,6 +34,12 @@ type TransactionsAPIService interface {
 	UpdateTransaction(ctx context.Context, id string, transactionNoId TransactionNoId) (ImplResponse, error)
 	// DeleteTransaction - delete transaction
 	DeleteTransaction(ctx context.Context, id string) (ImplResponse, error)
+	// GetRefundCandidates - suggest original transactions which the given incoming transaction could refund
+	GetRefundCandidates(ctx context.Context, id string) (ImplResponse, error)
+	// LinkRefund - link transaction as a refund or reimbursement of another transaction
+	LinkRefund(ctx context.Context, id string, linkRefundRequest LinkRefundRequest) (ImplResponse, error)
+	// UnlinkRefund - remove refund or reimbursement link of transaction
+	UnlinkRefund(ctx context.Context, id string) (ImplResponse, error)
 	// MergeTransactions - merge two transactions
 	MergeTransactions(ctx context.Context, mergeTransactionsRequest MergeTransactionsRequest) (ImplResponse, error)
 }
@@ -115,6 +121,51 @@ func (s *TransactionsAPIServiceImpl) DeleteTransaction(ctx context.Context, id s
 	return Response(http.StatusNotImplemented, nil), errors.New("DeleteTransaction method not implemented")
 }
 
+// GetRefundCandidates - suggest original transactions which the given incoming transaction could refund
+func (s *TransactionsAPIServiceImpl) GetRefundCandidates(ctx context.Context, id string) (ImplResponse, error) {
+	// TODO - update GetRefundCandidates with the required logic for this service method.
+	// Add api_transactions_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []RefundCandidate{}) or use other options such as http.Ok ...
+	// return Response(200, []RefundCandidate{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetRefundCandidates method not implemented")
+}
+
+// LinkRefund - link transaction as a refund or reimbursement of another transaction
+func (s *TransactionsAPIServiceImpl) LinkRefund(ctx context.Context, id string, linkRefundRequest LinkRefundRequest) (ImplResponse, error) {
+	// TODO - update LinkRefund with the required logic for this service method.
+	// Add api_transactions_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, Transaction{}) or use other options such as http.Ok ...
+	// return Response(200, Transaction{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("LinkRefund method not implemented")
+}
+
+// UnlinkRefund - remove refund or reimbursement link of transaction
+func (s *TransactionsAPIServiceImpl) UnlinkRefund(ctx context.Context, id string) (ImplResponse, error) {
+	// TODO - update UnlinkRefund with the required logic for this service method.
+	// Add api_transactions_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, Transaction{}) or use other options such as http.Ok ...
+	// return Response(200, Transaction{}), nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UnlinkRefund method not implemented")
+}
+
 // MergeTransactions - merge two transactions
 func (s *TransactionsAPIServiceImpl) MergeTransactions(ctx context.Context, mergeTransactionsRequest MergeTransactionsRequest) (ImplResponse, error) {
 	// TODO - update MergeTransactions with the required logic for this service method.
@@ -33,13 +33,18 @@ type CustomControllers struct {
 	BudgetItemsAPIService             BudgetItemsAPIService
 	CurrenciesAPIService              CurrenciesAPIService
 	ExportAPIService                  ExportAPIService
+	ForecastAPIService                ForecastAPIService
+	GoalsAPIService                   GoalsAPIService
 	ImportAPIService                  ImportAPIService
 	MatchersAPIService                MatchersAPIService
 	MergedTransactionsAPIService      MergedTransactionsAPIService
 	NotificationsAPIService           NotificationsAPIService
 	ReconciliationAPIService          ReconciliationAPIService
+	SecuritiesAPIService              SecuritiesAPIService
+	TagsAPIService                    TagsAPIService
 	TemplatesAPIService               TemplatesAPIService
 	TransactionsAPIService            TransactionsAPIService
+	TransfersAPIService               TransfersAPIService
 	UnprocessedTransactionsAPIService UnprocessedTransactionsAPIService
 	UserAPIService                    UserAPIService
 }
@@ -100,6 +105,18 @@ func Serve(ctx context.Context, logger *slog.Logger, cfg *config.Config,
 	}
 	ExportAPIController := NewExportAPIController(ExportAPIService)
 
+	ForecastAPIService := NewForecastAPIService()
+	if controllers.ForecastAPIService != nil {
+		ForecastAPIService = controllers.ForecastAPIService
+	}
+	ForecastAPIController := NewForecastAPIController(ForecastAPIService)
+
+	GoalsAPIService := NewGoalsAPIService()
+	if controllers.GoalsAPIService != nil {
+		GoalsAPIService = controllers.GoalsAPIService
+	}
+	GoalsAPIController := NewGoalsAPIController(GoalsAPIService)
+
 	ImportAPIService := NewImportAPIService()
 	if controllers.ImportAPIService != nil {
 		ImportAPIService = controllers.ImportAPIService
@@ -130,6 +147,18 @@ func Serve(ctx context.Context, logger *slog.Logger, cfg *config.Config,
 	}
 	ReconciliationAPIController := NewReconciliationAPIController(ReconciliationAPIService)
 
+	SecuritiesAPIService := NewSecuritiesAPIService()
+	if controllers.SecuritiesAPIService != nil {
+		SecuritiesAPIService = controllers.SecuritiesAPIService
+	}
+	SecuritiesAPIController := NewSecuritiesAPIController(SecuritiesAPIService)
+
+	TagsAPIService := NewTagsAPIService()
+	if controllers.TagsAPIService != nil {
+		TagsAPIService = controllers.TagsAPIService
+	}
+	TagsAPIController := NewTagsAPIController(TagsAPIService)
+
 	TemplatesAPIService := NewTemplatesAPIService()
 	if controllers.TemplatesAPIService != nil {
 		TemplatesAPIService = controllers.TemplatesAPIService
@@ -142,6 +171,12 @@ func Serve(ctx context.Context, logger *slog.Logger, cfg *config.Config,
 	}
 	TransactionsAPIController := NewTransactionsAPIController(TransactionsAPIService)
 
+	TransfersAPIService := NewTransfersAPIService()
+	if controllers.TransfersAPIService != nil {
+		TransfersAPIService = controllers.TransfersAPIService
+	}
+	TransfersAPIController := NewTransfersAPIController(TransfersAPIService)
+
 	UnprocessedTransactionsAPIService := NewUnprocessedTransactionsAPIService()
 	if controllers.UnprocessedTransactionsAPIService != nil {
 		UnprocessedTransactionsAPIService = controllers.UnprocessedTransactionsAPIService
@@ -154,7 +189,7 @@ func Serve(ctx context.Context, logger *slog.Logger, cfg *config.Config,
 	}
 	UserAPIController := NewUserAPIController(UserAPIService)
 
-	routers := append(extraRouters, AccountsAPIController, AggregationsAPIController, AuditLogsAPIController, AuthAPIController, BankImportersAPIController, BudgetItemsAPIController, CurrenciesAPIController, ExportAPIController, ImportAPIController, MatchersAPIController, MergedTransactionsAPIController, NotificationsAPIController, ReconciliationAPIController, TemplatesAPIController, TransactionsAPIController, UnprocessedTransactionsAPIController, UserAPIController)
+	routers := append(extraRouters, AccountsAPIController, AggregationsAPIController, AuditLogsAPIController, AuthAPIController, BankImportersAPIController, BudgetItemsAPIController, CurrenciesAPIController, ExportAPIController, ForecastAPIController, GoalsAPIController, ImportAPIController, MatchersAPIController, MergedTransactionsAPIController, NotificationsAPIController, ReconciliationAPIController, SecuritiesAPIController, TagsAPIController, TemplatesAPIController, TransactionsAPIController, TransfersAPIController, UnprocessedTransactionsAPIController, UserAPIController)
 	router := NewRouter(logger, routers...)
 
 	router.Use(middlewares...)
@@ -46,6 +46,15 @@ type Account struct {
 
 	// If true, this account is shown on the reconciliation page even if it has no bank importer.
 	ShowInReconciliation bool `json:"showInReconciliation,omitempty"`
+
+	// ID of the parent account of the same type, e.g. \"Food\" for \"Groceries\". Empty for top-level accounts.
+	ParentId string `json:"parentId,omitempty"`
+
+	LoanTerms LoanTerms `json:"loanTerms,omitempty"`
+
+	BudgetAlerts BudgetAlerts `json:"budgetAlerts,omitempty"`
+
+	BudgetRollover BudgetRollover `json:"budgetRollover,omitempty"`
 }
 
 type AccountInterface interface {
@@ -61,6 +70,10 @@ type AccountInterface interface {
 	GetOpeningDate() time.Time
 	GetClosingDate() time.Time
 	GetShowInReconciliation() bool
+	GetParentId() string
+	GetLoanTerms() LoanTerms
+	GetBudgetAlerts() BudgetAlerts
+	GetBudgetRollover() BudgetRollover
 }
 
 func (c *Account) GetId() string {
@@ -99,6 +112,18 @@ func (c *Account) GetClosingDate() time.Time {
 func (c *Account) GetShowInReconciliation() bool {
 	return c.ShowInReconciliation
 }
+func (c *Account) GetParentId() string {
+	return c.ParentId
+}
+func (c *Account) GetLoanTerms() LoanTerms {
+	return c.LoanTerms
+}
+func (c *Account) GetBudgetAlerts() BudgetAlerts {
+	return c.BudgetAlerts
+}
+func (c *Account) GetBudgetRollover() BudgetRollover {
+	return c.BudgetRollover
+}
 
 // AssertAccountRequired checks if the required fields are not zero-ed
 func AssertAccountRequired(obj Account) error {
@@ -117,6 +142,15 @@ func AssertAccountRequired(obj Account) error {
 	if err := AssertBankAccountInfoRequired(obj.BankInfo); err != nil {
 		return err
 	}
+	if err := AssertLoanTermsRequired(obj.LoanTerms); err != nil {
+		return err
+	}
+	if err := AssertBudgetAlertsRequired(obj.BudgetAlerts); err != nil {
+		return err
+	}
+	if err := AssertBudgetRolloverRequired(obj.BudgetRollover); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -125,5 +159,14 @@ func AssertAccountConstraints(obj Account) error {
 	if err := AssertBankAccountInfoConstraints(obj.BankInfo); err != nil {
 		return err
 	}
+	if err := AssertLoanTermsConstraints(obj.LoanTerms); err != nil {
+		return err
+	}
+	if err := AssertBudgetAlertsConstraints(obj.BudgetAlerts); err != nil {
+		return err
+	}
+	if err := AssertBudgetRolloverConstraints(obj.BudgetRollover); err != nil {
+		return err
+	}
 	return nil
 }
@@ -44,6 +44,15 @@ type AccountNoId struct {
 
 	// If true, this account is shown on the reconciliation page even if it has no bank importer.
 	ShowInReconciliation bool `json:"showInReconciliation,omitempty"`
+
+	// ID of the parent account of the same type, e.g. \"Food\" for \"Groceries\". Empty for top-level accounts.
+	ParentId string `json:"parentId,omitempty"`
+
+	LoanTerms LoanTerms `json:"loanTerms,omitempty"`
+
+	BudgetAlerts BudgetAlerts `json:"budgetAlerts,omitempty"`
+
+	BudgetRollover BudgetRollover `json:"budgetRollover,omitempty"`
 }
 
 type AccountNoIdInterface interface {
@@ -58,6 +67,10 @@ type AccountNoIdInterface interface {
 	GetOpeningDate() time.Time
 	GetClosingDate() time.Time
 	GetShowInReconciliation() bool
+	GetParentId() string
+	GetLoanTerms() LoanTerms
+	GetBudgetAlerts() BudgetAlerts
+	GetBudgetRollover() BudgetRollover
 }
 
 func (c *AccountNoId) GetName() string {
@@ -93,6 +106,18 @@ func (c *AccountNoId) GetClosingDate() time.Time {
 func (c *AccountNoId) GetShowInReconciliation() bool {
 	return c.ShowInReconciliation
 }
+func (c *AccountNoId) GetParentId() string {
+	return c.ParentId
+}
+func (c *AccountNoId) GetLoanTerms() LoanTerms {
+	return c.LoanTerms
+}
+func (c *AccountNoId) GetBudgetAlerts() BudgetAlerts {
+	return c.BudgetAlerts
+}
+func (c *AccountNoId) GetBudgetRollover() BudgetRollover {
+	return c.BudgetRollover
+}
 
 // AssertAccountNoIdRequired checks if the required fields are not zero-ed
 func AssertAccountNoIdRequired(obj AccountNoId) error {
@@ -109,6 +134,15 @@ func AssertAccountNoIdRequired(obj AccountNoId) error {
 	if err := AssertBankAccountInfoRequired(obj.BankInfo); err != nil {
 		return err
 	}
+	if err := AssertLoanTermsRequired(obj.LoanTerms); err != nil {
+		return err
+	}
+	if err := AssertBudgetAlertsRequired(obj.BudgetAlerts); err != nil {
+		return err
+	}
+	if err := AssertBudgetRolloverRequired(obj.BudgetRollover); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -117,5 +151,14 @@ func AssertAccountNoIdConstraints(obj AccountNoId) error {
 	if err := AssertBankAccountInfoConstraints(obj.BankInfo); err != nil {
 		return err
 	}
+	if err := AssertLoanTermsConstraints(obj.LoanTerms); err != nil {
+		return err
+	}
+	if err := AssertBudgetAlertsConstraints(obj.BudgetAlerts); err != nil {
+		return err
+	}
+	if err := AssertBudgetRolloverConstraints(obj.BudgetRollover); err != nil {
+		return err
+	}
 	return nil
 }
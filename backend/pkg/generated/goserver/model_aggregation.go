@@ -25,6 +25,9 @@ type Aggregation struct {
 	Intervals []time.Time `json:"intervals"`
 
 	Currencies []CurrencyAggregation `json:"currencies"`
+
+	// Sources of the exchange rates used to convert amounts to the output currency: rates the transactions were executed at, manual rates of the family or rate providers.
+	RateSources []string `json:"rateSources,omitempty"`
 }
 
 type AggregationInterface interface {
@@ -33,6 +36,7 @@ type AggregationInterface interface {
 	GetGranularity() string
 	GetIntervals() []time.Time
 	GetCurrencies() []CurrencyAggregation
+	GetRateSources() []string
 }
 
 func (c *Aggregation) GetFrom() time.Time {
@@ -50,6 +54,9 @@ func (c *Aggregation) GetIntervals() []time.Time {
 func (c *Aggregation) GetCurrencies() []CurrencyAggregation {
 	return c.Currencies
 }
+func (c *Aggregation) GetRateSources() []string {
+	return c.RateSources
+}
 
 // AssertAggregationRequired checks if the required fields are not zero-ed
 func AssertAggregationRequired(obj Aggregation) error {
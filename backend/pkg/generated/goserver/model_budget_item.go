@@ -22,10 +22,19 @@ type BudgetItem struct {
 
 	Date time.Time `json:"date"`
 
-	AccountId string `json:"accountId"`
+	AccountId string `json:"accountId,omitempty"`
+
+	// Budgets transactions with this tag or its sub-tags
+	Tag string `json:"tag,omitempty"`
+
+	// Budgets transactions with this partner name or place, compared ignoring case and punctuation
+	Partner string `json:"partner,omitempty"`
 
 	Amount decimal.Decimal `json:"amount"`
 
+	// Currency of the amount of tag and partner budgets, account budgets are in the currency of their account
+	CurrencyId string `json:"currencyId,omitempty"`
+
 	Description string `json:"description,omitempty"`
 }
 
@@ -33,7 +42,10 @@ type BudgetItemInterface interface {
 	GetId() string
 	GetDate() time.Time
 	GetAccountId() string
+	GetTag() string
+	GetPartner() string
 	GetAmount() decimal.Decimal
+	GetCurrencyId() string
 	GetDescription() string
 }
 
@@ -46,9 +58,18 @@ func (c *BudgetItem) GetDate() time.Time {
 func (c *BudgetItem) GetAccountId() string {
 	return c.AccountId
 }
+func (c *BudgetItem) GetTag() string {
+	return c.Tag
+}
+func (c *BudgetItem) GetPartner() string {
+	return c.Partner
+}
 func (c *BudgetItem) GetAmount() decimal.Decimal {
 	return c.Amount
 }
+func (c *BudgetItem) GetCurrencyId() string {
+	return c.CurrencyId
+}
 func (c *BudgetItem) GetDescription() string {
 	return c.Description
 }
@@ -56,10 +77,9 @@ func (c *BudgetItem) GetDescription() string {
 // AssertBudgetItemRequired checks if the required fields are not zero-ed
 func AssertBudgetItemRequired(obj BudgetItem) error {
 	elements := map[string]interface{}{
-		"id":        obj.Id,
-		"date":      obj.Date,
-		"accountId": obj.AccountId,
-		"amount":    obj.Amount,
+		"id":     obj.Id,
+		"date":   obj.Date,
+		"amount": obj.Amount,
 	}
 	for name, el := range elements {
 		if isZero := IsZeroValue(el); isZero {
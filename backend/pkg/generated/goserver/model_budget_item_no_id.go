@@ -17,20 +17,33 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// BudgetItemNoId - Budget of a month for exactly one target: an account, a tag or a partner. Tags and partners cut across accounts, their spending is what transactions with the tag (or its sub-tags) or with the partner bring to expense accounts.
 type BudgetItemNoId struct {
 	Date time.Time `json:"date"`
 
-	AccountId string `json:"accountId"`
+	AccountId string `json:"accountId,omitempty"`
+
+	// Budgets transactions with this tag or its sub-tags
+	Tag string `json:"tag,omitempty"`
+
+	// Budgets transactions with this partner name or place, compared ignoring case and punctuation
+	Partner string `json:"partner,omitempty"`
 
 	Amount decimal.Decimal `json:"amount"`
 
+	// Currency of the amount of tag and partner budgets, account budgets are in the currency of their account
+	CurrencyId string `json:"currencyId,omitempty"`
+
 	Description string `json:"description,omitempty"`
 }
 
 type BudgetItemNoIdInterface interface {
 	GetDate() time.Time
 	GetAccountId() string
+	GetTag() string
+	GetPartner() string
 	GetAmount() decimal.Decimal
+	GetCurrencyId() string
 	GetDescription() string
 }
 
@@ -40,9 +53,18 @@ func (c *BudgetItemNoId) GetDate() time.Time {
 func (c *BudgetItemNoId) GetAccountId() string {
 	return c.AccountId
 }
+func (c *BudgetItemNoId) GetTag() string {
+	return c.Tag
+}
+func (c *BudgetItemNoId) GetPartner() string {
+	return c.Partner
+}
 func (c *BudgetItemNoId) GetAmount() decimal.Decimal {
 	return c.Amount
 }
+func (c *BudgetItemNoId) GetCurrencyId() string {
+	return c.CurrencyId
+}
 func (c *BudgetItemNoId) GetDescription() string {
 	return c.Description
 }
@@ -50,9 +72,8 @@ func (c *BudgetItemNoId) GetDescription() string {
 // AssertBudgetItemNoIdRequired checks if the required fields are not zero-ed
 func AssertBudgetItemNoIdRequired(obj BudgetItemNoId) error {
 	elements := map[string]interface{}{
-		"date":      obj.Date,
-		"accountId": obj.AccountId,
-		"amount":    obj.Amount,
+		"date":   obj.Date,
+		"amount": obj.Amount,
 	}
 	for name, el := range elements {
 		if isZero := IsZeroValue(el); isZero {
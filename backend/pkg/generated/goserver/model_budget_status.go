@@ -26,9 +26,21 @@ type BudgetStatus struct {
 
 	Spent decimal.Decimal `json:"spent"`
 
+	// Budget moved into the envelope in the period, negative if moved out
+	Transferred decimal.Decimal `json:"transferred,omitempty"`
+
 	Rollover decimal.Decimal `json:"rollover"`
 
 	Available decimal.Decimal `json:"available"`
+
+	// Set for budgets of a tag, the account is empty then
+	Tag string `json:"tag,omitempty"`
+
+	// Set for budgets of a partner, the account is empty then
+	Partner string `json:"partner,omitempty"`
+
+	// Set for savings goals. Budgeted is then the contribution needed in the period to reach the goal in time, spent is the amount saved in the period and available is what is still to be saved. The account is the first account of the goal
+	GoalId string `json:"goalId,omitempty"`
 }
 
 type BudgetStatusInterface interface {
@@ -36,8 +48,12 @@ type BudgetStatusInterface interface {
 	GetAccountId() string
 	GetBudgeted() decimal.Decimal
 	GetSpent() decimal.Decimal
+	GetTransferred() decimal.Decimal
 	GetRollover() decimal.Decimal
 	GetAvailable() decimal.Decimal
+	GetTag() string
+	GetPartner() string
+	GetGoalId() string
 }
 
 func (c *BudgetStatus) GetDate() time.Time {
@@ -52,12 +68,24 @@ func (c *BudgetStatus) GetBudgeted() decimal.Decimal {
 func (c *BudgetStatus) GetSpent() decimal.Decimal {
 	return c.Spent
 }
+func (c *BudgetStatus) GetTransferred() decimal.Decimal {
+	return c.Transferred
+}
 func (c *BudgetStatus) GetRollover() decimal.Decimal {
 	return c.Rollover
 }
 func (c *BudgetStatus) GetAvailable() decimal.Decimal {
 	return c.Available
 }
+func (c *BudgetStatus) GetTag() string {
+	return c.Tag
+}
+func (c *BudgetStatus) GetPartner() string {
+	return c.Partner
+}
+func (c *BudgetStatus) GetGoalId() string {
+	return c.GoalId
+}
 
 // AssertBudgetStatusRequired checks if the required fields are not zero-ed
 func AssertBudgetStatusRequired(obj BudgetStatus) error {
@@ -23,6 +23,12 @@ type Movement struct {
 	AccountId string `json:"accountId,omitempty"`
 
 	Description string `json:"description,omitempty"`
+
+	// Security bought (positive quantity) or sold (negative quantity), the amount is the cost or the proceeds
+	SecurityId string `json:"securityId,omitempty"`
+
+	// Units of the security
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
 }
 
 type MovementInterface interface {
@@ -30,6 +36,8 @@ type MovementInterface interface {
 	GetCurrencyId() string
 	GetAccountId() string
 	GetDescription() string
+	GetSecurityId() string
+	GetQuantity() decimal.Decimal
 }
 
 func (c *Movement) GetAmount() decimal.Decimal {
@@ -44,6 +52,12 @@ func (c *Movement) GetAccountId() string {
 func (c *Movement) GetDescription() string {
 	return c.Description
 }
+func (c *Movement) GetSecurityId() string {
+	return c.SecurityId
+}
+func (c *Movement) GetQuantity() decimal.Decimal {
+	return c.Quantity
+}
 
 // AssertMovementRequired checks if the required fields are not zero-ed
 func AssertMovementRequired(obj Movement) error {
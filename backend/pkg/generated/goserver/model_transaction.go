@@ -69,6 +69,15 @@ type Transaction struct {
 
 	// List of transaction IDs that are potential duplicates of this one (from separate junction table)
 	DuplicateTransactionIds []string `json:"duplicateTransactionIds,omitempty"`
+
+	// ID of the original expense transaction this one refunds or reimburses (if any), set through the refund link endpoints only
+	RefundOfId string `json:"refundOfId,omitempty"`
+
+	// Kind of the link to the original transaction, set together with refundOfId
+	RefundKind string `json:"refundKind,omitempty"`
+
+	// Effective exchange rates of a transaction with movements in two currencies, calculated from its movements when the transaction is saved. Ignored on input.
+	ExchangeRates []TransactionRate `json:"exchangeRates,omitempty"`
 }
 
 type TransactionInterface interface {
@@ -93,6 +102,9 @@ type TransactionInterface interface {
 	GetDuplicateDismissed() bool
 	GetMergedTransactionIds() []string
 	GetDuplicateTransactionIds() []string
+	GetRefundOfId() string
+	GetRefundKind() string
+	GetExchangeRates() []TransactionRate
 }
 
 func (c *Transaction) GetId() string {
@@ -158,6 +170,15 @@ func (c *Transaction) GetMergedTransactionIds() []string {
 func (c *Transaction) GetDuplicateTransactionIds() []string {
 	return c.DuplicateTransactionIds
 }
+func (c *Transaction) GetRefundOfId() string {
+	return c.RefundOfId
+}
+func (c *Transaction) GetRefundKind() string {
+	return c.RefundKind
+}
+func (c *Transaction) GetExchangeRates() []TransactionRate {
+	return c.ExchangeRates
+}
 
 // AssertTransactionRequired checks if the required fields are not zero-ed
 func AssertTransactionRequired(obj Transaction) error {
@@ -177,6 +198,11 @@ func AssertTransactionRequired(obj Transaction) error {
 			return err
 		}
 	}
+	for _, el := range obj.ExchangeRates {
+		if err := AssertTransactionRateRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -187,5 +213,10 @@ func AssertTransactionConstraints(obj Transaction) error {
 			return err
 		}
 	}
+	for _, el := range obj.ExchangeRates {
+		if err := AssertTransactionRateConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -67,6 +67,15 @@ type TransactionNoId struct {
 
 	// List of transaction IDs that are potential duplicates of this one (from separate junction table)
 	DuplicateTransactionIds []string `json:"duplicateTransactionIds,omitempty"`
+
+	// ID of the original expense transaction this one refunds or reimburses (if any), set through the refund link endpoints only
+	RefundOfId string `json:"refundOfId,omitempty"`
+
+	// Kind of the link to the original transaction, set together with refundOfId
+	RefundKind string `json:"refundKind,omitempty"`
+
+	// Effective exchange rates of a transaction with movements in two currencies, calculated from its movements when the transaction is saved. Ignored on input.
+	ExchangeRates []TransactionRate `json:"exchangeRates,omitempty"`
 }
 
 type TransactionNoIdInterface interface {
@@ -90,6 +99,9 @@ type TransactionNoIdInterface interface {
 	GetDuplicateDismissed() bool
 	GetMergedTransactionIds() []string
 	GetDuplicateTransactionIds() []string
+	GetRefundOfId() string
+	GetRefundKind() string
+	GetExchangeRates() []TransactionRate
 }
 
 func (c *TransactionNoId) GetDate() time.Time {
@@ -152,6 +164,15 @@ func (c *TransactionNoId) GetMergedTransactionIds() []string {
 func (c *TransactionNoId) GetDuplicateTransactionIds() []string {
 	return c.DuplicateTransactionIds
 }
+func (c *TransactionNoId) GetRefundOfId() string {
+	return c.RefundOfId
+}
+func (c *TransactionNoId) GetRefundKind() string {
+	return c.RefundKind
+}
+func (c *TransactionNoId) GetExchangeRates() []TransactionRate {
+	return c.ExchangeRates
+}
 
 // AssertTransactionNoIdRequired checks if the required fields are not zero-ed
 func AssertTransactionNoIdRequired(obj TransactionNoId) error {
@@ -170,6 +191,11 @@ func AssertTransactionNoIdRequired(obj TransactionNoId) error {
 			return err
 		}
 	}
+	for _, el := range obj.ExchangeRates {
+		if err := AssertTransactionRateRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -180,5 +206,10 @@ func AssertTransactionNoIdConstraints(obj TransactionNoId) error {
 			return err
 		}
 	}
+	for _, el := range obj.ExchangeRates {
+		if err := AssertTransactionRateConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
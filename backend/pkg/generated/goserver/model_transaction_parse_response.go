@@ -16,11 +16,15 @@ type TransactionParseResponse struct {
 
 	// Human-readable warnings for fields that could not be parsed or were ambiguously matched
 	Warnings []string `json:"warnings"`
+
+	// Structured form of warnings, in the same order
+	Details []TransactionParseWarning `json:"details,omitempty"`
 }
 
 type TransactionParseResponseInterface interface {
 	GetTransaction() TransactionNoId
 	GetWarnings() []string
+	GetDetails() []TransactionParseWarning
 }
 
 func (c *TransactionParseResponse) GetTransaction() TransactionNoId {
@@ -29,6 +33,9 @@ func (c *TransactionParseResponse) GetTransaction() TransactionNoId {
 func (c *TransactionParseResponse) GetWarnings() []string {
 	return c.Warnings
 }
+func (c *TransactionParseResponse) GetDetails() []TransactionParseWarning {
+	return c.Details
+}
 
 // AssertTransactionParseResponseRequired checks if the required fields are not zero-ed
 func AssertTransactionParseResponseRequired(obj TransactionParseResponse) error {
@@ -45,6 +52,11 @@ func AssertTransactionParseResponseRequired(obj TransactionParseResponse) error
 	if err := AssertTransactionNoIdRequired(obj.Transaction); err != nil {
 		return err
 	}
+	for _, el := range obj.Details {
+		if err := AssertTransactionParseWarningRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -53,5 +65,10 @@ func AssertTransactionParseResponseConstraints(obj TransactionParseResponse) err
 	if err := AssertTransactionNoIdConstraints(obj.Transaction); err != nil {
 		return err
 	}
+	for _, el := range obj.Details {
+		if err := AssertTransactionParseWarningConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
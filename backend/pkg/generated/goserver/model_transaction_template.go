@@ -11,6 +11,10 @@
 
 package goserver
 
+import (
+	"time"
+)
+
 type TransactionTemplate struct {
 	Id string `json:"id"`
 
@@ -28,6 +32,12 @@ type TransactionTemplate struct {
 	Extra string `json:"extra,omitempty"`
 
 	Movements []Movement `json:"movements"`
+
+	// Makes the template a scheduled payment repeating with this period, used by the balance forecast
+	Repeat string `json:"repeat,omitempty"`
+
+	// Date of the next scheduled payment, required with repeat
+	NextDate time.Time `json:"nextDate,omitempty"`
 }
 
 type TransactionTemplateInterface interface {
@@ -39,6 +49,8 @@ type TransactionTemplateInterface interface {
 	GetPartnerName() string
 	GetExtra() string
 	GetMovements() []Movement
+	GetRepeat() string
+	GetNextDate() time.Time
 }
 
 func (c *TransactionTemplate) GetId() string {
@@ -65,6 +77,12 @@ func (c *TransactionTemplate) GetExtra() string {
 func (c *TransactionTemplate) GetMovements() []Movement {
 	return c.Movements
 }
+func (c *TransactionTemplate) GetRepeat() string {
+	return c.Repeat
+}
+func (c *TransactionTemplate) GetNextDate() time.Time {
+	return c.NextDate
+}
 
 // AssertTransactionTemplateRequired checks if the required fields are not zero-ed
 func AssertTransactionTemplateRequired(obj TransactionTemplate) error {
@@ -11,6 +11,10 @@
 
 package goserver
 
+import (
+	"time"
+)
+
 type TransactionTemplateNoId struct {
 
 	// User-given label for the template
@@ -27,6 +31,12 @@ type TransactionTemplateNoId struct {
 	Extra string `json:"extra,omitempty"`
 
 	Movements []Movement `json:"movements"`
+
+	// Makes the template a scheduled payment repeating with this period, used by the balance forecast
+	Repeat string `json:"repeat,omitempty"`
+
+	// Date of the next scheduled payment, required with repeat
+	NextDate time.Time `json:"nextDate,omitempty"`
 }
 
 type TransactionTemplateNoIdInterface interface {
@@ -37,6 +47,8 @@ type TransactionTemplateNoIdInterface interface {
 	GetPartnerName() string
 	GetExtra() string
 	GetMovements() []Movement
+	GetRepeat() string
+	GetNextDate() time.Time
 }
 
 func (c *TransactionTemplateNoId) GetName() string {
@@ -60,6 +72,12 @@ func (c *TransactionTemplateNoId) GetExtra() string {
 func (c *TransactionTemplateNoId) GetMovements() []Movement {
 	return c.Movements
 }
+func (c *TransactionTemplateNoId) GetRepeat() string {
+	return c.Repeat
+}
+func (c *TransactionTemplateNoId) GetNextDate() time.Time {
+	return c.NextDate
+}
 
 // AssertTransactionTemplateNoIdRequired checks if the required fields are not zero-ed
 func AssertTransactionTemplateNoIdRequired(obj TransactionTemplateNoId) error {
@@ -12,6 +12,7 @@
 package goserver
 
 import (
+	"errors"
 	"time"
 )
 
@@ -24,6 +25,27 @@ type User struct {
 
 	// ID of the user's favorite currency. By default this currency will be used to convert other currencies.
 	FavoriteCurrencyId string `json:"favoriteCurrencyId,omitempty"`
+
+	// ID of the account money is taken from when a quick entry text doesn't specify one.
+	QuickEntryAccountId string `json:"quickEntryAccountId,omitempty"`
+
+	// Day of month on which monthly reports and budgets start, for example the salary day. 1 means calendar months. Shared by all users of the family.
+	MonthStartDay int32 `json:"monthStartDay,omitempty"`
+
+	// How unusual spending has to be to notify about it. Empty means medium. Shared by all users of the family.
+	AnomalySensitivity string `json:"anomalySensitivity,omitempty"`
+
+	// How overspent budgets are handled. \"rollover\" (the default when empty) carries the overspending to the next period of the envelope, \"reset\" covers it from ready to assign. Shared by all users of the family.
+	BudgetOverspending string `json:"budgetOverspending,omitempty"`
+
+	// Accounts which are never reported as spending anomalies. Shared by all users of the family.
+	AnomalyMutedAccountIds []string `json:"anomalyMutedAccountIds,omitempty"`
+
+	// Exchange rate providers asked in order until one knows both currencies of a conversion. Empty means only the Czech National Bank. Manual rates are always preferred to providers, so \"manual\" may be omitted. Shared by all users of the family.
+	RateProviders []string `json:"rateProviders,omitempty"`
+
+	// Currency the manual exchange rates are expressed in and conversions fall back to when no provider knows both currencies. Empty means CZK. Shared by all users of the family.
+	RateBaseCurrencyId string `json:"rateBaseCurrencyId,omitempty"`
 }
 
 type UserInterface interface {
@@ -31,6 +53,13 @@ type UserInterface interface {
 	GetEmail() string
 	GetStartDate() time.Time
 	GetFavoriteCurrencyId() string
+	GetQuickEntryAccountId() string
+	GetMonthStartDay() int32
+	GetAnomalySensitivity() string
+	GetBudgetOverspending() string
+	GetAnomalyMutedAccountIds() []string
+	GetRateProviders() []string
+	GetRateBaseCurrencyId() string
 }
 
 func (c *User) GetId() string {
@@ -45,6 +74,27 @@ func (c *User) GetStartDate() time.Time {
 func (c *User) GetFavoriteCurrencyId() string {
 	return c.FavoriteCurrencyId
 }
+func (c *User) GetQuickEntryAccountId() string {
+	return c.QuickEntryAccountId
+}
+func (c *User) GetMonthStartDay() int32 {
+	return c.MonthStartDay
+}
+func (c *User) GetAnomalySensitivity() string {
+	return c.AnomalySensitivity
+}
+func (c *User) GetBudgetOverspending() string {
+	return c.BudgetOverspending
+}
+func (c *User) GetAnomalyMutedAccountIds() []string {
+	return c.AnomalyMutedAccountIds
+}
+func (c *User) GetRateProviders() []string {
+	return c.RateProviders
+}
+func (c *User) GetRateBaseCurrencyId() string {
+	return c.RateBaseCurrencyId
+}
 
 // AssertUserRequired checks if the required fields are not zero-ed
 func AssertUserRequired(obj User) error {
@@ -64,5 +114,11 @@ func AssertUserRequired(obj User) error {
 
 // AssertUserConstraints checks if the values respects the defined constraints
 func AssertUserConstraints(obj User) error {
+	if obj.MonthStartDay < 1 {
+		return &ParsingError{Param: "MonthStartDay", Err: errors.New(errMsgMinValueConstraint)}
+	}
+	if obj.MonthStartDay > 28 {
+		return &ParsingError{Param: "MonthStartDay", Err: errors.New(errMsgMaxValueConstraint)}
+	}
 	return nil
 }
@@ -11,19 +11,70 @@
 
 package goserver
 
+import "errors"
+
 type UserPatchBody struct {
 
 	// ID of the user's favorite currency. By default this currency will be used to convert other currencies.
 	FavoriteCurrencyId string `json:"favoriteCurrencyId,omitempty"`
+
+	// ID of the default account for quick entry, an asset account of the family. Left unchanged when omitted, empty string clears it.
+	QuickEntryAccountId *string `json:"quickEntryAccountId,omitempty"`
+
+	// Day of month on which monthly reports and budgets start, between 1 and 28. Shared by all users of the family. Left unchanged when omitted.
+	MonthStartDay *int32 `json:"monthStartDay,omitempty"`
+
+	// How unusual spending has to be to notify about it. Left unchanged when omitted.
+	AnomalySensitivity *string `json:"anomalySensitivity,omitempty"`
+
+	// How overspent budgets are handled. Left unchanged when omitted.
+	BudgetOverspending *string `json:"budgetOverspending,omitempty"`
+
+	// Accounts of the family which are never reported as spending anomalies. Left unchanged when omitted.
+	AnomalyMutedAccountIds *[]string `json:"anomalyMutedAccountIds,omitempty"`
+
+	// Exchange rate providers asked in order. Left unchanged when omitted.
+	RateProviders *[]string `json:"rateProviders,omitempty"`
+
+	// Currency of the family the manual exchange rates are expressed in. Left unchanged when omitted, empty string means CZK.
+	RateBaseCurrencyId *string `json:"rateBaseCurrencyId,omitempty"`
 }
 
 type UserPatchBodyInterface interface {
 	GetFavoriteCurrencyId() string
+	GetQuickEntryAccountId() *string
+	GetMonthStartDay() *int32
+	GetAnomalySensitivity() *string
+	GetBudgetOverspending() *string
+	GetAnomalyMutedAccountIds() *[]string
+	GetRateProviders() *[]string
+	GetRateBaseCurrencyId() *string
 }
 
 func (c *UserPatchBody) GetFavoriteCurrencyId() string {
 	return c.FavoriteCurrencyId
 }
+func (c *UserPatchBody) GetQuickEntryAccountId() *string {
+	return c.QuickEntryAccountId
+}
+func (c *UserPatchBody) GetMonthStartDay() *int32 {
+	return c.MonthStartDay
+}
+func (c *UserPatchBody) GetAnomalySensitivity() *string {
+	return c.AnomalySensitivity
+}
+func (c *UserPatchBody) GetBudgetOverspending() *string {
+	return c.BudgetOverspending
+}
+func (c *UserPatchBody) GetAnomalyMutedAccountIds() *[]string {
+	return c.AnomalyMutedAccountIds
+}
+func (c *UserPatchBody) GetRateProviders() *[]string {
+	return c.RateProviders
+}
+func (c *UserPatchBody) GetRateBaseCurrencyId() *string {
+	return c.RateBaseCurrencyId
+}
 
 // AssertUserPatchBodyRequired checks if the required fields are not zero-ed
 func AssertUserPatchBodyRequired(obj UserPatchBody) error {
@@ -32,5 +83,11 @@ func AssertUserPatchBodyRequired(obj UserPatchBody) error {
 
 // AssertUserPatchBodyConstraints checks if the values respects the defined constraints
 func AssertUserPatchBodyConstraints(obj UserPatchBody) error {
+	if obj.MonthStartDay != nil && *obj.MonthStartDay < 1 {
+		return &ParsingError{Param: "MonthStartDay", Err: errors.New(errMsgMinValueConstraint)}
+	}
+	if obj.MonthStartDay != nil && *obj.MonthStartDay > 28 {
+		return &ParsingError{Param: "MonthStartDay", Err: errors.New(errMsgMaxValueConstraint)}
+	}
 	return nil
 }
@@ -4,12 +4,13 @@ const instructions = `GeekBudget is a personal finance management application.
 
 ## Data Model
 
-- **Accounts** have a type: "asset" (bank accounts, cash), "expense" (categories like groceries, rent), or "income" (salary, etc.)
+- **Accounts** have a type: "asset" (bank accounts, cash), "liability" (loans, mortgages), "expense" (categories like groceries, rent), or "income" (salary, etc.). Liabilities have a negative balance while money is owed; loans carry loan terms and their payments are split into principal and interest. Investment accounts hold securities: a movement with a "securityId" and "quantity" buys (positive) or sells (negative) units, and such accounts are valued at the latest security price.
 - **Currencies** are user-defined (e.g. CZK, EUR, USD). All amounts reference a currency ID.
 - **Transactions** represent financial events. Each transaction has a date, description, optional place/tags/partner info, and a list of **Movements**.
 - **Movements** are the core of double-entry bookkeeping: each movement transfers an amount in a specific currency to/from an account. A transaction typically has 2+ movements that balance out (e.g. -100 CZK from "Cash" account, +100 CZK to "Groceries" account).
 - **Matchers** are regex-based rules that auto-categorize imported bank transactions. They match on description, partner name, partner account number, currency, place, or keywords. Matchers have a confirmation history tracking their accuracy.
-- **Budget Items** define expected spending per account/category.
+- **Budget Plans** define recurring expected spending per account/category (monthly, quarterly, yearly or in chosen months); accruing quarterly and yearly plans spread their amount over every month. **Budget Items** set the budget of an account for a single month and override its plans in that month; an item may budget a tag or a partner instead, which counts spending across expense accounts. **Budget Transfers** move budget between accounts (envelopes); an empty account stands for income which is ready to assign. Each account has a rollover policy (unlimited, none, positive, negative or capped, optionally reset every quarter or year) deciding what its budget remainder carries to the next month. Accounts may enable **Budget Alerts** (a consumed percentage, exceeded, or projected to exceed by the end of the month), which create notifications at most once per alert and month.
+- **Goals** are savings goals with a target amount, a target date and a priority. Their saved amount is the balance of linked asset accounts or what transactions with the goal's tag bring to asset accounts. Progress tells the required monthly contribution and whether the recent contributions keep the goal on track.
 - **Bank Importers** connect to banks (FIO, Revolut, KB) to fetch transactions automatically.
 - **Reconciliation** compares the app's computed balance against the bank's reported balance for asset accounts.
 
@@ -32,8 +33,11 @@ const instructions = `GeekBudget is a personal finance management application.
 
 ## Common Queries
 
-- To understand spending: list transactions filtered by date, look at movements going to expense accounts
+- To understand spending: use spending_by_period for totals per expense account by day, week, month, quarter or year, or list transactions filtered by date and look at movements going to expense accounts
+- To see who money goes to: use partner_analytics, with top for the top N merchants
 - To check balances: use get_account_balance for a specific account+currency, or financial_summary for an overview
 - To find categorization issues: list_transactions with onlySuspicious=true, or check matchers
+- To check budgets: use list_budget_alerts for the enabled alerts and the alerts reached this month
+- To check savings goals: financial_summary lists the progress of all goals ordered by priority
 - To verify bank sync: get_reconciliation_status shows delta between app and bank balances
 `
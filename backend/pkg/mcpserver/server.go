@@ -7,20 +7,25 @@ import (
 	"github.com/google/uuid"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 )
 
 // MCPServer wraps the MCP server with GeekBudget-specific context
 type MCPServer struct {
 	logger   *slog.Logger
 	storage  database.Storage
+	rates    *common.RateService
 	familyID uuid.UUID
 }
 
 // Run starts the MCP stdio server
-func Run(ctx context.Context, logger *slog.Logger, storage database.Storage, familyID uuid.UUID) error {
+func Run(
+	ctx context.Context, logger *slog.Logger, storage database.Storage, rates *common.RateService, familyID uuid.UUID,
+) error {
 	s := &MCPServer{
 		logger:   logger,
 		storage:  storage,
+		rates:    rates,
 		familyID: familyID,
 	}
 
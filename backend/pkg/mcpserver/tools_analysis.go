@@ -2,27 +2,49 @@ package mcpserver
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/shopspring/decimal"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
 )
 
 func (s *MCPServer) registerAnalysisTools(server *mcp.Server) {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "financial_summary",
-		Description: "Get a comprehensive financial overview including all accounts, balances, notifications, and reconciliation status. Use this first to understand the user's financial state.",
+		Description: "Get a comprehensive financial overview including all accounts, balances, notifications, reconciliation status and progress of savings goals. Use this first to understand the user's financial state.",
 		Annotations: &mcp.ToolAnnotations{
 			ReadOnlyHint: true,
 		},
 	}, s.financialSummary)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "spending_by_period",
+		Description: "Get expenses per expense account split into periods: day, week (ISO), month (starting on the user's month start day), quarter or year. Defaults to the current month by months.",
+		Annotations: &mcp.ToolAnnotations{
+			ReadOnlyHint: true,
+		},
+	}, s.spendingByPeriod)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "partner_analytics",
+		Description: "Get money paid to and received from each partner (merchant, payee, employer) in a period with transaction count, average ticket, first and last seen dates and change against the previous period. Use top to get the top N merchants. Defaults to the current month in the favorite currency.",
+		Annotations: &mcp.ToolAnnotations{
+			ReadOnlyHint: true,
+		},
+	}, s.partnerAnalytics)
 }
 
 type financialSummaryResponse struct {
-	Accounts      []accountSummary      `json:"accounts"`
-	Currencies    []currencySummary     `json:"currencies"`
-	Balances      []balanceSummary      `json:"balances"`
-	Notifications []notificationSummary `json:"notifications"`
+	Accounts      []accountSummary        `json:"accounts"`
+	Currencies    []currencySummary       `json:"currencies"`
+	Balances      []balanceSummary        `json:"balances"`
+	Notifications []notificationSummary   `json:"notifications"`
+	Goals         []goserver.GoalProgress `json:"goals"`
 }
 
 type accountSummary struct {
@@ -82,6 +104,12 @@ func (s *MCPServer) financialSummary(ctx context.Context, req *mcp.CallToolReque
 		return errorResult(err)
 	}
 
+	goals, err := api.CalculateGoalsProgress(ctx, s.logger, s.storage, s.rates, s.familyID, time.Time{})
+	if err != nil {
+		s.logger.Error("Failed to calculate progress of goals", "error", err)
+		return errorResult(err)
+	}
+
 	currencyMap := make(map[string]string)
 	for _, c := range currencies {
 		currencyMap[c.Id] = c.Name
@@ -107,6 +135,7 @@ func (s *MCPServer) financialSummary(ctx context.Context, req *mcp.CallToolReque
 		Currencies:    make([]currencySummary, 0, len(currencies)),
 		Balances:      make([]balanceSummary, 0),
 		Notifications: make([]notificationSummary, 0, len(notifications)),
+		Goals:         goals,
 	}
 
 	for _, acc := range accounts {
@@ -199,3 +228,180 @@ func (s *MCPServer) financialSummary(ctx context.Context, req *mcp.CallToolReque
 
 	return jsonResult(resp)
 }
+
+type spendingByPeriodArgs struct {
+	DateFrom         string `json:"dateFrom,omitempty" jsonschema:"Start date YYYY-MM-DD, defaults to the start of the current month"`
+	DateTo           string `json:"dateTo,omitempty" jsonschema:"End date YYYY-MM-DD (exclusive), defaults to the end of the current month"`
+	Granularity      string `json:"granularity,omitempty" jsonschema:"One of day, week, month, quarter, year. Defaults to month"`
+	OutputCurrencyID string `json:"outputCurrencyId,omitempty" jsonschema:"Convert all amounts to this currency ID"`
+	Depth            int    `json:"depth,omitempty" jsonschema:"Roll sub-accounts up into their parent on this level, 1 reports only top-level accounts. Defaults to all accounts separately"`
+}
+
+type spendingByPeriodResponse struct {
+	Granularity string            `json:"granularity"`
+	Periods     []string          `json:"periods"`
+	Accounts    []periodSpendings `json:"accounts"`
+}
+
+type periodSpendings struct {
+	AccountID   string   `json:"accountId"`
+	AccountName string   `json:"accountName"`
+	CurrencyID  string   `json:"currencyId"`
+	Amounts     []string `json:"amounts"`
+	Total       string   `json:"total"`
+}
+
+func (s *MCPServer) spendingByPeriod(ctx context.Context, req *mcp.CallToolRequest, args spendingByPeriodArgs) (*mcp.CallToolResult, any, error) {
+	dateFrom, err := parseOptionalDate(args.DateFrom)
+	if err != nil {
+		return errorResult(err)
+	}
+	dateTo, err := parseOptionalDate(args.DateTo)
+	if err != nil {
+		return errorResult(err)
+	}
+
+	switch utils.Granularity(args.Granularity) {
+	case "", utils.GranularityDay, utils.GranularityWeek, utils.GranularityMonth,
+		utils.GranularityQuarter, utils.GranularityYear:
+	default:
+		return errorResult(fmt.Errorf("unknown granularity %q", args.Granularity))
+	}
+
+	granularity := utils.ParseGranularity(args.Granularity, 1)
+	if granularity == utils.GranularityMonth {
+		granularity = common.BudgetMonth(s.logger, s.storage, s.familyID)
+	}
+
+	aggregations := api.NewAggregationsAPIServiceImpl(s.logger, s.storage, s.rates)
+	agg, err := aggregations.GetAggregatedExpenses(
+		ctx, s.familyID, dateFrom, dateTo, args.OutputCurrencyID, granularity, false, "account", nil, nil, args.Depth)
+	if err != nil {
+		s.logger.Error("Failed to aggregate expenses", "error", err)
+		return errorResult(err)
+	}
+	if agg == nil {
+		return errorResult(fmt.Errorf("failed to aggregate expenses"))
+	}
+
+	accounts, err := s.storage.GetAccounts(s.familyID)
+	if err != nil {
+		s.logger.Error("Failed to get accounts", "error", err)
+		return errorResult(err)
+	}
+	accountMap := make(map[string]string)
+	for _, a := range accounts {
+		accountMap[a.Id] = a.Name
+	}
+
+	resp := spendingByPeriodResponse{
+		Granularity: agg.Granularity,
+		Periods:     make([]string, 0, len(agg.Intervals)),
+		Accounts:    make([]periodSpendings, 0),
+	}
+	for _, interval := range agg.Intervals {
+		resp.Periods = append(resp.Periods, interval.Format("2006-01-02"))
+	}
+	for _, c := range agg.Currencies {
+		for _, a := range c.Accounts {
+			item := periodSpendings{
+				AccountID:   a.AccountId,
+				AccountName: accountMap[a.AccountId],
+				CurrencyID:  c.CurrencyId,
+				Amounts:     make([]string, 0, len(a.Amounts)),
+			}
+			total := decimal.Zero
+			for _, amount := range a.Amounts {
+				item.Amounts = append(item.Amounts, amount.String())
+				total = total.Add(amount)
+			}
+			item.Total = total.String()
+			resp.Accounts = append(resp.Accounts, item)
+		}
+	}
+
+	return jsonResult(resp)
+}
+
+type partnerAnalyticsArgs struct {
+	DateFrom         string `json:"dateFrom,omitempty" jsonschema:"Start date YYYY-MM-DD, defaults to the start of the current month"`
+	DateTo           string `json:"dateTo,omitempty" jsonschema:"End date YYYY-MM-DD (exclusive), defaults to the end of the current month"`
+	OutputCurrencyID string `json:"outputCurrencyId,omitempty" jsonschema:"Convert all amounts to this currency ID, defaults to the favorite currency"`
+	SortBy           string `json:"sortBy,omitempty" jsonschema:"One of spent, received, count. Defaults to spent"`
+	Top              int    `json:"top,omitempty" jsonschema:"Return only this number of first partners, all if zero"`
+}
+
+type partnerSummary struct {
+	Name                     string `json:"name"`
+	PartnerAccount           string `json:"partnerAccount,omitempty"`
+	Spent                    string `json:"spent"`
+	Received                 string `json:"received"`
+	TransactionCount         int32  `json:"transactionCount"`
+	AverageTicket            string `json:"averageTicket"`
+	FirstSeen                string `json:"firstSeen"`
+	LastSeen                 string `json:"lastSeen"`
+	PreviousSpent            string `json:"previousSpent"`
+	PreviousReceived         string `json:"previousReceived"`
+	PreviousTransactionCount int32  `json:"previousTransactionCount"`
+	SpentChangePercent       string `json:"spentChangePercent"`
+	ReceivedChangePercent    string `json:"receivedChangePercent"`
+}
+
+type partnerAnalyticsResponse struct {
+	DateFrom         string           `json:"dateFrom"`
+	DateTo           string           `json:"dateTo"`
+	PreviousDateFrom string           `json:"previousDateFrom"`
+	OutputCurrencyID string           `json:"outputCurrencyId"`
+	Partners         []partnerSummary `json:"partners"`
+}
+
+func (s *MCPServer) partnerAnalytics(ctx context.Context, req *mcp.CallToolRequest, args partnerAnalyticsArgs) (*mcp.CallToolResult, any, error) {
+	dateFrom, err := parseOptionalDate(args.DateFrom)
+	if err != nil {
+		return errorResult(err)
+	}
+	dateTo, err := parseOptionalDate(args.DateTo)
+	if err != nil {
+		return errorResult(err)
+	}
+
+	outputCurrencyID := args.OutputCurrencyID
+	if outputCurrencyID == "" {
+		outputCurrencyID = common.FamilySettings(s.logger, s.storage, s.familyID).FavoriteCurrencyID()
+	}
+
+	aggregations := api.NewAggregationsAPIServiceImpl(s.logger, s.storage, s.rates)
+	report, err := aggregations.GetPartnerReport(
+		ctx, s.familyID, dateFrom, dateTo, outputCurrencyID, args.SortBy, args.Top)
+	if err != nil {
+		s.logger.Error("Failed to build partner report", "error", err)
+		return errorResult(err)
+	}
+
+	resp := partnerAnalyticsResponse{
+		DateFrom:         report.From.Format("2006-01-02"),
+		DateTo:           report.To.Format("2006-01-02"),
+		PreviousDateFrom: report.PreviousFrom.Format("2006-01-02"),
+		OutputCurrencyID: report.OutputCurrencyId,
+		Partners:         make([]partnerSummary, 0, len(report.Partners)),
+	}
+	for _, p := range report.Partners {
+		resp.Partners = append(resp.Partners, partnerSummary{
+			Name:                     p.Name,
+			PartnerAccount:           p.PartnerAccount,
+			Spent:                    p.Spent.String(),
+			Received:                 p.Received.String(),
+			TransactionCount:         p.TransactionCount,
+			AverageTicket:            p.AverageTicket.String(),
+			FirstSeen:                p.FirstSeen.Format("2006-01-02"),
+			LastSeen:                 p.LastSeen.Format("2006-01-02"),
+			PreviousSpent:            p.PreviousSpent.String(),
+			PreviousReceived:         p.PreviousReceived.String(),
+			PreviousTransactionCount: p.PreviousTransactionCount,
+			SpentChangePercent:       p.SpentChangePercent.String(),
+			ReceivedChangePercent:    p.ReceivedChangePercent.String(),
+		})
+	}
+
+	return jsonResult(resp)
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
 )
 
 func (s *MCPServer) registerBudgetTools(server *mcp.Server) {
@@ -14,6 +17,30 @@ func (s *MCPServer) registerBudgetTools(server *mcp.Server) {
 			ReadOnlyHint: true,
 		},
 	}, s.listBudgetItems)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_budget_plans",
+		Description: "List all budget plans (recurring expected spending per account/category)",
+		Annotations: &mcp.ToolAnnotations{
+			ReadOnlyHint: true,
+		},
+	}, s.listBudgetPlans)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_budget_transfers",
+		Description: "List all budget transfers (budget moved between envelopes or from ready to assign)",
+		Annotations: &mcp.ToolAnnotations{
+			ReadOnlyHint: true,
+		},
+	}, s.listBudgetTransfers)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_budget_alerts",
+		Description: "List budget alerts enabled per account and the budget alert notifications sent so far",
+		Annotations: &mcp.ToolAnnotations{
+			ReadOnlyHint: true,
+		},
+	}, s.listBudgetAlerts)
 }
 
 func (s *MCPServer) listBudgetItems(ctx context.Context, req *mcp.CallToolRequest, _ any) (*mcp.CallToolResult, any, error) {
@@ -24,3 +51,58 @@ func (s *MCPServer) listBudgetItems(ctx context.Context, req *mcp.CallToolReques
 	}
 	return jsonResult(budgetItems)
 }
+
+func (s *MCPServer) listBudgetPlans(ctx context.Context, req *mcp.CallToolRequest, _ any) (*mcp.CallToolResult, any, error) {
+	budgetPlans, err := s.storage.GetBudgetPlans(s.familyID)
+	if err != nil {
+		s.logger.Error("Failed to get budget plans", "error", err)
+		return errorResult(err)
+	}
+	return jsonResult(budgetPlans)
+}
+
+func (s *MCPServer) listBudgetTransfers(ctx context.Context, req *mcp.CallToolRequest, _ any) (*mcp.CallToolResult, any, error) {
+	budgetTransfers, err := s.storage.GetBudgetTransfers(s.familyID)
+	if err != nil {
+		s.logger.Error("Failed to get budget transfers", "error", err)
+		return errorResult(err)
+	}
+	return jsonResult(budgetTransfers)
+}
+
+type budgetAlertsResponse struct {
+	Accounts      []budgetAlertAccount    `json:"accounts"`
+	Notifications []goserver.Notification `json:"notifications"`
+}
+
+type budgetAlertAccount struct {
+	ID           string                `json:"id"`
+	Name         string                `json:"name"`
+	BudgetAlerts goserver.BudgetAlerts `json:"budgetAlerts"`
+}
+
+func (s *MCPServer) listBudgetAlerts(ctx context.Context, req *mcp.CallToolRequest, _ any) (*mcp.CallToolResult, any, error) {
+	accounts, err := s.storage.GetAccounts(s.familyID)
+	if err != nil {
+		s.logger.Error("Failed to get accounts", "error", err)
+		return errorResult(err)
+	}
+	notifications, err := s.storage.GetNotifications(s.familyID)
+	if err != nil {
+		s.logger.Error("Failed to get notifications", "error", err)
+		return errorResult(err)
+	}
+
+	res := budgetAlertsResponse{Accounts: []budgetAlertAccount{}, Notifications: []goserver.Notification{}}
+	for _, a := range accounts {
+		if utils.HasBudgetAlerts(a.BudgetAlerts) {
+			res.Accounts = append(res.Accounts, budgetAlertAccount{ID: a.Id, Name: a.Name, BudgetAlerts: a.BudgetAlerts})
+		}
+	}
+	for _, n := range notifications {
+		if n.Type == string(models.NotificationTypeBudgetAlert) {
+			res.Notifications = append(res.Notifications, n)
+		}
+	}
+	return jsonResult(res)
+}
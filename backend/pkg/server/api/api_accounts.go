@@ -13,6 +13,7 @@ import (
 	"github.com/ya-breeze/geekbudgetbe/pkg/database"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
 )
 
 type AccountsAPIServicerImpl struct {
@@ -40,6 +41,18 @@ func (s *AccountsAPIServicerImpl) CreateAccount(
 
 	account, err := s.db.CreateAccount(familyID, &acc)
 	if err != nil {
+		if errors.Is(err, database.ErrInvalidAccountParent) {
+			s.logger.With("error", err, "parentId", acc.ParentId).Warn("Invalid parent account")
+			return goserver.Response(400, nil), nil
+		}
+		if errors.Is(err, database.ErrInvalidLoanTerms) {
+			s.logger.With("error", err).Warn("Invalid loan terms")
+			return goserver.Response(400, nil), nil
+		}
+		if errors.Is(err, database.ErrInvalidBudgetAlerts) || errors.Is(err, database.ErrInvalidBudgetRollover) {
+			s.logger.With("error", err).Warn("Invalid budget settings")
+			return goserver.Response(400, nil), nil
+		}
 		s.logger.With("error", err).Error("Failed to create account")
 		return goserver.Response(500, nil), nil
 	}
@@ -76,6 +89,18 @@ func (s *AccountsAPIServicerImpl) UpdateAccount(
 
 	account, err := s.db.UpdateAccount(familyID, accountID, &acc)
 	if err != nil {
+		if errors.Is(err, database.ErrInvalidAccountParent) {
+			s.logger.With("error", err, "parentId", acc.ParentId).Warn("Invalid parent account")
+			return goserver.Response(400, nil), nil
+		}
+		if errors.Is(err, database.ErrInvalidLoanTerms) {
+			s.logger.With("error", err).Warn("Invalid loan terms")
+			return goserver.Response(400, nil), nil
+		}
+		if errors.Is(err, database.ErrInvalidBudgetAlerts) || errors.Is(err, database.ErrInvalidBudgetRollover) {
+			s.logger.With("error", err).Warn("Invalid budget settings")
+			return goserver.Response(400, nil), nil
+		}
 		s.logger.With("error", err).Error("Failed to update account")
 		return goserver.Response(500, nil), nil
 	}
@@ -159,6 +184,40 @@ func (s *AccountsAPIServicerImpl) GetAccount(
 	return goserver.Response(200, account), nil
 }
 
+func (s *AccountsAPIServicerImpl) GetAccountAmortization(
+	ctx context.Context, accountID string,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("FamilyID not found in context")
+		return goserver.Response(500, nil), nil
+	}
+
+	account, err := s.db.GetAccount(familyID, accountID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(404, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to get account")
+		return goserver.Response(500, nil), nil
+	}
+	if !utils.HasLoanTerms(account.LoanTerms) {
+		return goserver.Response(400, "account has no loan terms"), nil
+	}
+
+	res := goserver.AmortizationSchedule{
+		AccountId:      account.Id,
+		CurrencyId:     account.LoanTerms.CurrencyId,
+		MonthlyPayment: utils.LoanMonthlyPayment(account.LoanTerms),
+		Payments:       utils.AmortizationSchedule(account.LoanTerms),
+	}
+	for _, p := range res.Payments {
+		res.TotalInterest = res.TotalInterest.Add(p.Interest)
+	}
+
+	return goserver.Response(200, res), nil
+}
+
 func (s *AccountsAPIServicerImpl) UploadAccountImage(
 	ctx context.Context, accountID string, file *os.File,
 ) (goserver.ImplResponse, error) {
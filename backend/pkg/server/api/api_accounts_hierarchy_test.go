@@ -0,0 +1,130 @@
+package api_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Account hierarchy roll-up", func() {
+	var (
+		st                                 database.Storage
+		ctx                                context.Context
+		log                                = test.CreateTestLogger()
+		familyID                           = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		usdID                              string
+		bank, cash, food, groceries, bread goserver.Account
+		dateFrom                           = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		dateTo                             = time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	create := func(name, accountType, parentID string) goserver.Account {
+		acc, err := st.CreateAccount(familyID, &goserver.AccountNoId{Name: name, Type: accountType, ParentId: parentID})
+		Expect(err).ToNot(HaveOccurred())
+		return acc
+	}
+	spend := func(from, to goserver.Account, amount int64) {
+		_, err := st.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date: time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC),
+			Movements: []goserver.Movement{
+				{AccountId: from.Id, CurrencyId: usdID, Amount: decimal.NewFromInt(-amount)},
+				{AccountId: to.Id, CurrencyId: usdID, Amount: decimal.NewFromInt(amount)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+	accountTotals := func(agg *goserver.Aggregation) map[string]decimal.Decimal {
+		res := map[string]decimal.Decimal{}
+		for _, acc := range agg.Currencies[0].Accounts {
+			res[acc.AccountId] = acc.Total
+		}
+		return res
+	}
+
+	BeforeEach(func() {
+		cfg := &config.Config{DBPath: ":memory:", Verbose: false}
+		st = database.NewStorage(log, cfg)
+		Expect(st.Open()).To(Succeed())
+		DeferCleanup(st.Close)
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+
+		currency, err := st.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "USD"})
+		Expect(err).ToNot(HaveOccurred())
+		usdID = currency.Id
+
+		bank = create("Bank", "asset", "")
+		cash = create("Cash", "asset", bank.Id)
+		food = create("Food", "expense", "")
+		groceries = create("Groceries", "expense", food.Id)
+		bread = create("Bread", "expense", groceries.Id)
+
+		spend(bank, food, 10)
+		spend(bank, groceries, 100)
+		spend(cash, bread, 5)
+	})
+
+	It("rolls expenses up to the requested depth", func() {
+		aggregations := api.NewAggregationsAPIServiceImpl(log, st, common.NewRateService(log, st, 0))
+
+		agg, err := aggregations.GetAggregatedExpenses(
+			ctx, familyID, dateFrom, dateTo, usdID, "month", false, "account", nil, nil, 1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(agg.Currencies[0].Accounts).To(HaveLen(1))
+		Expect(accountTotals(agg)[food.Id].Equal(decimal.NewFromInt(115))).To(BeTrue())
+
+		agg, err = aggregations.GetAggregatedExpenses(
+			ctx, familyID, dateFrom, dateTo, usdID, "month", false, "account", nil, nil, 2)
+		Expect(err).ToNot(HaveOccurred())
+		totals := accountTotals(agg)
+		Expect(totals).To(HaveLen(2))
+		Expect(totals[food.Id].Equal(decimal.NewFromInt(10))).To(BeTrue())
+		Expect(totals[groceries.Id].Equal(decimal.NewFromInt(105))).To(BeTrue())
+
+		agg, err = aggregations.GetAggregatedExpenses(
+			ctx, familyID, dateFrom, dateTo, usdID, "month", false, "account", nil, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(agg.Currencies[0].Accounts).To(HaveLen(3))
+	})
+
+	It("rolls balances up into the parent account", func() {
+		aggregations := api.NewAggregationsAPIServiceImpl(log, st, common.NewRateService(log, st, 0))
+
+		agg, err := aggregations.GetAggregatedBalances(ctx, familyID, dateFrom, dateTo, usdID, false, 1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(agg.Currencies[0].Accounts).To(HaveLen(1))
+		Expect(accountTotals(agg)[bank.Id].Equal(decimal.NewFromInt(-115))).To(BeTrue())
+	})
+
+	It("rolls budgets and spendings up into the parent account", func() {
+		for _, b := range []struct {
+			account goserver.Account
+			amount  int64
+		}{{food, 50}, {groceries, 200}} {
+			_, err := st.CreateBudgetItem(familyID, &goserver.BudgetItemNoId{
+				Date: dateFrom, AccountId: b.account.Id, Amount: decimal.NewFromInt(b.amount),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		resp, err := api.NewBudgetItemsAPIService(
+			log, st, common.NewRateService(log, st, 0),
+		).GetBudgetStatus(ctx, dateFrom, dateTo, "", "month", false, 1)
+		Expect(err).ToNot(HaveOccurred())
+		status := resp.Body.([]goserver.BudgetStatus)
+		Expect(status).To(HaveLen(1))
+		Expect(status[0].AccountId).To(Equal(food.Id))
+		Expect(status[0].Budgeted.Equal(decimal.NewFromInt(250))).To(BeTrue())
+		Expect(status[0].Spent.Equal(decimal.NewFromInt(115))).To(BeTrue())
+	})
+})
@@ -18,22 +18,26 @@ import (
 type AggregationsAPIServiceImpl struct {
 	logger *slog.Logger
 	db     database.Storage
+	rates  *common.RateService
 }
 
-func NewAggregationsAPIServiceImpl(logger *slog.Logger, db database.Storage) *AggregationsAPIServiceImpl {
+func NewAggregationsAPIServiceImpl(
+	logger *slog.Logger, db database.Storage, rates *common.RateService,
+) *AggregationsAPIServiceImpl {
 	return &AggregationsAPIServiceImpl{
 		logger: logger,
 		db:     db,
+		rates:  rates,
 	}
 }
 
-func (s *AggregationsAPIServiceImpl) GetIncomes(ctx context.Context, from time.Time, to time.Time, outputCurrencyID string, includeHidden bool) (goserver.ImplResponse, error) {
+func (s *AggregationsAPIServiceImpl) GetIncomes(ctx context.Context, from time.Time, to time.Time, outputCurrencyID string, includeHidden bool, depth int32) (goserver.ImplResponse, error) {
 	familyID, ok := constants.GetFamilyID(ctx)
 	if !ok {
 		return goserver.Response(500, nil), nil
 	}
 
-	aggregation, err := s.GetAggregatedIncomes(ctx, familyID, from, to, outputCurrencyID, includeHidden)
+	aggregation, err := s.GetAggregatedIncomes(ctx, familyID, from, to, outputCurrencyID, includeHidden, int(depth))
 	if err != nil {
 		return goserver.Response(500, nil), nil
 	}
@@ -81,13 +85,14 @@ func (s *AggregationsAPIServiceImpl) calculatePostAggregationData(res *goserver.
 }
 
 func (s *AggregationsAPIServiceImpl) GetAggregatedIncomes(
-	ctx context.Context, familyID uuid.UUID, dateFrom, dateTo time.Time, outputCurrencyID string, includeHidden bool,
+	ctx context.Context, familyID uuid.UUID, dateFrom, dateTo time.Time, outputCurrencyID string, includeHidden bool, depth int,
 ) (*goserver.Aggregation, error) {
+	granularity := getGranularity(s.logger, s.db, familyID, string(utils.GranularityMonth))
 	if dateFrom.IsZero() {
-		dateFrom = utils.RoundToGranularity(time.Now(), utils.GranularityMonth, false)
+		dateFrom = utils.RoundToGranularity(time.Now(), granularity, false)
 	}
 	if dateTo.IsZero() {
-		dateTo = utils.RoundToGranularity(time.Now(), utils.GranularityMonth, true)
+		dateTo = utils.RoundToGranularity(time.Now(), granularity, true)
 	}
 
 	accounts, err := s.db.GetAccounts(familyID)
@@ -96,19 +101,21 @@ func (s *AggregationsAPIServiceImpl) GetAggregatedIncomes(
 		return nil, nil
 	}
 
-	transactions, err := s.db.GetTransactions(familyID, dateFrom, dateTo, false)
+	// Refunds categorized as income are moved to the expense accounts of their originals
+	transactions, err := s.getReportTransactions(
+		familyID, accounts, dateFrom, dateTo, granularity, "account", outputCurrencyID, true)
 	if err != nil {
 		s.logger.With("error", err).Error("Failed to get transactions")
 		return nil, nil
 	}
 
 	currencyMap := buildCurrencyMap(s.logger, s.db, familyID)
-	currenciesRatesFetcher := common.NewCurrenciesRatesFetcher(s.logger, s.db)
+	currenciesRatesFetcher := s.rates.Fetcher(familyID)
 
 	res := Aggregate(
 		ctx, accounts, transactions,
 		dateFrom, dateTo,
-		utils.GranularityMonth,
+		granularity,
 		outputCurrencyID, currenciesRatesFetcher,
 		currencyMap,
 		func(a goserver.Account) bool {
@@ -117,24 +124,21 @@ func (s *AggregationsAPIServiceImpl) GetAggregatedIncomes(
 		"account", nil,
 		s.logger)
 
+	rollupAggregation(&res, accounts, depth)
 	s.calculatePostAggregationData(&res, false)
 	return &res, nil
 }
 
 func (s *AggregationsAPIServiceImpl) GetExpenses(
-	ctx context.Context, dateFrom, dateTo time.Time, outputCurrencyID string, granularity string, includeHidden bool, groupBy string, tags []string, accounts []string,
+	ctx context.Context, dateFrom, dateTo time.Time, outputCurrencyID string, granularity string, includeHidden bool, groupBy string, tags []string, accounts []string, depth int32,
 ) (goserver.ImplResponse, error) {
 	familyID, ok := constants.GetFamilyID(ctx)
 	if !ok {
 		return goserver.Response(500, nil), nil
 	}
 
-	aggGranularity := utils.GranularityMonth
-	if granularity == "year" {
-		aggGranularity = utils.GranularityYear
-	}
-
-	aggregation, err := s.GetAggregatedExpenses(ctx, familyID, dateFrom, dateTo, outputCurrencyID, aggGranularity, includeHidden, groupBy, tags, accounts)
+	aggGranularity := getGranularity(s.logger, s.db, familyID, granularity)
+	aggregation, err := s.GetAggregatedExpenses(ctx, familyID, dateFrom, dateTo, outputCurrencyID, aggGranularity, includeHidden, groupBy, tags, accounts, int(depth))
 	if err != nil {
 		return goserver.Response(500, nil), nil
 	}
@@ -143,13 +147,15 @@ func (s *AggregationsAPIServiceImpl) GetExpenses(
 }
 
 func (s *AggregationsAPIServiceImpl) GetAggregatedExpenses(
-	ctx context.Context, familyID uuid.UUID, dateFrom, dateTo time.Time, outputCurrencyID string, granularity utils.Granularity, includeHidden bool, groupBy string, tagsFilter []string, accountsFilter []string,
+	ctx context.Context, familyID uuid.UUID, dateFrom, dateTo time.Time, outputCurrencyID string, granularity utils.Granularity, includeHidden bool, groupBy string, tagsFilter []string, accountsFilter []string, depth int,
 ) (*goserver.Aggregation, error) {
+	// Without dates the current month is used, whatever the granularity is
+	currentMonth := utils.FinancialMonth(granularity.MonthStartDay())
 	if dateFrom.IsZero() {
-		dateFrom = utils.RoundToGranularity(time.Now(), utils.GranularityMonth, false)
+		dateFrom = utils.RoundToGranularity(time.Now(), currentMonth, false)
 	}
 	if dateTo.IsZero() {
-		dateTo = utils.RoundToGranularity(time.Now(), utils.GranularityMonth, true)
+		dateTo = utils.RoundToGranularity(time.Now(), currentMonth, true)
 	}
 
 	accounts, err := s.db.GetAccounts(familyID)
@@ -158,6 +164,15 @@ func (s *AggregationsAPIServiceImpl) GetAggregatedExpenses(
 		return nil, nil
 	}
 
+	// Linked refunds reduce the expense category of the original transaction
+	transactions, err := s.getReportTransactions(
+		familyID, accounts, dateFrom, dateTo, granularity, groupBy, outputCurrencyID, true)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to get transactions")
+		return nil, nil
+	}
+
+	allAccounts := accounts
 	if len(accountsFilter) > 0 {
 		var filteredAccounts []goserver.Account
 		for _, acc := range accounts {
@@ -168,16 +183,10 @@ func (s *AggregationsAPIServiceImpl) GetAggregatedExpenses(
 		accounts = filteredAccounts
 	}
 
-	transactions, err := s.db.GetTransactions(familyID, dateFrom, dateTo, false)
-	if err != nil {
-		s.logger.With("error", err).Error("Failed to get transactions")
-		return nil, nil
-	}
-
 	// Prepare map currencyID->CurrencyName for all currencies of the current user.
 	currencyMap := buildCurrencyMap(s.logger, s.db, familyID)
 
-	currenciesRatesFetcher := common.NewCurrenciesRatesFetcher(s.logger, s.db)
+	currenciesRatesFetcher := s.rates.Fetcher(familyID)
 	res := Aggregate(
 		ctx, accounts, transactions,
 		dateFrom, dateTo,
@@ -190,19 +199,22 @@ func (s *AggregationsAPIServiceImpl) GetAggregatedExpenses(
 		groupBy, tagsFilter,
 		s.logger)
 
+	if groupBy != "tag" {
+		rollupAggregation(&res, allAccounts, depth)
+	}
 	s.calculatePostAggregationData(&res, false)
 	return &res, nil
 }
 
 func (s *AggregationsAPIServiceImpl) GetBalances(
-	ctx context.Context, dateFrom, dateTo time.Time, outputCurrencyID string, includeHidden bool,
+	ctx context.Context, dateFrom, dateTo time.Time, outputCurrencyID string, includeHidden bool, depth int32,
 ) (goserver.ImplResponse, error) {
 	familyID, ok := constants.GetFamilyID(ctx)
 	if !ok {
 		return goserver.Response(500, nil), nil
 	}
 
-	aggregation, err := s.GetAggregatedBalances(ctx, familyID, dateFrom, dateTo, outputCurrencyID, includeHidden)
+	aggregation, err := s.GetAggregatedBalances(ctx, familyID, dateFrom, dateTo, outputCurrencyID, includeHidden, int(depth))
 	if err != nil {
 		return goserver.Response(500, nil), nil
 	}
@@ -211,7 +223,7 @@ func (s *AggregationsAPIServiceImpl) GetBalances(
 }
 
 func (s *AggregationsAPIServiceImpl) GetAggregatedBalances(
-	ctx context.Context, familyID uuid.UUID, dateFrom, dateTo time.Time, outputCurrencyID string, includeHidden bool,
+	ctx context.Context, familyID uuid.UUID, dateFrom, dateTo time.Time, outputCurrencyID string, includeHidden bool, depth int,
 ) (*goserver.Aggregation, error) {
 	if dateFrom.IsZero() {
 		// Use a very old date to capture all history
@@ -221,24 +233,33 @@ func (s *AggregationsAPIServiceImpl) GetAggregatedBalances(
 		dateTo = utils.RoundToGranularity(time.Now(), utils.GranularityMonth, true)
 	}
 
+	filter := func(a goserver.Account) bool {
+		return isBalanceAccount(a) && (includeHidden || !a.HideFromReports)
+	}
+	return s.aggregateBalances(ctx, familyID, dateFrom, dateTo, outputCurrencyID, utils.GranularityMonth, filter, depth)
+}
+
+// aggregateBalances returns cumulative balances of the accounts passing the filter at the end of
+// each interval of the granularity.
+func (s *AggregationsAPIServiceImpl) aggregateBalances(
+	ctx context.Context, familyID uuid.UUID, dateFrom, dateTo time.Time, outputCurrencyID string,
+	granularity utils.Granularity, filter AccountFilter, depth int,
+) (*goserver.Aggregation, error) {
 	accounts, err := s.db.GetAccounts(familyID)
 	if err != nil {
 		s.logger.With("error", err).Error("Failed to get accounts")
 		return nil, nil
 	}
 
-	transactions, err := s.db.GetTransactions(familyID, dateFrom, dateTo, false)
+	transactions, err := s.getReportTransactions(
+		familyID, accounts, dateFrom, dateTo, granularity, "account", outputCurrencyID, false)
 	if err != nil {
 		s.logger.With("error", err).Error("Failed to get transactions")
 		return nil, nil
 	}
 
 	currencyMap := buildCurrencyMap(s.logger, s.db, familyID)
-	currenciesRatesFetcher := common.NewCurrenciesRatesFetcher(s.logger, s.db)
-
-	filter := func(a goserver.Account) bool {
-		return isAssetAccount(a) && (includeHidden || !a.HideFromReports)
-	}
+	currenciesRatesFetcher := s.rates.Fetcher(familyID)
 
 	// Create virtual transactions for opening balances of accounts that open mid-period
 	allTransactions := append([]goserver.Transaction{}, transactions...)
@@ -269,7 +290,7 @@ func (s *AggregationsAPIServiceImpl) GetAggregatedBalances(
 	res := Aggregate(
 		ctx, accounts, allTransactions,
 		dateFrom, dateTo,
-		utils.GranularityMonth,
+		granularity,
 		outputCurrencyID, currenciesRatesFetcher,
 		currencyMap,
 		filter,
@@ -277,12 +298,13 @@ func (s *AggregationsAPIServiceImpl) GetAggregatedBalances(
 		s.logger)
 
 	// Calculate initial balances (before dateFrom) to make the graph cumulative
-	initialBalances, err := s.calculateInitialBalances(
+	initialBalances, rateSources, err := s.calculateInitialBalances(
 		ctx, familyID, accounts, dateFrom, outputCurrencyID, currencyMap, currenciesRatesFetcher, filter)
 	if err != nil {
 		s.logger.With("error", err).Error("Failed to calculate initial balances")
 		return nil, nil
 	}
+	res.RateSources = addRateSources(res.RateSources, rateSources...)
 
 	// Apply cumulative sum
 	for i := range res.Currencies {
@@ -330,6 +352,12 @@ func (s *AggregationsAPIServiceImpl) GetAggregatedBalances(
 		}
 	}
 
+	if err := s.valueHoldings(ctx, familyID, &res, accounts, filter, dateTo, outputCurrencyID,
+		currencyMap, currenciesRatesFetcher); err != nil {
+		s.logger.With("error", err).Error("Failed to value holdings")
+		return nil, nil
+	}
+
 	// Finally, zero out amounts for intervals where the account is not active
 	for i := range res.Currencies {
 		for j := range res.Currencies[i].Accounts {
@@ -342,6 +370,7 @@ func (s *AggregationsAPIServiceImpl) GetAggregatedBalances(
 		}
 	}
 
+	rollupAggregation(&res, accounts, depth)
 	s.calculatePostAggregationData(&res, true)
 	return &res, nil
 }
@@ -351,9 +380,10 @@ func (s *AggregationsAPIServiceImpl) calculateInitialBalances(
 	outputCurrencyID string, currencyMap map[string]string,
 	currenciesRatesFetcher *common.CurrenciesRatesFetcher,
 	filter AccountFilter,
-) (map[string]map[string]decimal.Decimal, error) {
+) (map[string]map[string]decimal.Decimal, []string, error) {
 	// Map: CurrencyID -> AccountID -> Amount
 	balances := make(map[string]map[string]decimal.Decimal)
+	var rateSources []string
 
 	// 1. Sum up Opening Balances from Accounts
 	for _, account := range accounts {
@@ -380,9 +410,10 @@ func (s *AggregationsAPIServiceImpl) calculateInitialBalances(
 			}
 			// Reuse convertMovementAmount logic? It requires slightly different params but logic is same.
 			// We use dateFrom as the reference date for conversion.
-			convertedAmount, targetCurrencyID := convertMovementAmount(
-				ctx, movement, dateFrom, outputCurrencyID, currencyMap[outputCurrencyID],
+			convertedAmount, targetCurrencyID, sources := convertTransactionMovement(
+				ctx, movement, dateFrom, nil, outputCurrencyID, currencyMap[outputCurrencyID],
 				currencyMap, currenciesRatesFetcher, s.logger)
+			rateSources = addRateSources(rateSources, sources...)
 
 			if _, ok := balances[targetCurrencyID]; !ok {
 				balances[targetCurrencyID] = make(map[string]decimal.Decimal)
@@ -394,9 +425,10 @@ func (s *AggregationsAPIServiceImpl) calculateInitialBalances(
 	// 2. Sum up Past Transactions (if any)
 	beginningOfTime := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
 	if dateFrom.After(beginningOfTime) {
-		pastTransactions, err := s.db.GetTransactions(familyID, beginningOfTime, dateFrom, false)
+		pastTransactions, err := s.getReportTransactions(
+			familyID, accounts, beginningOfTime, dateFrom, utils.GranularityMonth, "account", outputCurrencyID, false)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		// Aggregate past transactions into a single bucket
@@ -410,6 +442,7 @@ func (s *AggregationsAPIServiceImpl) calculateInitialBalances(
 			filter,
 			"account", nil,
 			s.logger)
+		rateSources = addRateSources(rateSources, resPast.RateSources...)
 
 		// Merge past aggregation results into balances
 		for _, currAgg := range resPast.Currencies {
@@ -428,7 +461,17 @@ func (s *AggregationsAPIServiceImpl) calculateInitialBalances(
 		}
 	}
 
-	return balances, nil
+	return balances, rateSources, nil
+}
+
+// getGranularity converts granularity name to utils.Granularity using month start day of the family.
+func getGranularity(logger *slog.Logger, storage database.Storage, familyID uuid.UUID, name string) utils.Granularity {
+	granularity := utils.ParseGranularity(name, 1)
+	if granularity != utils.GranularityMonth {
+		return granularity
+	}
+
+	return common.BudgetMonth(logger, storage, familyID)
 }
 
 func buildCurrencyMap(logger *slog.Logger, storage database.Storage, familyID uuid.UUID) map[string]string {
@@ -449,15 +492,17 @@ func buildCurrencyMap(logger *slog.Logger, storage database.Storage, familyID uu
 
 func processMovements(
 	ctx context.Context, movements []goserver.Movement, transactionDate time.Time,
+	transactionRates []goserver.TransactionRate,
 	outputCurrencyID, outputCurrencyName string, currencyMap map[string]string,
 	currenciesRatesFetcher *common.CurrenciesRatesFetcher, intervalIdx int,
 	res *goserver.Aggregation, log *slog.Logger,
 ) {
 	for _, m := range movements {
 		// Convert movement amount to target currency if needed
-		convertedAmount, targetCurrencyID := convertMovementAmount(
-			ctx, m, transactionDate, outputCurrencyID, outputCurrencyName,
+		convertedAmount, targetCurrencyID, sources := convertTransactionMovement(
+			ctx, m, transactionDate, transactionRates, outputCurrencyID, outputCurrencyName,
 			currencyMap, currenciesRatesFetcher, log)
+		res.RateSources = addRateSources(res.RateSources, sources...)
 
 		// Use target currency ID for grouping (either converted or original)
 		currencyIdx := slices.IndexFunc(res.Currencies,
@@ -489,6 +534,36 @@ func processMovements(
 
 type AccountFilter func(goserver.Account) bool
 
+// rollupAggregation adds amounts of accounts nested deeper than depth levels to their ancestor on
+// that level, keeping the position of the first of them. Nothing is changed for non-positive depth.
+func rollupAggregation(res *goserver.Aggregation, accounts []goserver.Account, depth int) {
+	rollup := utils.AccountRollup(accounts, depth)
+	if len(rollup) == 0 {
+		return
+	}
+
+	for i := range res.Currencies {
+		rolled := []goserver.AccountAggregation{}
+		for _, acc := range res.Currencies[i].Accounts {
+			if ancestorID, ok := rollup[acc.AccountId]; ok {
+				acc.AccountId = ancestorID
+			}
+			idx := slices.IndexFunc(rolled, func(a goserver.AccountAggregation) bool { return a.AccountId == acc.AccountId })
+			if idx == -1 {
+				rolled = append(rolled, goserver.AccountAggregation{
+					AccountId: acc.AccountId,
+					Amounts:   slices.Clone(acc.Amounts),
+				})
+				continue
+			}
+			for k, amount := range acc.Amounts {
+				rolled[idx].Amounts[k] = rolled[idx].Amounts[k].Add(amount)
+			}
+		}
+		res.Currencies[i].Accounts = rolled
+	}
+}
+
 func Aggregate(
 	ctx context.Context, accounts []goserver.Account, transactions []goserver.Transaction,
 	dateFrom, dateTo time.Time, granularity utils.Granularity,
@@ -508,6 +583,7 @@ func Aggregate(
 		From: dateFrom,
 		To:   dateTo,
 	}
+	res.Granularity = string(granularity.Base())
 	res.Intervals = getIntervals(res.From, res.To, granularity)
 
 	// Get the output currency name from the map if outputCurrencyID is provided
@@ -518,7 +594,8 @@ func Aggregate(
 
 	res.Currencies = []goserver.CurrencyAggregation{}
 	for _, t := range transactions {
-		if t.Date.Before(res.From) || t.Date.After(res.To) {
+		// Rollups are dated on the first day of a month, so the end of the range is excluded
+		if t.Date.Before(res.From) || !t.Date.Before(res.To) {
 			log.Info("Ignore transaction", "date", t.Date)
 			continue
 		}
@@ -575,12 +652,12 @@ func Aggregate(
 					taggedMovements[mIdx] = m
 					taggedMovements[mIdx].AccountId = tag // Co-opting AccountId field
 				}
-				processMovements(ctx, taggedMovements, t.Date, outputCurrencyID, outputCurrencyName,
+				processMovements(ctx, taggedMovements, t.Date, t.ExchangeRates, outputCurrencyID, outputCurrencyName,
 					currencyMap, currenciesRatesFetcher, intervalIdx, &res, log)
 			}
 		} else {
 			movements := getMovements(accounts, t, accountFilter)
-			processMovements(ctx, movements, t.Date, outputCurrencyID, outputCurrencyName,
+			processMovements(ctx, movements, t.Date, t.ExchangeRates, outputCurrencyID, outputCurrencyName,
 				currencyMap, currenciesRatesFetcher, intervalIdx, &res, log)
 		}
 	}
@@ -647,18 +724,16 @@ func isAssetAccount(a goserver.Account) bool {
 	return a.Type == constants.AccountAsset
 }
 
+// isBalanceAccount checks if the account has a balance, i.e. it's an asset or a liability.
+func isBalanceAccount(a goserver.Account) bool {
+	return a.Type == constants.AccountAsset || a.Type == constants.AccountLiability
+}
+
 func getIntervals(dateFrom, dateTo time.Time, granularity utils.Granularity) []time.Time {
 	intervals := []time.Time{}
 	for dateFrom.Before(dateTo) {
 		intervals = append(intervals, dateFrom)
-		switch granularity {
-		case utils.GranularityMonth:
-			dateFrom = dateFrom.AddDate(0, 1, 0)
-		case utils.GranularityYear:
-			dateFrom = dateFrom.AddDate(1, 0, 0)
-		default:
-			dateFrom = dateFrom.AddDate(1, 0, 0)
-		}
+		dateFrom = utils.AddIntervals(dateFrom, granularity, 1)
 	}
 	return intervals
 }
@@ -672,32 +747,52 @@ func convertMovementAmount(
 	currencyMap map[string]string,
 	currenciesRatesFetcher *common.CurrenciesRatesFetcher, log *slog.Logger,
 ) (decimal.Decimal, string) {
+	amount, currencyID, _ := convertTransactionMovement(ctx, movement, transactionDate, nil,
+		outputCurrencyID, outputCurrencyName, currencyMap, currenciesRatesFetcher, log)
+	return amount, currencyID
+}
+
+// convertTransactionMovement works like convertMovementAmount, but prefers the exchange rates the
+// transaction was executed at. It also returns the sources of the used exchange rates.
+func convertTransactionMovement(
+	ctx context.Context, movement goserver.Movement, transactionDate time.Time,
+	transactionRates []goserver.TransactionRate,
+	outputCurrencyID string, outputCurrencyName string,
+	currencyMap map[string]string,
+	currenciesRatesFetcher *common.CurrenciesRatesFetcher, log *slog.Logger,
+) (decimal.Decimal, string, []string) {
 	// If no output currency specified, return original
 	if outputCurrencyID == "" {
 		log.Debug("No output currency specified, using original currency",
 			"originalCurrency", movement.CurrencyId, "amount", movement.Amount)
-		return movement.Amount, movement.CurrencyId
-	}
-
-	// If currencies rate fetcher is nil, return original
-	if currenciesRatesFetcher == nil {
-		log.Warn("Currency rate fetcher is nil, using original currency",
-			"originalCurrency", movement.CurrencyId, "outputCurrency", outputCurrencyName, "amount", movement.Amount)
-		return movement.Amount, movement.CurrencyId
+		return movement.Amount, movement.CurrencyId, nil
 	}
 
 	// If same currency, no conversion needed
 	if movement.CurrencyId == outputCurrencyID {
 		log.Debug("Same currency, no conversion needed",
 			"currency", movement.CurrencyId, "amount", movement.Amount)
-		return movement.Amount, movement.CurrencyId
+		return movement.Amount, movement.CurrencyId, nil
+	}
+
+	// The rate the transaction was executed at is preferred to any other rate
+	if convertedAmount, ok := utils.ConvertByTransactionRate(
+		transactionRates, movement.CurrencyId, outputCurrencyID, movement.Amount); ok {
+		return convertedAmount, outputCurrencyID, []string{common.RateSourceTransaction}
+	}
+
+	// If currencies rate fetcher is nil, return original
+	if currenciesRatesFetcher == nil {
+		log.Warn("Currency rate fetcher is nil, using original currency",
+			"originalCurrency", movement.CurrencyId, "outputCurrency", outputCurrencyName, "amount", movement.Amount)
+		return movement.Amount, movement.CurrencyId, nil
 	}
 
 	// Name of the original currency
 	originalCurrencyName := currencyMap[movement.CurrencyId]
 
 	// Attempt currency conversion
-	convertedAmount, err := currenciesRatesFetcher.Convert(
+	convertedAmount, sources, err := currenciesRatesFetcher.ConvertWithSources(
 		ctx, transactionDate, originalCurrencyName, outputCurrencyName, movement.Amount)
 	if err != nil {
 		log.Warn("Currency conversion failed, using original amount",
@@ -706,7 +801,7 @@ func convertMovementAmount(
 			"fromCurrency", originalCurrencyName,
 			"toCurrency", outputCurrencyName,
 			"originalAmount", movement.Amount)
-		return movement.Amount, movement.CurrencyId
+		return movement.Amount, movement.CurrencyId, nil
 	}
 
 	log.Debug("Currency conversion successful",
@@ -714,7 +809,18 @@ func convertMovementAmount(
 		"fromCurrency", originalCurrencyName,
 		"toCurrency", outputCurrencyName,
 		"originalAmount", movement.Amount,
-		"convertedAmount", convertedAmount)
+		"convertedAmount", convertedAmount,
+		"sources", sources)
 
-	return convertedAmount, outputCurrencyID
+	return convertedAmount, outputCurrencyID, sources
+}
+
+// addRateSources adds the sources of exchange rates to the list, keeping it free of duplicates
+func addRateSources(list []string, sources ...string) []string {
+	for _, source := range sources {
+		if !slices.Contains(list, source) {
+			list = append(list, source)
+		}
+	}
+	return list
 }
@@ -12,8 +12,10 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/shopspring/decimal"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
 	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 	"github.com/ya-breeze/geekbudgetbe/test"
 )
 
@@ -29,7 +31,7 @@ var _ = Describe("Balances Aggregation API", func() {
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockStorage = mocks.NewMockStorage(ctrl)
-		sut = api.NewAggregationsAPIServiceImpl(log, mockStorage)
+		sut = api.NewAggregationsAPIServiceImpl(log, mockStorage, common.NewRateService(log, mockStorage, 0))
 		// Inject UserID into context
 		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, uuid.MustParse("00000000-0000-0000-0000-000000000001"))
 	})
@@ -67,6 +69,7 @@ var _ = Describe("Balances Aggregation API", func() {
 		}
 
 		mockStorage.EXPECT().GetAccounts(userID).Return(accounts, nil).AnyTimes()
+		mockStorage.EXPECT().GetSecurities(userID).Return(nil, nil).AnyTimes()
 
 		// 3. Prepare Transactions
 		// T1: Past transaction (Jan 2024), +50 USD
@@ -74,33 +77,35 @@ var _ = Describe("Balances Aggregation API", func() {
 		t1Date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
 		t2Date := time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)
 
-		t1 := goserver.Transaction{
-			Date: t1Date,
-			Movements: []goserver.Movement{
-				{AccountId: accountID, CurrencyId: usdID, Amount: decimal.NewFromFloat(50.0)},
-			},
+		// Whole months are read from monthly rollups
+		r1 := models.MonthlyRollup{
+			FamilyID: userID, AccountID: accountID, CurrencyID: usdID, Month: t1Date,
+			Amount: decimal.NewFromFloat(50.0), Count: 1,
 		}
-		t2 := goserver.Transaction{
-			Date: t2Date,
-			Movements: []goserver.Movement{
-				{AccountId: accountID, CurrencyId: usdID, Amount: decimal.NewFromFloat(-20.0)},
-			},
+		r2 := models.MonthlyRollup{
+			FamilyID: userID, AccountID: accountID, CurrencyID: usdID, Month: models.RollupMonth(t2Date),
+			Amount: decimal.NewFromFloat(-20.0), Count: 1,
 		}
 
-		// Expect GetTransactions checks
 		// 1. Range query (Sep-Nov) -> Returns T2
 		mockStorage.EXPECT().
-			GetTransactions(userID, dateFrom, dateTo, false).
-			Return([]goserver.Transaction{t2}, nil)
+			GetMonthlyRollups(userID, dateFrom, dateTo).
+			Return([]models.MonthlyRollup{r2}, nil)
+		mockStorage.EXPECT().
+			GetExchangeTransactions(userID, dateFrom, dateTo).
+			Return(nil, nil)
 
 		// 2. Past query (2000 - Sep) -> Returns T1
 		beginningOfTime := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
 		mockStorage.EXPECT().
-			GetTransactions(userID, beginningOfTime, dateFrom, false).
-			Return([]goserver.Transaction{t1}, nil)
+			GetMonthlyRollups(userID, beginningOfTime, dateFrom).
+			Return([]models.MonthlyRollup{r1}, nil)
+		mockStorage.EXPECT().
+			GetExchangeTransactions(userID, beginningOfTime, dateFrom).
+			Return(nil, nil)
 
 		// Call SUT
-		agg, err := sut.GetAggregatedBalances(ctx, userID, dateFrom, dateTo, usdID, false)
+		agg, err := sut.GetAggregatedBalances(ctx, userID, dateFrom, dateTo, usdID, false, 0)
 		Expect(err).ToNot(HaveOccurred())
 
 		// Verify Results
@@ -157,6 +162,7 @@ var _ = Describe("Balances Aggregation API", func() {
 		}
 
 		mockStorage.EXPECT().GetAccounts(userID).Return(accounts, nil).AnyTimes()
+		mockStorage.EXPECT().GetSecurities(userID).Return(nil, nil).AnyTimes()
 
 		// 3. Prepare Transactions
 		// T1: Pre-opening transaction (Sep 2024), +50 USD -> should be ignored
@@ -185,18 +191,31 @@ var _ = Describe("Balances Aggregation API", func() {
 			},
 		}
 
+		// Sep is read from rollups, months of opening and closing transaction by transaction
 		mockStorage.EXPECT().
-			GetTransactions(userID, dateFrom, dateTo, false).
-			Return([]goserver.Transaction{t1, t2, t3}, nil)
+			GetMonthlyRollups(userID, dateFrom, openingDate).
+			Return([]models.MonthlyRollup{{
+				FamilyID: userID, AccountID: accountID, CurrencyID: usdID, Month: dateFrom,
+				Amount: t1.Movements[0].Amount, Count: 1,
+			}}, nil)
+		mockStorage.EXPECT().
+			GetExchangeTransactions(userID, dateFrom, openingDate).
+			Return(nil, nil)
+		mockStorage.EXPECT().
+			GetTransactions(userID, openingDate, dateTo, false).
+			Return([]goserver.Transaction{t2, t3}, nil)
 
 		// Initial balances query (2000 - Sep)
 		beginningOfTime := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
 		mockStorage.EXPECT().
-			GetTransactions(userID, beginningOfTime, dateFrom, false).
-			Return([]goserver.Transaction{}, nil)
+			GetMonthlyRollups(userID, beginningOfTime, dateFrom).
+			Return([]models.MonthlyRollup{}, nil)
+		mockStorage.EXPECT().
+			GetExchangeTransactions(userID, beginningOfTime, dateFrom).
+			Return(nil, nil)
 
 		// Call SUT
-		agg, err := sut.GetAggregatedBalances(ctx, userID, dateFrom, dateTo, usdID, false)
+		agg, err := sut.GetAggregatedBalances(ctx, userID, dateFrom, dateTo, usdID, false, 0)
 		Expect(err).ToNot(HaveOccurred())
 
 		// Verify Results
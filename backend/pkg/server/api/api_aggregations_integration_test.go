@@ -14,6 +14,7 @@ import (
 	"github.com/ya-breeze/geekbudgetbe/pkg/database"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
 	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 	"github.com/ya-breeze/geekbudgetbe/test"
 )
 
@@ -31,7 +32,7 @@ var _ = Describe("Soft Delete Aggregation Integration", func() {
 		cfg := &config.Config{DBPath: ":memory:", Verbose: false}
 		st = database.NewStorage(log, cfg)
 		Expect(st.Open()).To(Succeed())
-		sut = api.NewAggregationsAPIServiceImpl(log, st)
+		sut = api.NewAggregationsAPIServiceImpl(log, st, common.NewRateService(log, st, 0))
 		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, userID)
 
 		// Setup base data
@@ -93,13 +94,13 @@ var _ = Describe("Soft Delete Aggregation Integration", func() {
 		Expect(st.DeleteTransaction(userID, t2.Id)).To(Succeed())
 
 		// 4. Verify Expenses - should be 100, not 600
-		expResp, err := sut.GetExpenses(ctx, dateFrom, dateTo, usdID, "month", false, "account", nil, nil)
+		expResp, err := sut.GetExpenses(ctx, dateFrom, dateTo, usdID, "month", false, "account", nil, nil, 0)
 		Expect(err).ToNot(HaveOccurred())
 		expAgg := expResp.Body.(*goserver.Aggregation)
 		Expect(expAgg.Currencies[0].Accounts[0].Total.Equal(decimal.NewFromFloat(100.0))).To(BeTrue())
 
 		// 5. Verify Balances - should be 900 (1000 - 100), not 400 (1000 - 100 - 500)
-		balResp, err := sut.GetBalances(ctx, dateFrom, dateTo, usdID, false)
+		balResp, err := sut.GetBalances(ctx, dateFrom, dateTo, usdID, false, 0)
 		Expect(err).ToNot(HaveOccurred())
 		balAgg := balResp.Body.(*goserver.Aggregation)
 
@@ -117,4 +118,119 @@ var _ = Describe("Soft Delete Aggregation Integration", func() {
 		Expect(found).To(BeTrue())
 		Expect(bankTotal.Equal(decimal.NewFromFloat(900.0))).To(BeTrue())
 	})
+
+	It("counts only transactions in explicit dates which don't start an interval", func() {
+		bank, err := st.CreateAccount(userID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		groceries, err := st.CreateAccount(userID, &goserver.AccountNoId{Name: "Groceries", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+		for _, day := range []int{5, 20} {
+			_, err = st.CreateTransaction(userID, &goserver.TransactionNoId{
+				Date: time.Date(2025, 1, day, 0, 0, 0, 0, time.UTC),
+				Movements: []goserver.Movement{
+					{AccountId: bank.Id, CurrencyId: usdID, Amount: decimal.NewFromInt(int64(-day))},
+					{AccountId: groceries.Id, CurrencyId: usdID, Amount: decimal.NewFromInt(int64(day))},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		dateFrom := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+		dateTo := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+		expResp, err := sut.GetExpenses(ctx, dateFrom, dateTo, "", "month", false, "account", nil, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+		expAgg := expResp.Body.(*goserver.Aggregation)
+		Expect(expAgg.Currencies).To(HaveLen(1))
+		Expect(expAgg.Currencies[0].Accounts[0].Total.Equal(decimal.NewFromInt(20))).To(BeTrue())
+	})
+
+	It("counts transactions near month boundaries in the months of the report location", func() {
+		bank, err := st.CreateAccount(userID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		groceries, err := st.CreateAccount(userID, &goserver.AccountNoId{Name: "Groceries", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+		cet := time.FixedZone("CET", 60*60)
+		for date, amount := range map[time.Time]int64{
+			time.Date(2025, 1, 15, 12, 0, 0, 0, cet): 10,
+			time.Date(2025, 2, 1, 0, 30, 0, 0, cet):  20,
+			time.Date(2025, 3, 1, 0, 30, 0, 0, cet):  40,
+			time.Date(2025, 3, 15, 12, 0, 0, 0, cet): 80,
+		} {
+			_, err = st.CreateTransaction(userID, &goserver.TransactionNoId{
+				Date: date,
+				Tags: []string{"food"},
+				Movements: []goserver.Movement{
+					{AccountId: bank.Id, CurrencyId: usdID, Amount: decimal.NewFromInt(-amount)},
+					{AccountId: groceries.Id, CurrencyId: usdID, Amount: decimal.NewFromInt(amount)},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		// Grouping by tags reads single transactions, grouping by accounts can read rollups
+		dateFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, cet)
+		dateTo := time.Date(2025, 4, 1, 0, 0, 0, 0, cet)
+		amounts := func(groupBy string) []string {
+			resp, err := sut.GetExpenses(ctx, dateFrom, dateTo, "", "month", false, groupBy, nil, nil, 0)
+			Expect(err).ToNot(HaveOccurred())
+			agg := resp.Body.(*goserver.Aggregation)
+			Expect(agg.Currencies).To(HaveLen(1))
+			Expect(agg.Currencies[0].Accounts).To(HaveLen(1))
+			res := []string{}
+			for _, amount := range agg.Currencies[0].Accounts[0].Amounts {
+				res = append(res, amount.String())
+			}
+			return res
+		}
+		Expect(amounts("tag")).To(Equal([]string{"10", "20", "120"}))
+		Expect(amounts("account")).To(Equal([]string{"10", "20", "120"}))
+	})
+
+	It("nets linked refunds in months read from rollups", func() {
+		bank, err := st.CreateAccount(userID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		groceries, err := st.CreateAccount(userID, &goserver.AccountNoId{Name: "Groceries", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+		other, err := st.CreateAccount(userID, &goserver.AccountNoId{Name: "Other income", Type: "income"})
+		Expect(err).ToNot(HaveOccurred())
+
+		original, err := st.CreateTransaction(userID, &goserver.TransactionNoId{
+			Date: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+			Movements: []goserver.Movement{
+				{AccountId: bank.Id, CurrencyId: usdID, Amount: decimal.NewFromInt(-100)},
+				{AccountId: groceries.Id, CurrencyId: usdID, Amount: decimal.NewFromInt(100)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		refund, err := st.CreateTransaction(userID, &goserver.TransactionNoId{
+			Date: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC),
+			Movements: []goserver.Movement{
+				{AccountId: bank.Id, CurrencyId: usdID, Amount: decimal.NewFromInt(40)},
+				{AccountId: other.Id, CurrencyId: usdID, Amount: decimal.NewFromInt(-40)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = st.LinkRefund(userID, refund.Id, original.Id, "refund")
+		Expect(err).ToNot(HaveOccurred())
+
+		dateFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		dateTo := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+		expResp, err := sut.GetExpenses(ctx, dateFrom, dateTo, "", "month", false, "account", nil, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+		expAgg := expResp.Body.(*goserver.Aggregation)
+		Expect(expAgg.Currencies).To(HaveLen(1))
+		Expect(expAgg.Currencies[0].Accounts).To(HaveLen(1))
+		amounts := expAgg.Currencies[0].Accounts[0].Amounts
+		Expect(amounts).To(HaveLen(2))
+		Expect(amounts[0].Equal(decimal.NewFromInt(100))).To(BeTrue())
+		Expect(amounts[1].Equal(decimal.NewFromInt(-40))).To(BeTrue())
+
+		incResp, err := sut.GetIncomes(ctx, dateFrom, dateTo, "", false, 0)
+		Expect(err).ToNot(HaveOccurred())
+		for _, cur := range incResp.Body.(*goserver.Aggregation).Currencies {
+			for _, acc := range cur.Accounts {
+				Expect(acc.Total.IsZero()).To(BeTrue())
+			}
+		}
+	})
 })
@@ -0,0 +1,181 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+// canUseRollups checks if intervals of the granularity consist of whole calendar months, so
+// monthly rollups can be aggregated into them. Grouping by tags needs single transactions.
+// Rollups are kept in UTC calendar months, which start at other times in other locations, so
+// they are used for reports in UTC only.
+func canUseRollups(granularity utils.Granularity, groupBy string, loc *time.Location) bool {
+	if groupBy == "tag" || loc != time.UTC {
+		return false
+	}
+
+	switch granularity.Base() {
+	case utils.GranularityMonth:
+		return granularity.MonthStartDay() == 1
+	case utils.GranularityQuarter, utils.GranularityYear:
+		return true
+	default:
+		return false
+	}
+}
+
+// getReportTransactions returns movements in [dateFrom, dateTo) for aggregations. If rollups can
+// be used for the granularity and location, whole calendar months are read from monthly rollups as
+// one transaction per account and currency dated on the first day of the month. Partial months at the
+// edges of the range and months in which an account is opened or closed are read transaction by
+// transaction, so opening and closing dates still split movements correctly.
+//
+// Movements in other currencies than outputCurrencyID are converted at the rate of their own date,
+// so months with such rollups are read transaction by transaction too. An empty outputCurrencyID
+// means amounts aren't converted.
+//
+// If netRefunds is set, linked refunds are netted against the expense accounts of their originals
+// like common.NetRefunds does, rollup months get a correcting transaction for each such refund.
+func (s *AggregationsAPIServiceImpl) getReportTransactions(
+	familyID uuid.UUID, accounts []goserver.Account, dateFrom, dateTo time.Time,
+	granularity utils.Granularity, groupBy, outputCurrencyID string, netRefunds bool,
+) ([]goserver.Transaction, error) {
+	rollupMonths := map[time.Time]bool{}
+	if canUseRollups(granularity, groupBy, dateFrom.Location()) {
+		rollupMonths = getRollupMonths(accounts, dateFrom, dateTo)
+	}
+	var rollups []models.MonthlyRollup
+	monthFrom, monthTo := dateTo, dateFrom
+	if len(rollupMonths) > 0 {
+		for month := range rollupMonths {
+			monthFrom = minTime(monthFrom, month)
+			monthTo = maxTime(monthTo, month.AddDate(0, 1, 0))
+		}
+		var err error
+		rollups, err = s.db.GetMonthlyRollups(familyID, monthFrom, monthTo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get monthly rollups: %w", err)
+		}
+		if outputCurrencyID != "" {
+			for _, r := range rollups {
+				if r.CurrencyID != outputCurrencyID {
+					delete(rollupMonths, r.Month)
+				}
+			}
+		}
+	}
+
+	// Read the rest of the range transaction by transaction
+	transactions := []goserver.Transaction{}
+	rawFrom := dateFrom
+	for month := models.RollupMonth(dateFrom); month.Before(dateTo); month = month.AddDate(0, 1, 0) {
+		if !rollupMonths[month] {
+			continue
+		}
+		if rawFrom.Before(month) {
+			raw, err := s.db.GetTransactions(familyID, rawFrom, month, false)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get transactions: %w", err)
+			}
+			transactions = append(transactions, raw...)
+		}
+		rawFrom = month.AddDate(0, 1, 0)
+	}
+	if rawFrom.Before(dateTo) {
+		raw, err := s.db.GetTransactions(familyID, rawFrom, dateTo, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get transactions: %w", err)
+		}
+		transactions = append(transactions, raw...)
+	}
+	if netRefunds {
+		transactions = common.NetRefunds(s.logger, s.db, familyID, accounts, transactions)
+	}
+	if len(rollupMonths) == 0 {
+		return transactions, nil
+	}
+
+	for _, r := range rollups {
+		if !rollupMonths[r.Month] {
+			continue
+		}
+		transactions = append(transactions, goserver.Transaction{
+			Date: r.Month,
+			Movements: []goserver.Movement{
+				{AccountId: r.AccountID, CurrencyId: r.CurrencyID, Amount: r.Amount},
+			},
+		})
+	}
+
+	// Transactions executed at their own exchange rate are converted with it, so they replace their
+	// movements in rollups
+	exchanges, err := s.db.GetExchangeTransactions(familyID, monthFrom, monthTo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange transactions: %w", err)
+	}
+	for _, exchange := range exchanges {
+		month := models.RollupMonth(exchange.Date)
+		if !rollupMonths[month] || (netRefunds && exchange.RefundOfId != "") {
+			continue
+		}
+		correction := goserver.Transaction{Date: month}
+		for _, m := range exchange.Movements {
+			m.Amount = m.Amount.Neg()
+			correction.Movements = append(correction.Movements, m)
+		}
+		transactions = append(transactions, correction, exchange)
+	}
+
+	if netRefunds {
+		refunds, err := s.db.GetRefunds(familyID, monthFrom, monthTo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get refunds: %w", err)
+		}
+		refunds = slices.DeleteFunc(refunds, func(t goserver.Transaction) bool {
+			return !rollupMonths[models.RollupMonth(t.Date)]
+		})
+		netted := common.NetRefunds(s.logger, s.db, familyID, accounts, refunds)
+		for i, refund := range refunds {
+			// Replace movements of the refund which are already in rollups with netted ones
+			correction := goserver.Transaction{Date: refund.Date}
+			for _, m := range refund.Movements {
+				m.Amount = m.Amount.Neg()
+				correction.Movements = append(correction.Movements, m)
+			}
+			correction.Movements = append(correction.Movements, netted[i].Movements...)
+			transactions = append(transactions, correction)
+		}
+	}
+
+	return transactions, nil
+}
+
+// getRollupMonths returns whole calendar months in [dateFrom, dateTo) in which no account is
+// opened or closed.
+func getRollupMonths(accounts []goserver.Account, dateFrom, dateTo time.Time) map[time.Time]bool {
+	boundaries := map[time.Time]bool{}
+	for _, a := range accounts {
+		if !a.OpeningDate.IsZero() {
+			boundaries[models.RollupMonth(a.OpeningDate)] = true
+		}
+		if !a.ClosingDate.IsZero() {
+			boundaries[models.RollupMonth(a.ClosingDate)] = true
+		}
+	}
+
+	res := map[time.Time]bool{}
+	for month := models.RollupMonth(dateFrom); month.Before(dateTo); month = month.AddDate(0, 1, 0) {
+		if month.Before(dateFrom) || month.AddDate(0, 1, 0).After(dateTo) || boundaries[month] {
+			continue
+		}
+		res[month] = true
+	}
+	return res
+}
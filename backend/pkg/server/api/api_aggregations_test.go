@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/shopspring/decimal"
@@ -119,7 +120,7 @@ var _ = Describe("Aggregation API", func() {
 			Return(mockRates, nil).
 			AnyTimes()
 
-		fetcher := common.NewCurrenciesRatesFetcher(log, mockStorage)
+		fetcher := common.NewRateService(log, mockStorage, 0).Fetcher(uuid.Nil)
 
 		// Create transactions in different currencies
 		mixedTransactions := []goserver.Transaction{
@@ -26,12 +26,13 @@ type BankImportersAPIServiceImpl struct {
 	logger *slog.Logger
 	db     database.Storage
 	config *config.Config
+	rates  *common.RateService
 }
 
 func NewBankImportersAPIServiceImpl(
-	logger *slog.Logger, db database.Storage, cfg *config.Config,
+	logger *slog.Logger, db database.Storage, cfg *config.Config, rates *common.RateService,
 ) *BankImportersAPIServiceImpl {
-	return &BankImportersAPIServiceImpl{logger: logger, db: db, config: cfg}
+	return &BankImportersAPIServiceImpl{logger: logger, db: db, config: cfg, rates: rates}
 }
 
 func (s *BankImportersAPIServiceImpl) CreateBankImporter(ctx context.Context, input goserver.BankImporterNoId,
@@ -348,6 +349,8 @@ func (s *BankImportersAPIServiceImpl) saveImportedTransactions(
 	// First pass: validate, deduplicate, and apply matchers
 	transactionsToSave := make([]goserver.TransactionNoId, 0)
 	matcherIDsToConfirm := make([]string, 0)
+	// Loaded on the first auto-matched transaction, most imports don't need it
+	var loans *common.LoanPaymentSplitter
 
 	for _, t := range transactions {
 		// Imported transactions should have at least one external ID filled by the bank importer.
@@ -463,6 +466,12 @@ func (s *BankImportersAPIServiceImpl) saveImportedTransactions(
 				}
 				t.Description = description
 				t.Movements = proposedMovements
+				if loans == nil {
+					if loans, err = common.LoadLoanPaymentSplitter(s.logger, s.db, familyID); err != nil {
+						return nil, fmt.Errorf("can't load loans: %w", err)
+					}
+				}
+				loans.Split("", &t)
 				t.Tags = append(t.Tags, matcher.Matcher.OutputTags...)
 				t.Tags = sortAndRemoveDuplicates(t.Tags)
 				t.MatcherId = matcher.Matcher.Id
@@ -635,6 +644,9 @@ func (s *BankImportersAPIServiceImpl) saveImportedTransactions(
 			s.logger.With("error", err).Error("Failed to check balance after import")
 		}
 	}
+	if err := CheckBudgetAlerts(context.Background(), s.logger, s.db, s.rates, familyID, time.Now()); err != nil {
+		s.logger.With("error", err).Error("Failed to check budget alerts after import")
+	}
 
 	// update last import fields
 	lastImport, err := s.updateLastImportFields(familyID, id, info, len(transactions), cnt, suspiciousCnt)
@@ -47,7 +47,7 @@ var _ = Describe("BankImporters API", func() {
 		cfg := &config.Config{
 			BankImporterFilesPath: "storage/bank-importer-files",
 		}
-		sut = NewBankImportersAPIServiceImpl(logger, mockDB, cfg)
+		sut = NewBankImportersAPIServiceImpl(logger, mockDB, cfg, common.NewRateService(logger, mockDB, 0))
 
 		// Default expectation for balance checks triggered during imports
 		// CountUnprocessedTransactionsForAccount returning 1 causes early return in CheckBalanceForAccount
@@ -82,6 +82,8 @@ var _ = Describe("BankImporters API", func() {
 
 			mockDB.EXPECT().GetTransactionsIncludingDeleted(userID, gomock.Any(), gomock.Any()).Return([]goserver.Transaction{}, nil)
 			mockDB.EXPECT().GetMatchersRuntime(userID).Return([]database.MatcherRuntime{runtimeMatcher}, nil)
+			// Loans are checked for auto-matched transactions
+			mockDB.EXPECT().GetAccounts(userID).Return([]goserver.Account{{Id: "acc1", Type: "expense"}}, nil)
 
 			// Expect batch transaction creation with auto-converted fields
 			mockDB.EXPECT().CreateTransactionsBatch(userID, gomock.Any()).DoAndReturn(func(uid uuid.UUID, transactions []goserver.TransactionNoIdInterface) ([]goserver.Transaction, error) {
@@ -111,6 +113,8 @@ var _ = Describe("BankImporters API", func() {
 				},
 			}
 
+			// Budget alerts are checked after the import
+			mockDB.EXPECT().GetAccounts(userID).Return(nil, nil)
 			_, err = sut.saveImportedTransactions(userID, "imp1", &goserver.BankAccountInfo{}, transactions, false)
 			Expect(err).ToNot(HaveOccurred())
 		})
@@ -158,6 +162,8 @@ var _ = Describe("BankImporters API", func() {
 				},
 			}
 
+			// Budget alerts are checked after the import
+			mockDB.EXPECT().GetAccounts(userID).Return(nil, nil)
 			_, err = sut.saveImportedTransactions(userID, "imp1", &goserver.BankAccountInfo{}, transactions, false)
 			Expect(err).ToNot(HaveOccurred())
 		})
@@ -210,6 +216,8 @@ var _ = Describe("BankImporters API", func() {
 				},
 			}
 
+			// Budget alerts are checked after the import
+			mockDB.EXPECT().GetAccounts(userID).Return(nil, nil)
 			_, err := sut.saveImportedTransactions(userID, "imp1", &goserver.BankAccountInfo{}, transactions, false)
 			Expect(err).ToNot(HaveOccurred())
 		})
@@ -286,6 +294,8 @@ var _ = Describe("BankImporters API", func() {
 				return goserver.BankImporter{}, nil
 			})
 
+			// Budget alerts are checked after the import
+			mockDB.EXPECT().GetAccounts(userID).Return(nil, nil)
 			_, err := sut.saveImportedTransactions(userID, "imp-dedup", &goserver.BankAccountInfo{}, importedTransactions, false)
 			Expect(err).ToNot(HaveOccurred())
 		})
@@ -363,6 +373,8 @@ var _ = Describe("BankImporters API", func() {
 					{CurrencyId: "USD", OpeningBalance: decimal.NewFromInt(1000), ClosingBalance: decimal.NewFromInt(900)},
 				},
 			}
+			// Budget alerts are checked after the import
+			mockDB.EXPECT().GetAccounts(userID).Return(nil, nil)
 			_, err := sut.saveImportedTransactions(userID, importerID, info, importedTransactions, true)
 			Expect(err).ToNot(HaveOccurred())
 		})
@@ -652,6 +664,8 @@ var _ = Describe("BankImporters API", func() {
 			}
 			mockDB.EXPECT().CreateTransactionsBatch(userID, gomock.Any()).Return([]goserver.Transaction{{}}, nil)
 
+			// Budget alerts are checked after the import
+			mockDB.EXPECT().GetAccounts(userID).Return(nil, nil)
 			_, err := sut.saveImportedTransactions(userID, importerID, importedInfo, transactions, true) // checkMissing=true
 			Expect(err).ToNot(HaveOccurred())
 		})
@@ -701,6 +715,8 @@ var _ = Describe("BankImporters API", func() {
 			}
 			mockDB.EXPECT().CreateTransactionsBatch(userID, gomock.Any()).Return([]goserver.Transaction{{}}, nil)
 
+			// Budget alerts are checked after the import
+			mockDB.EXPECT().GetAccounts(userID).Return(nil, nil)
 			_, err := sut.saveImportedTransactions(userID, importerID, importedInfo, transactions, false) // checkMissing=false
 			Expect(err).ToNot(HaveOccurred())
 		})
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+var budgetAlertTitles = map[string]string{
+	utils.BudgetAlertConsumed:  "Budget Almost Spent: ",
+	utils.BudgetAlertExceeded:  "Budget Exceeded: ",
+	utils.BudgetAlertProjected: "Budget Projected to Exceed: ",
+}
+
+// CheckBudgetAlerts notifies about budgets of the current month which reached the alerts enabled
+// for their accounts. It's called after imports and changes of transactions. Every alert of an
+// account is sent once per month, even if the user dismissed its notification.
+func CheckBudgetAlerts(
+	ctx context.Context, logger *slog.Logger, db database.Storage, rates *common.RateService,
+	familyID uuid.UUID, now time.Time,
+) error {
+	accounts, err := db.GetAccounts(familyID)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts: %w", err)
+	}
+	alerts := make(map[string]goserver.Account)
+	for _, a := range accounts {
+		if utils.HasBudgetAlerts(a.BudgetAlerts) {
+			alerts[a.Id] = a
+		}
+	}
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	outputCurrencyID := common.FamilySettings(logger, db, familyID).FavoriteCurrencyID()
+	currencyName := buildCurrencyMap(logger, db, familyID)[outputCurrencyID]
+
+	month := common.BudgetMonth(logger, db, familyID)
+	today := utils.RoundToGranularity(now, utils.GranularityDay, false)
+	start := utils.RoundToGranularity(today, month, false)
+	end := utils.AddIntervals(start, month, 1)
+	service := &budgetItemsAPIService{logger: logger, db: db, rates: rates}
+	statuses, _, err := service.calculateEnvelopes(
+		ctx, familyID, start, end, outputCurrencyID, string(utils.GranularityMonth), true, 0)
+	if err != nil {
+		return fmt.Errorf("failed to calculate budget status: %w", err)
+	}
+
+	for _, status := range statuses {
+		account, ok := alerts[status.AccountId]
+		if !ok {
+			continue
+		}
+		available := status.Budgeted.Add(status.Transferred).Add(status.Rollover)
+		for _, alert := range utils.ReachedBudgetAlerts(account.BudgetAlerts, available, status.Spent, start, end, today) {
+			n := goserver.Notification{
+				Date: now,
+				Type: string(models.NotificationTypeBudgetAlert),
+				Url: fmt.Sprintf("/budget?accountId=%s&month=%s&alert=%s",
+					account.Id, start.Format(time.DateOnly), alert),
+				Title:       budgetAlertTitles[alert] + account.Name,
+				Description: budgetAlertDescription(alert, status.Spent, available, currencyName, start),
+			}
+
+			if _, err := db.CreateNotificationOnce(familyID, &n); err != nil {
+				return fmt.Errorf("failed to create budget alert notification: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func budgetAlertDescription(alert string, spent, available decimal.Decimal, currencyName string, start time.Time) string {
+	amounts := fmt.Sprintf("Spent %s of %s since %s", spent.StringFixed(2),
+		strings.TrimSpace(available.StringFixed(2)+" "+currencyName), start.Format(time.DateOnly))
+	switch alert {
+	case utils.BudgetAlertExceeded:
+		return amounts + ", the budget is exceeded."
+	case utils.BudgetAlertProjected:
+		return amounts + ", at this rate the budget is exceeded by the end of the month."
+	default:
+		return amounts + "."
+	}
+}
@@ -0,0 +1,162 @@
+package api_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Budget alerts", func() {
+	var (
+		st              database.Storage
+		ctx             context.Context
+		log             = test.CreateTestLogger()
+		familyID        = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		czk             goserver.Currency
+		bank, food, fun goserver.Account
+		now             = time.Date(2025, 4, 20, 12, 0, 0, 0, time.UTC)
+	)
+
+	spend := func(account goserver.Account, amount int64) {
+		_, err := st.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date: time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC),
+			Movements: []goserver.Movement{
+				{AccountId: bank.Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(-amount)},
+				{AccountId: account.Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(amount)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	titles := func() []string {
+		notifications, err := st.GetNotifications(familyID)
+		Expect(err).ToNot(HaveOccurred())
+		res := []string{}
+		for _, n := range notifications {
+			res = append(res, n.Title)
+		}
+		return res
+	}
+
+	BeforeEach(func() {
+		st = database.NewStorage(log, &config.Config{DBPath: ":memory:"})
+		Expect(st.Open()).To(Succeed())
+		DeferCleanup(st.Close)
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+
+		var err error
+		czk, err = st.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "CZK"})
+		Expect(err).ToNot(HaveOccurred())
+		bank, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		food, err = st.CreateAccount(familyID, &goserver.AccountNoId{
+			Name: "Food", Type: "expense",
+			BudgetAlerts: goserver.BudgetAlerts{ConsumedPercent: 80, Exceeded: true, Projected: true},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		fun, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Fun", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, account := range []goserver.Account{food, fun} {
+			_, err = st.CreateBudgetItem(familyID, &goserver.BudgetItemNoId{
+				Date: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), AccountId: account.Id, Amount: decimal.NewFromInt(300),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	It("notifies about every reached alert once per month", func() {
+		spend(food, 250)
+		spend(fun, 500)
+		Expect(api.CheckBudgetAlerts(ctx, log, st, common.NewRateService(log, st, 0), familyID, now)).To(Succeed())
+		Expect(titles()).To(ConsistOf("Budget Almost Spent: Food", "Budget Projected to Exceed: Food"))
+
+		Expect(api.CheckBudgetAlerts(ctx, log, st, common.NewRateService(log, st, 0), familyID, now)).To(Succeed())
+		Expect(titles()).To(HaveLen(2))
+
+		spend(food, 100)
+		Expect(api.CheckBudgetAlerts(
+			ctx, log, st, common.NewRateService(log, st, 0), familyID, now.AddDate(0, 0, 1),
+		)).To(Succeed())
+		Expect(titles()).To(ConsistOf(
+			"Budget Almost Spent: Food", "Budget Projected to Exceed: Food", "Budget Exceeded: Food"))
+	})
+
+	It("reports amounts in the favorite currency of the family", func() {
+		family := &models.Family{}
+		family.ID = familyID
+		family.Name = "family"
+		Expect(st.PutFamily(family)).To(Succeed())
+		// User IDs are never family IDs
+		user, err := st.CreateUser("user", "hash", familyID)
+		Expect(err).ToNot(HaveOccurred())
+		user.FavoriteCurrencyID = czk.Id
+		Expect(st.PutUser(user)).To(Succeed())
+
+		spend(food, 350)
+		Expect(api.CheckBudgetAlerts(ctx, log, st, common.NewRateService(log, st, 0), familyID, now)).To(Succeed())
+		notifications, err := st.GetNotifications(familyID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(notifications).ToNot(BeEmpty())
+		for _, n := range notifications {
+			Expect(n.Description).To(ContainSubstring("of 300.00 CZK since 2025-04-01"))
+		}
+	})
+
+	It("rechecks alerts when refunds are linked and unlinked", func() {
+		// Budgets of April roll over, so a new account is budgeted in the current month
+		today := time.Now().UTC()
+		travel, err := st.CreateAccount(familyID, &goserver.AccountNoId{
+			Name: "Travel", Type: "expense", BudgetAlerts: goserver.BudgetAlerts{ConsumedPercent: 80, Exceeded: true},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = st.CreateBudgetItem(familyID, &goserver.BudgetItemNoId{
+			Date: time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC), AccountId: travel.Id,
+			Amount: decimal.NewFromInt(300),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		refunds, err := st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Refunds", Type: "income"})
+		Expect(err).ToNot(HaveOccurred())
+		create := func(from, to goserver.Account, amount int64) goserver.Transaction {
+			t, err := st.CreateTransaction(familyID, &goserver.TransactionNoId{
+				Date: today,
+				Movements: []goserver.Movement{
+					{AccountId: from.Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(-amount)},
+					{AccountId: to.Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(amount)},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			return t
+		}
+		original := create(bank, travel, 350)
+		refund := create(refunds, bank, 100)
+		sut := api.NewTransactionsAPIService(log, st, common.NewRateService(log, st, 0))
+
+		_, err = sut.LinkRefund(ctx, refund.Id, goserver.LinkRefundRequest{OriginalId: original.Id, Kind: "refund"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(titles()).To(ConsistOf("Budget Almost Spent: Travel"))
+
+		_, err = sut.UnlinkRefund(ctx, refund.Id)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(titles()).To(ConsistOf("Budget Almost Spent: Travel", "Budget Exceeded: Travel"))
+	})
+
+	It("refuses invalid alert settings", func() {
+		_, err := st.CreateAccount(familyID, &goserver.AccountNoId{
+			Name: "Travel", Type: "expense", BudgetAlerts: goserver.BudgetAlerts{ConsumedPercent: 120},
+		})
+		Expect(err).To(MatchError(database.ErrInvalidBudgetAlerts))
+	})
+})
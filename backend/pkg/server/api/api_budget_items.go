@@ -2,60 +2,145 @@ package api
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"maps"
 	"net/http"
+	"slices"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
 	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
 )
 
 type budgetItemsAPIService struct {
 	logger *slog.Logger
 	db     database.Storage
+	rates  *common.RateService
 }
 
-func NewBudgetItemsAPIService(logger *slog.Logger, db database.Storage) goserver.BudgetItemsAPIServicer {
+func NewBudgetItemsAPIService(
+	logger *slog.Logger, db database.Storage, rates *common.RateService,
+) goserver.BudgetItemsAPIServicer {
 	return &budgetItemsAPIService{
 		logger: logger,
 		db:     db,
+		rates:  rates,
 	}
 }
 
 // GetBudgetStatus - get budget status with rollover
-func (s *budgetItemsAPIService) GetBudgetStatus(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, includeHidden bool) (goserver.ImplResponse, error) {
+func (s *budgetItemsAPIService) GetBudgetStatus(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, granularity string, includeHidden bool, depth int32) (goserver.ImplResponse, error) {
 	familyID, ok := constants.GetFamilyID(ctx)
 	if !ok {
 		return goserver.Response(http.StatusInternalServerError, nil), nil
 	}
 
-	// Fetch all budget items
-	budgetItems, err := s.db.GetBudgetItems(familyID)
+	results, _, err := s.calculateEnvelopes(ctx, familyID, from, to, outputCurrencyId, granularity, includeHidden, depth)
+	if err != nil {
+		s.logger.Error("Failed to calculate budget status", "error", err)
+		return goserver.Response(http.StatusInternalServerError, nil), err
+	}
+	goals, err := goalBudgetStatuses(ctx, s.logger, s.db, s.rates, familyID, from, to, outputCurrencyId,
+		getGranularity(s.logger, s.db, familyID, granularity))
+	if err != nil {
+		s.logger.Error("Failed to calculate budget status of goals", "error", err)
+		return goserver.Response(http.StatusInternalServerError, nil), err
+	}
+	return goserver.Response(http.StatusOK, append(results, goals...)), nil
+}
+
+// GetReadyToAssign - get income which is not assigned to envelopes yet
+func (s *budgetItemsAPIService) GetReadyToAssign(ctx context.Context, from time.Time, to time.Time, outputCurrencyId string, granularity string, includeHidden bool) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	_, results, err := s.calculateEnvelopes(ctx, familyID, from, to, outputCurrencyId, granularity, includeHidden, 0)
 	if err != nil {
-		s.logger.Error("Failed to fetch budget items", "error", err)
+		s.logger.Error("Failed to calculate ready to assign", "error", err)
 		return goserver.Response(http.StatusInternalServerError, nil), err
 	}
+	return goserver.Response(http.StatusOK, results), nil
+}
+
+// calculateEnvelopes returns the budget status of every envelope and the income ready to assign
+// in every period from the first budget till the end date, the results start with the period of
+// the start date. Envelopes are accounts, tags or partners (see utils.BudgetSelector), spending on
+// expense accounts counts for the account and for every tag and partner matching the transaction.
+// The available amount of an envelope is its budget, the budget transferred to it and its rollover
+// minus the spendings. Depending on the overspending rule of the family a negative
+// remainder either rolls over to the next period or is covered from ready to assign. The rollover
+// settings of the account then limit what's carried over.
+func (s *budgetItemsAPIService) calculateEnvelopes(
+	ctx context.Context, familyID uuid.UUID, from, to time.Time, outputCurrencyId, granularity string,
+	includeHidden bool, depth int32,
+) ([]goserver.BudgetStatus, []goserver.ReadyToAssign, error) {
+	periodGranularity := getGranularity(s.logger, s.db, familyID, granularity)
+	from = utils.RoundToGranularity(from, periodGranularity, false)
+	resetOverspending := common.BudgetOverspending(s.logger, s.db, familyID) == utils.BudgetOverspendingReset
+
+	// Fetch all budget items, plans are expanded into items of their months
+	budgetItems, err := common.GetBudget(s.db, familyID, common.BudgetMonth(s.logger, s.db, familyID), to)
+	if err != nil {
+		return nil, nil, err
+	}
+	budgetTransfers, err := s.db.GetBudgetTransfers(familyID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get budget transfers: %w", err)
+	}
 
 	// Fetch all accounts to determine their primary currency
 	accounts, err := s.db.GetAccounts(familyID)
 	if err != nil {
-		s.logger.Error("Failed to fetch accounts", "error", err)
-		return goserver.Response(http.StatusInternalServerError, nil), err
+		return nil, nil, fmt.Errorf("failed to get accounts: %w", err)
 	}
 	accountCurrencyMap := make(map[string]string) // AccountID -> CurrencyID
+	accountRollover := make(map[string]goserver.BudgetRollover)
 	allowedAccounts := make(map[string]bool)
+	expenseAccounts := make(map[string]bool)
+	incomeAccounts := make(map[string]bool)
 	for _, acc := range accounts {
 		if includeHidden || !acc.HideFromReports {
 			allowedAccounts[acc.Id] = true
 		}
+		if isExpenseAccount(acc) {
+			expenseAccounts[acc.Id] = true
+		}
+		if acc.Type == constants.AccountIncome {
+			incomeAccounts[acc.Id] = true
+		}
+		accountRollover[acc.Id] = acc.BudgetRollover
 		// Use first balance currency as "Account Currency" for budgeting purposes
 		if len(acc.BankInfo.Balances) > 0 {
 			accountCurrencyMap[acc.Id] = acc.BankInfo.Balances[0].CurrencyId
 		}
 	}
+	// Budgets and spendings of sub-accounts count for their ancestor on the requested level
+	rollup := utils.AccountRollup(accounts, int(depth))
+	budgetAccount := func(accountID string) string {
+		if ancestorID, ok := rollup[accountID]; ok {
+			return ancestorID
+		}
+		return accountID
+	}
+	// Tag and partner envelopes by their keys, budgets of hidden accounts are skipped
+	selectors := make(map[string]utils.BudgetSelector)
+	budgetKey := func(b goserver.BudgetItem) (string, bool) {
+		selector := utils.BudgetItemSelector(b)
+		if selector.IsAccount() {
+			return budgetAccount(b.AccountId), allowedAccounts[b.AccountId]
+		}
+		selectors[selector.Key()] = selector
+		return selector.Key(), true
+	}
 
 	// Helpers for currency conversion
 	currencyMap := buildCurrencyMap(s.logger, s.db, familyID)
@@ -63,141 +148,224 @@ func (s *budgetItemsAPIService) GetBudgetStatus(ctx context.Context, from time.T
 	if outputCurrencyId != "" {
 		outputCurrencyName = currencyMap[outputCurrencyId]
 	}
-	currenciesRatesFetcher := common.NewCurrenciesRatesFetcher(s.logger, s.db)
+	currenciesRatesFetcher := s.rates.Fetcher(familyID)
+	convertBudget := func(currencyID string, date time.Time, amount decimal.Decimal) decimal.Decimal {
+		if outputCurrencyId == "" || currencyID == "" || currencyID == outputCurrencyId {
+			return amount
+		}
+		originalCurrencyName := currencyMap[currencyID]
+		converted, err := currenciesRatesFetcher.Convert(ctx, date, originalCurrencyName, outputCurrencyName, amount)
+		if err != nil {
+			s.logger.Warn("Failed to convert budget amount", "error", err, "from", originalCurrencyName, "to", outputCurrencyName)
+			return amount
+		}
+		return converted
+	}
 
-	// Calculate start date for rollover calculation (find earliest budget item)
+	// Calculate start date for rollover calculation (find earliest budget item or transfer)
 	minDate := time.Now()
-	if len(budgetItems) > 0 {
-		minDate = budgetItems[0].Date
-		for _, b := range budgetItems {
-			if !allowedAccounts[b.AccountId] {
-				continue
-			}
-			if b.Date.Before(minDate) {
-				minDate = b.Date
-			}
+	for _, b := range budgetItems {
+		if _, ok := budgetKey(b); ok && b.Date.Before(minDate) {
+			minDate = b.Date
 		}
 	}
-	// Align minDate to start of month
-	minDate = time.Date(minDate.Year(), minDate.Month(), 1, 0, 0, 0, 0, minDate.Location())
+	for _, t := range budgetTransfers {
+		if t.Date.Before(minDate) {
+			minDate = t.Date
+		}
+	}
+	// Align minDate to start of period
+	minDate = utils.RoundToGranularity(minDate, periodGranularity, false)
 
 	transactions, err := s.db.GetTransactions(familyID, minDate, to, false)
 	if err != nil {
-		s.logger.Error("Failed to fetch transactions", "error", err)
-		return goserver.Response(http.StatusInternalServerError, nil), err
+		return nil, nil, fmt.Errorf("failed to get transactions: %w", err)
 	}
+	// Linked refunds become negative movements on the expense accounts of their originals
+	transactions = common.NetRefunds(s.logger, s.db, familyID, accounts, transactions)
 
-	// Map: Month -> AccountID -> BudgetedAmount (Converted)
+	// Map: Period -> AccountID -> BudgetedAmount (Converted)
 	budgetMap := make(map[string]map[string]decimal.Decimal)
-	// Map: Month -> AccountID -> SpentAmount (Converted)
+	// Map: Period -> AccountID -> TransferredAmount (Converted)
+	transferMap := make(map[string]map[string]decimal.Decimal)
+	// Map: Period -> AccountID -> SpentAmount (Converted)
 	spentMap := make(map[string]map[string]decimal.Decimal)
+	// Map: Period -> received income and budget assigned to envelopes (Converted)
+	incomeMap := make(map[string]decimal.Decimal)
+	assignedMap := make(map[string]decimal.Decimal)
 
-	// Helper to keys
-	getMonthKey := func(d time.Time) string {
-		return d.Format("2006-01")
+	// Helper to keys, periods are identified by their start
+	getPeriodKey := func(d time.Time) string {
+		return utils.RoundToGranularity(d, periodGranularity, false).Format(time.DateOnly)
+	}
+	addToPeriod := func(m map[string]map[string]decimal.Decimal, key, accountID string, amount decimal.Decimal) {
+		if _, ok := m[key]; !ok {
+			m[key] = make(map[string]decimal.Decimal)
+		}
+		m[key][accountID] = m[key][accountID].Add(amount)
 	}
 
 	for _, b := range budgetItems {
-		if !allowedAccounts[b.AccountId] {
+		envelope, ok := budgetKey(b)
+		if !ok {
 			continue
 		}
-		key := getMonthKey(b.Date)
-		if _, ok := budgetMap[key]; !ok {
-			budgetMap[key] = make(map[string]decimal.Decimal)
-		}
-
-		amount := b.Amount
-		// Convert if needed
-		accCurrencyId := accountCurrencyMap[b.AccountId]
-		if outputCurrencyId != "" && accCurrencyId != "" && accCurrencyId != outputCurrencyId {
-			// Find currency name
-			originalCurrencyName := currencyMap[accCurrencyId]
-			converted, err := currenciesRatesFetcher.Convert(ctx, b.Date, originalCurrencyName, outputCurrencyName, amount)
-			if err == nil {
-				amount = converted
-			} else {
-				s.logger.Warn("Failed to convert budget amount", "error", err, "from", originalCurrencyName, "to", outputCurrencyName)
-			}
+		key := getPeriodKey(b.Date)
+		// Account budgets are in the currency of their account, tag and partner budgets have their own
+		currencyID := b.CurrencyId
+		if b.AccountId != "" {
+			currencyID = accountCurrencyMap[b.AccountId]
+		}
+		amount := convertBudget(currencyID, b.Date, b.Amount)
+		addToPeriod(budgetMap, key, envelope, amount)
+		if !incomeAccounts[b.AccountId] {
+			assignedMap[key] = assignedMap[key].Add(amount)
 		}
+	}
 
-		budgetMap[key][b.AccountId] = budgetMap[key][b.AccountId].Add(amount)
+	// Transfers are in the currency of the envelope the budget is taken from. An empty account
+	// is ready to assign, so moving budget from it assigns income to an envelope.
+	for _, t := range budgetTransfers {
+		key := getPeriodKey(t.Date)
+		currencyAccountID := t.FromAccountId
+		if currencyAccountID == "" {
+			currencyAccountID = t.ToAccountId
+		}
+		amount := convertBudget(accountCurrencyMap[currencyAccountID], t.Date, t.Amount)
+		if t.FromAccountId == "" {
+			assignedMap[key] = assignedMap[key].Add(amount)
+		} else if allowedAccounts[t.FromAccountId] {
+			addToPeriod(transferMap, key, budgetAccount(t.FromAccountId), amount.Neg())
+		}
+		if t.ToAccountId == "" {
+			assignedMap[key] = assignedMap[key].Sub(amount)
+		} else if allowedAccounts[t.ToAccountId] {
+			addToPeriod(transferMap, key, budgetAccount(t.ToAccountId), amount)
+		}
 	}
 
 	for _, t := range transactions {
-		tMonth := getMonthKey(t.Date)
+		tPeriod := getPeriodKey(t.Date)
 		for _, m := range t.Movements {
 			if !allowedAccounts[m.AccountId] {
 				continue
 			}
-			if m.Amount.IsPositive() {
-				if _, ok := spentMap[tMonth]; !ok {
-					spentMap[tMonth] = make(map[string]decimal.Decimal)
+			isNettedRefund := t.RefundOfId != "" && m.Amount.IsNegative() && expenseAccounts[m.AccountId]
+			isIncome := incomeAccounts[m.AccountId]
+			if !m.Amount.IsPositive() && !isNettedRefund && !isIncome {
+				continue
+			}
+
+			amount := m.Amount
+			if outputCurrencyId != "" && m.CurrencyId != outputCurrencyId {
+				originalCurrencyName := currencyMap[m.CurrencyId]
+				converted, err := currenciesRatesFetcher.Convert(ctx, t.Date, originalCurrencyName, outputCurrencyName, amount)
+				if err == nil {
+					amount = converted
+				} else {
+					s.logger.Warn("Failed to convert movement amount", "error", err)
 				}
+			}
 
-				amount := m.Amount
-				if outputCurrencyId != "" && m.CurrencyId != outputCurrencyId {
-					originalCurrencyName := currencyMap[m.CurrencyId]
-					converted, err := currenciesRatesFetcher.Convert(ctx, t.Date, originalCurrencyName, outputCurrencyName, amount)
-					if err == nil {
-						amount = converted
-					} else {
-						s.logger.Warn("Failed to convert movement amount", "error", err)
+			// Income is taken from income accounts
+			if isIncome {
+				incomeMap[tPeriod] = incomeMap[tPeriod].Sub(amount)
+			}
+			if m.Amount.IsPositive() || isNettedRefund {
+				addToPeriod(spentMap, tPeriod, budgetAccount(m.AccountId), amount)
+				if expenseAccounts[m.AccountId] {
+					for key, selector := range selectors {
+						if selector.Matches(t, m.AccountId) {
+							addToPeriod(spentMap, tPeriod, key, amount)
+						}
 					}
 				}
-
-				spentMap[tMonth][m.AccountId] = spentMap[tMonth][m.AccountId].Add(amount)
 			}
 		}
 	}
 
 	rolloverMap := make(map[string]decimal.Decimal) // AccountID -> Current Rollover
+	readyToAssign := decimal.Zero
 
-	// Iterate months from minDate to 'to'
+	// Iterate periods from minDate to 'to'
 	current := minDate
+	var previous time.Time
 	results := []goserver.BudgetStatus{}
+	readyToAssignResults := []goserver.ReadyToAssign{}
 
 	for current.Before(to) {
-		monthKey := getMonthKey(current)
+		periodKey := getPeriodKey(current)
 
-		// Get unique accounts involved this month (budgeted or spent)
+		// Get unique accounts involved this period (budgeted, transferred or spent)
 		accountsSet := make(map[string]bool)
-		for acc := range budgetMap[monthKey] {
-			accountsSet[acc] = true
-		}
-		for acc := range spentMap[monthKey] {
-			accountsSet[acc] = true
-		}
-		for acc := range rolloverMap {
-			accountsSet[acc] = true
+		for _, m := range []map[string]decimal.Decimal{
+			budgetMap[periodKey], transferMap[periodKey], spentMap[periodKey], rolloverMap,
+		} {
+			for acc := range m {
+				accountsSet[acc] = true
+			}
 		}
 
+		overspent := decimal.Zero
 		for accId := range accountsSet {
-			budgeted := budgetMap[monthKey][accId]
-			spent := spentMap[monthKey][accId]
+			budgeted := budgetMap[periodKey][accId]
+			transferred := transferMap[periodKey][accId]
+			spent := spentMap[periodKey][accId]
 			previousRollover := rolloverMap[accId]
+			if !previous.IsZero() && utils.BudgetRolloverResets(accountRollover[accId], previous, current) {
+				previousRollover = decimal.Zero
+			}
 
-			available := budgeted.Add(previousRollover)
+			available := budgeted.Add(transferred).Add(previousRollover)
 			remainder := available.Sub(spent)
 
 			rolloverMap[accId] = remainder
+			// Overspent envelopes start the next period empty, ready to assign covers the overspending
+			_, isSelector := selectors[accId]
+			if resetOverspending && (expenseAccounts[accId] || isSelector) && remainder.IsNegative() {
+				rolloverMap[accId] = decimal.Zero
+				overspent = overspent.Sub(remainder)
+			}
+			rolloverMap[accId] = utils.BudgetRolloverAmount(accountRollover[accId], rolloverMap[accId])
 
 			// Only add to results if within requested range
 			if !current.Before(from) {
+				selector, isSelector := selectors[accId]
+				if !isSelector {
+					selector = utils.BudgetSelector{AccountID: accId}
+				}
 				results = append(results, goserver.BudgetStatus{
-					Date:      current,
-					AccountId: accId,
-					Budgeted:  budgeted,
-					Spent:     spent,
-					Rollover:  previousRollover,
-					Available: remainder,
+					Date:        current,
+					AccountId:   selector.AccountID,
+					Tag:         selector.Tag,
+					Partner:     selector.Partner,
+					Budgeted:    budgeted,
+					Spent:       spent,
+					Transferred: transferred,
+					Rollover:    previousRollover,
+					Available:   remainder,
 				})
 			}
 		}
 
-		current = current.AddDate(0, 1, 0)
+		income := incomeMap[periodKey]
+		assigned := assignedMap[periodKey]
+		readyToAssign = readyToAssign.Add(income).Sub(assigned).Sub(overspent)
+		if !current.Before(from) {
+			readyToAssignResults = append(readyToAssignResults, goserver.ReadyToAssign{
+				Date:          current,
+				Income:        income,
+				Assigned:      assigned,
+				Overspent:     overspent,
+				ReadyToAssign: readyToAssign,
+			})
+		}
+
+		previous = current
+		current = utils.AddIntervals(current, periodGranularity, 1)
 	}
 
-	return goserver.Response(http.StatusOK, results), nil
+	return results, readyToAssignResults, nil
 }
 
 // CreateBudgetItem - create new budgetItem
@@ -208,6 +376,9 @@ func (s *budgetItemsAPIService) CreateBudgetItem(ctx context.Context, budgetItem
 	}
 	budgetItem, err := s.db.CreateBudgetItem(familyID, &budgetItemNoID)
 	if err != nil {
+		if errors.Is(err, database.ErrInvalidBudgetItem) {
+			return goserver.Response(http.StatusBadRequest, err.Error()), nil
+		}
 		s.logger.Error("Failed to create budget item", "error", err)
 		return goserver.Response(http.StatusInternalServerError, nil), err
 	}
@@ -266,7 +437,244 @@ func (s *budgetItemsAPIService) GetBudgetItems(ctx context.Context) (goserver.Im
 func (s *budgetItemsAPIService) UpdateBudgetItem(ctx context.Context, id string, budgetItemNoID goserver.BudgetItemNoId) (goserver.ImplResponse, error) {
 	res, _, err := updateEntity(ctx, s.logger, "budgetItem", id, &budgetItemNoID, s.db.UpdateBudgetItem)
 	if err != nil {
+		if errors.Is(err, database.ErrInvalidBudgetItem) {
+			return goserver.Response(http.StatusBadRequest, err.Error()), nil
+		}
+		return mapErrorToResponse(err), nil
+	}
+	return goserver.Response(http.StatusOK, res), nil
+}
+
+// GetBudgetPlans - get all budget plans
+func (s *budgetItemsAPIService) GetBudgetPlans(ctx context.Context) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+	plans, err := s.db.GetBudgetPlans(familyID)
+	if err != nil {
+		s.logger.Error("Failed to get budget plans", "error", err)
+		return goserver.Response(http.StatusInternalServerError, nil), err
+	}
+	return goserver.Response(http.StatusOK, plans), nil
+}
+
+// CreateBudgetPlan - create new budget plan
+func (s *budgetItemsAPIService) CreateBudgetPlan(ctx context.Context, planNoID goserver.BudgetPlanNoId) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+	plan, err := s.db.CreateBudgetPlan(familyID, &planNoID)
+	if err != nil {
+		if errors.Is(err, database.ErrInvalidBudgetPlan) {
+			return goserver.Response(http.StatusBadRequest, err.Error()), nil
+		}
+		s.logger.Error("Failed to create budget plan", "error", err)
+		return goserver.Response(http.StatusInternalServerError, nil), err
+	}
+	return goserver.Response(http.StatusOK, plan), nil
+}
+
+// GetBudgetPlan - get budget plan
+func (s *budgetItemsAPIService) GetBudgetPlan(ctx context.Context, id string) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+	plan, err := s.db.GetBudgetPlan(familyID, id)
+	if err != nil {
+		if err == database.ErrNotFound {
+			return goserver.Response(http.StatusNotFound, nil), nil
+		}
+		s.logger.Error("Failed to get budget plan", "error", err)
+		return goserver.Response(http.StatusInternalServerError, nil), err
+	}
+	return goserver.Response(http.StatusOK, plan), nil
+}
+
+// UpdateBudgetPlan - update budget plan
+func (s *budgetItemsAPIService) UpdateBudgetPlan(ctx context.Context, id string, planNoID goserver.BudgetPlanNoId) (goserver.ImplResponse, error) {
+	res, _, err := updateEntity(ctx, s.logger, "budgetPlan", id, &planNoID, s.db.UpdateBudgetPlan)
+	if err != nil {
+		if errors.Is(err, database.ErrInvalidBudgetPlan) {
+			return goserver.Response(http.StatusBadRequest, err.Error()), nil
+		}
+		return mapErrorToResponse(err), nil
+	}
+	return goserver.Response(http.StatusOK, res), nil
+}
+
+// DeleteBudgetPlan - delete budget plan
+func (s *budgetItemsAPIService) DeleteBudgetPlan(ctx context.Context, id string) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+	if err := s.db.DeleteBudgetPlan(familyID, id); err != nil {
+		if err == database.ErrNotFound {
+			return goserver.Response(http.StatusNotFound, nil), nil
+		}
+		s.logger.Error("Failed to delete budget plan", "error", err)
+		return goserver.Response(http.StatusInternalServerError, nil), err
+	}
+	return goserver.Response(http.StatusOK, nil), nil
+}
+
+// GetBudgetTransfers - get all budget transfers
+func (s *budgetItemsAPIService) GetBudgetTransfers(ctx context.Context) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+	transfers, err := s.db.GetBudgetTransfers(familyID)
+	if err != nil {
+		s.logger.Error("Failed to get budget transfers", "error", err)
+		return goserver.Response(http.StatusInternalServerError, nil), err
+	}
+	return goserver.Response(http.StatusOK, transfers), nil
+}
+
+// CreateBudgetTransfer - move budget between envelopes
+func (s *budgetItemsAPIService) CreateBudgetTransfer(ctx context.Context, transferNoID goserver.BudgetTransferNoId) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+	transfer, err := s.db.CreateBudgetTransfer(familyID, &transferNoID)
+	if err != nil {
+		if errors.Is(err, database.ErrInvalidBudgetTransfer) {
+			return goserver.Response(http.StatusBadRequest, err.Error()), nil
+		}
+		s.logger.Error("Failed to create budget transfer", "error", err)
+		return goserver.Response(http.StatusInternalServerError, nil), err
+	}
+	return goserver.Response(http.StatusOK, transfer), nil
+}
+
+// GetBudgetTransfer - get budget transfer
+func (s *budgetItemsAPIService) GetBudgetTransfer(ctx context.Context, id string) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+	transfer, err := s.db.GetBudgetTransfer(familyID, id)
+	if err != nil {
+		if err == database.ErrNotFound {
+			return goserver.Response(http.StatusNotFound, nil), nil
+		}
+		s.logger.Error("Failed to get budget transfer", "error", err)
+		return goserver.Response(http.StatusInternalServerError, nil), err
+	}
+	return goserver.Response(http.StatusOK, transfer), nil
+}
+
+// UpdateBudgetTransfer - update budget transfer
+func (s *budgetItemsAPIService) UpdateBudgetTransfer(ctx context.Context, id string, transferNoID goserver.BudgetTransferNoId) (goserver.ImplResponse, error) {
+	res, _, err := updateEntity(ctx, s.logger, "budgetTransfer", id, &transferNoID, s.db.UpdateBudgetTransfer)
+	if err != nil {
+		if errors.Is(err, database.ErrInvalidBudgetTransfer) {
+			return goserver.Response(http.StatusBadRequest, err.Error()), nil
+		}
 		return mapErrorToResponse(err), nil
 	}
 	return goserver.Response(http.StatusOK, res), nil
 }
+
+// DeleteBudgetTransfer - delete budget transfer
+func (s *budgetItemsAPIService) DeleteBudgetTransfer(ctx context.Context, id string) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+	if err := s.db.DeleteBudgetTransfer(familyID, id); err != nil {
+		if err == database.ErrNotFound {
+			return goserver.Response(http.StatusNotFound, nil), nil
+		}
+		s.logger.Error("Failed to delete budget transfer", "error", err)
+		return goserver.Response(http.StatusInternalServerError, nil), err
+	}
+	return goserver.Response(http.StatusOK, nil), nil
+}
+
+// CopyBudget - copy the budget of the previous month
+func (s *budgetItemsAPIService) CopyBudget(ctx context.Context, month time.Time) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+	if month.IsZero() {
+		month = time.Now()
+	}
+
+	created, err := CopyPreviousBudget(s.logger, s.db, familyID, month)
+	if err != nil {
+		s.logger.Error("Failed to copy budget", "error", err)
+		return goserver.Response(http.StatusInternalServerError, nil), err
+	}
+	return goserver.Response(http.StatusOK, created), nil
+}
+
+// CopyPreviousBudget makes the budget of accounts, tags and partners budgeted in the previous month
+// the same in the month of the date by creating budget items. Targets which already have budget
+// items in the month, or whose plans give them the same budget, are skipped.
+func CopyPreviousBudget(
+	logger *slog.Logger, db database.Storage, familyID uuid.UUID, date time.Time,
+) ([]goserver.BudgetItem, error) {
+	budgetMonth := common.BudgetMonth(logger, db, familyID)
+	target := utils.RoundToGranularity(date, budgetMonth, false)
+	source := utils.AddIntervals(target, budgetMonth, -1)
+	budget, err := common.GetBudget(db, familyID, budgetMonth, utils.AddIntervals(target, budgetMonth, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	// Budgets by the keys of their targets
+	selectors := make(map[string]utils.BudgetSelector)
+	sourceBudget := make(map[string]decimal.Decimal)
+	descriptions := make(map[string]string)
+	currencies := make(map[string]string)
+	targetBudget := make(map[string]decimal.Decimal)
+	hasItems := make(map[string]bool)
+	for _, b := range budget {
+		selector := utils.BudgetItemSelector(b)
+		key := selector.Key()
+		switch utils.RoundToGranularity(b.Date, budgetMonth, false) {
+		case source:
+			selectors[key] = selector
+			sourceBudget[key] = sourceBudget[key].Add(b.Amount)
+			if descriptions[key] == "" {
+				descriptions[key] = b.Description
+			}
+			if currencies[key] == "" {
+				currencies[key] = b.CurrencyId
+			}
+		case target:
+			targetBudget[key] = targetBudget[key].Add(b.Amount)
+			// Budget items have IDs, budgets expanded from plans don't
+			hasItems[key] = hasItems[key] || b.Id != ""
+		}
+	}
+
+	keys := slices.Sorted(maps.Keys(sourceBudget))
+	created := []goserver.BudgetItem{}
+	for _, key := range keys {
+		if hasItems[key] || sourceBudget[key].Equal(targetBudget[key]) {
+			continue
+		}
+		item, err := db.CreateBudgetItem(familyID, &goserver.BudgetItemNoId{
+			Date:        target,
+			AccountId:   selectors[key].AccountID,
+			Tag:         selectors[key].Tag,
+			Partner:     selectors[key].Partner,
+			Amount:      sourceBudget[key],
+			CurrencyId:  currencies[key],
+			Description: descriptions[key],
+		})
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, item)
+	}
+	return created, nil
+}
@@ -13,8 +13,10 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/shopspring/decimal"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
 	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 	"github.com/ya-breeze/geekbudgetbe/test"
 )
 
@@ -26,12 +28,25 @@ var _ = Describe("BudgetItems API", func() {
 		ctrl        *gomock.Controller
 		mockStorage *mocks.MockStorage
 		sut         goserver.BudgetItemsAPIServicer
+		// monthStartDay of the family, 1 means calendar months
+		monthStartDay int
 	)
 
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockStorage = mocks.NewMockStorage(ctrl)
-		sut = api.NewBudgetItemsAPIService(log, mockStorage)
+		sut = api.NewBudgetItemsAPIService(log, mockStorage, common.NewRateService(log, mockStorage, 0))
+		monthStartDay = 1
+		mockStorage.EXPECT().GetFamily(uuid.MustParse("00000000-0000-0000-0000-000000000001")).
+			DoAndReturn(func(uuid.UUID) (*models.Family, error) {
+				return &models.Family{MonthStartDay: monthStartDay}, nil
+			}).AnyTimes()
+		mockStorage.EXPECT().GetBudgetPlans(uuid.MustParse("00000000-0000-0000-0000-000000000001")).
+			Return(nil, nil).AnyTimes()
+		mockStorage.EXPECT().GetBudgetTransfers(uuid.MustParse("00000000-0000-0000-0000-000000000001")).
+			Return(nil, nil).AnyTimes()
+		mockStorage.EXPECT().GetGoals(uuid.MustParse("00000000-0000-0000-0000-000000000001")).
+			Return(nil, nil).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -83,7 +98,7 @@ var _ = Describe("BudgetItems API", func() {
 		mockStorage.EXPECT().GetTransactions(uuid.MustParse("00000000-0000-0000-0000-000000000001"), gomock.Any(), gomock.Any(), false).Return(transactions, nil)
 
 		// Call SUT for Jan and Feb status
-		resp, err := sut.GetBudgetStatus(ctx, startOfMonth, startOfMonth.AddDate(0, 2, 0), "", false)
+		resp, err := sut.GetBudgetStatus(ctx, startOfMonth, startOfMonth.AddDate(0, 2, 0), "", "month", false, 0)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(resp.Code).To(Equal(http.StatusOK))
 
@@ -133,7 +148,7 @@ var _ = Describe("BudgetItems API", func() {
 		mockStorage.EXPECT().GetCurrencies(uuid.MustParse("00000000-0000-0000-0000-000000000001")).Return([]goserver.Currency{}, nil)
 		mockStorage.EXPECT().GetTransactions(uuid.MustParse("00000000-0000-0000-0000-000000000001"), gomock.Any(), gomock.Any(), false).Return(transactions, nil)
 
-		resp, err := sut.GetBudgetStatus(ctx, startOfMonth, startOfMonth.AddDate(0, 2, 0), "", false)
+		resp, err := sut.GetBudgetStatus(ctx, startOfMonth, startOfMonth.AddDate(0, 2, 0), "", "month", false, 0)
 		Expect(err).ToNot(HaveOccurred())
 		body := resp.Body.([]goserver.BudgetStatus)
 
@@ -146,6 +161,98 @@ var _ = Describe("BudgetItems API", func() {
 		Expect(feb.Available.Equal(decimal.NewFromInt(50))).To(BeTrue())
 	})
 
+	It("nets linked refunds against the expense account of the original", func() {
+		familyID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		startOfMonth := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		budgetItems := []goserver.BudgetItem{
+			{Date: startOfMonth, AccountId: "shopping", Amount: decimal.NewFromInt(100)},
+		}
+		// Original was bought in the previous year, so it is not in the fetched range
+		original := goserver.Transaction{
+			Id:   "original",
+			Date: startOfMonth.AddDate(0, 0, -10),
+			Movements: []goserver.Movement{
+				{AccountId: "bank", Amount: decimal.NewFromInt(-80)},
+				{AccountId: "shopping", Amount: decimal.NewFromInt(80)},
+			},
+		}
+		transactions := []goserver.Transaction{
+			{
+				Date:      startOfMonth.Add(time.Hour),
+				Movements: []goserver.Movement{{AccountId: "shopping", Amount: decimal.NewFromInt(70)}},
+			},
+			{
+				Id:         "refund",
+				Date:       startOfMonth.AddDate(0, 0, 5),
+				RefundOfId: "original",
+				RefundKind: "refund",
+				Movements: []goserver.Movement{
+					{AccountId: "bank", Amount: decimal.NewFromInt(30)},
+					{AccountId: "refunds", Amount: decimal.NewFromInt(-30)},
+				},
+			},
+		}
+
+		mockStorage.EXPECT().GetBudgetItems(familyID).Return(budgetItems, nil)
+		mockStorage.EXPECT().GetAccounts(familyID).Return([]goserver.Account{
+			{Id: "bank", Type: "asset", HideFromReports: true},
+			{Id: "shopping", Type: "expense"},
+			{Id: "refunds", Type: "income", HideFromReports: true},
+		}, nil)
+		mockStorage.EXPECT().GetCurrencies(familyID).Return([]goserver.Currency{}, nil)
+		mockStorage.EXPECT().GetTransactions(familyID, gomock.Any(), gomock.Any(), false).Return(transactions, nil)
+		mockStorage.EXPECT().GetTransaction(familyID, "original").Return(original, nil)
+
+		resp, err := sut.GetBudgetStatus(ctx, startOfMonth, startOfMonth.AddDate(0, 1, 0), "", "month", false, 0)
+		Expect(err).ToNot(HaveOccurred())
+		body := resp.Body.([]goserver.BudgetStatus)
+		Expect(body).To(HaveLen(1))
+		Expect(body[0].AccountId).To(Equal("shopping"))
+		Expect(body[0].Spent.Equal(decimal.NewFromInt(40))).To(BeTrue())
+		Expect(body[0].Available.Equal(decimal.NewFromInt(60))).To(BeTrue())
+	})
+
+	It("uses financial months starting on the family month start day", func() {
+		familyID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		monthStartDay = 25
+		budgetItems := []goserver.BudgetItem{
+			// Budget of February covers 25th January - 24th February
+			{Date: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), AccountId: "food", Amount: decimal.NewFromInt(100)},
+		}
+		transactions := []goserver.Transaction{
+			{
+				Date:      time.Date(2023, 1, 24, 12, 0, 0, 0, time.UTC),
+				Movements: []goserver.Movement{{AccountId: "food", Amount: decimal.NewFromInt(10)}},
+			},
+			{
+				Date:      time.Date(2023, 1, 26, 12, 0, 0, 0, time.UTC),
+				Movements: []goserver.Movement{{AccountId: "food", Amount: decimal.NewFromInt(30)}},
+			},
+			{
+				Date:      time.Date(2023, 2, 24, 12, 0, 0, 0, time.UTC),
+				Movements: []goserver.Movement{{AccountId: "food", Amount: decimal.NewFromInt(20)}},
+			},
+		}
+
+		mockStorage.EXPECT().GetBudgetItems(familyID).Return(budgetItems, nil)
+		mockStorage.EXPECT().GetAccounts(familyID).Return([]goserver.Account{{Id: "food", Type: "expense"}}, nil)
+		mockStorage.EXPECT().GetCurrencies(familyID).Return([]goserver.Currency{}, nil)
+		mockStorage.EXPECT().GetTransactions(familyID, time.Date(2023, 1, 25, 0, 0, 0, 0, time.UTC), gomock.Any(), false).
+			Return(transactions, nil)
+
+		from := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+		resp, err := sut.GetBudgetStatus(ctx, from, from.AddDate(0, 1, 0), "", "month", false, 0)
+		Expect(err).ToNot(HaveOccurred())
+		body := resp.Body.([]goserver.BudgetStatus)
+		Expect(body).To(HaveLen(2))
+		Expect(body[0].Date).To(Equal(time.Date(2023, 1, 25, 0, 0, 0, 0, time.UTC)))
+		Expect(body[0].Spent.Equal(decimal.NewFromInt(50))).To(BeTrue())
+		Expect(body[0].Available.Equal(decimal.NewFromInt(50))).To(BeTrue())
+		Expect(body[1].Date).To(Equal(time.Date(2023, 2, 25, 0, 0, 0, 0, time.UTC)))
+		Expect(body[1].Rollover.Equal(decimal.NewFromInt(50))).To(BeTrue())
+	})
+
 	It("updates a budget item successfully", func() {
 		budgetItemID := "bi-1"
 		input := goserver.BudgetItemNoId{
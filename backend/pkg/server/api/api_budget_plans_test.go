@@ -0,0 +1,151 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Budget plans", func() {
+	var (
+		st       database.Storage
+		sut      goserver.BudgetItemsAPIServicer
+		ctx      context.Context
+		log      = test.CreateTestLogger()
+		familyID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		food     goserver.Account
+		month    = func(m int) time.Time { return time.Date(2025, time.Month(m), 1, 0, 0, 0, 0, time.UTC) }
+	)
+
+	BeforeEach(func() {
+		st = database.NewStorage(log, &config.Config{DBPath: ":memory:"})
+		Expect(st.Open()).To(Succeed())
+		DeferCleanup(st.Close)
+		sut = api.NewBudgetItemsAPIService(log, st, common.NewRateService(log, st, 0))
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+
+		var err error
+		food, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Food", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("refuses invalid plans", func() {
+		resp, err := sut.CreateBudgetPlan(ctx, goserver.BudgetPlanNoId{
+			AccountId: food.Id, Amount: decimal.NewFromInt(100), Recurrence: "custom", EffectiveFrom: month(1),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+
+		resp, err = sut.CreateBudgetPlan(ctx, goserver.BudgetPlanNoId{
+			AccountId: uuid.NewString(), Amount: decimal.NewFromInt(100), Recurrence: "monthly", EffectiveFrom: month(1),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+
+		resp, err = sut.GetBudgetPlan(ctx, uuid.NewString())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("expands plans in budget status with budget items as overrides", func() {
+		resp, err := sut.CreateBudgetPlan(ctx, goserver.BudgetPlanNoId{
+			AccountId: food.Id, Amount: decimal.NewFromInt(100), Recurrence: "monthly", EffectiveFrom: month(1),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		_, err = st.CreateBudgetItem(familyID, &goserver.BudgetItemNoId{
+			Date: month(3), AccountId: food.Id, Amount: decimal.NewFromInt(150),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		resp, err = sut.GetBudgetStatus(ctx, month(1), month(4), "", "month", false, 0)
+		Expect(err).ToNot(HaveOccurred())
+		budgeted := []string{}
+		for _, status := range resp.Body.([]goserver.BudgetStatus) {
+			budgeted = append(budgeted, status.Budgeted.String())
+		}
+		Expect(budgeted).To(Equal([]string{"100", "100", "150"}))
+	})
+
+	It("copies the budget of the previous month", func() {
+		_, err := st.CreateBudgetPlan(familyID, &goserver.BudgetPlanNoId{
+			AccountId: food.Id, Amount: decimal.NewFromInt(100), Recurrence: "monthly", EffectiveFrom: month(1),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = st.CreateBudgetItem(familyID, &goserver.BudgetItemNoId{
+			Date: month(3), AccountId: food.Id, Amount: decimal.NewFromInt(150), Description: "Party",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// The plan already gives February the budget of January
+		created, err := api.CopyPreviousBudget(log, st, familyID, month(2))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(BeEmpty())
+
+		created, err = api.CopyPreviousBudget(log, st, familyID, month(4).AddDate(0, 0, 14))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(HaveLen(1))
+		Expect(created[0].Date).To(Equal(month(4)))
+		Expect(created[0].Amount.String()).To(Equal("150"))
+		Expect(created[0].Description).To(Equal("Party"))
+
+		// Budget items of the month are kept
+		created, err = api.CopyPreviousBudget(log, st, familyID, month(4))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(BeEmpty())
+	})
+	It("limits rollover by the policy of the account", func() {
+		czk, err := st.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "CZK"})
+		Expect(err).ToNot(HaveOccurred())
+		bank, err := st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		fun, err := st.CreateAccount(familyID, &goserver.AccountNoId{
+			Name: "Fun", Type: "expense",
+			BudgetRollover: goserver.BudgetRollover{Policy: "positive", ResetEvery: "quarter"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = st.CreateBudgetPlan(familyID, &goserver.BudgetPlanNoId{
+			AccountId: fun.Id, Amount: decimal.NewFromInt(100), Recurrence: "monthly", EffectiveFrom: month(1),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = st.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date: month(1).AddDate(0, 0, 5),
+			Movements: []goserver.Movement{
+				{AccountId: bank.Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(-150)},
+				{AccountId: fun.Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(150)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		resp, err := sut.GetBudgetStatus(ctx, month(1), month(5), "", "month", false, 0)
+		Expect(err).ToNot(HaveOccurred())
+		rollovers := []string{}
+		for _, status := range resp.Body.([]goserver.BudgetStatus) {
+			if status.AccountId == fun.Id {
+				rollovers = append(rollovers, status.Rollover.String())
+			}
+		}
+		// Overspending isn't carried over, savings are dropped in the second quarter
+		Expect(rollovers).To(Equal([]string{"0", "0", "100", "0"}))
+	})
+
+	It("refuses accruing monthly plans", func() {
+		resp, err := sut.CreateBudgetPlan(ctx, goserver.BudgetPlanNoId{
+			AccountId: food.Id, Amount: decimal.NewFromInt(1200), Recurrence: "monthly", EffectiveFrom: month(1), Accrue: true,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -0,0 +1,128 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Budgets by tag and partner", func() {
+	var (
+		st                      database.Storage
+		sut                     goserver.BudgetItemsAPIServicer
+		ctx                     context.Context
+		log                     = test.CreateTestLogger()
+		familyID                = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		czk                     goserver.Currency
+		bank, food, electronics goserver.Account
+		month                   = func(m int) time.Time { return time.Date(2025, time.Month(m), 1, 0, 0, 0, 0, time.UTC) }
+	)
+
+	spend := func(account goserver.Account, amount int64, partner string, tags ...string) {
+		_, err := st.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date:        month(1).AddDate(0, 0, 9),
+			PartnerName: partner,
+			Tags:        tags,
+			Movements: []goserver.Movement{
+				{AccountId: bank.Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(-amount)},
+				{AccountId: account.Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(amount)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	budget := func(item goserver.BudgetItemNoId) int {
+		item.Date = month(1)
+		item.Amount = decimal.NewFromInt(300)
+		resp, err := sut.CreateBudgetItem(ctx, item)
+		Expect(err).ToNot(HaveOccurred())
+		return resp.Code
+	}
+
+	BeforeEach(func() {
+		st = database.NewStorage(log, &config.Config{DBPath: ":memory:"})
+		Expect(st.Open()).To(Succeed())
+		DeferCleanup(st.Close)
+		sut = api.NewBudgetItemsAPIService(log, st, common.NewRateService(log, st, 0))
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+
+		var err error
+		czk, err = st.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "CZK"})
+		Expect(err).ToNot(HaveOccurred())
+		bank, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		food, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Food", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+		electronics, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Electronics", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("refuses items without exactly one target", func() {
+		Expect(budget(goserver.BudgetItemNoId{})).To(Equal(http.StatusBadRequest))
+		Expect(budget(goserver.BudgetItemNoId{AccountId: food.Id, Tag: "vacation"})).To(Equal(http.StatusBadRequest))
+		Expect(budget(goserver.BudgetItemNoId{Partner: "Amazon"})).To(Equal(http.StatusOK))
+	})
+
+	It("counts spending across accounts for tags and partners", func() {
+		Expect(budget(goserver.BudgetItemNoId{AccountId: food.Id})).To(Equal(http.StatusOK))
+		Expect(budget(goserver.BudgetItemNoId{Tag: " vacation / 2026 "})).To(Equal(http.StatusOK))
+		Expect(budget(goserver.BudgetItemNoId{Partner: "Amazon"})).To(Equal(http.StatusOK))
+
+		spend(food, 100, "Restaurante Roma", "vacation/2026/italy")
+		spend(electronics, 150, "AMAZON EU S.a.r.l.", "vacation/2026")
+		spend(electronics, 50, "Amazon")
+		spend(food, 20, "Albert")
+
+		resp, err := sut.GetBudgetStatus(ctx, month(1), month(2), "", "month", false, 0)
+		Expect(err).ToNot(HaveOccurred())
+		spent := map[string]string{}
+		for _, status := range resp.Body.([]goserver.BudgetStatus) {
+			spent[status.AccountId+status.Tag+status.Partner] = status.Spent.String() + "/" + status.Available.String()
+		}
+		Expect(spent).To(Equal(map[string]string{
+			food.Id:         "120/180",
+			electronics.Id:  "200/-200",
+			"vacation/2026": "250/50",
+			"Amazon":        "50/250",
+		}))
+	})
+
+	It("converts budgets of tags and partners from their own currency", func() {
+		eur, err := st.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "EUR"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(st.SaveCNBRates(map[string]decimal.Decimal{"EUR": decimal.NewFromInt(25)}, month(1))).To(Succeed())
+		Expect(budget(goserver.BudgetItemNoId{Tag: "vacation", CurrencyId: eur.Id})).To(Equal(http.StatusOK))
+		Expect(budget(goserver.BudgetItemNoId{Partner: "Amazon", CurrencyId: czk.Id})).To(Equal(http.StatusOK))
+
+		resp, err := sut.GetBudgetStatus(ctx, month(1), month(2), czk.Id, "month", false, 0)
+		Expect(err).ToNot(HaveOccurred())
+		budgeted := map[string]string{}
+		for _, status := range resp.Body.([]goserver.BudgetStatus) {
+			budgeted[status.Tag+status.Partner] = status.Budgeted.String()
+		}
+		Expect(budgeted).To(Equal(map[string]string{"vacation": "7500", "Amazon": "300"}))
+	})
+
+	It("copies budgets of tags and partners", func() {
+		Expect(budget(goserver.BudgetItemNoId{Tag: "vacation", CurrencyId: czk.Id})).To(Equal(http.StatusOK))
+
+		created, err := api.CopyPreviousBudget(log, st, familyID, month(2))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(HaveLen(1))
+		Expect(created[0].Tag).To(Equal("vacation"))
+		Expect(created[0].AccountId).To(BeEmpty())
+		Expect(created[0].CurrencyId).To(Equal(czk.Id))
+	})
+})
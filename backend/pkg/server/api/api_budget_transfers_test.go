@@ -0,0 +1,160 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Budget transfers", func() {
+	var (
+		st                             database.Storage
+		sut                            goserver.BudgetItemsAPIServicer
+		ctx                            context.Context
+		log                            = test.CreateTestLogger()
+		familyID                       = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		czk                            goserver.Currency
+		salary, bank, food, restaurant goserver.Account
+		month                          = func(m int) time.Time { return time.Date(2025, time.Month(m), 1, 0, 0, 0, 0, time.UTC) }
+	)
+
+	move := func(from, to goserver.Account, amount int64) {
+		_, err := st.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date: month(1).AddDate(0, 0, 9),
+			Movements: []goserver.Movement{
+				{AccountId: from.Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(-amount)},
+				{AccountId: to.Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(amount)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	budgetTransfer := func(from, to string, amount int64) {
+		resp, err := sut.CreateBudgetTransfer(ctx, goserver.BudgetTransferNoId{
+			Date: month(1), FromAccountId: from, ToAccountId: to, Amount: decimal.NewFromInt(amount),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusOK))
+	}
+
+	// statuses returns the available amounts of the food and restaurant envelopes by month
+	statuses := func() map[string][]string {
+		resp, err := sut.GetBudgetStatus(ctx, month(1), month(3), "", "month", false, 0)
+		Expect(err).ToNot(HaveOccurred())
+		res := map[string][]string{}
+		for _, status := range resp.Body.([]goserver.BudgetStatus) {
+			if status.AccountId == food.Id || status.AccountId == restaurant.Id {
+				res[status.AccountId] = append(res[status.AccountId],
+					status.Transferred.String()+"/"+status.Rollover.String()+"/"+status.Available.String())
+			}
+		}
+		return res
+	}
+
+	BeforeEach(func() {
+		st = database.NewStorage(log, &config.Config{DBPath: ":memory:"})
+		Expect(st.Open()).To(Succeed())
+		DeferCleanup(st.Close)
+		sut = api.NewBudgetItemsAPIService(log, st, common.NewRateService(log, st, 0))
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+
+		var err error
+		czk, err = st.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "CZK"})
+		Expect(err).ToNot(HaveOccurred())
+		salary, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Salary", Type: "income"})
+		Expect(err).ToNot(HaveOccurred())
+		bank, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		food, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Food", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+		restaurant, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Restaurant", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+
+		for accountID, amount := range map[string]int64{food.Id: 300, restaurant.Id: 200} {
+			_, err = st.CreateBudgetItem(familyID, &goserver.BudgetItemNoId{
+				Date: month(1), AccountId: accountID, Amount: decimal.NewFromInt(amount),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+		move(salary, bank, 1000)
+		move(bank, food, 500)
+		move(bank, restaurant, 100)
+		budgetTransfer(restaurant.Id, food.Id, 50)
+		budgetTransfer("", food.Id, 100)
+	})
+
+	It("refuses invalid transfers", func() {
+		for _, transfer := range []goserver.BudgetTransferNoId{
+			{Date: month(1), FromAccountId: food.Id, ToAccountId: restaurant.Id},
+			{Date: month(1), FromAccountId: food.Id, ToAccountId: food.Id, Amount: decimal.NewFromInt(10)},
+			{Date: month(1), ToAccountId: uuid.NewString(), Amount: decimal.NewFromInt(10)},
+		} {
+			resp, err := sut.CreateBudgetTransfer(ctx, transfer)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		}
+
+		resp, err := sut.DeleteBudgetTransfer(ctx, uuid.NewString())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("moves budget between envelopes and rolls overspending over", func() {
+		Expect(statuses()).To(Equal(map[string][]string{
+			food.Id:       {"150/0/-50", "0/-50/-50"},
+			restaurant.Id: {"-50/0/50", "0/50/50"},
+		}))
+
+		resp, err := sut.GetReadyToAssign(ctx, month(1), month(3), "", "month", false)
+		Expect(err).ToNot(HaveOccurred())
+		ready := resp.Body.([]goserver.ReadyToAssign)
+		Expect(ready).To(HaveLen(2))
+		Expect(ready[0].Income.String()).To(Equal("1000"))
+		Expect(ready[0].Assigned.String()).To(Equal("600"))
+		Expect(ready[0].Overspent.String()).To(Equal("0"))
+		Expect(ready[0].ReadyToAssign.String()).To(Equal("400"))
+		Expect(ready[1].ReadyToAssign.String()).To(Equal("400"))
+	})
+
+	It("covers overspending from ready to assign with the reset rule", func() {
+		// The rule is patched by a user of the family, whose ID isn't the family ID
+		family := &models.Family{}
+		family.ID = familyID
+		family.Name = "family"
+		Expect(st.PutFamily(family)).To(Succeed())
+		user, err := st.CreateUser("user", "hash", familyID)
+		Expect(err).ToNot(HaveOccurred())
+		overspending := utils.BudgetOverspendingReset
+		patched, err := api.NewUserAPIService(log, st).UpdateUserFavoriteCurrency(
+			context.WithValue(ctx, constants.UserIDKey, user.ID),
+			goserver.UserPatchBody{BudgetOverspending: &overspending})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patched.Code).To(Equal(http.StatusOK))
+
+		Expect(statuses()).To(Equal(map[string][]string{
+			food.Id:       {"150/0/-50", "0/0/0"},
+			restaurant.Id: {"-50/0/50", "0/50/50"},
+		}))
+
+		resp, err := sut.GetReadyToAssign(ctx, month(1), month(3), "", "month", false)
+		Expect(err).ToNot(HaveOccurred())
+		ready := resp.Body.([]goserver.ReadyToAssign)
+		Expect(ready[0].Overspent.String()).To(Equal("50"))
+		Expect(ready[0].ReadyToAssign.String()).To(Equal("350"))
+		Expect(ready[1].ReadyToAssign.String()).To(Equal("350"))
+	})
+})
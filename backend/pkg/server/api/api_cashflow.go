@@ -0,0 +1,300 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+// ErrNoOutputCurrency is returned when the cash-flow report can't be converted to a single currency.
+var ErrNoOutputCurrency = errors.New("output currency is not set")
+
+func (s *AggregationsAPIServiceImpl) GetCashFlow(
+	ctx context.Context, dateFrom, dateTo time.Time, outputCurrencyID string, granularity string, includeHidden bool,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	if outputCurrencyID == "" {
+		if userID, ok := ctx.Value(constants.UserIDKey).(uuid.UUID); ok {
+			if user, err := s.db.GetUser(userID); err == nil && user != nil {
+				outputCurrencyID = user.FavoriteCurrencyID
+			}
+		}
+	}
+
+	aggGranularity := getGranularity(s.logger, s.db, familyID, granularity)
+	report, err := s.GetCashFlowReport(ctx, familyID, dateFrom, dateTo, outputCurrencyID, aggGranularity, includeHidden)
+	if err != nil {
+		if errors.Is(err, ErrNoOutputCurrency) {
+			return goserver.Response(400, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to build cash-flow report")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, report), nil
+}
+
+// GetCashFlowReport returns incomes and expenses per account for the period together with
+// totals of the previous period of the same length and of the same period last year.
+// Incomes are returned as positive amounts.
+func (s *AggregationsAPIServiceImpl) GetCashFlowReport(
+	ctx context.Context, familyID uuid.UUID, dateFrom, dateTo time.Time, outputCurrencyID string,
+	granularity utils.Granularity, includeHidden bool,
+) (*goserver.CashFlowReport, error) {
+	if outputCurrencyID == "" {
+		return nil, ErrNoOutputCurrency
+	}
+	// Without dates the current month is used, whatever the granularity is
+	currentMonth := utils.FinancialMonth(granularity.MonthStartDay())
+	if dateFrom.IsZero() {
+		dateFrom = utils.RoundToGranularity(time.Now(), currentMonth, false)
+	}
+	if dateTo.IsZero() {
+		dateTo = utils.RoundToGranularity(time.Now(), currentMonth, true)
+	}
+	dateFrom = utils.RoundToGranularity(dateFrom, granularity, false)
+	dateTo = utils.RoundToGranularity(dateTo, granularity, true)
+	if !dateTo.After(dateFrom) {
+		dateTo = utils.RoundToGranularity(dateFrom.AddDate(0, 0, 1), granularity, true)
+	}
+
+	intervals := len(getIntervals(dateFrom, dateTo, granularity))
+	prevFrom, prevTo := utils.AddIntervals(dateFrom, granularity, -intervals), dateFrom
+	yearFrom, yearTo := dateFrom.AddDate(-1, 0, 0), dateTo.AddDate(-1, 0, 0)
+
+	accounts, err := s.db.GetAccounts(familyID)
+	if err != nil {
+		return nil, err
+	}
+	accountTypes := make(map[string]string, len(accounts))
+	for _, a := range accounts {
+		accountTypes[a.Id] = a.Type
+	}
+
+	transactions, err := s.getReportTransactions(
+		familyID, accounts, minTime(prevFrom, yearFrom), dateTo, granularity, "account", outputCurrencyID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	currencyMap := buildCurrencyMap(s.logger, s.db, familyID)
+	currenciesRatesFetcher := s.rates.Fetcher(familyID)
+	filter := func(a goserver.Account) bool {
+		return (a.Type == constants.AccountIncome || isExpenseAccount(a)) && (includeHidden || !a.HideFromReports)
+	}
+	aggregate := func(from, to time.Time) goserver.Aggregation {
+		return Aggregate(
+			ctx, accounts, transactions,
+			from, to,
+			granularity,
+			outputCurrencyID, currenciesRatesFetcher,
+			currencyMap,
+			filter,
+			"account", nil,
+			s.logger)
+	}
+
+	current := aggregate(dateFrom, dateTo)
+	report := &goserver.CashFlowReport{
+		From:                 current.From,
+		To:                   current.To,
+		Granularity:          string(granularity.Base()),
+		OutputCurrencyId:     outputCurrencyID,
+		Intervals:            current.Intervals,
+		Incomes:              []goserver.CashFlowAccount{},
+		Expenses:             []goserver.CashFlowAccount{},
+		IncomeByInterval:     make([]decimal.Decimal, len(current.Intervals)),
+		ExpenseByInterval:    make([]decimal.Decimal, len(current.Intervals)),
+		NetSavingsByInterval: make([]decimal.Decimal, len(current.Intervals)),
+	}
+
+	for _, acc := range s.cashFlowAccounts(current, outputCurrencyID) {
+		isIncome := accountTypes[acc.AccountId] == constants.AccountIncome
+		if !isIncome && accountTypes[acc.AccountId] != constants.AccountExpense {
+			continue
+		}
+
+		amounts := slices.Clone(acc.Amounts)
+		total := decimal.Zero
+		for i := range amounts {
+			if isIncome {
+				amounts[i] = amounts[i].Neg()
+				report.IncomeByInterval[i] = report.IncomeByInterval[i].Add(amounts[i])
+			} else {
+				report.ExpenseByInterval[i] = report.ExpenseByInterval[i].Add(amounts[i])
+			}
+			total = total.Add(amounts[i])
+		}
+
+		item := goserver.CashFlowAccount{
+			AccountId: acc.AccountId,
+			Amounts:   amounts,
+			Total:     total,
+		}
+		if isIncome {
+			report.Incomes = append(report.Incomes, item)
+		} else {
+			report.Expenses = append(report.Expenses, item)
+		}
+	}
+	for i := range report.NetSavingsByInterval {
+		report.NetSavingsByInterval[i] = report.IncomeByInterval[i].Sub(report.ExpenseByInterval[i])
+	}
+
+	previous := aggregate(prevFrom, prevTo)
+	lastYear := aggregate(yearFrom, yearTo)
+	previousTotals := s.cashFlowTotals(previous, outputCurrencyID, accountTypes)
+	lastYearTotals := s.cashFlowTotals(lastYear, outputCurrencyID, accountTypes)
+	for _, list := range [][]goserver.CashFlowAccount{report.Incomes, report.Expenses} {
+		for i := range list {
+			acc := &list[i]
+			acc.PreviousPeriodTotal = previousTotals[acc.AccountId]
+			acc.PreviousYearTotal = lastYearTotals[acc.AccountId]
+			acc.ChangePercent = changePercent(acc.Total, acc.PreviousPeriodTotal)
+			acc.YearOverYearChangePercent = changePercent(acc.Total, acc.PreviousYearTotal)
+		}
+	}
+	// Accounts without movements in the current period still appear if they had some before
+	for _, accountID := range slices.Sorted(maps.Keys(mergeTotals(previousTotals, lastYearTotals))) {
+		if slices.ContainsFunc(report.Incomes, func(a goserver.CashFlowAccount) bool { return a.AccountId == accountID }) ||
+			slices.ContainsFunc(report.Expenses, func(a goserver.CashFlowAccount) bool { return a.AccountId == accountID }) {
+			continue
+		}
+		item := goserver.CashFlowAccount{
+			AccountId:                 accountID,
+			Amounts:                   make([]decimal.Decimal, len(report.Intervals)),
+			PreviousPeriodTotal:       previousTotals[accountID],
+			PreviousYearTotal:         lastYearTotals[accountID],
+			ChangePercent:             changePercent(decimal.Zero, previousTotals[accountID]),
+			YearOverYearChangePercent: changePercent(decimal.Zero, lastYearTotals[accountID]),
+		}
+		if accountTypes[accountID] == constants.AccountIncome {
+			report.Incomes = append(report.Incomes, item)
+		} else {
+			report.Expenses = append(report.Expenses, item)
+		}
+	}
+
+	report.Current = cashFlowSummary(current.From, current.To, report.Incomes, report.Expenses,
+		func(a goserver.CashFlowAccount) decimal.Decimal { return a.Total })
+	report.PreviousPeriod = cashFlowSummary(previous.From, previous.To, report.Incomes, report.Expenses,
+		func(a goserver.CashFlowAccount) decimal.Decimal { return a.PreviousPeriodTotal })
+	report.PreviousYear = cashFlowSummary(lastYear.From, lastYear.To, report.Incomes, report.Expenses,
+		func(a goserver.CashFlowAccount) decimal.Decimal { return a.PreviousYearTotal })
+	compareCashFlowSummaries(report.Current, &report.PreviousPeriod)
+	compareCashFlowSummaries(report.Current, &report.PreviousYear)
+
+	return report, nil
+}
+
+// cashFlowAccounts returns account aggregations in the output currency. Movements which
+// couldn't be converted stay in their own currency and are left out of the report.
+func (s *AggregationsAPIServiceImpl) cashFlowAccounts(
+	agg goserver.Aggregation, outputCurrencyID string,
+) []goserver.AccountAggregation {
+	var res []goserver.AccountAggregation
+	for _, c := range agg.Currencies {
+		if c.CurrencyId != outputCurrencyID {
+			s.logger.Warn("Cash-flow report ignores amounts which couldn't be converted", "currencyId", c.CurrencyId)
+			continue
+		}
+		res = append(res, c.Accounts...)
+	}
+	return res
+}
+
+// cashFlowTotals returns totals per income and expense account, incomes as positive amounts.
+func (s *AggregationsAPIServiceImpl) cashFlowTotals(
+	agg goserver.Aggregation, outputCurrencyID string, accountTypes map[string]string,
+) map[string]decimal.Decimal {
+	res := make(map[string]decimal.Decimal)
+	for _, acc := range s.cashFlowAccounts(agg, outputCurrencyID) {
+		accountType := accountTypes[acc.AccountId]
+		if accountType != constants.AccountIncome && accountType != constants.AccountExpense {
+			continue
+		}
+		total := decimal.Zero
+		for _, amount := range acc.Amounts {
+			total = total.Add(amount)
+		}
+		if accountType == constants.AccountIncome {
+			total = total.Neg()
+		}
+		res[acc.AccountId] = total
+	}
+	return res
+}
+
+func mergeTotals(a, b map[string]decimal.Decimal) map[string]decimal.Decimal {
+	res := make(map[string]decimal.Decimal, len(a)+len(b))
+	for k, v := range a {
+		res[k] = v
+	}
+	for k, v := range b {
+		res[k] = res[k].Add(v)
+	}
+	return res
+}
+
+func cashFlowSummary(
+	from, to time.Time, incomes, expenses []goserver.CashFlowAccount,
+	total func(goserver.CashFlowAccount) decimal.Decimal,
+) goserver.CashFlowSummary {
+	res := goserver.CashFlowSummary{From: from, To: to}
+	for _, a := range incomes {
+		res.Income = res.Income.Add(total(a))
+	}
+	for _, a := range expenses {
+		res.Expense = res.Expense.Add(total(a))
+	}
+	res.NetSavings = res.Income.Sub(res.Expense)
+	if res.Income.IsPositive() {
+		res.SavingsRate = res.NetSavings.Div(res.Income).Mul(decimal.NewFromInt(100)).Round(2)
+	}
+	return res
+}
+
+// compareCashFlowSummaries fills change fields of other with the change of current against it.
+func compareCashFlowSummaries(current goserver.CashFlowSummary, other *goserver.CashFlowSummary) {
+	other.IncomeChangePercent = changePercent(current.Income, other.Income)
+	other.ExpenseChangePercent = changePercent(current.Expense, other.Expense)
+	other.NetSavingsChangePercent = changePercent(current.NetSavings, other.NetSavings)
+}
+
+// changePercent follows calculatePostAggregationData: growth from zero is reported as 100%.
+func changePercent(curr, prev decimal.Decimal) decimal.Decimal {
+	switch {
+	case !prev.IsZero():
+		return curr.Sub(prev).Div(prev.Abs()).Mul(decimal.NewFromInt(100)).Round(2)
+	case !curr.IsZero():
+		return decimal.NewFromInt(100)
+	default:
+		return decimal.Zero
+	}
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,164 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Cash-flow report", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockStorage *mocks.MockStorage
+		sut         *api.AggregationsAPIServiceImpl
+		ctx         context.Context
+		log         = test.CreateTestLogger()
+		familyID    = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	)
+
+	accounts := []goserver.Account{
+		{Id: "bank", Name: "Bank", Type: "asset"},
+		{Id: "salary", Name: "Salary", Type: "income"},
+		{Id: "food", Name: "Food", Type: "expense"},
+		{Id: "hidden", Name: "Hidden", Type: "expense", HideFromReports: true},
+	}
+	transaction := func(date time.Time, from, to string, amount int64) goserver.Transaction {
+		return goserver.Transaction{
+			Id:   uuid.NewString(),
+			Date: date,
+			Movements: []goserver.Movement{
+				{AccountId: from, Amount: decimal.NewFromInt(-amount), CurrencyId: "czk"},
+				{AccountId: to, Amount: decimal.NewFromInt(amount), CurrencyId: "czk"},
+			},
+		}
+	}
+	// rollups sums transactions like the storage does
+	rollups := func(transactions []goserver.Transaction) []models.MonthlyRollup {
+		res := []models.MonthlyRollup{}
+		for _, t := range transactions {
+			for _, m := range t.Movements {
+				idx := slices.IndexFunc(res, func(r models.MonthlyRollup) bool {
+					return r.AccountID == m.AccountId && r.Month.Equal(models.RollupMonth(t.Date))
+				})
+				if idx == -1 {
+					res = append(res, models.MonthlyRollup{
+						FamilyID: familyID, AccountID: m.AccountId, CurrencyID: m.CurrencyId,
+						Month: models.RollupMonth(t.Date),
+					})
+					idx = len(res) - 1
+				}
+				res[idx].Amount = res[idx].Amount.Add(m.Amount)
+				res[idx].Count++
+			}
+		}
+		return res
+	}
+	month := func(year int, m time.Month) time.Time {
+		return time.Date(year, m, 10, 0, 0, 0, 0, time.UTC)
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockStorage = mocks.NewMockStorage(ctrl)
+		sut = api.NewAggregationsAPIServiceImpl(log, mockStorage, common.NewRateService(log, mockStorage, 0))
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+
+		mockStorage.EXPECT().GetAccounts(familyID).Return(accounts, nil).AnyTimes()
+		mockStorage.EXPECT().GetCurrencies(familyID).Return([]goserver.Currency{{Id: "czk", Name: "CZK"}}, nil).AnyTimes()
+		mockStorage.EXPECT().GetUser(familyID).Return(&models.User{}, nil).AnyTimes()
+		mockStorage.EXPECT().GetFamily(familyID).Return(&models.Family{}, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("compares the period with the previous period and the same period last year", func() {
+		dateFrom := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+		dateTo := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+		transactions := []goserver.Transaction{
+			// Same period last year
+			transaction(month(2023, 9), "salary", "bank", 800),
+			transaction(month(2023, 10), "bank", "food", 400),
+			// Previous period
+			transaction(month(2024, 7), "salary", "bank", 1000),
+			transaction(month(2024, 8), "bank", "food", 500),
+			// Current period
+			transaction(month(2024, 9), "salary", "bank", 1000),
+			transaction(month(2024, 10), "salary", "bank", 1000),
+			transaction(month(2024, 9), "bank", "food", 300),
+			transaction(month(2024, 10), "bank", "food", 200),
+			transaction(month(2024, 10), "bank", "hidden", 1000),
+		}
+		mockStorage.EXPECT().
+			GetMonthlyRollups(familyID, time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC), dateTo).
+			Return(rollups(transactions), nil)
+		mockStorage.EXPECT().
+			GetRefunds(familyID, time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC), dateTo).
+			Return(nil, nil)
+		mockStorage.EXPECT().
+			GetExchangeTransactions(familyID, time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC), dateTo).
+			Return(nil, nil)
+
+		resp, err := sut.GetCashFlow(ctx, dateFrom, dateTo, "czk", "month", false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		report, ok := resp.Body.(*goserver.CashFlowReport)
+		Expect(ok).To(BeTrue())
+
+		Expect(report.Intervals).To(HaveLen(2))
+		Expect(report.Incomes).To(HaveLen(1))
+		Expect(report.Incomes[0].AccountId).To(Equal("salary"))
+		Expect(report.Incomes[0].Total.Equal(decimal.NewFromInt(2000))).To(BeTrue())
+		Expect(report.Incomes[0].PreviousPeriodTotal.Equal(decimal.NewFromInt(1000))).To(BeTrue())
+		Expect(report.Incomes[0].PreviousYearTotal.Equal(decimal.NewFromInt(800))).To(BeTrue())
+		Expect(report.Incomes[0].ChangePercent.Equal(decimal.NewFromInt(100))).To(BeTrue())
+		Expect(report.Incomes[0].YearOverYearChangePercent.Equal(decimal.NewFromInt(150))).To(BeTrue())
+
+		Expect(report.Expenses).To(HaveLen(1))
+		Expect(report.Expenses[0].AccountId).To(Equal("food"))
+		Expect(report.Expenses[0].Amounts[0].Equal(decimal.NewFromInt(300))).To(BeTrue())
+		Expect(report.Expenses[0].Amounts[1].Equal(decimal.NewFromInt(200))).To(BeTrue())
+
+		Expect(report.NetSavingsByInterval[0].Equal(decimal.NewFromInt(700))).To(BeTrue())
+		Expect(report.NetSavingsByInterval[1].Equal(decimal.NewFromInt(800))).To(BeTrue())
+
+		Expect(report.Current.Income.Equal(decimal.NewFromInt(2000))).To(BeTrue())
+		Expect(report.Current.Expense.Equal(decimal.NewFromInt(500))).To(BeTrue())
+		Expect(report.Current.NetSavings.Equal(decimal.NewFromInt(1500))).To(BeTrue())
+		Expect(report.Current.SavingsRate.Equal(decimal.NewFromInt(75))).To(BeTrue())
+
+		Expect(report.PreviousPeriod.From.Equal(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC))).To(BeTrue())
+		Expect(report.PreviousPeriod.NetSavings.Equal(decimal.NewFromInt(500))).To(BeTrue())
+		Expect(report.PreviousPeriod.SavingsRate.Equal(decimal.NewFromInt(50))).To(BeTrue())
+		Expect(report.PreviousPeriod.NetSavingsChangePercent.Equal(decimal.NewFromInt(200))).To(BeTrue())
+
+		Expect(report.PreviousYear.From.Equal(time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC))).To(BeTrue())
+		Expect(report.PreviousYear.Expense.Equal(decimal.NewFromInt(400))).To(BeTrue())
+		Expect(report.PreviousYear.ExpenseChangePercent.Equal(decimal.NewFromInt(25))).To(BeTrue())
+	})
+
+	It("uses the favorite currency of the user and fails without it", func() {
+		userID := uuid.New()
+		ctx = context.WithValue(ctx, constants.UserIDKey, userID)
+		mockStorage.EXPECT().GetUser(userID).Return(&models.User{}, nil)
+
+		resp, err := sut.GetCashFlow(ctx, time.Time{}, time.Time{}, "", "month", false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -8,17 +8,22 @@ import (
 	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 )
 
 type CurrenciesAPIServicerImpl struct {
 	logger *slog.Logger
 	db     database.Storage
+	rates  *common.RateService
 }
 
-func NewCurrenciesAPIServicer(logger *slog.Logger, db database.Storage) goserver.CurrenciesAPIServicer {
+func NewCurrenciesAPIServicer(
+	logger *slog.Logger, db database.Storage, rates *common.RateService,
+) goserver.CurrenciesAPIServicer {
 	return &CurrenciesAPIServicerImpl{
 		logger: logger,
 		db:     db,
+		rates:  rates,
 	}
 }
 
@@ -0,0 +1,152 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+// GetExchangeRates - get manual exchange rates of the family
+func (s *CurrenciesAPIServicerImpl) GetExchangeRates(ctx context.Context) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	rates, err := s.db.GetExchangeRates(familyID)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to get exchange rates")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	return goserver.Response(http.StatusOK, rates), nil
+}
+
+// CreateExchangeRate - create new manual exchange rate
+func (s *CurrenciesAPIServicerImpl) CreateExchangeRate(
+	ctx context.Context, rateNoID goserver.ExchangeRateNoId,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	rate, err := s.db.CreateExchangeRate(familyID, &rateNoID)
+	if err != nil {
+		if errors.Is(err, database.ErrInvalidExchangeRate) {
+			return goserver.Response(http.StatusBadRequest, err.Error()), nil
+		}
+		s.logger.With("error", err).Error("Failed to create exchange rate")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	return goserver.Response(http.StatusOK, rate), nil
+}
+
+// GetExchangeRate - get manual exchange rate
+func (s *CurrenciesAPIServicerImpl) GetExchangeRate(ctx context.Context, id string) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	rate, err := s.db.GetExchangeRate(familyID, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(http.StatusNotFound, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to get exchange rate")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	return goserver.Response(http.StatusOK, rate), nil
+}
+
+// UpdateExchangeRate - update manual exchange rate
+func (s *CurrenciesAPIServicerImpl) UpdateExchangeRate(
+	ctx context.Context, id string, rateNoID goserver.ExchangeRateNoId,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	rate, err := s.db.UpdateExchangeRate(familyID, id, &rateNoID)
+	if err != nil {
+		switch {
+		case errors.Is(err, database.ErrInvalidExchangeRate):
+			return goserver.Response(http.StatusBadRequest, err.Error()), nil
+		case errors.Is(err, database.ErrNotFound):
+			return goserver.Response(http.StatusNotFound, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to update exchange rate")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	return goserver.Response(http.StatusOK, rate), nil
+}
+
+// DeleteExchangeRate - delete manual exchange rate
+func (s *CurrenciesAPIServicerImpl) DeleteExchangeRate(ctx context.Context, id string) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	if err := s.db.DeleteExchangeRate(familyID, id); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(http.StatusNotFound, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to delete exchange rate")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	return goserver.Response(http.StatusOK, nil), nil
+}
+
+// GetExchangeRateHistory - get stored exchange rate history of a currency pair
+func (s *CurrenciesAPIServicerImpl) GetExchangeRateHistory(
+	ctx context.Context, fromCurrencyID, toCurrencyID string, dateFrom, dateTo time.Time,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	if fromCurrencyID == toCurrencyID {
+		return goserver.Response(http.StatusBadRequest, "currencies must differ"), nil
+	}
+	from, err := s.db.GetCurrency(familyID, fromCurrencyID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(http.StatusBadRequest, "unknown currency "+fromCurrencyID), nil
+		}
+		s.logger.With("error", err).Error("Failed to get currency")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+	to, err := s.db.GetCurrency(familyID, toCurrencyID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(http.StatusBadRequest, "unknown currency "+toCurrencyID), nil
+		}
+		s.logger.With("error", err).Error("Failed to get currency")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	if dateTo.IsZero() {
+		dateTo = time.Now()
+	}
+	fetcher := s.rates.Fetcher(familyID)
+	history, err := fetcher.History(from.Name, to.Name, dateFrom, dateTo)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to get exchange rate history")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	return goserver.Response(http.StatusOK, history), nil
+}
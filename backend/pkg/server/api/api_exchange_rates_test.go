@@ -0,0 +1,218 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Manual exchange rates", func() {
+	var (
+		st       database.Storage
+		rates    *common.RateService
+		sut      goserver.CurrenciesAPIServicer
+		ctx      context.Context
+		log      = test.CreateTestLogger()
+		familyID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		eur, usd goserver.Currency
+		day      = func(d int) time.Time { return time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC) }
+	)
+
+	createRangeRate := func(currency goserver.Currency, date, dateTo time.Time, rate float64) int {
+		resp, err := sut.CreateExchangeRate(ctx, goserver.ExchangeRateNoId{
+			CurrencyId: currency.Id, Date: date, DateTo: dateTo, Rate: decimal.NewFromFloat(rate),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return resp.Code
+	}
+
+	createRate := func(currency goserver.Currency, date time.Time, rate float64) int {
+		return createRangeRate(currency, date, time.Time{}, rate)
+	}
+
+	BeforeEach(func() {
+		st = database.NewStorage(log, &config.Config{DBPath: ":memory:"})
+		Expect(st.Open()).To(Succeed())
+		DeferCleanup(st.Close)
+		rates = common.NewRateService(log, st, 0)
+		sut = api.NewCurrenciesAPIServicer(log, st, rates)
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+
+		var err error
+		eur, err = st.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "EUR"})
+		Expect(err).ToNot(HaveOccurred())
+		usd, err = st.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "USD"})
+		Expect(err).ToNot(HaveOccurred())
+
+		family := &models.Family{RateProviders: []string{common.RateProviderManual}, RateBaseCurrencyID: eur.Id}
+		family.ID = familyID
+		family.Name = "family"
+		Expect(st.PutFamily(family)).To(Succeed())
+	})
+
+	It("refuses invalid rates", func() {
+		Expect(createRate(usd, day(1), 0)).To(Equal(http.StatusBadRequest))
+		Expect(createRate(usd, time.Time{}, 0.9)).To(Equal(http.StatusBadRequest))
+		Expect(createRate(goserver.Currency{Id: uuid.NewString()}, day(1), 0.9)).To(Equal(http.StatusBadRequest))
+		Expect(createRangeRate(usd, day(10), day(5), 0.9)).To(Equal(http.StatusBadRequest))
+
+		resp, err := sut.DeleteExchangeRate(ctx, uuid.NewString())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("converts with the rate valid on the day", func() {
+		Expect(createRate(usd, day(1), 0.9)).To(Equal(http.StatusOK))
+		Expect(createRate(usd, day(10), 0.8)).To(Equal(http.StatusOK))
+
+		fetcher := rates.Fetcher(familyID)
+		for d, expected := range map[int]string{5: "90", 10: "80", 20: "80"} {
+			res, err := fetcher.Convert(ctx, day(d), "USD", "EUR", decimal.NewFromInt(100))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.String()).To(Equal(expected))
+		}
+
+		_, err := fetcher.Convert(ctx, day(0), "USD", "EUR", decimal.NewFromInt(100))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("overrides other rates during the date range", func() {
+		Expect(createRate(usd, day(1), 0.9)).To(Equal(http.StatusOK))
+		Expect(createRangeRate(usd, day(10), day(12), 0.5)).To(Equal(http.StatusOK))
+
+		fetcher := rates.Fetcher(familyID)
+		for d, expected := range map[int]string{9: "90", 10: "50", 12: "50", 13: "90"} {
+			res, sources, err := fetcher.ConvertWithSources(ctx, day(d), "USD", "EUR", decimal.NewFromInt(100))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.String()).To(Equal(expected))
+			Expect(sources).To(Equal([]string{common.RateProviderManual}))
+		}
+	})
+
+	It("prefers the rate the transaction was executed at", func() {
+		Expect(createRate(usd, day(1), 0.9)).To(Equal(http.StatusOK))
+		bank, err := st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		food, err := st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Food", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+
+		// paid 12.5 USD by a EUR card
+		abroad, err := st.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date: day(5),
+			Movements: []goserver.Movement{
+				{AccountId: bank.Id, CurrencyId: eur.Id, Amount: decimal.NewFromInt(-10)},
+				{AccountId: food.Id, CurrencyId: usd.Id, Amount: decimal.NewFromFloat(12.5)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(abroad.ExchangeRates).To(HaveLen(1))
+		Expect(abroad.ExchangeRates[0].Rate.String()).To(Equal("1.25"))
+
+		_, err = st.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date: day(6),
+			Movements: []goserver.Movement{
+				{AccountId: bank.Id, CurrencyId: usd.Id, Amount: decimal.NewFromInt(-12)},
+				{AccountId: food.Id, CurrencyId: usd.Id, Amount: decimal.NewFromInt(12)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		res, err := api.NewAggregationsAPIServiceImpl(log, st, rates).GetAggregatedExpenses(
+			ctx, familyID, day(1), day(28), eur.Id, utils.GranularityMonth, false, "", nil, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RateSources).To(ConsistOf(common.RateSourceTransaction, common.RateProviderManual))
+		Expect(res.Currencies).To(HaveLen(1))
+		Expect(res.Currencies[0].CurrencyId).To(Equal(eur.Id))
+		Expect(res.Currencies[0].Accounts).To(HaveLen(1))
+		Expect(res.Currencies[0].Accounts[0].Amounts[0].String()).To(Equal("20.8"))
+	})
+
+	It("converts movements of whole months at the rate of their own date", func() {
+		Expect(createRate(usd, day(1), 0.9)).To(Equal(http.StatusOK))
+		Expect(createRate(usd, day(10), 0.5)).To(Equal(http.StatusOK))
+		bank, err := st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		food, err := st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Food", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+		for _, d := range []int{5, 20} {
+			_, err = st.CreateTransaction(familyID, &goserver.TransactionNoId{
+				Date: day(d),
+				Movements: []goserver.Movement{
+					{AccountId: bank.Id, CurrencyId: usd.Id, Amount: decimal.NewFromInt(-100)},
+					{AccountId: food.Id, CurrencyId: usd.Id, Amount: decimal.NewFromInt(100)},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		// The whole month could be read from rollups, which are dated on its first day
+		aggregations := api.NewAggregationsAPIServiceImpl(log, st, rates)
+		res, err := aggregations.GetAggregatedExpenses(
+			ctx, familyID, day(1), day(1).AddDate(0, 1, 0), eur.Id, utils.GranularityMonth, false, "", nil, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Currencies).To(HaveLen(1))
+		Expect(res.Currencies[0].Accounts).To(HaveLen(1))
+		Expect(res.Currencies[0].Accounts[0].Amounts[0].String()).To(Equal("140"))
+
+		res, err = aggregations.GetAggregatedExpenses(
+			ctx, familyID, day(1), day(1).AddDate(0, 1, 0), usd.Id, utils.GranularityMonth, false, "", nil, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Currencies[0].Accounts[0].Amounts[0].String()).To(Equal("200"))
+	})
+
+	It("returns stored rate history of a currency pair", func() {
+		family := &models.Family{RateProviders: []string{common.RateProviderCNB, common.RateProviderECB}}
+		family.ID = familyID
+		family.Name = "family"
+		Expect(st.PutFamily(family)).To(Succeed())
+
+		Expect(st.SaveCNBRates(map[string]decimal.Decimal{
+			"USD": decimal.NewFromInt(22), "EUR": decimal.NewFromInt(25),
+		}, day(3))).To(Succeed())
+		Expect(st.SaveCNBRates(map[string]decimal.Decimal{
+			"USD": decimal.NewFromInt(23), "EUR": decimal.NewFromInt(25),
+		}, day(4))).To(Succeed())
+		Expect(st.SaveProviderRates(common.RateProviderECB, map[string]decimal.Decimal{
+			"USD": decimal.NewFromFloat(0.9),
+		}, day(4))).To(Succeed())
+		Expect(st.SaveProviderRates(common.RateProviderECB, map[string]decimal.Decimal{
+			"USD": decimal.NewFromFloat(0.95),
+		}, day(5))).To(Succeed())
+
+		resp, err := sut.GetExchangeRateHistory(ctx, usd.Id, eur.Id, day(1), day(10))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		history, ok := resp.Body.([]goserver.ExchangeRateHistoryPoint)
+		Expect(ok).To(BeTrue())
+		Expect(history).To(HaveLen(3))
+
+		// CNB is the first provider of the chain, ECB fills the days without CNB rates
+		Expect(history[0].Date).To(Equal(day(3)))
+		Expect(history[0].Rate.String()).To(Equal("0.88"))
+		Expect(history[0].Source).To(Equal(common.RateProviderCNB))
+		Expect(history[1].Date).To(Equal(day(4)))
+		Expect(history[1].Rate.String()).To(Equal("0.92"))
+		Expect(history[1].Source).To(Equal(common.RateProviderCNB))
+		Expect(history[2].Date).To(Equal(day(5)))
+		Expect(history[2].Rate.String()).To(Equal("0.95"))
+		Expect(history[2].Source).To(Equal(common.RateProviderECB))
+
+		resp, err = sut.GetExchangeRateHistory(ctx, usd.Id, usd.Id, day(1), day(10))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -13,8 +13,10 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/shopspring/decimal"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
 	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 	"github.com/ya-breeze/geekbudgetbe/test"
 )
 
@@ -31,7 +33,7 @@ var _ = Describe("Expenses Aggregation API", func() {
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockStorage = mocks.NewMockStorage(ctrl)
-		sut = api.NewAggregationsAPIServiceImpl(log, mockStorage)
+		sut = api.NewAggregationsAPIServiceImpl(log, mockStorage, common.NewRateService(log, mockStorage, 0))
 	})
 
 	AfterEach(func() {
@@ -78,10 +80,20 @@ var _ = Describe("Expenses Aggregation API", func() {
 		}
 
 		mockStorage.EXPECT().GetAccounts(uuid.MustParse("00000000-0000-0000-0000-000000000001")).Return([]goserver.Account{accountA}, nil)
-		mockStorage.EXPECT().GetTransactions(uuid.MustParse("00000000-0000-0000-0000-000000000001"), from, to, false).Return(transactions, nil)
+		// The whole year is read from monthly rollups
+		rollups := []models.MonthlyRollup{}
+		for _, t := range transactions {
+			rollups = append(rollups, models.MonthlyRollup{
+				AccountID: "acc-a", CurrencyID: "USD", Month: models.RollupMonth(t.Date),
+				Amount: t.Movements[0].Amount, Count: 1,
+			})
+		}
+		mockStorage.EXPECT().GetMonthlyRollups(uuid.MustParse("00000000-0000-0000-0000-000000000001"), from, to).Return(rollups, nil)
+		mockStorage.EXPECT().GetRefunds(uuid.MustParse("00000000-0000-0000-0000-000000000001"), from, to).Return(nil, nil)
+		mockStorage.EXPECT().GetExchangeTransactions(uuid.MustParse("00000000-0000-0000-0000-000000000001"), from, to).Return(nil, nil)
 		mockStorage.EXPECT().GetCurrencies(uuid.MustParse("00000000-0000-0000-0000-000000000001")).Return([]goserver.Currency{{Id: "USD", Name: "USD"}}, nil)
 
-		resp, err := sut.GetExpenses(ctx, from, to, "", "year", false, "account", nil, nil)
+		resp, err := sut.GetExpenses(ctx, from, to, "", "year", false, "account", nil, nil, 0)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(resp.Code).To(Equal(http.StatusOK))
 
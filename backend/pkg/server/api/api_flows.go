@@ -0,0 +1,191 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+func (s *AggregationsAPIServiceImpl) GetFlows(
+	ctx context.Context, dateFrom, dateTo time.Time, outputCurrencyID string, includeHidden bool,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	if outputCurrencyID == "" {
+		if userID, ok := ctx.Value(constants.UserIDKey).(uuid.UUID); ok {
+			if user, err := s.db.GetUser(userID); err == nil && user != nil {
+				outputCurrencyID = user.FavoriteCurrencyID
+			}
+		}
+	}
+
+	report, err := s.GetFlowReport(ctx, familyID, dateFrom, dateTo, outputCurrencyID, includeHidden)
+	if err != nil {
+		if errors.Is(err, ErrNoOutputCurrency) {
+			return goserver.Response(400, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to build flow report")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, report), nil
+}
+
+type flowKey struct {
+	source string
+	target string
+}
+
+// GetFlowReport returns the money flows between accounts in the period. In every transaction the
+// money taken from accounts is split between the accounts receiving money in proportion to the
+// received amounts. Only flows from income accounts, between asset and liability accounts and to
+// expense accounts are kept, flows in the opposite direction (e.g. refunds) are netted.
+func (s *AggregationsAPIServiceImpl) GetFlowReport(
+	ctx context.Context, familyID uuid.UUID, dateFrom, dateTo time.Time, outputCurrencyID string, includeHidden bool,
+) (*goserver.FlowReport, error) {
+	if outputCurrencyID == "" {
+		return nil, ErrNoOutputCurrency
+	}
+	if dateFrom.IsZero() {
+		dateFrom = utils.RoundToGranularity(time.Now(), utils.GranularityYear, false)
+	}
+	if dateTo.IsZero() {
+		dateTo = utils.RoundToGranularity(time.Now(), utils.GranularityYear, true)
+	}
+
+	accounts, err := s.db.GetAccounts(familyID)
+	if err != nil {
+		return nil, err
+	}
+	accountMap := make(map[string]goserver.Account, len(accounts))
+	for _, a := range accounts {
+		if includeHidden || !a.HideFromReports {
+			accountMap[a.Id] = a
+		}
+	}
+
+	transactions, err := s.db.GetTransactions(familyID, dateFrom, dateTo, false)
+	if err != nil {
+		return nil, err
+	}
+	transactions = common.NetRefunds(s.logger, s.db, familyID, accounts, transactions)
+
+	currencyMap := buildCurrencyMap(s.logger, s.db, familyID)
+	currenciesRatesFetcher := s.rates.Fetcher(familyID)
+	flows := make(map[flowKey]decimal.Decimal)
+	for _, t := range transactions {
+		var sources, targets []goserver.Movement
+		totalIn := decimal.Zero
+		for _, m := range t.Movements {
+			if _, ok := accountMap[m.AccountId]; !ok || m.Amount.IsZero() {
+				continue
+			}
+			amount, currencyID, _ := convertTransactionMovement(ctx, m, t.Date, t.ExchangeRates,
+				outputCurrencyID, currencyMap[outputCurrencyID], currencyMap, currenciesRatesFetcher, s.logger)
+			if currencyID != outputCurrencyID {
+				continue
+			}
+			m.Amount = amount
+			if amount.IsNegative() {
+				sources = append(sources, m)
+			} else {
+				targets = append(targets, m)
+				totalIn = totalIn.Add(amount)
+			}
+		}
+
+		for _, source := range sources {
+			for _, target := range targets {
+				if source.AccountId == target.AccountId {
+					continue
+				}
+				amount := source.Amount.Neg().Mul(target.Amount).Div(totalIn)
+				addFlow(flows, accountMap[source.AccountId], accountMap[target.AccountId], amount)
+			}
+		}
+	}
+
+	report := &goserver.FlowReport{
+		From:             dateFrom,
+		To:               dateTo,
+		OutputCurrencyId: outputCurrencyID,
+		Nodes:            []goserver.FlowNode{},
+		Links:            []goserver.FlowLink{},
+	}
+	nodes := make(map[string]bool)
+	for key, amount := range flows {
+		amount = amount.Round(2)
+		// Only flows between asset and liability accounts may change their direction
+		if amount.IsNegative() && isBalanceAccount(accountMap[key.source]) && isBalanceAccount(accountMap[key.target]) {
+			key = flowKey{source: key.target, target: key.source}
+			amount = amount.Neg()
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+		report.Links = append(report.Links, goserver.FlowLink{SourceId: key.source, TargetId: key.target, Amount: amount})
+		nodes[key.source] = true
+		nodes[key.target] = true
+	}
+	slices.SortFunc(report.Links, func(a, b goserver.FlowLink) int {
+		if c := b.Amount.Cmp(a.Amount); c != 0 {
+			return c
+		}
+		return cmp.Or(cmp.Compare(a.SourceId, b.SourceId), cmp.Compare(a.TargetId, b.TargetId))
+	})
+
+	for id := range nodes {
+		a := accountMap[id]
+		report.Nodes = append(report.Nodes, goserver.FlowNode{AccountId: a.Id, Name: a.Name, Type: a.Type})
+	}
+	slices.SortFunc(report.Nodes, func(a, b goserver.FlowNode) int {
+		return cmp.Or(cmp.Compare(flowNodeOrder(a.Type), flowNodeOrder(b.Type)), cmp.Compare(a.Name, b.Name))
+	})
+
+	return report, nil
+}
+
+// addFlow adds the amount which flowed from source to target to the flow in the direction of the
+// money flow between their account types, as a negative amount if it flowed backwards. Flows
+// between two asset or liability accounts use the order of their IDs.
+func addFlow(flows map[flowKey]decimal.Decimal, source, target goserver.Account, amount decimal.Decimal) {
+	forward, backward := flowNodeOrder(source.Type) < flowNodeOrder(target.Type),
+		flowNodeOrder(source.Type) > flowNodeOrder(target.Type)
+	if isBalanceAccount(source) && isBalanceAccount(target) {
+		forward, backward = source.Id < target.Id, source.Id > target.Id
+	}
+
+	switch {
+	case forward:
+		key := flowKey{source: source.Id, target: target.Id}
+		flows[key] = flows[key].Add(amount)
+	case backward:
+		key := flowKey{source: target.Id, target: source.Id}
+		flows[key] = flows[key].Sub(amount)
+	}
+}
+
+// flowNodeOrder returns the position of the account type in the money flow: from incomes through
+// asset and liability accounts to expenses.
+func flowNodeOrder(accountType string) int {
+	switch accountType {
+	case constants.AccountIncome:
+		return 0
+	case constants.AccountAsset, constants.AccountLiability:
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,102 @@
+package api_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Flow report", func() {
+	var (
+		st       database.Storage
+		sut      *api.AggregationsAPIServiceImpl
+		ctx      context.Context
+		log      = test.CreateTestLogger()
+		familyID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		czk      goserver.Currency
+		accounts map[string]goserver.Account
+		date     = time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	)
+
+	// transaction creates a transaction with amounts by account name
+	transaction := func(amounts map[string]int64) {
+		t := &goserver.TransactionNoId{Date: date}
+		for name, amount := range amounts {
+			t.Movements = append(t.Movements, goserver.Movement{
+				AccountId: accounts[name].Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(amount),
+			})
+		}
+		_, err := st.CreateTransaction(familyID, t)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		st = database.NewStorage(log, &config.Config{DBPath: ":memory:"})
+		Expect(st.Open()).To(Succeed())
+		DeferCleanup(st.Close)
+		sut = api.NewAggregationsAPIServiceImpl(log, st, common.NewRateService(log, st, 0))
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+
+		var err error
+		czk, err = st.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "CZK"})
+		Expect(err).ToNot(HaveOccurred())
+		accounts = map[string]goserver.Account{}
+		for name, accountType := range map[string]string{
+			"Salary": "income", "Bank": "asset", "Savings": "asset",
+			"Groceries": "expense", "Fun": "expense", "Tax": "expense",
+		} {
+			accounts[name], err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: name, Type: accountType})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	It("splits and nets flows between accounts", func() {
+		transaction(map[string]int64{"Salary": -1000, "Bank": 1000})
+		// Gross salary with the tax paid directly
+		transaction(map[string]int64{"Salary": -500, "Bank": 400, "Tax": 100})
+		transaction(map[string]int64{"Bank": -300, "Groceries": 300})
+		transaction(map[string]int64{"Groceries": -50, "Bank": 50})
+		transaction(map[string]int64{"Bank": -100, "Groceries": 60, "Fun": 40})
+		transaction(map[string]int64{"Bank": -200, "Savings": 200})
+		transaction(map[string]int64{"Savings": -50, "Bank": 50})
+
+		report, err := sut.GetFlowReport(ctx, familyID,
+			time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), czk.Id, false)
+		Expect(err).ToNot(HaveOccurred())
+
+		names := map[string]string{}
+		for _, n := range report.Nodes {
+			names[n.AccountId] = n.Name
+		}
+		links := map[string]string{}
+		for _, l := range report.Links {
+			links[names[l.SourceId]+"->"+names[l.TargetId]] = l.Amount.String()
+		}
+		Expect(links).To(Equal(map[string]string{
+			"Salary->Bank":    "1400",
+			"Salary->Tax":     "100",
+			"Bank->Groceries": "310",
+			"Bank->Fun":       "40",
+			"Bank->Savings":   "150",
+		}))
+		Expect(report.Links[0].Amount.String()).To(Equal("1400"))
+		Expect(report.Nodes[0].Name).To(Equal("Salary"))
+		Expect(report.Nodes).To(HaveLen(6))
+	})
+
+	It("refuses reports without an output currency", func() {
+		_, err := sut.GetFlowReport(ctx, familyID, time.Time{}, time.Time{}, "", false)
+		Expect(err).To(MatchError(api.ErrNoOutputCurrency))
+	})
+})
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+)
+
+type ForecastAPIServiceImpl struct {
+	logger *slog.Logger
+	db     database.Storage
+}
+
+func NewForecastAPIServiceImpl(logger *slog.Logger, db database.Storage) *ForecastAPIServiceImpl {
+	return &ForecastAPIServiceImpl{
+		logger: logger,
+		db:     db,
+	}
+}
+
+func (s *ForecastAPIServiceImpl) GetBalanceForecast(
+	ctx context.Context, months int32, threshold int64, accountID string, includeHidden bool,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+	if months <= 0 {
+		months = 3
+	}
+
+	forecast, err := common.ForecastBalances(s.logger, s.db, familyID, common.ForecastOptions{
+		Today:         time.Now(),
+		Months:        int(months),
+		Threshold:     decimal.NewFromInt(threshold),
+		AccountID:     accountID,
+		IncludeHidden: includeHidden,
+	})
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to forecast balances")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, forecast), nil
+}
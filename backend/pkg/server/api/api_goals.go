@@ -0,0 +1,393 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+type GoalsAPIServiceImpl struct {
+	logger *slog.Logger
+	db     database.Storage
+	rates  *common.RateService
+}
+
+func NewGoalsAPIServiceImpl(
+	logger *slog.Logger, db database.Storage, rates *common.RateService,
+) *GoalsAPIServiceImpl {
+	return &GoalsAPIServiceImpl{logger: logger, db: db, rates: rates}
+}
+
+// GetGoals - get all savings goals
+func (s *GoalsAPIServiceImpl) GetGoals(ctx context.Context) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	goals, err := s.db.GetGoals(familyID)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to get goals")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	return goserver.Response(http.StatusOK, goals), nil
+}
+
+// CreateGoal - create new savings goal
+func (s *GoalsAPIServiceImpl) CreateGoal(ctx context.Context, goalNoID goserver.GoalNoId) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	goal, err := s.db.CreateGoal(familyID, &goalNoID)
+	if err != nil {
+		if errors.Is(err, database.ErrInvalidGoal) {
+			return goserver.Response(http.StatusBadRequest, err.Error()), nil
+		}
+		s.logger.With("error", err).Error("Failed to create goal")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	return goserver.Response(http.StatusOK, goal), nil
+}
+
+// GetGoal - get savings goal
+func (s *GoalsAPIServiceImpl) GetGoal(ctx context.Context, id string) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	goal, err := s.db.GetGoal(familyID, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(http.StatusNotFound, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to get goal")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	return goserver.Response(http.StatusOK, goal), nil
+}
+
+// UpdateGoal - update savings goal
+func (s *GoalsAPIServiceImpl) UpdateGoal(ctx context.Context, id string, goalNoID goserver.GoalNoId) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	goal, err := s.db.UpdateGoal(familyID, id, &goalNoID)
+	if err != nil {
+		switch {
+		case errors.Is(err, database.ErrInvalidGoal):
+			return goserver.Response(http.StatusBadRequest, err.Error()), nil
+		case errors.Is(err, database.ErrNotFound):
+			return goserver.Response(http.StatusNotFound, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to update goal")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	return goserver.Response(http.StatusOK, goal), nil
+}
+
+// DeleteGoal - delete savings goal
+func (s *GoalsAPIServiceImpl) DeleteGoal(ctx context.Context, id string) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	if err := s.db.DeleteGoal(familyID, id); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(http.StatusNotFound, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to delete goal")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	return goserver.Response(http.StatusOK, nil), nil
+}
+
+// GetGoalsProgress - get progress of all savings goals ordered by priority
+func (s *GoalsAPIServiceImpl) GetGoalsProgress(ctx context.Context, date time.Time) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	progress, err := CalculateGoalsProgress(ctx, s.logger, s.db, s.rates, familyID, date)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to calculate progress of goals")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	return goserver.Response(http.StatusOK, progress), nil
+}
+
+// GetGoalProgress - get progress of savings goal
+func (s *GoalsAPIServiceImpl) GetGoalProgress(ctx context.Context, id string, date time.Time) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	goal, err := s.db.GetGoal(familyID, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(http.StatusNotFound, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to get goal")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	progress, err := goalsProgress(ctx, s.logger, s.db, s.rates, familyID, []goserver.Goal{goal}, date)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to calculate progress of goal")
+		return goserver.Response(http.StatusInternalServerError, nil), nil
+	}
+
+	return goserver.Response(http.StatusOK, progress[0]), nil
+}
+
+// CalculateGoalsProgress returns the progress of all goals of the family at the date, today by
+// default. Goals are ordered by priority, goals without priority go last.
+func CalculateGoalsProgress(
+	ctx context.Context, logger *slog.Logger, db database.Storage, rates *common.RateService,
+	familyID uuid.UUID, date time.Time,
+) ([]goserver.GoalProgress, error) {
+	goals, err := db.GetGoals(familyID)
+	if err != nil {
+		return nil, err
+	}
+	return goalsProgress(ctx, logger, db, rates, familyID, goals, date)
+}
+
+func goalsProgress(
+	ctx context.Context, logger *slog.Logger, db database.Storage, rates *common.RateService, familyID uuid.UUID,
+	goals []goserver.Goal, date time.Time,
+) ([]goserver.GoalProgress, error) {
+	if date.IsZero() {
+		date = time.Now()
+	}
+	// Everything saved on the day of the date counts
+	end := utils.RoundToGranularity(date, utils.GranularityDay, false).AddDate(0, 0, 1)
+	savings, err := loadGoalSavings(ctx, logger, db, rates, familyID, goals, end)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]goserver.GoalProgress, 0, len(goals))
+	for _, goal := range goals {
+		history := savings[goal.Id]
+		res = append(res, utils.CalculateGoalProgress(goal,
+			history.savedBefore(end), history.savedBefore(end.AddDate(0, -utils.GoalHistoryMonths, 0)), date))
+	}
+	slices.SortStableFunc(res, func(a, b goserver.GoalProgress) int {
+		if (a.Priority == 0) != (b.Priority == 0) {
+			if a.Priority == 0 {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Compare(a.Priority, b.Priority)
+	})
+	return res, nil
+}
+
+// goalBudgetStatuses returns the budget status of every goal in the periods from the start till
+// the end date. The contribution needed in a period is budgeted and the amount saved in it is spent.
+func goalBudgetStatuses(
+	ctx context.Context, logger *slog.Logger, db database.Storage, rates *common.RateService, familyID uuid.UUID,
+	from, to time.Time, outputCurrencyID string, granularity utils.Granularity,
+) ([]goserver.BudgetStatus, error) {
+	goals, err := db.GetGoals(familyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goals: %w", err)
+	}
+	if len(goals) == 0 || to.IsZero() {
+		return nil, nil
+	}
+	if from.IsZero() {
+		from = time.Now()
+	}
+	periods := getIntervals(utils.RoundToGranularity(from, granularity, false), to, granularity)
+	savings, err := loadGoalSavings(ctx, logger, db, rates, familyID, goals, to)
+	if err != nil {
+		return nil, err
+	}
+
+	currencyMap := buildCurrencyMap(logger, db, familyID)
+	currenciesRatesFetcher := rates.Fetcher(familyID)
+	convert := func(goal goserver.Goal, date time.Time, amount decimal.Decimal) decimal.Decimal {
+		if outputCurrencyID == "" {
+			return amount
+		}
+		amount, _ = convertMovementAmount(ctx, goserver.Movement{Amount: amount, CurrencyId: goal.CurrencyId},
+			date, outputCurrencyID, currencyMap[outputCurrencyID], currencyMap, currenciesRatesFetcher, logger)
+		return amount
+	}
+
+	res := []goserver.BudgetStatus{}
+	for _, goal := range goals {
+		accountID := ""
+		if len(goal.AccountIds) > 0 {
+			accountID = goal.AccountIds[0]
+		}
+		history := savings[goal.Id]
+		for _, start := range periods {
+			end := utils.AddIntervals(start, granularity, 1)
+			savedStart := history.savedBefore(start)
+			budgeted := convert(goal, start,
+				utils.GoalPeriodContribution(goal.TargetAmount.Sub(savedStart), start, end, goal.TargetDate))
+			saved := convert(goal, start, history.savedBefore(end).Sub(savedStart))
+			res = append(res, goserver.BudgetStatus{
+				Date:      start,
+				AccountId: accountID,
+				GoalId:    goal.Id,
+				Budgeted:  budgeted,
+				Spent:     saved,
+				Available: budgeted.Sub(saved),
+			})
+		}
+	}
+	return res, nil
+}
+
+// goalSavings is the history of amounts saved for a goal, in the currency of the goal.
+type goalSavings struct {
+	dates   []time.Time
+	amounts []decimal.Decimal
+}
+
+func (g *goalSavings) add(date time.Time, amount decimal.Decimal) {
+	g.dates = append(g.dates, date)
+	g.amounts = append(g.amounts, amount)
+}
+
+// savedBefore returns the amount saved before the date.
+func (g *goalSavings) savedBefore(date time.Time) decimal.Decimal {
+	res := decimal.Zero
+	if g == nil {
+		return res
+	}
+	for i, d := range g.dates {
+		if d.Before(date) {
+			res = res.Add(g.amounts[i])
+		}
+	}
+	return res
+}
+
+// loadGoalSavings returns the savings history of every goal till the end date. Goals with
+// accounts save the balance of the accounts including their opening balances. Goals with a tag
+// save what transactions with the tag bring to asset accounts, or if a transaction only takes
+// money from asset accounts, it's withdrawn from the goal.
+func loadGoalSavings(
+	ctx context.Context, logger *slog.Logger, db database.Storage, rates *common.RateService, familyID uuid.UUID,
+	goals []goserver.Goal, end time.Time,
+) (map[string]*goalSavings, error) {
+	res := make(map[string]*goalSavings, len(goals))
+	if len(goals) == 0 {
+		return res, nil
+	}
+
+	accounts, err := db.GetAccounts(familyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	transactions, err := db.GetTransactions(familyID, time.Time{}, end, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	currencyMap := buildCurrencyMap(logger, db, familyID)
+	currenciesRatesFetcher := rates.Fetcher(familyID)
+	convert := func(goal goserver.Goal, date time.Time, m goserver.Movement) (decimal.Decimal, bool) {
+		amount, currencyID := convertMovementAmount(ctx, m, date, goal.CurrencyId, currencyMap[goal.CurrencyId],
+			currencyMap, currenciesRatesFetcher, logger)
+		// Not convertible amounts are skipped, the warning is already logged
+		return amount, currencyID == goal.CurrencyId
+	}
+	assetAccounts := make(map[string]bool)
+	for _, acc := range accounts {
+		if isAssetAccount(acc) {
+			assetAccounts[acc.Id] = true
+		}
+	}
+
+	for _, goal := range goals {
+		history := &goalSavings{}
+		res[goal.Id] = history
+
+		if goal.Tag == "" {
+			for _, acc := range accounts {
+				if !slices.Contains(goal.AccountIds, acc.Id) {
+					continue
+				}
+				// Accounts without opening date are converted at today's rates
+				rateDate := acc.OpeningDate
+				if rateDate.IsZero() {
+					rateDate = time.Now()
+				}
+				for _, b := range acc.BankInfo.Balances {
+					if amount, ok := convert(goal, rateDate,
+						goserver.Movement{Amount: b.OpeningBalance, CurrencyId: b.CurrencyId}); ok {
+						history.add(acc.OpeningDate, amount)
+					}
+				}
+			}
+		}
+
+		for _, t := range transactions {
+			if goal.Tag != "" && !slices.ContainsFunc(t.Tags, func(tag string) bool {
+				return utils.IsTagOrSubTag(tag, goal.Tag)
+			}) {
+				continue
+			}
+
+			deposited, withdrawn := decimal.Zero, decimal.Zero
+			for _, m := range t.Movements {
+				if (goal.Tag == "" && !slices.Contains(goal.AccountIds, m.AccountId)) ||
+					(goal.Tag != "" && !assetAccounts[m.AccountId]) {
+					continue
+				}
+				amount, ok := convert(goal, t.Date, m)
+				if !ok {
+					continue
+				}
+				if amount.IsPositive() {
+					deposited = deposited.Add(amount)
+				} else {
+					withdrawn = withdrawn.Add(amount)
+				}
+			}
+
+			switch {
+			case goal.Tag == "":
+				history.add(t.Date, deposited.Add(withdrawn))
+			case deposited.IsPositive():
+				history.add(t.Date, deposited)
+			default:
+				history.add(t.Date, withdrawn)
+			}
+		}
+	}
+	return res, nil
+}
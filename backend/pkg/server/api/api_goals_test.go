@@ -0,0 +1,158 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Goals API", func() {
+	var (
+		st                  database.Storage
+		sut                 *api.GoalsAPIServiceImpl
+		ctx                 context.Context
+		log                 = test.CreateTestLogger()
+		familyID            = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		czk                 goserver.Currency
+		bank, savings, food goserver.Account
+		car, vacation       goserver.Goal
+		date                = time.Date(2025, 4, 15, 12, 0, 0, 0, time.UTC)
+		month               = func(m int) time.Time { return time.Date(2025, time.Month(m), 1, 0, 0, 0, 0, time.UTC) }
+	)
+
+	move := func(day time.Time, from, to goserver.Account, amount int64, tags ...string) {
+		_, err := st.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date: day,
+			Tags: tags,
+			Movements: []goserver.Movement{
+				{AccountId: from.Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(-amount)},
+				{AccountId: to.Id, CurrencyId: czk.Id, Amount: decimal.NewFromInt(amount)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	createGoal := func(goal goserver.GoalNoId) goserver.Goal {
+		resp, err := sut.CreateGoal(ctx, goal)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		return resp.Body.(goserver.Goal)
+	}
+
+	BeforeEach(func() {
+		st = database.NewStorage(log, &config.Config{DBPath: ":memory:"})
+		Expect(st.Open()).To(Succeed())
+		DeferCleanup(st.Close)
+		sut = api.NewGoalsAPIServiceImpl(log, st, common.NewRateService(log, st, 0))
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+
+		var err error
+		czk, err = st.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "CZK"})
+		Expect(err).ToNot(HaveOccurred())
+		bank, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		savings, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Savings", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		food, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Food", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+
+		car = createGoal(goserver.GoalNoId{
+			Name: "Car", TargetAmount: decimal.NewFromInt(1200), CurrencyId: czk.Id,
+			TargetDate: month(10), AccountIds: []string{savings.Id},
+		})
+		vacation = createGoal(goserver.GoalNoId{
+			Name: "Vacation", TargetAmount: decimal.NewFromInt(300), CurrencyId: czk.Id,
+			TargetDate: month(6), Tag: "vacation", Priority: 1,
+		})
+
+		for m := 1; m <= 4; m++ {
+			move(month(m).AddDate(0, 0, 9), bank, savings, 100)
+		}
+		move(month(3), bank, savings, 150, "vacation")
+		move(month(4), bank, food, 50, "vacation/food")
+	})
+
+	It("refuses invalid goals", func() {
+		for _, goal := range []goserver.GoalNoId{
+			{Name: "Both", TargetAmount: decimal.NewFromInt(100), CurrencyId: czk.Id, TargetDate: month(6),
+				AccountIds: []string{savings.Id}, Tag: "vacation"},
+			{Name: "Expense", TargetAmount: decimal.NewFromInt(100), CurrencyId: czk.Id, TargetDate: month(6),
+				AccountIds: []string{food.Id}},
+			{Name: "Zero", CurrencyId: czk.Id, TargetDate: month(6), Tag: "vacation"},
+		} {
+			resp, err := sut.CreateGoal(ctx, goal)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		}
+
+		resp, err := sut.GetGoalProgress(ctx, uuid.NewString(), date)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("tracks progress of goals by priority", func() {
+		resp, err := sut.GetGoalsProgress(ctx, date)
+		Expect(err).ToNot(HaveOccurred())
+		progress := resp.Body.([]goserver.GoalProgress)
+		Expect(progress).To(HaveLen(2))
+
+		Expect(progress[0].GoalId).To(Equal(vacation.Id))
+		Expect(progress[0].CurrentAmount.String()).To(Equal("100"))
+		Expect(progress[0].MonthsLeft).To(Equal(int32(2)))
+		Expect(progress[0].RequiredMonthlyContribution.String()).To(Equal("100"))
+		Expect(progress[0].AverageMonthlyContribution.String()).To(Equal("33.33"))
+		Expect(progress[0].Status).To(Equal(utils.GoalStatusBehind))
+
+		// The tagged deposit to the savings account counts for the car too
+		Expect(progress[1].GoalId).To(Equal(car.Id))
+		Expect(progress[1].CurrentAmount.String()).To(Equal("550"))
+		Expect(progress[1].Progress.String()).To(Equal("45.83"))
+		Expect(progress[1].RemainingAmount.String()).To(Equal("650"))
+		Expect(progress[1].MonthsLeft).To(Equal(int32(6)))
+		Expect(progress[1].RequiredMonthlyContribution.String()).To(Equal("108.33"))
+		Expect(progress[1].AverageMonthlyContribution.String()).To(Equal("150"))
+		Expect(progress[1].Status).To(Equal(utils.GoalStatusOnTrack))
+
+		resp, err = sut.GetGoalProgress(ctx, car.Id, month(12))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Body.(goserver.GoalProgress).Status).To(Equal(utils.GoalStatusBehind))
+	})
+
+	It("reports goals in the budget status", func() {
+		resp, err := api.NewBudgetItemsAPIService(
+			log, st, common.NewRateService(log, st, 0),
+		).GetBudgetStatus(ctx, month(4), month(5), "", "month", false, 0)
+		Expect(err).ToNot(HaveOccurred())
+		statuses := map[string]goserver.BudgetStatus{}
+		for _, status := range resp.Body.([]goserver.BudgetStatus) {
+			if status.GoalId != "" {
+				statuses[status.GoalId] = status
+			}
+		}
+		Expect(statuses).To(HaveLen(2))
+
+		// 750 remain for 183 days till October, 30 of them in April
+		Expect(statuses[car.Id].AccountId).To(Equal(savings.Id))
+		Expect(statuses[car.Id].Budgeted.String()).To(Equal("122.95"))
+		Expect(statuses[car.Id].Spent.String()).To(Equal("100"))
+		Expect(statuses[car.Id].Available.String()).To(Equal("22.95"))
+
+		// 150 remain for 61 days till June, 30 of them in April
+		Expect(statuses[vacation.Id].Budgeted.String()).To(Equal("73.77"))
+		Expect(statuses[vacation.Id].Spent.String()).To(Equal("-50"))
+		Expect(statuses[vacation.Id].Available.String()).To(Equal("123.77"))
+	})
+})
@@ -0,0 +1,127 @@
+package api_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Loans", func() {
+	var (
+		st                   database.Storage
+		ctx                  context.Context
+		log                  = test.CreateTestLogger()
+		familyID             = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		usdID                string
+		bank, interest, loan goserver.Account
+	)
+
+	BeforeEach(func() {
+		st = database.NewStorage(log, &config.Config{DBPath: ":memory:"})
+		Expect(st.Open()).To(Succeed())
+		DeferCleanup(st.Close)
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+
+		usd, err := st.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "USD"})
+		Expect(err).ToNot(HaveOccurred())
+		usdID = usd.Id
+		bank, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		interest, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Interest", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+		loan, err = st.CreateAccount(familyID, &goserver.AccountNoId{
+			Name: "Mortgage",
+			Type: "liability",
+			LoanTerms: goserver.LoanTerms{
+				Principal:         decimal.NewFromInt(12000),
+				AnnualRate:        decimal.NewFromInt(6),
+				TermMonths:        12,
+				StartDate:         time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+				CurrencyId:        usdID,
+				InterestAccountId: interest.Id,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("returns the amortization schedule", func() {
+		sut := api.NewAccountsAPIService(log, st, &config.Config{})
+
+		resp, err := sut.GetAccountAmortization(ctx, loan.Id)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(200))
+		schedule := resp.Body.(goserver.AmortizationSchedule)
+		Expect(schedule.MonthlyPayment.String()).To(Equal("1032.8"))
+		Expect(schedule.Payments).To(HaveLen(12))
+		Expect(schedule.Payments[0].Date).To(Equal(time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)))
+		Expect(schedule.TotalInterest.IsPositive()).To(BeTrue())
+
+		resp, err = sut.GetAccountAmortization(ctx, bank.Id)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(400))
+
+		resp, err = sut.GetAccountAmortization(ctx, uuid.NewString())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Code).To(Equal(404))
+	})
+
+	It("splits payments into principal and interest", func() {
+		payment := func(date time.Time) *goserver.TransactionNoId {
+			return &goserver.TransactionNoId{
+				Date: date,
+				Movements: []goserver.Movement{
+					{AccountId: bank.Id, CurrencyId: usdID, Amount: decimal.NewFromInt(-1000)},
+					{AccountId: loan.Id, CurrencyId: usdID, Amount: decimal.NewFromInt(1000)},
+				},
+			}
+		}
+		sut := api.NewUnprocessedTransactionsAPIServiceImpl(log, st, common.NewRateService(log, st, 0))
+
+		first, err := st.CreateTransaction(familyID, &goserver.TransactionNoId{Date: time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)})
+		Expect(err).ToNot(HaveOccurred())
+		converted, err := sut.Convert(ctx, familyID, first.Id, payment(first.Date))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(converted.Movements).To(HaveLen(3))
+		Expect(converted.Movements[1].AccountId).To(Equal(loan.Id))
+		Expect(converted.Movements[1].Amount.String()).To(Equal("940"))
+		Expect(converted.Movements[2].AccountId).To(Equal(interest.Id))
+		Expect(converted.Movements[2].Amount.String()).To(Equal("60"))
+
+		// Interest of the next payment is calculated from the reduced principal
+		second, err := st.CreateTransaction(familyID, &goserver.TransactionNoId{Date: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)})
+		Expect(err).ToNot(HaveOccurred())
+		converted, err = sut.Convert(ctx, familyID, second.Id, payment(second.Date))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(converted.Movements[2].Amount.String()).To(Equal("55.3"))
+
+		// Already split payments are kept
+		converted, err = sut.Convert(ctx, familyID, second.Id, &goserver.TransactionNoId{
+			Date: second.Date, Movements: converted.Movements,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(converted.Movements).To(HaveLen(3))
+		Expect(converted.Movements[1].Amount.String()).To(Equal("944.7"))
+	})
+
+	It("reports loans in balances", func() {
+		sut := api.NewAggregationsAPIServiceImpl(log, st, common.NewRateService(log, st, 0))
+		agg, err := sut.GetAggregatedBalances(ctx, familyID,
+			time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), "", false, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(agg.Currencies).To(HaveLen(1))
+		Expect(agg.Currencies[0].Accounts).To(HaveLen(1))
+		Expect(agg.Currencies[0].Accounts[0].AccountId).To(Equal(loan.Id))
+		Expect(agg.Currencies[0].Accounts[0].Amounts[1].String()).To(Equal("-12000"))
+	})
+})
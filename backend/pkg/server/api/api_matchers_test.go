@@ -15,6 +15,7 @@ import (
 	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
 	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 	"github.com/ya-breeze/geekbudgetbe/test"
 )
 
@@ -85,7 +86,8 @@ var _ = Describe("Matchers API", func() {
 			}
 
 			// Instantiate real UnprocessedTransactionsAPIServiceImpl with mockStorage
-			unprocessedService := api.NewUnprocessedTransactionsAPIServiceImpl(log, mockStorage)
+			unprocessedService := api.NewUnprocessedTransactionsAPIServiceImpl(
+				log, mockStorage, common.NewRateService(log, mockStorage, 0))
 			// Re-instantiate SUT with the service
 			sut = api.NewMatchersAPIServiceImpl(log, mockStorage, cfg, unprocessedService)
 
@@ -0,0 +1,231 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+func (s *AggregationsAPIServiceImpl) GetNetWorth(
+	ctx context.Context, dateFrom, dateTo time.Time, outputCurrencyID string, granularity string, includeHidden bool,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	if outputCurrencyID == "" {
+		if userID, ok := ctx.Value(constants.UserIDKey).(uuid.UUID); ok {
+			if user, err := s.db.GetUser(userID); err == nil && user != nil {
+				outputCurrencyID = user.FavoriteCurrencyID
+			}
+		}
+	}
+
+	aggGranularity := getGranularity(s.logger, s.db, familyID, granularity)
+	report, err := s.GetNetWorthReport(ctx, familyID, dateFrom, dateTo, outputCurrencyID, aggGranularity, includeHidden)
+	if err != nil {
+		if errors.Is(err, ErrNoOutputCurrency) {
+			return goserver.Response(400, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to build net worth report")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, report), nil
+}
+
+// GetNetWorthReport returns balances of asset and liability accounts at the end of each interval.
+// Balances are aggregated in their own currencies and converted to the output currency at the
+// rate of the end of the interval, so that all accounts of a point use the same rates.
+func (s *AggregationsAPIServiceImpl) GetNetWorthReport(
+	ctx context.Context, familyID uuid.UUID, dateFrom, dateTo time.Time, outputCurrencyID string,
+	granularity utils.Granularity, includeHidden bool,
+) (*goserver.NetWorthReport, error) {
+	if outputCurrencyID == "" {
+		return nil, ErrNoOutputCurrency
+	}
+	currentMonth := utils.FinancialMonth(granularity.MonthStartDay())
+	if dateTo.IsZero() {
+		dateTo = utils.RoundToGranularity(time.Now(), currentMonth, true)
+	}
+	if dateFrom.IsZero() {
+		dateFrom = dateTo.AddDate(-1, 0, 0)
+	}
+	dateFrom = utils.RoundToGranularity(dateFrom, granularity, false)
+	dateTo = utils.RoundToGranularity(dateTo, granularity, true)
+	if !dateTo.After(dateFrom) {
+		dateTo = utils.RoundToGranularity(dateFrom.AddDate(0, 0, 1), granularity, true)
+	}
+
+	accounts, err := s.db.GetAccounts(familyID)
+	if err != nil {
+		return nil, err
+	}
+	filter := func(a goserver.Account) bool {
+		return isBalanceAccount(a) && (includeHidden || !a.HideFromReports)
+	}
+
+	// Balances stay in their own currencies, they are converted per point below
+	balances, err := s.aggregateBalances(ctx, familyID, dateFrom, dateTo, "", granularity, filter, 0)
+	if err != nil {
+		return nil, err
+	}
+	if balances == nil {
+		return nil, fmt.Errorf("failed to aggregate balances")
+	}
+
+	ends := make([]time.Time, len(balances.Intervals))
+	for k := range balances.Intervals {
+		ends[k] = dateTo
+		if k+1 < len(balances.Intervals) {
+			ends[k] = balances.Intervals[k+1]
+		}
+	}
+	unreconciledSince, err := s.unreconciledSince(familyID, balances, dateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &goserver.NetWorthReport{
+		From:             dateFrom,
+		To:               dateTo,
+		Granularity:      string(granularity.Base()),
+		OutputCurrencyId: outputCurrencyID,
+		Intervals:        balances.Intervals,
+		Points:           make([]goserver.NetWorthPoint, len(balances.Intervals)),
+		Accounts:         []goserver.NetWorthAccount{},
+		Currencies:       []goserver.NetWorthCurrency{},
+	}
+	for k := range report.Points {
+		report.Points[k].Date = ends[k]
+	}
+
+	currencyMap := buildCurrencyMap(s.logger, s.db, familyID)
+	currenciesRatesFetcher := s.rates.Fetcher(familyID)
+	today := utils.RoundToGranularity(time.Now(), utils.GranularityDay, false)
+	for _, curr := range balances.Currencies {
+		item := goserver.NetWorthCurrency{
+			CurrencyId: curr.CurrencyId,
+			Balances:   make([]decimal.Decimal, len(balances.Intervals)),
+			Amounts:    make([]decimal.Decimal, len(balances.Intervals)),
+		}
+		for _, acc := range curr.Accounts {
+			idx := slices.IndexFunc(accounts, func(a goserver.Account) bool { return a.Id == acc.AccountId })
+			if idx == -1 {
+				continue
+			}
+			accIdx := slices.IndexFunc(report.Accounts, func(a goserver.NetWorthAccount) bool { return a.AccountId == acc.AccountId })
+			if accIdx == -1 {
+				report.Accounts = append(report.Accounts, goserver.NetWorthAccount{
+					AccountId: acc.AccountId,
+					Amounts:   make([]decimal.Decimal, len(balances.Intervals)),
+				})
+				accIdx = len(report.Accounts) - 1
+			}
+
+			for k, balance := range acc.Amounts {
+				if balance.IsZero() {
+					continue
+				}
+				item.Balances[k] = item.Balances[k].Add(balance)
+
+				since, ok := unreconciledSince[reconciliationKey{accountID: acc.AccountId, currencyID: curr.CurrencyId}]
+				if ok && since.Before(ends[k]) && !slices.Contains(report.Points[k].UnreconciledAccountIds, acc.AccountId) {
+					report.Points[k].Unreconciled = true
+					report.Points[k].UnreconciledAccountIds = append(report.Points[k].UnreconciledAccountIds, acc.AccountId)
+				}
+
+				// Rates of the last day of the interval, future rates are unknown so today's are used instead
+				rateDay := ends[k].AddDate(0, 0, -1)
+				if rateDay.After(today) {
+					rateDay = today
+				}
+				amount, currencyID := convertMovementAmount(ctx,
+					goserver.Movement{Amount: balance, CurrencyId: curr.CurrencyId}, rateDay,
+					outputCurrencyID, currencyMap[outputCurrencyID], currencyMap, currenciesRatesFetcher, s.logger)
+				if currencyID != outputCurrencyID {
+					// Not convertible, the warning is already logged
+					continue
+				}
+
+				item.Amounts[k] = item.Amounts[k].Add(amount)
+				report.Accounts[accIdx].Amounts[k] = report.Accounts[accIdx].Amounts[k].Add(amount)
+				if accounts[idx].Type == constants.AccountLiability {
+					report.Points[k].Liabilities = report.Points[k].Liabilities.Add(amount)
+				} else {
+					report.Points[k].Assets = report.Points[k].Assets.Add(amount)
+				}
+				report.Points[k].NetWorth = report.Points[k].NetWorth.Add(amount)
+			}
+		}
+		report.Currencies = append(report.Currencies, item)
+	}
+
+	return report, nil
+}
+
+type reconciliationKey struct {
+	accountID  string
+	currencyID string
+}
+
+// unreconciledSince returns for every account and currency of the balances the date of the first
+// movement after its latest reconciliation. Balances at points after this date differ from the
+// reconciled one. Balances which were never reconciled are unreconciled since the beginning.
+func (s *AggregationsAPIServiceImpl) unreconciledSince(
+	familyID uuid.UUID, balances *goserver.Aggregation, dateTo time.Time,
+) (map[reconciliationKey]time.Time, error) {
+	res := make(map[reconciliationKey]time.Time)
+	reconciledAt := make(map[reconciliationKey]time.Time)
+	var earliest time.Time
+	for _, curr := range balances.Currencies {
+		for _, acc := range curr.Accounts {
+			key := reconciliationKey{accountID: acc.AccountId, currencyID: curr.CurrencyId}
+			rec, err := s.db.GetLatestReconciliation(familyID, acc.AccountId, curr.CurrencyId)
+			if err != nil {
+				return nil, err
+			}
+			if rec == nil {
+				res[key] = time.Time{}
+				continue
+			}
+			if !rec.ReconciledAt.Before(dateTo) {
+				continue
+			}
+			reconciledAt[key] = rec.ReconciledAt
+			if earliest.IsZero() || rec.ReconciledAt.Before(earliest) {
+				earliest = rec.ReconciledAt
+			}
+		}
+	}
+	if len(reconciledAt) == 0 {
+		return res, nil
+	}
+
+	transactions, err := s.db.GetTransactions(familyID, earliest, dateTo, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	for _, t := range transactions {
+		for _, m := range t.Movements {
+			key := reconciliationKey{accountID: m.AccountId, currencyID: m.CurrencyId}
+			at, ok := reconciledAt[key]
+			if !ok || !t.Date.After(at) || m.Amount.IsZero() {
+				continue
+			}
+			if since, ok := res[key]; !ok || t.Date.Before(since) {
+				res[key] = t.Date
+			}
+		}
+	}
+	return res, nil
+}
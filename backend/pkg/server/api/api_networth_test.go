@@ -0,0 +1,132 @@
+package api_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Net worth report", func() {
+	var (
+		st                  database.Storage
+		sut                 *api.AggregationsAPIServiceImpl
+		ctx                 context.Context
+		log                 = test.CreateTestLogger()
+		familyID            = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		czk, eur            goserver.Currency
+		bank, savings, loan goserver.Account
+		salary              goserver.Account
+	)
+
+	transfer := func(date time.Time, from, to goserver.Account, currency goserver.Currency, amount int64) {
+		_, err := st.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date: date,
+			Movements: []goserver.Movement{
+				{AccountId: from.Id, CurrencyId: currency.Id, Amount: decimal.NewFromInt(-amount)},
+				{AccountId: to.Id, CurrencyId: currency.Id, Amount: decimal.NewFromInt(amount)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		st = database.NewStorage(log, &config.Config{DBPath: ":memory:"})
+		Expect(st.Open()).To(Succeed())
+		DeferCleanup(st.Close)
+		sut = api.NewAggregationsAPIServiceImpl(log, st, common.NewRateService(log, st, 0))
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+
+		var err error
+		czk, err = st.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "CZK"})
+		Expect(err).ToNot(HaveOccurred())
+		eur, err = st.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "EUR"})
+		Expect(err).ToNot(HaveOccurred())
+		bank, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Bank", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		savings, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Savings", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		loan, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Loan", Type: "liability"})
+		Expect(err).ToNot(HaveOccurred())
+		salary, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Salary", Type: "income"})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("converts balances at the rate of each point", func() {
+		transfer(time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC), salary, bank, czk, 1000)
+		transfer(time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), salary, savings, eur, 100)
+		transfer(time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), loan, bank, czk, 500)
+		Expect(st.SaveCNBRates(map[string]decimal.Decimal{"EUR": decimal.NewFromInt(25)},
+			time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC))).To(Succeed())
+		Expect(st.SaveCNBRates(map[string]decimal.Decimal{"EUR": decimal.NewFromInt(24)},
+			time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC))).To(Succeed())
+
+		report, err := sut.GetNetWorthReport(ctx, familyID,
+			time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			czk.Id, utils.GranularityMonth, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.Points).To(HaveLen(2))
+
+		Expect(report.Points[0].Date).To(Equal(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(report.Points[0].Assets.String()).To(Equal("4000"))
+		Expect(report.Points[0].Liabilities.String()).To(Equal("-500"))
+		Expect(report.Points[0].NetWorth.String()).To(Equal("3500"))
+		Expect(report.Points[1].NetWorth.String()).To(Equal("3400"))
+
+		amounts := map[string][]string{}
+		for _, acc := range report.Accounts {
+			for _, amount := range acc.Amounts {
+				amounts[acc.AccountId] = append(amounts[acc.AccountId], amount.String())
+			}
+		}
+		Expect(amounts).To(HaveLen(3))
+		Expect(amounts[savings.Id]).To(Equal([]string{"2500", "2400"}))
+		Expect(amounts[loan.Id]).To(Equal([]string{"-500", "-500"}))
+
+		for _, curr := range report.Currencies {
+			if curr.CurrencyId == eur.Id {
+				Expect(curr.Balances[1].String()).To(Equal("100"))
+				Expect(curr.Amounts[1].String()).To(Equal("2400"))
+			}
+		}
+	})
+
+	It("flags points changed after the last reconciliation", func() {
+		today := utils.RoundToGranularity(time.Now(), utils.GranularityDay, false)
+		transfer(today.AddDate(0, 0, -1), salary, bank, czk, 100)
+		transfer(today.AddDate(0, 0, -1), salary, savings, czk, 50)
+		_, err := st.CreateReconciliation(familyID, &goserver.ReconciliationNoId{
+			AccountId: bank.Id, CurrencyId: czk.Id, ReconciledBalance: decimal.NewFromInt(100),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		transfer(today.AddDate(0, 0, 1), salary, bank, czk, 10)
+
+		report, err := sut.GetNetWorthReport(ctx, familyID,
+			today.AddDate(0, 0, -1), today.AddDate(0, 0, 2), czk.Id, utils.GranularityDay, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.Points).To(HaveLen(3))
+
+		// Savings were never reconciled, the bank changed tomorrow
+		Expect(report.Points[0].Unreconciled).To(BeTrue())
+		Expect(report.Points[0].UnreconciledAccountIds).To(ConsistOf(savings.Id))
+		Expect(report.Points[1].UnreconciledAccountIds).To(ConsistOf(savings.Id))
+		Expect(report.Points[2].UnreconciledAccountIds).To(ConsistOf(savings.Id, bank.Id))
+		Expect(report.Points[2].NetWorth.String()).To(Equal("160"))
+	})
+
+	It("refuses reports without an output currency", func() {
+		_, err := sut.GetNetWorthReport(ctx, familyID, time.Time{}, time.Time{}, "", utils.GranularityMonth, false)
+		Expect(err).To(MatchError(api.ErrNoOutputCurrency))
+	})
+})
@@ -0,0 +1,215 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+// Values of sortBy of the partner report
+const (
+	PartnerSortBySpent    = "spent"
+	PartnerSortByReceived = "received"
+	PartnerSortByCount    = "count"
+)
+
+// ErrUnknownPartnerSort is returned for an unsupported sortBy of the partner report.
+var ErrUnknownPartnerSort = errors.New("unknown partner sort")
+
+func (s *AggregationsAPIServiceImpl) GetPartners(
+	ctx context.Context, dateFrom, dateTo time.Time, outputCurrencyID, sortBy string, top int32,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	if outputCurrencyID == "" {
+		if userID, ok := ctx.Value(constants.UserIDKey).(uuid.UUID); ok {
+			if user, err := s.db.GetUser(userID); err == nil && user != nil {
+				outputCurrencyID = user.FavoriteCurrencyID
+			}
+		}
+	}
+
+	report, err := s.GetPartnerReport(ctx, familyID, dateFrom, dateTo, outputCurrencyID, sortBy, int(top))
+	if err != nil {
+		if errors.Is(err, ErrNoOutputCurrency) || errors.Is(err, ErrUnknownPartnerSort) {
+			return goserver.Response(400, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to build partner report")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, report), nil
+}
+
+// GetPartnerReport returns money paid to and received from each partner in the period together
+// with the same figures for the previous period of the same length. Paid and received amounts
+// are changes of asset accounts, so transfers between own accounts don't count. Only partners
+// with transactions in the period are returned, sorted by sortBy; top limits their number.
+func (s *AggregationsAPIServiceImpl) GetPartnerReport(
+	ctx context.Context, familyID uuid.UUID, dateFrom, dateTo time.Time, outputCurrencyID, sortBy string, top int,
+) (*goserver.PartnerReport, error) {
+	if outputCurrencyID == "" {
+		return nil, ErrNoOutputCurrency
+	}
+	compare, err := comparePartners(sortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	month := getGranularity(s.logger, s.db, familyID, string(utils.GranularityMonth))
+	if dateFrom.IsZero() {
+		dateFrom = utils.RoundToGranularity(time.Now(), month, false)
+	}
+	if dateTo.IsZero() {
+		dateTo = utils.RoundToGranularity(time.Now(), month, true)
+	}
+	if !dateTo.After(dateFrom) {
+		return nil, errors.New("period is empty")
+	}
+	prevFrom := previousPeriodStart(dateFrom, dateTo, month)
+
+	accounts, err := s.db.GetAccounts(familyID)
+	if err != nil {
+		return nil, err
+	}
+	// The whole history is needed to know when a partner was seen first
+	transactions, err := s.db.GetTransactions(familyID, time.Time{}, dateTo, false)
+	if err != nil {
+		return nil, err
+	}
+
+	currencyMap := buildCurrencyMap(s.logger, s.db, familyID)
+	outputCurrencyName := currencyMap[outputCurrencyID]
+	currenciesRatesFetcher := s.rates.Fetcher(familyID)
+
+	partners := make(map[string]*goserver.PartnerStats)
+	names := make(map[string]map[string]int)
+	for _, t := range transactions {
+		key := utils.PartnerKey(t.PartnerName, t.PartnerAccount, t.Place)
+		if key == "" {
+			continue
+		}
+		p, ok := partners[key]
+		if !ok {
+			p = &goserver.PartnerStats{Key: key, FirstSeen: t.Date}
+			partners[key] = p
+			names[key] = make(map[string]int)
+		}
+		if t.Date.Before(prevFrom) {
+			continue
+		}
+
+		flow := decimal.Zero
+		for _, m := range getMovements(accounts, t, isAssetAccount) {
+			if m.AccountId == "" {
+				continue
+			}
+			amount, currencyID, _ := convertTransactionMovement(
+				ctx, m, t.Date, t.ExchangeRates, outputCurrencyID, outputCurrencyName,
+				currencyMap, currenciesRatesFetcher, s.logger)
+			if currencyID != outputCurrencyID {
+				s.logger.Warn("Partner report ignores amounts which couldn't be converted", "currencyId", currencyID)
+				continue
+			}
+			flow = flow.Add(amount)
+		}
+
+		if t.Date.Before(dateFrom) {
+			p.PreviousTransactionCount++
+			if flow.IsNegative() {
+				p.PreviousSpent = p.PreviousSpent.Sub(flow)
+			} else {
+				p.PreviousReceived = p.PreviousReceived.Add(flow)
+			}
+			continue
+		}
+
+		p.TransactionCount++
+		p.LastSeen = t.Date
+		if flow.IsNegative() {
+			p.Spent = p.Spent.Sub(flow)
+		} else {
+			p.Received = p.Received.Add(flow)
+		}
+		if t.PartnerAccount != "" {
+			p.PartnerAccount = t.PartnerAccount
+		}
+		names[key][partnerDisplayName(t)]++
+	}
+
+	report := &goserver.PartnerReport{
+		From:             dateFrom,
+		To:               dateTo,
+		PreviousFrom:     prevFrom,
+		PreviousTo:       dateFrom,
+		OutputCurrencyId: outputCurrencyID,
+		Partners:         []goserver.PartnerStats{},
+	}
+	for key, p := range partners {
+		if p.TransactionCount == 0 {
+			continue
+		}
+		// The most used spelling wins, ties are broken alphabetically to keep the name stable
+		p.Name = slices.MaxFunc(slices.Sorted(maps.Keys(names[key])), func(a, b string) int {
+			return cmp.Or(cmp.Compare(names[key][a], names[key][b]), cmp.Compare(b, a))
+		})
+		p.AverageTicket = p.Spent.Add(p.Received).Div(decimal.NewFromInt32(p.TransactionCount)).Round(2)
+		p.SpentChangePercent = changePercent(p.Spent, p.PreviousSpent)
+		p.ReceivedChangePercent = changePercent(p.Received, p.PreviousReceived)
+		report.Partners = append(report.Partners, *p)
+	}
+	slices.SortFunc(report.Partners, func(a, b goserver.PartnerStats) int {
+		return cmp.Or(compare(b, a), cmp.Compare(a.Key, b.Key))
+	})
+	if top > 0 && len(report.Partners) > top {
+		report.Partners = report.Partners[:top]
+	}
+
+	return report, nil
+}
+
+func comparePartners(sortBy string) (func(a, b goserver.PartnerStats) int, error) {
+	switch sortBy {
+	case "", PartnerSortBySpent:
+		return func(a, b goserver.PartnerStats) int { return a.Spent.Cmp(b.Spent) }, nil
+	case PartnerSortByReceived:
+		return func(a, b goserver.PartnerStats) int { return a.Received.Cmp(b.Received) }, nil
+	case PartnerSortByCount:
+		return func(a, b goserver.PartnerStats) int { return cmp.Compare(a.TransactionCount, b.TransactionCount) }, nil
+	default:
+		return nil, ErrUnknownPartnerSort
+	}
+}
+
+// previousPeriodStart returns the start of the period of the same length right before the given
+// one. Whole months are shifted by months, other periods by their duration.
+func previousPeriodStart(dateFrom, dateTo time.Time, month utils.Granularity) time.Time {
+	if utils.RoundToGranularity(dateFrom, month, false).Equal(dateFrom) &&
+		utils.RoundToGranularity(dateTo, month, false).Equal(dateTo) {
+		return utils.AddIntervals(dateFrom, month, -len(getIntervals(dateFrom, dateTo, month)))
+	}
+	return dateFrom.Add(-dateTo.Sub(dateFrom))
+}
+
+func partnerDisplayName(t goserver.Transaction) string {
+	switch {
+	case utils.NormalizePartnerName(t.PartnerName) != "":
+		return t.PartnerName
+	case utils.NormalizePartnerAccount(t.PartnerAccount) != "":
+		return t.PartnerAccount
+	default:
+		return t.Place
+	}
+}
@@ -0,0 +1,126 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Partner report", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockStorage *mocks.MockStorage
+		sut         *api.AggregationsAPIServiceImpl
+		ctx         context.Context
+		log         = test.CreateTestLogger()
+		familyID    = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		dateFrom    = time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+		dateTo      = time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	accounts := []goserver.Account{
+		{Id: "bank", Name: "Bank", Type: "asset"},
+		{Id: "savings", Name: "Savings", Type: "asset"},
+		{Id: "food", Name: "Food", Type: "expense"},
+		{Id: "salary", Name: "Salary", Type: "income"},
+	}
+	// payment moves amount from the bank to the account, negative amounts come to the bank
+	payment := func(date time.Time, partner, account string, amount int64) goserver.Transaction {
+		return goserver.Transaction{
+			Id:          uuid.NewString(),
+			Date:        date,
+			PartnerName: partner,
+			Movements: []goserver.Movement{
+				{AccountId: "bank", Amount: decimal.NewFromInt(-amount), CurrencyId: "czk"},
+				{AccountId: account, Amount: decimal.NewFromInt(amount), CurrencyId: "czk"},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockStorage = mocks.NewMockStorage(ctrl)
+		sut = api.NewAggregationsAPIServiceImpl(log, mockStorage, common.NewRateService(log, mockStorage, 0))
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+
+		mockStorage.EXPECT().GetAccounts(familyID).Return(accounts, nil).AnyTimes()
+		mockStorage.EXPECT().GetCurrencies(familyID).Return([]goserver.Currency{{Id: "czk", Name: "CZK"}}, nil).AnyTimes()
+		mockStorage.EXPECT().GetUser(familyID).Return(&models.User{}, nil).AnyTimes()
+		mockStorage.EXPECT().GetFamily(familyID).Return(&models.Family{}, nil).AnyTimes()
+		mockStorage.EXPECT().GetTransactions(familyID, time.Time{}, dateTo, false).Return([]goserver.Transaction{
+			payment(time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC), "Albert", "food", 50),
+			payment(time.Date(2026, 5, 3, 0, 0, 0, 0, time.UTC), "ALBERT", "food", 200),
+			payment(time.Date(2026, 6, 3, 0, 0, 0, 0, time.UTC), "Albert", "food", 100),
+			payment(time.Date(2026, 6, 20, 0, 0, 0, 0, time.UTC), "albert,", "food", 300),
+			payment(time.Date(2026, 6, 10, 0, 0, 0, 0, time.UTC), "ACME", "salary", -5000),
+			payment(time.Date(2026, 6, 11, 0, 0, 0, 0, time.UTC), "Me", "savings", 1000),
+			payment(time.Date(2026, 6, 12, 0, 0, 0, 0, time.UTC), "", "food", 30),
+		}, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("groups payments by normalized partner and compares with the previous month", func() {
+		resp, err := sut.GetPartners(ctx, dateFrom, dateTo, "czk", "", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		report := resp.Body.(*goserver.PartnerReport)
+
+		Expect(report.PreviousFrom).To(Equal(time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(report.Partners).To(HaveLen(3))
+
+		albert := report.Partners[0]
+		Expect(albert.Key).To(Equal("name:albert"))
+		Expect(albert.Name).To(Equal("Albert"))
+		Expect(albert.Spent.Equal(decimal.NewFromInt(400))).To(BeTrue())
+		Expect(albert.Received.IsZero()).To(BeTrue())
+		Expect(albert.TransactionCount).To(Equal(int32(2)))
+		Expect(albert.AverageTicket.Equal(decimal.NewFromInt(200))).To(BeTrue())
+		Expect(albert.FirstSeen).To(Equal(time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)))
+		Expect(albert.LastSeen).To(Equal(time.Date(2026, 6, 20, 0, 0, 0, 0, time.UTC)))
+		Expect(albert.PreviousSpent.Equal(decimal.NewFromInt(200))).To(BeTrue())
+		Expect(albert.PreviousTransactionCount).To(Equal(int32(1)))
+		Expect(albert.SpentChangePercent.Equal(decimal.NewFromInt(100))).To(BeTrue())
+
+		Expect(report.Partners[1].Name).To(Equal("ACME"))
+		Expect(report.Partners[1].Received.Equal(decimal.NewFromInt(5000))).To(BeTrue())
+
+		// A transfer to an own account is neither spent nor received
+		Expect(report.Partners[2].Name).To(Equal("Me"))
+		Expect(report.Partners[2].Spent.IsZero()).To(BeTrue())
+		Expect(report.Partners[2].Received.IsZero()).To(BeTrue())
+	})
+
+	It("returns top partners by the sort value", func() {
+		resp, err := sut.GetPartners(ctx, dateFrom, dateTo, "czk", "received", 1)
+		Expect(err).NotTo(HaveOccurred())
+		report := resp.Body.(*goserver.PartnerReport)
+		Expect(report.Partners).To(HaveLen(1))
+		Expect(report.Partners[0].Name).To(Equal("ACME"))
+
+		resp, err = sut.GetPartners(ctx, dateFrom, dateTo, "czk", "unknown", 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("requires an output currency", func() {
+		resp, err := sut.GetPartners(ctx, dateFrom, dateTo, "", "", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -0,0 +1,452 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+type SecuritiesAPIServiceImpl struct {
+	logger *slog.Logger
+	db     database.Storage
+	rates  *common.RateService
+}
+
+func NewSecuritiesAPIServiceImpl(
+	logger *slog.Logger, db database.Storage, rates *common.RateService,
+) *SecuritiesAPIServiceImpl {
+	return &SecuritiesAPIServiceImpl{logger: logger, db: db, rates: rates}
+}
+
+func (s *SecuritiesAPIServiceImpl) GetSecurities(ctx context.Context) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	securities, err := s.db.GetSecurities(familyID)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to get securities")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, securities), nil
+}
+
+func (s *SecuritiesAPIServiceImpl) CreateSecurity(
+	ctx context.Context, securityNoID goserver.SecurityNoId,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	security, err := s.db.CreateSecurity(familyID, &securityNoID)
+	if err != nil {
+		if errors.Is(err, database.ErrInvalidSecurity) {
+			s.logger.With("error", err).Warn("Invalid security")
+			return goserver.Response(400, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to create security")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, security), nil
+}
+
+func (s *SecuritiesAPIServiceImpl) GetSecurity(ctx context.Context, id string) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	security, err := s.db.GetSecurity(familyID, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(404, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to get security")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, security), nil
+}
+
+func (s *SecuritiesAPIServiceImpl) UpdateSecurity(
+	ctx context.Context, id string, securityNoID goserver.SecurityNoId,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	security, err := s.db.UpdateSecurity(familyID, id, &securityNoID)
+	if err != nil {
+		switch {
+		case errors.Is(err, database.ErrInvalidSecurity):
+			s.logger.With("error", err).Warn("Invalid security")
+			return goserver.Response(400, nil), nil
+		case errors.Is(err, database.ErrNotFound):
+			return goserver.Response(404, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to update security")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, security), nil
+}
+
+func (s *SecuritiesAPIServiceImpl) DeleteSecurity(ctx context.Context, id string) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	if err := s.db.DeleteSecurity(familyID, id); err != nil {
+		if errors.Is(err, database.ErrSecurityInUse) {
+			s.logger.With("error", err).Warn("Cannot delete security in use")
+			return goserver.Response(400, "security is in use"), nil
+		}
+		s.logger.With("error", err).Error("Failed to delete security")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, nil), nil
+}
+
+func (s *SecuritiesAPIServiceImpl) GetSecurityPrices(
+	ctx context.Context, id string, dateFrom, dateTo time.Time,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+	if resp, ok := s.checkSecurity(familyID, id); !ok {
+		return resp, nil
+	}
+
+	prices, err := s.db.GetSecurityPrices(familyID, id, dateFrom, dateTo)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to get security prices")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, prices), nil
+}
+
+func (s *SecuritiesAPIServiceImpl) SetSecurityPrice(
+	ctx context.Context, id string, price goserver.SecurityPrice,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+	if !price.Price.IsPositive() {
+		return goserver.Response(400, "price must be positive"), nil
+	}
+	if resp, ok := s.checkSecurity(familyID, id); !ok {
+		return resp, nil
+	}
+
+	if err := s.db.SetSecurityPrices(familyID, id, []goserver.SecurityPrice{price}); err != nil {
+		s.logger.With("error", err).Error("Failed to set security price")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, price), nil
+}
+
+func (s *SecuritiesAPIServiceImpl) UploadSecurityPrices(
+	ctx context.Context, id string, file *os.File,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+	if resp, ok := s.checkSecurity(familyID, id); !ok {
+		return resp, nil
+	}
+
+	// The generated code stores the upload in a temporary file
+	defer os.Remove(file.Name())
+	if _, err := file.Seek(0, 0); err != nil {
+		s.logger.With("error", err).Error("Failed to seek to beginning of uploaded file")
+		return goserver.Response(500, nil), nil
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to read uploaded file")
+		return goserver.Response(500, nil), nil
+	}
+
+	prices, err := utils.ParseSecurityPricesCSV(data)
+	if err != nil {
+		s.logger.With("error", err).Warn("Failed to parse security prices")
+		return goserver.Response(400, err.Error()), nil
+	}
+	if err := s.db.SetSecurityPrices(familyID, id, prices); err != nil {
+		s.logger.With("error", err).Error("Failed to store security prices")
+		return goserver.Response(500, nil), nil
+	}
+
+	s.logger.Info("Imported security prices", "security", id, "count", len(prices))
+	return goserver.Response(200, goserver.SecurityPricesImportResult{Imported: int32(len(prices))}), nil
+}
+
+// checkSecurity returns the response to send if the security doesn't exist.
+func (s *SecuritiesAPIServiceImpl) checkSecurity(familyID uuid.UUID, id string) (goserver.ImplResponse, bool) {
+	if _, err := s.db.GetSecurity(familyID, id); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(404, nil), false
+		}
+		s.logger.With("error", err).Error("Failed to get security")
+		return goserver.Response(500, nil), false
+	}
+	return goserver.ImplResponse{}, true
+}
+
+func (s *SecuritiesAPIServiceImpl) GetHoldings(
+	ctx context.Context, date time.Time, outputCurrencyID string,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	holdings, err := s.CalculateHoldings(ctx, familyID, date, outputCurrencyID)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to calculate holdings")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, holdings), nil
+}
+
+// CalculateHoldings returns securities held in accounts at the end of the day of date (now if
+// zero) valued by their last known price. Costs paid in other currencies are converted to the
+// currency of the security on the day of the trade; with outputCurrencyID all values are then
+// converted on the date. Holdings which were sold completely are returned while they have a
+// realized gain.
+func (s *SecuritiesAPIServiceImpl) CalculateHoldings(
+	ctx context.Context, familyID uuid.UUID, date time.Time, outputCurrencyID string,
+) ([]goserver.Holding, error) {
+	if date.IsZero() {
+		date = time.Now()
+	}
+	day := utils.RoundToGranularity(date, utils.GranularityDay, false)
+	dayEnd := day.AddDate(0, 0, 1)
+
+	res := []goserver.Holding{}
+	securities, err := s.db.GetSecurities(familyID)
+	if err != nil || len(securities) == 0 {
+		return res, err
+	}
+	transactions, err := s.db.GetTransactions(familyID, time.Time{}, dayEnd, false)
+	if err != nil {
+		return nil, err
+	}
+
+	currencyMap := buildCurrencyMap(s.logger, s.db, familyID)
+	fetcher := s.rates.Fetcher(familyID)
+	convert := func(amount decimal.Decimal, currencyID string, day time.Time, toCurrencyID string) decimal.Decimal {
+		converted, _ := convertMovementAmount(ctx, goserver.Movement{Amount: amount, CurrencyId: currencyID},
+			day, toCurrencyID, currencyMap[toCurrencyID], currencyMap, fetcher, s.logger)
+		return converted
+	}
+
+	trades := make(map[holdingKey][]utils.HoldingTrade)
+	for _, m := range getSecurityMovements(transactions) {
+		security, ok := findSecurity(securities, m.SecurityId)
+		if !ok {
+			continue
+		}
+		key := holdingKey{accountID: m.AccountId, securityID: m.SecurityId}
+		trades[key] = append(trades[key], utils.HoldingTrade{
+			Date:     m.date,
+			Quantity: m.Quantity,
+			Amount:   convert(m.Amount, m.CurrencyId, m.date, security.CurrencyId),
+		})
+	}
+
+	for key, accountTrades := range trades {
+		security, _ := findSecurity(securities, key.securityID)
+		lots, realized := utils.FIFOLots(accountTrades)
+		h := goserver.Holding{
+			AccountId:    key.accountID,
+			SecurityId:   key.securityID,
+			CurrencyId:   security.CurrencyId,
+			RealizedGain: realized,
+			Lots:         lots,
+		}
+		for _, lot := range lots {
+			h.Quantity = h.Quantity.Add(lot.Quantity)
+			h.CostBasis = h.CostBasis.Add(lot.Cost)
+		}
+		if h.Quantity.IsZero() && h.RealizedGain.IsZero() {
+			continue
+		}
+
+		prices, err := s.db.GetSecurityPrices(familyID, key.securityID, time.Time{}, dayEnd)
+		if err != nil {
+			return nil, err
+		}
+		if price, ok := utils.PriceOn(prices, dayEnd); ok {
+			h.Price = price.Price
+			h.PriceDate = price.Date
+			h.MarketValue = h.Quantity.Mul(price.Price).Round(2)
+			h.UnrealizedGain = h.MarketValue.Sub(h.CostBasis)
+		}
+
+		if outputCurrencyID != "" && outputCurrencyID != h.CurrencyId {
+			for _, value := range []*decimal.Decimal{
+				&h.CostBasis, &h.Price, &h.MarketValue, &h.UnrealizedGain, &h.RealizedGain,
+			} {
+				*value = convert(*value, h.CurrencyId, day, outputCurrencyID)
+			}
+			for i := range h.Lots {
+				h.Lots[i].Cost = convert(h.Lots[i].Cost, h.CurrencyId, day, outputCurrencyID)
+			}
+			h.CurrencyId = outputCurrencyID
+		}
+		res = append(res, h)
+	}
+
+	slices.SortFunc(res, func(a, b goserver.Holding) int {
+		return cmp.Or(cmp.Compare(a.AccountId, b.AccountId), cmp.Compare(a.SecurityId, b.SecurityId))
+	})
+	return res, nil
+}
+
+type holdingKey struct {
+	accountID  string
+	securityID string
+}
+
+// securityMovement is a movement buying or selling units of a security with the date of its
+// transaction.
+type securityMovement struct {
+	goserver.Movement
+	date time.Time
+}
+
+func getSecurityMovements(transactions []goserver.Transaction) []securityMovement {
+	res := []securityMovement{}
+	for _, t := range transactions {
+		for _, m := range t.Movements {
+			if m.SecurityId != "" && m.AccountId != "" && !m.Quantity.IsZero() {
+				res = append(res, securityMovement{Movement: m, date: t.Date})
+			}
+		}
+	}
+	return res
+}
+
+func findSecurity(securities []goserver.Security, id string) (goserver.Security, bool) {
+	idx := slices.IndexFunc(securities, func(s goserver.Security) bool { return s.Id == id })
+	if idx == -1 {
+		return goserver.Security{}, false
+	}
+	return securities[idx], true
+}
+
+// valueHoldings replaces the cost of securities held in balance accounts by their market value at
+// the end of each interval of the cumulative balances. The cost is removed in the currencies it
+// was paid in, the market value is added in the currency of the security; both are converted to
+// the output currency if it's set. Securities without a known price keep their cost.
+func (s *AggregationsAPIServiceImpl) valueHoldings(
+	ctx context.Context, familyID uuid.UUID, res *goserver.Aggregation, accounts []goserver.Account,
+	filter AccountFilter, dateTo time.Time, outputCurrencyID string, currencyMap map[string]string,
+	currenciesRatesFetcher *common.CurrenciesRatesFetcher,
+) error {
+	securities, err := s.db.GetSecurities(familyID)
+	if err != nil || len(securities) == 0 {
+		return err
+	}
+	transactions, err := s.db.GetTransactions(familyID, time.Time{}, dateTo, false)
+	if err != nil {
+		return err
+	}
+
+	movements := make(map[holdingKey][]securityMovement)
+	for _, m := range getSecurityMovements(transactions) {
+		idx := slices.IndexFunc(accounts, func(a goserver.Account) bool { return a.Id == m.AccountId })
+		if idx == -1 || !filter(accounts[idx]) {
+			continue
+		}
+		// Costs are converted on the day of the trade as the balances are
+		m.Amount, m.CurrencyId = convertMovementAmount(ctx, m.Movement, m.date,
+			outputCurrencyID, currencyMap[outputCurrencyID], currencyMap, currenciesRatesFetcher, s.logger)
+		key := holdingKey{accountID: m.AccountId, securityID: m.SecurityId}
+		movements[key] = append(movements[key], m)
+	}
+
+	for key, securityMovements := range movements {
+		security, ok := findSecurity(securities, key.securityID)
+		if !ok {
+			continue
+		}
+		prices, err := s.db.GetSecurityPrices(familyID, key.securityID, time.Time{}, dateTo)
+		if err != nil {
+			return err
+		}
+
+		for k := range res.Intervals {
+			end := dateTo
+			if k+1 < len(res.Intervals) {
+				end = res.Intervals[k+1]
+			}
+			price, ok := utils.PriceOn(prices, end.Add(-time.Nanosecond))
+			if !ok {
+				continue
+			}
+
+			quantity := decimal.Zero
+			for _, m := range securityMovements {
+				if !m.date.Before(end) {
+					continue
+				}
+				quantity = quantity.Add(m.Quantity)
+				addToAggregation(res, m.CurrencyId, key.accountID, k, m.Amount.Neg())
+			}
+			value, currencyID := convertMovementAmount(ctx,
+				goserver.Movement{Amount: quantity.Mul(price.Price).Round(2), CurrencyId: security.CurrencyId},
+				end.Add(-time.Nanosecond), outputCurrencyID, currencyMap[outputCurrencyID],
+				currencyMap, currenciesRatesFetcher, s.logger)
+			addToAggregation(res, currencyID, key.accountID, k, value)
+		}
+	}
+	return nil
+}
+
+// addToAggregation adds the amount to the interval of the account, missing currencies and accounts
+// are added with zero amounts.
+func addToAggregation(res *goserver.Aggregation, currencyID, accountID string, interval int, amount decimal.Decimal) {
+	currIdx := slices.IndexFunc(res.Currencies, func(c goserver.CurrencyAggregation) bool { return c.CurrencyId == currencyID })
+	if currIdx == -1 {
+		res.Currencies = append(res.Currencies, goserver.CurrencyAggregation{CurrencyId: currencyID, Accounts: []goserver.AccountAggregation{}})
+		currIdx = len(res.Currencies) - 1
+	}
+	accounts := &res.Currencies[currIdx].Accounts
+	accIdx := slices.IndexFunc(*accounts, func(a goserver.AccountAggregation) bool { return a.AccountId == accountID })
+	if accIdx == -1 {
+		*accounts = append(*accounts, goserver.AccountAggregation{
+			AccountId: accountID,
+			Amounts:   make([]decimal.Decimal, len(res.Intervals)),
+		})
+		accIdx = len(*accounts) - 1
+	}
+	(*accounts)[accIdx].Amounts[interval] = (*accounts)[accIdx].Amounts[interval].Add(amount)
+}
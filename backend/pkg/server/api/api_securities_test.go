@@ -0,0 +1,107 @@
+package api_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Securities holdings", func() {
+	var (
+		st           database.Storage
+		ctx          context.Context
+		log          = test.CreateTestLogger()
+		familyID     = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		usdID        string
+		broker, cash goserver.Account
+		etf          goserver.Security
+	)
+
+	trade := func(date time.Time, quantity, amount int64) {
+		_, err := st.CreateTransaction(familyID, &goserver.TransactionNoId{
+			Date: date,
+			Movements: []goserver.Movement{
+				{
+					AccountId: broker.Id, CurrencyId: usdID, Amount: decimal.NewFromInt(amount),
+					SecurityId: etf.Id, Quantity: decimal.NewFromInt(quantity),
+				},
+				{AccountId: cash.Id, CurrencyId: usdID, Amount: decimal.NewFromInt(-amount)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		st = database.NewStorage(log, &config.Config{DBPath: ":memory:"})
+		Expect(st.Open()).To(Succeed())
+		DeferCleanup(st.Close)
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+
+		usd, err := st.CreateCurrency(familyID, &goserver.CurrencyNoId{Name: "USD"})
+		Expect(err).ToNot(HaveOccurred())
+		usdID = usd.Id
+		broker, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Broker", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		cash, err = st.CreateAccount(familyID, &goserver.AccountNoId{Name: "Cash", Type: "asset"})
+		Expect(err).ToNot(HaveOccurred())
+		etf, err = st.CreateSecurity(familyID, &goserver.SecurityNoId{Name: "World ETF", CurrencyId: usdID})
+		Expect(err).ToNot(HaveOccurred())
+
+		// Buy 10 units for 1000, sell 4 of them for 480
+		trade(time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), 10, 1000)
+		trade(time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), -4, -480)
+		Expect(st.SetSecurityPrices(familyID, etf.Id, []goserver.SecurityPrice{
+			{Date: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), Price: decimal.NewFromInt(110)},
+			{Date: time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), Price: decimal.NewFromInt(130)},
+		})).To(Succeed())
+	})
+
+	It("values holdings with FIFO gains", func() {
+		sut := api.NewSecuritiesAPIServiceImpl(log, st, common.NewRateService(log, st, 0))
+		holdings, err := sut.CalculateHoldings(ctx, familyID, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(holdings).To(HaveLen(1))
+
+		h := holdings[0]
+		Expect(h.AccountId).To(Equal(broker.Id))
+		Expect(h.Quantity.String()).To(Equal("6"))
+		Expect(h.CostBasis.String()).To(Equal("600"))
+		Expect(h.Price.String()).To(Equal("130"))
+		Expect(h.MarketValue.String()).To(Equal("780"))
+		Expect(h.UnrealizedGain.String()).To(Equal("180"))
+		Expect(h.RealizedGain.String()).To(Equal("80"))
+
+		// Before the sale and the second price
+		holdings, err = sut.CalculateHoldings(ctx, familyID, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(holdings[0].Quantity.String()).To(Equal("10"))
+		Expect(holdings[0].MarketValue.String()).To(Equal("1100"))
+	})
+
+	It("reports balances at market value", func() {
+		sut := api.NewAggregationsAPIServiceImpl(log, st, common.NewRateService(log, st, 0))
+		agg, err := sut.GetAggregatedBalances(ctx, familyID,
+			time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), "", false, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		balances := map[string][]string{}
+		for _, acc := range agg.Currencies[0].Accounts {
+			for _, amount := range acc.Amounts {
+				balances[acc.AccountId] = append(balances[acc.AccountId], amount.String())
+			}
+		}
+		Expect(balances[broker.Id]).To(Equal([]string{"1100", "780"}))
+		Expect(balances[cash.Id]).To(Equal([]string{"-1000", "-520"}))
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 	"github.com/ya-breeze/geekbudgetbe/pkg/version"
 )
 
@@ -13,14 +14,18 @@ type StatusResponse struct {
 	BuildTime string `json:"buildTime"`
 	Commit    string `json:"commit"`
 	StartTime string `json:"startTime"`
+	// RateCache are counters of the shared exchange rate cache
+	RateCache common.RateCacheStats `json:"rateCache"`
 }
 
 // StatusAPIController handles status-related requests.
-type StatusAPIController struct{}
+type StatusAPIController struct {
+	rates *common.RateService
+}
 
 // NewStatusAPIController creates a new StatusAPIController.
-func NewStatusAPIController() *StatusAPIController {
-	return &StatusAPIController{}
+func NewStatusAPIController(rates *common.RateService) *StatusAPIController {
+	return &StatusAPIController{rates: rates}
 }
 
 // Routes returns the routes for the status API.
@@ -40,6 +45,7 @@ func (c *StatusAPIController) GetStatus(w http.ResponseWriter, _ *http.Request)
 		BuildTime: version.BuildTime,
 		Commit:    version.Commit,
 		StartTime: version.StartTime.Format(time.RFC3339),
+		RateCache: c.rates.Stats(),
 	}
 
 	_ = goserver.EncodeJSONResponse(response, nil, w)
@@ -0,0 +1,278 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+type TagsAPIServiceImpl struct {
+	logger *slog.Logger
+	db     database.Storage
+	rates  *common.RateService
+}
+
+func NewTagsAPIServiceImpl(
+	logger *slog.Logger, db database.Storage, rates *common.RateService,
+) *TagsAPIServiceImpl {
+	return &TagsAPIServiceImpl{
+		logger: logger,
+		db:     db,
+		rates:  rates,
+	}
+}
+
+func (s *TagsAPIServiceImpl) GetTags(ctx context.Context) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	tags, err := s.db.GetTags(familyID)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to get tags")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, tags), nil
+}
+
+func (s *TagsAPIServiceImpl) RenameTag(ctx context.Context, input goserver.TagRename) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	from, to := utils.NormalizeTag(input.From), utils.NormalizeTag(input.To)
+	// A tag can't become its own sub-tag
+	if from == "" || to == "" || utils.IsTagOrSubTag(to, from) {
+		return goserver.Response(400, nil), nil
+	}
+
+	tags, err := s.db.GetTags(familyID)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to get tags")
+		return goserver.Response(500, nil), nil
+	}
+	if !slices.ContainsFunc(tags, func(t goserver.TagInfo) bool { return t.Name == from }) {
+		return goserver.Response(404, nil), nil
+	}
+	// Renaming into a used tag would silently merge them, sub-tags of the renamed one are fine
+	if slices.ContainsFunc(tags, func(t goserver.TagInfo) bool {
+		return utils.IsTagOrSubTag(t.Name, to) && !utils.IsTagOrSubTag(t.Name, from)
+	}) {
+		return goserver.Response(409, nil), nil
+	}
+
+	res, err := s.db.RenameTag(familyID, from, to)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to rename tag")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, res), nil
+}
+
+func (s *TagsAPIServiceImpl) MergeTags(ctx context.Context, input goserver.TagMerge) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	target := utils.NormalizeTag(input.Target)
+	if target == "" || len(input.Sources) == 0 {
+		return goserver.Response(400, nil), nil
+	}
+	sources := make([]string, 0, len(input.Sources))
+	for _, source := range input.Sources {
+		source = utils.NormalizeTag(source)
+		// A tag can't be merged into itself or into its own sub-tag
+		if source == "" || utils.IsTagOrSubTag(target, source) {
+			return goserver.Response(400, nil), nil
+		}
+		sources = append(sources, source)
+	}
+
+	res, err := s.db.MergeTags(familyID, sources, target)
+	if err != nil {
+		s.logger.With("error", err, "sources", sources).Error("Failed to merge tags")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, res), nil
+}
+
+func (s *TagsAPIServiceImpl) GetTagAggregation(
+	ctx context.Context, dateFrom, dateTo time.Time, outputCurrencyID, granularityName, accountType string,
+	tagsFilter []string, depth int32, includeHidden bool,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	granularity := getGranularity(s.logger, s.db, familyID, granularityName)
+	// Without dates the current month is used, whatever the granularity is
+	currentMonth := utils.FinancialMonth(granularity.MonthStartDay())
+	if dateFrom.IsZero() {
+		dateFrom = utils.RoundToGranularity(time.Now(), currentMonth, false)
+	}
+	if dateTo.IsZero() {
+		dateTo = utils.RoundToGranularity(time.Now(), currentMonth, true)
+	}
+	dateFrom = utils.RoundToGranularity(dateFrom, granularity, false)
+	dateTo = utils.RoundToGranularity(dateTo, granularity, true)
+
+	accounts, err := s.db.GetAccounts(familyID)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to get accounts")
+		return goserver.Response(500, nil), nil
+	}
+	transactions, err := s.db.GetTransactions(familyID, dateFrom, dateTo, false)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to get transactions")
+		return goserver.Response(500, nil), nil
+	}
+	// Linked refunds reduce the expense category of the original transaction
+	transactions = common.NetRefunds(s.logger, s.db, familyID, accounts, transactions)
+
+	if accountType == "" {
+		accountType = constants.AccountExpense
+	}
+	filter := func(a goserver.Account) bool {
+		return a.Type == accountType && (includeHidden || !a.HideFromReports)
+	}
+	roots := make([]string, 0, len(tagsFilter))
+	for _, tag := range tagsFilter {
+		if tag = utils.NormalizeTag(tag); tag != "" {
+			roots = append(roots, tag)
+		}
+	}
+
+	res := AggregateTags(
+		ctx, accounts, transactions, dateFrom, dateTo, granularity,
+		outputCurrencyID, s.rates.Fetcher(familyID),
+		buildCurrencyMap(s.logger, s.db, familyID), filter,
+		roots, int(depth), accountType == constants.AccountIncome,
+		s.logger)
+
+	return goserver.Response(200, &res), nil
+}
+
+// AggregateTags sums movements of accounts passing the filter per tag and interval. Amounts of a
+// tag include its sub-tags, a transaction is counted once per tag even if it has several sub-tags
+// of it. Only roots and their sub-tags are reported if roots are given, and only tags up to depth
+// levels if depth is positive. Amounts are negated if negate is set, e.g. to report incomes as
+// positive numbers.
+func AggregateTags(
+	ctx context.Context, accounts []goserver.Account, transactions []goserver.Transaction,
+	dateFrom, dateTo time.Time, granularity utils.Granularity,
+	outputCurrencyID string, currenciesRatesFetcher *common.CurrenciesRatesFetcher,
+	currencyMap map[string]string, accountFilter AccountFilter,
+	roots []string, depth int, negate bool,
+	log *slog.Logger,
+) goserver.TagAggregation {
+	res := goserver.TagAggregation{
+		From:        dateFrom,
+		To:          dateTo,
+		Granularity: string(granularity.Base()),
+		Intervals:   getIntervals(dateFrom, dateTo, granularity),
+		Currencies:  []goserver.TagCurrencyAggregation{},
+	}
+
+	outputCurrencyName := ""
+	if outputCurrencyID != "" {
+		outputCurrencyName = currencyMap[outputCurrencyID]
+	}
+
+	// amounts[currencyID][tag] are amounts per interval
+	amounts := make(map[string]map[string][]decimal.Decimal)
+	for _, t := range transactions {
+		if t.Date.Before(dateFrom) || !t.Date.Before(dateTo) {
+			continue
+		}
+		tags := getAggregatedTags(t.Tags, roots, depth)
+		if len(tags) == 0 {
+			continue
+		}
+		intervalIdx := 0
+		for i, interval := range res.Intervals {
+			if !t.Date.Before(interval) {
+				intervalIdx = i
+			}
+		}
+
+		for _, m := range getMovements(accounts, t, accountFilter) {
+			if m.AccountId == "" {
+				continue
+			}
+			amount, currencyID, sources := convertTransactionMovement(
+				ctx, m, t.Date, t.ExchangeRates, outputCurrencyID, outputCurrencyName,
+				currencyMap, currenciesRatesFetcher, log)
+			res.RateSources = addRateSources(res.RateSources, sources...)
+			if negate {
+				amount = amount.Neg()
+			}
+			if _, ok := amounts[currencyID]; !ok {
+				amounts[currencyID] = make(map[string][]decimal.Decimal)
+			}
+			for _, tag := range tags {
+				if _, ok := amounts[currencyID][tag]; !ok {
+					amounts[currencyID][tag] = make([]decimal.Decimal, len(res.Intervals))
+				}
+				amounts[currencyID][tag][intervalIdx] = amounts[currencyID][tag][intervalIdx].Add(amount)
+			}
+		}
+	}
+
+	for currencyID, tags := range amounts {
+		currency := goserver.TagCurrencyAggregation{CurrencyId: currencyID, Tags: []goserver.TagAmounts{}}
+		for tag, values := range tags {
+			currency.Tags = append(currency.Tags, goserver.TagAmounts{
+				Tag:     tag,
+				Parent:  utils.TagParent(tag),
+				Amounts: values,
+				Total:   decimal.Sum(decimal.Zero, values...),
+			})
+		}
+		slices.SortFunc(currency.Tags, func(a, b goserver.TagAmounts) int {
+			return utils.CompareTags(a.Tag, b.Tag)
+		})
+		res.Currencies = append(res.Currencies, currency)
+	}
+	slices.SortFunc(res.Currencies, func(a, b goserver.TagCurrencyAggregation) int {
+		return cmp.Compare(a.CurrencyId, b.CurrencyId)
+	})
+
+	return res
+}
+
+// getAggregatedTags returns the tags and all their parents which should be reported, each once.
+func getAggregatedTags(transactionTags, roots []string, depth int) []string {
+	res := []string{}
+	for _, tag := range transactionTags {
+		for _, t := range utils.TagWithAncestors(utils.NormalizeTag(tag)) {
+			if depth > 0 && utils.TagDepth(t) > depth {
+				break
+			}
+			if len(roots) > 0 && !slices.ContainsFunc(roots, func(root string) bool {
+				return utils.IsTagOrSubTag(t, root)
+			}) {
+				continue
+			}
+			if !slices.Contains(res, t) {
+				res = append(res, t)
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,163 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Tags API", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockStorage *mocks.MockStorage
+		sut         *api.TagsAPIServiceImpl
+		ctx         context.Context
+		log         = test.CreateTestLogger()
+		familyID    = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	)
+
+	accounts := []goserver.Account{
+		{Id: "bank", Name: "Bank", Type: "asset"},
+		{Id: "hotels", Name: "Hotels", Type: "expense"},
+		{Id: "food", Name: "Food", Type: "expense"},
+		{Id: "salary", Name: "Salary", Type: "income"},
+	}
+	transaction := func(date time.Time, account string, amount int64, tags ...string) goserver.Transaction {
+		return goserver.Transaction{
+			Id:   uuid.NewString(),
+			Date: date,
+			Tags: tags,
+			Movements: []goserver.Movement{
+				{AccountId: "bank", Amount: decimal.NewFromInt(-amount), CurrencyId: "czk"},
+				{AccountId: account, Amount: decimal.NewFromInt(amount), CurrencyId: "czk"},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockStorage = mocks.NewMockStorage(ctrl)
+		sut = api.NewTagsAPIServiceImpl(log, mockStorage, common.NewRateService(log, mockStorage, 0))
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+
+		mockStorage.EXPECT().GetAccounts(familyID).Return(accounts, nil).AnyTimes()
+		mockStorage.EXPECT().GetCurrencies(familyID).Return([]goserver.Currency{{Id: "czk", Name: "CZK"}}, nil).AnyTimes()
+		mockStorage.EXPECT().GetUser(familyID).Return(&models.User{}, nil).AnyTimes()
+		mockStorage.EXPECT().GetFamily(familyID).Return(&models.Family{}, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("sums trip costs across expense accounts by tag and sub-tag", func() {
+		dateFrom := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+		dateTo := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+		mockStorage.EXPECT().GetTransactions(familyID, dateFrom, dateTo, false).Return([]goserver.Transaction{
+			transaction(time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC), "hotels", 300, "travel/2026-italy"),
+			transaction(time.Date(2026, 6, 2, 0, 0, 0, 0, time.UTC), "food", 100, "travel/2026-italy"),
+			// Counted once in the common parent
+			transaction(time.Date(2026, 6, 5, 0, 0, 0, 0, time.UTC), "food", 50, "travel/2026-italy", "travel/2026-spain"),
+			transaction(time.Date(2026, 6, 7, 0, 0, 0, 0, time.UTC), "food", 20, "groceries"),
+			transaction(time.Date(2026, 6, 8, 0, 0, 0, 0, time.UTC), "food", 10),
+		}, nil)
+
+		resp, err := sut.GetTagAggregation(ctx, dateFrom, dateTo, "", "month", "", []string{"travel"}, 0, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		agg := resp.Body.(*goserver.TagAggregation)
+
+		Expect(agg.Intervals).To(HaveLen(2))
+		Expect(agg.Currencies).To(HaveLen(1))
+		tags := agg.Currencies[0].Tags
+		Expect(tags).To(HaveLen(3))
+
+		Expect(tags[0].Tag).To(Equal("travel"))
+		Expect(tags[0].Parent).To(BeEmpty())
+		Expect(tags[0].Amounts[0].Equal(decimal.NewFromInt(300))).To(BeTrue())
+		Expect(tags[0].Amounts[1].Equal(decimal.NewFromInt(150))).To(BeTrue())
+		Expect(tags[0].Total.Equal(decimal.NewFromInt(450))).To(BeTrue())
+
+		Expect(tags[1].Tag).To(Equal("travel/2026-italy"))
+		Expect(tags[1].Parent).To(Equal("travel"))
+		Expect(tags[1].Total.Equal(decimal.NewFromInt(450))).To(BeTrue())
+
+		Expect(tags[2].Tag).To(Equal("travel/2026-spain"))
+		Expect(tags[2].Total.Equal(decimal.NewFromInt(50))).To(BeTrue())
+	})
+
+	It("reports incomes as positive amounts limited by depth", func() {
+		dateFrom := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+		dateTo := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+		salary := transaction(time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC), "salary", 0, "work/bonus")
+		salary.Movements[0].Amount = decimal.NewFromInt(1000)
+		salary.Movements[1].Amount = decimal.NewFromInt(-1000)
+		mockStorage.EXPECT().GetTransactions(familyID, dateFrom, dateTo, false).
+			Return([]goserver.Transaction{salary}, nil)
+
+		resp, err := sut.GetTagAggregation(ctx, dateFrom, dateTo, "", "month", "income", nil, 1, false)
+		Expect(err).NotTo(HaveOccurred())
+		agg := resp.Body.(*goserver.TagAggregation)
+		Expect(agg.Currencies).To(HaveLen(1))
+		Expect(agg.Currencies[0].Tags).To(HaveLen(1))
+		Expect(agg.Currencies[0].Tags[0].Tag).To(Equal("work"))
+		Expect(agg.Currencies[0].Tags[0].Total.Equal(decimal.NewFromInt(1000))).To(BeTrue())
+	})
+
+	It("refuses to rename into a used tag", func() {
+		mockStorage.EXPECT().GetTags(familyID).Return([]goserver.TagInfo{
+			{Name: "car"},
+			{Name: "car/fuel", Parent: "car", TransactionCount: 1},
+			{Name: "car/gas", Parent: "car", TransactionCount: 1},
+		}, nil).Times(3)
+
+		resp, err := sut.RenameTag(ctx, goserver.TagRename{From: "car/gas", To: "car/fuel"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusConflict))
+
+		resp, err = sut.RenameTag(ctx, goserver.TagRename{From: "boat", To: "ship"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusNotFound))
+
+		mockStorage.EXPECT().RenameTag(familyID, "car", "auto").
+			Return(goserver.TagChangeResult{Transactions: 2}, nil)
+		resp, err = sut.RenameTag(ctx, goserver.TagRename{From: " car ", To: "auto"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusOK))
+	})
+
+	It("merges tags into the target", func() {
+		mockStorage.EXPECT().MergeTags(familyID, []string{"car/gas", "petrol"}, "car/fuel").
+			Return(goserver.TagChangeResult{Transactions: 5, Matchers: 1}, nil)
+
+		resp, err := sut.MergeTags(ctx, goserver.TagMerge{Sources: []string{"car/gas", "petrol"}, Target: "car/fuel"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Body).To(Equal(goserver.TagChangeResult{Transactions: 5, Matchers: 1}))
+
+		resp, err = sut.MergeTags(ctx, goserver.TagMerge{Sources: []string{"car/fuel"}, Target: "car/fuel"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+
+		// A tag can't be nested into itself
+		resp, err = sut.MergeTags(ctx, goserver.TagMerge{Sources: []string{"petrol", "car"}, Target: "car/fuel"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		resp, err = sut.RenameTag(ctx, goserver.TagRename{From: "car", To: "car/fuel"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+	})
+})
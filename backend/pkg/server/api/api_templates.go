@@ -52,6 +52,9 @@ func (s *TemplatesAPIServiceImpl) CreateTemplate(
 	if len(t.Movements) == 0 {
 		return goserver.Response(http.StatusBadRequest, "movements must not be empty"), nil
 	}
+	if t.Repeat != "" && t.NextDate.IsZero() {
+		return goserver.Response(http.StatusBadRequest, "nextDate is required for repeated templates"), nil
+	}
 
 	result, err := s.db.CreateTemplate(familyID, &t)
 	if err != nil {
@@ -71,6 +74,10 @@ func (s *TemplatesAPIServiceImpl) UpdateTemplate(
 		return goserver.Response(http.StatusInternalServerError, nil), nil
 	}
 
+	if t.Repeat != "" && t.NextDate.IsZero() {
+		return goserver.Response(http.StatusBadRequest, "nextDate is required for repeated templates"), nil
+	}
+
 	result, err := s.db.UpdateTemplate(familyID, id, &t)
 	if err != nil {
 		s.logger.With("error", err).Error("Failed to update template")
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
 	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
@@ -31,11 +32,33 @@ func (s *TransactionsAPIServiceImpl) ParseTransaction(
 		return goserver.Response(500, nil), nil
 	}
 
-	today := time.Now().UTC().Truncate(24 * time.Hour)
-	transaction, warnings := common.ParseTransactionText(req.Text, accounts, currencies, today)
+	templates, err := s.db.GetTemplates(familyID, nil)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to get templates for parser")
+		return goserver.Response(500, nil), nil
+	}
+
+	opts := common.TransactionParseOptions{
+		Accounts:   accounts,
+		Currencies: currencies,
+		Templates:  templates,
+		Today:      time.Now().UTC().Truncate(24 * time.Hour),
+	}
+	// Defaults for omitted currency and account come from user preferences
+	if userID, ok := ctx.Value(constants.UserIDKey).(uuid.UUID); ok {
+		if user, err := s.db.GetUser(userID); err == nil && user != nil {
+			opts.DefaultCurrencyID = user.FavoriteCurrencyID
+			opts.DefaultAccountID = user.QuickEntryAccountID
+		} else if err != nil {
+			s.logger.With("error", err).Warn("Failed to get user preferences for parser")
+		}
+	}
+
+	transaction, warnings := common.ParseTransaction(req.Text, opts)
 
 	return goserver.Response(200, goserver.TransactionParseResponse{
 		Transaction: transaction,
-		Warnings:    warnings,
+		Warnings:    common.ParseWarningMessages(warnings),
+		Details:     warnings,
 	}), nil
 }
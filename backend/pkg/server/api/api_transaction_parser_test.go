@@ -0,0 +1,82 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("ParseTransaction API", func() {
+	familyID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	var (
+		ctrl   *gomock.Controller
+		mockDB *mocks.MockStorage
+		sut    goserver.TransactionsAPIServicer
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDB = mocks.NewMockStorage(ctrl)
+		sut = api.NewTransactionsAPIService(
+			test.CreateTestLogger(), mockDB, common.NewRateService(test.CreateTestLogger(), mockDB, 0))
+		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, familyID)
+		ctx = context.WithValue(ctx, constants.UserIDKey, familyID)
+
+		mockDB.EXPECT().GetAccounts(familyID).Return([]goserver.Account{
+			{Id: "acc-kb", Name: "KB Current"},
+			{Id: "acc-food", Name: "Food"},
+		}, nil)
+		mockDB.EXPECT().GetCurrencies(familyID).Return([]goserver.Currency{{Id: "cur-czk", Name: "CZK"}}, nil)
+		mockDB.EXPECT().GetTemplates(familyID, nil).Return([]goserver.TransactionTemplate{}, nil)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("uses user preferences for omitted currency and account", func() {
+		mockDB.EXPECT().GetUser(familyID).Return(&models.User{
+			FavoriteCurrencyID:  "cur-czk",
+			QuickEntryAccountID: "acc-kb",
+		}, nil)
+
+		resp, err := sut.ParseTransaction(ctx, goserver.TransactionParseRequest{Text: "250 to Food lunch"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		body := resp.Body.(goserver.TransactionParseResponse)
+		Expect(body.Warnings).To(BeEmpty())
+		Expect(body.Transaction.Description).To(Equal("lunch"))
+		Expect(body.Transaction.Movements).To(HaveLen(2))
+		Expect(body.Transaction.Movements[0].AccountId).To(Equal("acc-kb"))
+		Expect(body.Transaction.Movements[0].CurrencyId).To(Equal("cur-czk"))
+		Expect(body.Transaction.Movements[0].Amount.Equal(decimal.NewFromInt(-250))).To(BeTrue())
+	})
+
+	It("returns structured warnings along with messages", func() {
+		mockDB.EXPECT().GetUser(familyID).Return(&models.User{}, nil)
+
+		resp, err := sut.ParseTransaction(ctx, goserver.TransactionParseRequest{Text: "250 to Food lunch"})
+		Expect(err).NotTo(HaveOccurred())
+
+		body := resp.Body.(goserver.TransactionParseResponse)
+		Expect(body.Details).To(HaveLen(1))
+		Expect(body.Details[0].Code).To(Equal(common.ParseWarningMissingCurrency))
+		Expect(body.Warnings).To(Equal([]string{body.Details[0].Message}))
+	})
+})
@@ -2,23 +2,29 @@ package api
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 )
 
 type TransactionsAPIServiceImpl struct {
 	logger *slog.Logger
 	db     database.Storage
+	rates  *common.RateService
 }
 
-func NewTransactionsAPIService(logger *slog.Logger, db database.Storage) goserver.TransactionsAPIServicer {
-	return &TransactionsAPIServiceImpl{logger: logger, db: db}
+func NewTransactionsAPIService(
+	logger *slog.Logger, db database.Storage, rates *common.RateService,
+) goserver.TransactionsAPIServicer {
+	return &TransactionsAPIServiceImpl{logger: logger, db: db, rates: rates}
 }
 
 func (s *TransactionsAPIServiceImpl) GetTransactions(
@@ -58,6 +64,7 @@ func (s *TransactionsAPIServiceImpl) CreateTransaction(
 		s.logger.With("error", err).Error("Failed to create transaction")
 		return goserver.Response(500, nil), nil
 	}
+	s.checkBudgetAlerts(ctx, familyID)
 
 	return goserver.Response(200, transaction), nil
 }
@@ -66,10 +73,11 @@ func (s *TransactionsAPIServiceImpl) UpdateTransaction(
 	ctx context.Context, transactionID string, transactionNoID goserver.TransactionNoId,
 ) (goserver.ImplResponse, error) {
 	s.logger.Info("Processing transaction update", "transaction", transactionID)
-	res, _, err := updateEntity[goserver.TransactionNoIdInterface, goserver.Transaction](ctx, s.logger, "transaction", transactionID, &transactionNoID, s.db.UpdateTransaction)
+	res, familyID, err := updateEntity[goserver.TransactionNoIdInterface, goserver.Transaction](ctx, s.logger, "transaction", transactionID, &transactionNoID, s.db.UpdateTransaction)
 	if err != nil {
 		return mapErrorToResponse(err), nil
 	}
+	s.checkBudgetAlerts(ctx, familyID)
 
 	return goserver.Response(http.StatusOK, res), nil
 }
@@ -88,6 +96,7 @@ func (s *TransactionsAPIServiceImpl) DeleteTransaction(
 		s.logger.With("error", err).Error("Failed to delete transaction")
 		return goserver.Response(500, nil), nil
 	}
+	s.checkBudgetAlerts(ctx, familyID)
 
 	return goserver.Response(200, nil), nil
 }
@@ -129,3 +138,81 @@ func (s *TransactionsAPIServiceImpl) MergeTransactions(
 
 	return goserver.Response(200, transaction), nil
 }
+
+func (s *TransactionsAPIServiceImpl) GetRefundCandidates(
+	ctx context.Context, transactionID string,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("FamilyID not found in context")
+		return goserver.Response(500, nil), nil
+	}
+
+	candidates, err := common.FindRefundCandidates(s.db, familyID, transactionID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(404, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to find refund candidates")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, candidates), nil
+}
+
+func (s *TransactionsAPIServiceImpl) LinkRefund(
+	ctx context.Context, transactionID string, linkRequest goserver.LinkRefundRequest,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("FamilyID not found in context")
+		return goserver.Response(500, nil), nil
+	}
+
+	s.logger.Info("Processing refund link", "id", transactionID, "original", linkRequest.OriginalId, "kind", linkRequest.Kind)
+
+	transaction, err := s.db.LinkRefund(familyID, transactionID, linkRequest.OriginalId, linkRequest.Kind)
+	if err != nil {
+		switch {
+		case errors.Is(err, database.ErrNotFound):
+			return goserver.Response(404, nil), nil
+		case errors.Is(err, database.ErrInvalidRefundLink):
+			return goserver.Response(400, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to link refund")
+		return goserver.Response(500, nil), nil
+	}
+	// Refunds are netted with their originals, so the spending of budgets changes
+	s.checkBudgetAlerts(ctx, familyID)
+
+	return goserver.Response(200, transaction), nil
+}
+
+func (s *TransactionsAPIServiceImpl) UnlinkRefund(
+	ctx context.Context, transactionID string,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		s.logger.Error("FamilyID not found in context")
+		return goserver.Response(500, nil), nil
+	}
+
+	transaction, err := s.db.UnlinkRefund(familyID, transactionID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(404, nil), nil
+		}
+		s.logger.With("error", err).Error("Failed to unlink refund")
+		return goserver.Response(500, nil), nil
+	}
+	s.checkBudgetAlerts(ctx, familyID)
+
+	return goserver.Response(200, transaction), nil
+}
+
+// checkBudgetAlerts notifies about budgets reaching their alerts after a change of transactions.
+func (s *TransactionsAPIServiceImpl) checkBudgetAlerts(ctx context.Context, familyID uuid.UUID) {
+	if err := CheckBudgetAlerts(ctx, s.logger, s.db, s.rates, familyID, time.Now()); err != nil {
+		s.logger.With("error", err).Error("Failed to check budget alerts")
+	}
+}
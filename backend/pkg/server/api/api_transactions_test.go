@@ -15,6 +15,7 @@ import (
 	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
 	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 	"github.com/ya-breeze/geekbudgetbe/test"
 )
 
@@ -30,7 +31,7 @@ var _ = Describe("Transactions API", func() {
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockStorage = mocks.NewMockStorage(ctrl)
-		sut = api.NewTransactionsAPIService(log, mockStorage)
+		sut = api.NewTransactionsAPIService(log, mockStorage, common.NewRateService(log, mockStorage, 0))
 		ctx = context.WithValue(context.Background(), constants.FamilyIDKey, uuid.MustParse("00000000-0000-0000-0000-000000000001"))
 	})
 
@@ -72,6 +73,8 @@ var _ = Describe("Transactions API", func() {
 		mockStorage.EXPECT().
 			UpdateTransaction(userID, transactionID, &input).
 			Return(expectedOutput, nil)
+		// Budget alerts are checked after the change
+		mockStorage.EXPECT().GetAccounts(userID).Return(nil, nil)
 
 		resp, err := sut.UpdateTransaction(ctx, transactionID, input)
 		Expect(err).ToNot(HaveOccurred())
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+)
+
+type TransfersAPIServiceImpl struct {
+	logger *slog.Logger
+	db     database.Storage
+	rates  *common.RateService
+}
+
+func NewTransfersAPIService(
+	logger *slog.Logger, db database.Storage, rates *common.RateService,
+) goserver.TransfersAPIServicer {
+	return &TransfersAPIServiceImpl{logger: logger, db: db, rates: rates}
+}
+
+func (s *TransfersAPIServiceImpl) GetTransferCandidates(
+	ctx context.Context, dateFrom, dateTo time.Time,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	candidates, err := common.FindTransferCandidates(ctx, s.logger, s.db, s.rates, familyID, dateFrom, dateTo)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to find transfer candidates")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, candidates), nil
+}
+
+func (s *TransfersAPIServiceImpl) ConfirmTransfer(
+	ctx context.Context, req goserver.ConfirmTransferRequest,
+) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	transaction, err := s.db.ConfirmTransfer(familyID, req.OutgoingId, req.IncomingId)
+	if err != nil {
+		switch {
+		case errors.Is(err, database.ErrNotFound):
+			return goserver.Response(404, nil), nil
+		case errors.Is(err, database.ErrNotTransferPair):
+			return goserver.Response(400, nil), nil
+		}
+		s.logger.With("error", err, "outgoingId", req.OutgoingId, "incomingId", req.IncomingId).
+			Error("Failed to confirm transfer")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, transaction), nil
+}
+
+func (s *TransfersAPIServiceImpl) GetTransferRules(ctx context.Context) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	rules, err := s.db.GetTransferRules(familyID)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to get transfer rules")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(200, rules), nil
+}
+
+func (s *TransfersAPIServiceImpl) DeleteTransferRule(ctx context.Context, id string) (goserver.ImplResponse, error) {
+	familyID, ok := constants.GetFamilyID(ctx)
+	if !ok {
+		return goserver.Response(500, nil), nil
+	}
+
+	if err := s.db.DeleteTransferRule(familyID, id); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return goserver.Response(404, nil), nil
+		}
+		s.logger.With("error", err, "id", id).Error("Failed to delete transfer rule")
+		return goserver.Response(500, nil), nil
+	}
+
+	return goserver.Response(204, nil), nil
+}
@@ -20,6 +20,7 @@ import (
 type UnprocessedTransactionsAPIServiceImpl struct {
 	logger *slog.Logger
 	db     database.Storage
+	rates  *common.RateService
 }
 
 func (s *UnprocessedTransactionsAPIServiceImpl) ProcessUnprocessedTransactionsAgainstMatcher(
@@ -86,6 +87,7 @@ func (s *UnprocessedTransactionsAPIServiceImpl) ProcessUnprocessedTransactionsAg
 	}
 
 	unprocessed := s.filterUnprocessedTransactions(allTransactions, ignoreBeforeMap)
+	loans := common.NewLoanPaymentSplitter(s.logger, accounts, allTransactions)
 	var processedIDs []string
 
 	for _, t := range unprocessed {
@@ -152,6 +154,7 @@ func (s *UnprocessedTransactionsAPIServiceImpl) ProcessUnprocessedTransactionsAg
 			transactionNoId.Description = description
 			transactionNoId.Tags = tags
 			transactionNoId.Movements = proposedMovements
+			loans.Split(t.Id, transactionNoId)
 			transactionNoId.IsAuto = true
 			// Merge tags
 			transactionNoId.Tags = append(transactionNoId.Tags, matcher.OutputTags...)
@@ -184,9 +187,9 @@ func (s *UnprocessedTransactionsAPIServiceImpl) ProcessUnprocessedTransactionsAg
 	return processedIDs, nil
 }
 
-func NewUnprocessedTransactionsAPIServiceImpl(logger *slog.Logger, db database.Storage,
+func NewUnprocessedTransactionsAPIServiceImpl(logger *slog.Logger, db database.Storage, rates *common.RateService,
 ) *UnprocessedTransactionsAPIServiceImpl {
-	return &UnprocessedTransactionsAPIServiceImpl{logger: logger, db: db}
+	return &UnprocessedTransactionsAPIServiceImpl{logger: logger, db: db, rates: rates}
 }
 
 func (s *UnprocessedTransactionsAPIServiceImpl) Convert(
@@ -194,6 +197,14 @@ func (s *UnprocessedTransactionsAPIServiceImpl) Convert(
 ) (*goserver.Transaction, error) {
 	s.logger.Info("Converting unprocessed transaction", "transaction", id, "user", familyID)
 
+	if t, ok := transactionNoID.(*goserver.TransactionNoId); ok {
+		loans, err := common.LoadLoanPaymentSplitter(s.logger, s.db, familyID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load loans: %w", err)
+		}
+		loans.Split(id, t)
+	}
+
 	transaction, err := s.db.UpdateTransactionInternal(familyID, id, transactionNoID)
 	if err != nil {
 		s.logger.With("error", err).Error("Failed to convert unprocessed transaction")
@@ -441,6 +452,9 @@ func (s *UnprocessedTransactionsAPIServiceImpl) ConvertUnprocessedTransaction(
 			s.logger.With("error", err, "accountId", accID).Error("Failed to check balance after conversion")
 		}
 	}
+	if err := CheckBudgetAlerts(ctx, s.logger, s.db, s.rates, familyID, time.Now()); err != nil {
+		s.logger.With("error", err).Error("Failed to check budget alerts after conversion")
+	}
 
 	return goserver.Response(200, goserver.ConvertUnprocessedTransaction200Response{
 		Transaction:      *transaction,
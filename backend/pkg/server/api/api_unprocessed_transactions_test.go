@@ -14,6 +14,7 @@ import (
 	"github.com/ya-breeze/geekbudgetbe/pkg/database"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 	"github.com/ya-breeze/geekbudgetbe/test"
 )
 
@@ -28,7 +29,7 @@ var _ = Describe("UnprocessedTransactions API", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockDB = mocks.NewMockStorage(mockCtrl)
-		sut = NewUnprocessedTransactionsAPIServiceImpl(logger, mockDB)
+		sut = NewUnprocessedTransactionsAPIServiceImpl(logger, mockDB, common.NewRateService(logger, mockDB, 0))
 	})
 
 	AfterEach(func() {
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 
 	"github.com/google/uuid"
@@ -10,6 +11,8 @@ import (
 	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
 )
 
 type UserAPIServiceImpl struct {
@@ -39,7 +42,9 @@ func (s *UserAPIServiceImpl) GetUser(ctx context.Context) (goserver.ImplResponse
 		return goserver.Response(404, nil), nil
 	}
 
-	return goserver.Response(200, user.FromDB()), nil
+	res := user.FromDB()
+	common.FamilySettings(s.logger, s.db, user.FamilyID).FillUser(&res)
+	return goserver.Response(200, res), nil
 }
 
 // UpdateUserFavoriteCurrency - update user's favorite currency
@@ -60,16 +65,96 @@ func (s *UserAPIServiceImpl) UpdateUserFavoriteCurrency(
 		s.logger.With("error", err).Error("Failed to get user")
 		return goserver.Response(500, nil), nil
 	}
+	// Settings used by reports, budgets and background tasks are shared by the family
+	family, err := s.db.GetFamily(user.FamilyID)
+	if err != nil {
+		s.logger.With("error", err).Error("Failed to get family")
+		return goserver.Response(500, nil), nil
+	}
 
 	// Standard PATCH logic: take the value from the request body as-is.
 	// Sending a non-empty favoriteCurrencyId sets the favorite currency;
 	// sending an empty string clears it.
 	user.FavoriteCurrencyID = body.FavoriteCurrencyId
+	// quickEntryAccountId is nullable, so clients which don't know it don't clear it
+	if body.QuickEntryAccountId != nil {
+		if *body.QuickEntryAccountId != "" {
+			account, err := s.db.GetAccount(user.FamilyID, *body.QuickEntryAccountId)
+			if err != nil {
+				if errors.Is(err, database.ErrNotFound) {
+					return goserver.Response(400, "unknown quick entry account "+*body.QuickEntryAccountId), nil
+				}
+				s.logger.With("error", err).Error("Failed to get quick entry account")
+				return goserver.Response(500, nil), nil
+			}
+			if account.Type != constants.AccountAsset {
+				return goserver.Response(400, "quick entry account must be an asset account"), nil
+			}
+		}
+		user.QuickEntryAccountID = *body.QuickEntryAccountId
+	}
+	if body.MonthStartDay != nil {
+		if *body.MonthStartDay < 1 || *body.MonthStartDay > utils.MaxMonthStartDay {
+			return goserver.Response(400, fmt.Sprintf("month start day must be between 1 and %d",
+				utils.MaxMonthStartDay)), nil
+		}
+		family.MonthStartDay = int(*body.MonthStartDay)
+	}
+	if body.AnomalySensitivity != nil {
+		if !utils.IsAnomalySensitivity(*body.AnomalySensitivity) {
+			return goserver.Response(400, "unknown anomaly sensitivity "+*body.AnomalySensitivity), nil
+		}
+		family.AnomalySensitivity = *body.AnomalySensitivity
+	}
+	if body.AnomalyMutedAccountIds != nil {
+		for _, accountID := range *body.AnomalyMutedAccountIds {
+			if _, err := s.db.GetAccount(user.FamilyID, accountID); err != nil {
+				if errors.Is(err, database.ErrNotFound) {
+					return goserver.Response(400, "unknown muted account "+accountID), nil
+				}
+				s.logger.With("error", err).Error("Failed to get muted account")
+				return goserver.Response(500, nil), nil
+			}
+		}
+		family.AnomalyMutedAccountIDs = *body.AnomalyMutedAccountIds
+	}
+	if body.BudgetOverspending != nil {
+		if !utils.IsBudgetOverspending(*body.BudgetOverspending) {
+			return goserver.Response(400, "unknown budget overspending "+*body.BudgetOverspending), nil
+		}
+		family.BudgetOverspending = *body.BudgetOverspending
+	}
+	if body.RateProviders != nil {
+		for _, provider := range *body.RateProviders {
+			if !common.IsRateProvider(provider) {
+				return goserver.Response(400, "unknown exchange rate provider "+provider), nil
+			}
+		}
+		family.RateProviders = *body.RateProviders
+	}
+	if body.RateBaseCurrencyId != nil {
+		if *body.RateBaseCurrencyId != "" {
+			if _, err := s.db.GetCurrency(user.FamilyID, *body.RateBaseCurrencyId); err != nil {
+				if errors.Is(err, database.ErrNotFound) {
+					return goserver.Response(400, "unknown exchange rate base currency "+*body.RateBaseCurrencyId), nil
+				}
+				s.logger.With("error", err).Error("Failed to get exchange rate base currency")
+				return goserver.Response(500, nil), nil
+			}
+		}
+		family.RateBaseCurrencyID = *body.RateBaseCurrencyId
+	}
 
 	if err := s.db.PutUser(user); err != nil {
 		s.logger.With("error", err).Error("Failed to update user")
 		return goserver.Response(500, nil), nil
 	}
+	if err := s.db.PutFamily(family); err != nil {
+		s.logger.With("error", err).Error("Failed to update family")
+		return goserver.Response(500, nil), nil
+	}
 
-	return goserver.Response(200, user.FromDB()), nil
+	res := user.FromDB()
+	family.FillUser(&res)
+	return goserver.Response(200, res), nil
 }
@@ -0,0 +1,146 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/api"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("User API", func() {
+	var (
+		st     database.Storage
+		ctx    context.Context
+		log    = test.CreateTestLogger()
+		family *models.Family
+		sut    goserver.UserAPIServicer
+	)
+
+	BeforeEach(func() {
+		st = database.NewStorage(log, &config.Config{DBPath: ":memory:"})
+		Expect(st.Open()).To(Succeed())
+		DeferCleanup(st.Close)
+
+		var err error
+		family, err = st.CreateFamily("family")
+		Expect(err).ToNot(HaveOccurred())
+		// User IDs are never family IDs
+		user, err := st.CreateUser("user@test.com", "hash", family.ID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(user.ID).ToNot(Equal(family.ID))
+		ctx = context.WithValue(context.Background(), constants.UserIDKey, user.ID)
+		ctx = context.WithValue(ctx, constants.FamilyIDKey, family.ID)
+
+		sut = api.NewUserAPIService(log, st)
+	})
+
+	patch := func(body goserver.UserPatchBody) goserver.ImplResponse {
+		resp, err := sut.UpdateUserFavoriteCurrency(ctx, body)
+		Expect(err).ToNot(HaveOccurred())
+		return resp
+	}
+
+	It("stores the month start day for the whole family", func() {
+		day := int32(25)
+		resp := patch(goserver.UserPatchBody{MonthStartDay: &day})
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Body.(goserver.User).MonthStartDay).To(Equal(day))
+
+		Expect(common.BudgetMonth(log, st, family.ID)).To(Equal(utils.FinancialMonth(25)))
+
+		resp, err := sut.GetUser(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Body.(goserver.User).MonthStartDay).To(Equal(day))
+	})
+
+	It("rejects a month start day outside 1-28", func() {
+		for _, day := range []int32{0, 29} {
+			resp := patch(goserver.UserPatchBody{MonthStartDay: &day})
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		}
+		Expect(common.BudgetMonth(log, st, family.ID)).To(Equal(utils.GranularityMonth))
+	})
+
+	It("stores anomaly settings for the whole family and rejects unknown sensitivity and accounts", func() {
+		groceries, err := st.CreateAccount(family.ID, &goserver.AccountNoId{Name: "Groceries", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+		sensitivity := utils.AnomalySensitivityHigh
+		muted := []string{groceries.Id}
+		resp := patch(goserver.UserPatchBody{AnomalySensitivity: &sensitivity, AnomalyMutedAccountIds: &muted})
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		unknownMuted := []string{groceries.Id, uuid.NewString()}
+		resp = patch(goserver.UserPatchBody{AnomalyMutedAccountIds: &unknownMuted})
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+
+		settings := common.FamilySettings(log, st, family.ID)
+		Expect(settings.AnomalySensitivity).To(Equal(utils.AnomalySensitivityHigh))
+		Expect(settings.AnomalyMutedAccountIDs).To(Equal(muted))
+
+		unknown := "extreme"
+		resp = patch(goserver.UserPatchBody{AnomalySensitivity: &unknown})
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		Expect(common.FamilySettings(log, st, family.ID).AnomalySensitivity).To(Equal(utils.AnomalySensitivityHigh))
+	})
+
+	It("stores the exchange rate providers for the whole family and rejects unknown base currencies", func() {
+		eur, err := st.CreateCurrency(family.ID, &goserver.CurrencyNoId{Name: "EUR"})
+		Expect(err).ToNot(HaveOccurred())
+		providers := []string{common.RateProviderManual, common.RateProviderECB}
+		resp := patch(goserver.UserPatchBody{RateProviders: &providers, RateBaseCurrencyId: &eur.Id})
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Body.(goserver.User).RateProviders).To(Equal(providers))
+
+		settings := common.FamilySettings(log, st, family.ID)
+		Expect(settings.RateProviders).To(Equal(providers))
+		Expect(settings.RateBaseCurrencyID).To(Equal(eur.Id))
+
+		other, err := st.CreateCurrency(uuid.New(), &goserver.CurrencyNoId{Name: "USD"})
+		Expect(err).ToNot(HaveOccurred())
+		for _, id := range []string{other.Id, uuid.NewString()} {
+			resp = patch(goserver.UserPatchBody{RateBaseCurrencyId: &id})
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		}
+		Expect(common.FamilySettings(log, st, family.ID).RateBaseCurrencyID).To(Equal(eur.Id))
+	})
+
+	It("stores an asset account of the family as the quick entry account", func() {
+		bank, err := st.CreateAccount(family.ID, &goserver.AccountNoId{Name: "Bank", Type: constants.AccountAsset})
+		Expect(err).ToNot(HaveOccurred())
+		food, err := st.CreateAccount(family.ID, &goserver.AccountNoId{Name: "Food", Type: "expense"})
+		Expect(err).ToNot(HaveOccurred())
+		other, err := st.CreateAccount(uuid.New(), &goserver.AccountNoId{Name: "Other", Type: constants.AccountAsset})
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, id := range []string{food.Id, other.Id, uuid.NewString()} {
+			resp := patch(goserver.UserPatchBody{QuickEntryAccountId: &id})
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		}
+		resp := patch(goserver.UserPatchBody{QuickEntryAccountId: &bank.Id})
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Body.(goserver.User).QuickEntryAccountId).To(Equal(bank.Id))
+
+		empty := ""
+		resp = patch(goserver.UserPatchBody{QuickEntryAccountId: &empty})
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Body.(goserver.User).QuickEntryAccountId).To(BeEmpty())
+	})
+
+	It("rejects unknown budget overspending", func() {
+		overspending := "forgive"
+		resp := patch(goserver.UserPatchBody{BudgetOverspending: &overspending})
+		Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		Expect(common.BudgetOverspending(log, st, family.ID)).To(Equal(utils.BudgetOverspendingRollover))
+	})
+})
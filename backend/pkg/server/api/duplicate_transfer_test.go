@@ -16,6 +16,7 @@ import (
 	"github.com/ya-breeze/geekbudgetbe/pkg/database"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 	"github.com/ya-breeze/geekbudgetbe/test"
 )
 
@@ -35,7 +36,7 @@ var _ = Describe("Duplicate Transfer Handling", func() {
 		cfg := &config.Config{
 			BankImporterFilesPath: "storage/bank-importer-files",
 		}
-		sutBI = NewBankImportersAPIServiceImpl(logger, mockDB, cfg)
+		sutBI = NewBankImportersAPIServiceImpl(logger, mockDB, cfg, common.NewRateService(logger, mockDB, 0))
 		sutUT = &UnprocessedTransactionsAPIServiceImpl{logger: logger, db: mockDB}
 
 		mockDB.EXPECT().CountUnprocessedTransactionsForAccount(gomock.Any(), gomock.Any(), gomock.Any()).Return(1, nil).AnyTimes()
@@ -101,6 +102,8 @@ var _ = Describe("Duplicate Transfer Handling", func() {
 			mockDB.EXPECT().GetBankImporter(userID, "imp1").Return(goserver.BankImporter{}, nil).AnyTimes()
 			mockDB.EXPECT().UpdateBankImporter(userID, "imp1", gomock.Any()).Return(goserver.BankImporter{}, nil)
 
+			// Budget alerts are checked after the import
+			mockDB.EXPECT().GetAccounts(userID).Return(nil, nil)
 			_, err := sutBI.saveImportedTransactions(userID, "imp1", &goserver.BankAccountInfo{}, []goserver.TransactionNoId{importedTx}, false)
 			Expect(err).ToNot(HaveOccurred())
 		})
@@ -0,0 +1,162 @@
+package background
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+// anomalyRecentDays is how old transactions are checked for unusually large payments. It's longer
+// than the job interval, so transactions imported with a delay are checked too.
+const anomalyRecentDays = 7
+
+func StartAnomalyDetection(
+	ctx context.Context, logger *slog.Logger, db database.Storage,
+) <-chan struct{} {
+	logger.Info("Starting spending anomaly detection task...")
+
+	done := make(chan struct{})
+
+	go func() {
+		// Wait a bit before first run, so bank importers have a chance to fetch new transactions
+		select {
+		case <-time.After(10 * time.Minute):
+		case <-ctx.Done():
+			close(done)
+			return
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				close(done)
+				logger.Info("Stopped spending anomaly detection task")
+				return
+			default:
+				detectAnomalies(logger, db)
+
+				logger.Info("Delaying spending anomaly detection for 24 hours...")
+				select {
+				case <-time.After(24 * time.Hour):
+					continue
+				case <-ctx.Done():
+					continue
+				}
+			}
+		}
+	}()
+
+	return done
+}
+
+func detectAnomalies(logger *slog.Logger, db database.Storage) {
+	logger.Info("Running spending anomaly detection...")
+
+	familyIDs, err := db.GetAllFamilyIDs()
+	if err != nil {
+		logger.With("error", err).Error("Failed to get families for anomaly detection")
+		return
+	}
+
+	for _, familyID := range familyIDs {
+		processFamilyAnomalies(logger, db, familyID, time.Now())
+	}
+
+	logger.Info("Completed spending anomaly detection")
+}
+
+// processFamilyAnomalies notifies about unusual spending of expense accounts in the current
+// period and about unusually large payments to partners. Each anomaly is reported once, even if
+// the user dismissed its notification.
+func processFamilyAnomalies(logger *slog.Logger, db database.Storage, familyID uuid.UUID, now time.Time) {
+	logger.Info("Processing spending anomalies for family", "familyID", familyID)
+
+	family := common.FamilySettings(logger, db, familyID)
+	deviations, ok := utils.AnomalyDeviations(family.AnomalySensitivity)
+	if !ok {
+		return
+	}
+
+	accounts, err := db.GetAccounts(familyID)
+	if err != nil {
+		logger.With("error", err, "familyID", familyID).Error("Failed to get accounts for anomaly detection")
+		return
+	}
+	isExpense := func(accountID string) bool {
+		if slices.Contains(family.AnomalyMutedAccountIDs, accountID) {
+			return false
+		}
+		return utils.GetAccount(accountID, accounts).Type == constants.AccountExpense
+	}
+
+	currencies, err := db.GetCurrencies(familyID)
+	if err != nil {
+		logger.With("error", err, "familyID", familyID).Error("Failed to get currencies for anomaly detection")
+		return
+	}
+	currencyName := func(currencyID string) string {
+		for _, c := range currencies {
+			if c.Id == currencyID {
+				return c.Name
+			}
+		}
+		return currencyID
+	}
+
+	granularity := utils.FinancialMonth(family.MonthStartDay)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	current := utils.RoundToGranularity(today, granularity, false)
+	transactions, err := db.GetTransactions(
+		familyID, utils.AddIntervals(current, granularity, -utils.AnomalyHistoryPeriods), time.Time{}, false)
+	if err != nil {
+		logger.With("error", err, "familyID", familyID).Error("Failed to get transactions for anomaly detection")
+		return
+	}
+
+	notifications := []goserver.Notification{}
+	for _, a := range utils.DetectPeriodAnomalies(transactions, isExpense, granularity, today, deviations) {
+		// The link opens the calendar month with most days of the period
+		month := a.PeriodStart.AddDate(0, 0, 14)
+		notifications = append(notifications, goserver.Notification{
+			Url: fmt.Sprintf("/transactions?accountId=%s&month=%d&year=%d",
+				a.AccountID, int(month.Month())-1, month.Year()),
+			Title: "Unusual Spending: " + utils.GetAccount(a.AccountID, accounts).Name,
+			Description: fmt.Sprintf("Spent %s %s since %s, usually about %s %s per period.",
+				a.Amount.StringFixed(2), currencyName(a.CurrencyID), a.PeriodStart.Format(time.DateOnly),
+				a.Expected.StringFixed(2), currencyName(a.CurrencyID)),
+		})
+	}
+
+	recent := slices.DeleteFunc(slices.Clone(transactions), func(t goserver.Transaction) bool {
+		return t.Date.Before(today.AddDate(0, 0, -anomalyRecentDays))
+	})
+	for _, a := range utils.DetectTransactionAnomalies(transactions, recent, isExpense, deviations) {
+		notifications = append(notifications, goserver.Notification{
+			Url:   "/transactions/" + a.Transaction.Id,
+			Title: "Unusual Transaction: " + a.Transaction.PartnerName,
+			Description: fmt.Sprintf("Paid %s %s to %s on %s, usually about %s %s.",
+				a.Amount.StringFixed(2), currencyName(a.CurrencyID), a.Transaction.PartnerName,
+				a.Transaction.Date.Format(time.DateOnly), a.Expected.StringFixed(2), currencyName(a.CurrencyID)),
+		})
+	}
+
+	for _, n := range notifications {
+		n.Date = now
+		n.Type = string(models.NotificationTypeSpendingAnomaly)
+
+		// Every anomaly is reported once, even by overlapping runs
+		if _, err := db.CreateNotificationOnce(familyID, &n); err != nil {
+			logger.With("error", err, "familyID", familyID).Error("Failed to create notification for anomaly")
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package background
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/config"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Background Anomaly Detection", func() {
+	var (
+		mockCtrl *gomock.Controller
+		mockDB   *mocks.MockStorage
+		logger   = test.CreateTestLogger()
+		familyID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		now      = time.Date(2024, 12, 10, 12, 0, 0, 0, time.UTC)
+		accounts = []goserver.Account{
+			{Id: "fio", Name: "Fio", Type: constants.AccountAsset},
+			{Id: "groceries", Name: "Groceries", Type: constants.AccountExpense},
+		}
+		transactions []goserver.Transaction
+	)
+
+	spend := func(amount int64, date time.Time) goserver.Transaction {
+		return goserver.Transaction{
+			Id:   uuid.New().String(),
+			Date: date,
+			Movements: []goserver.Movement{
+				{AccountId: "fio", Amount: decimal.NewFromInt(-amount), CurrencyId: "czk"},
+				{AccountId: "groceries", Amount: decimal.NewFromInt(amount), CurrencyId: "czk"},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockDB = mocks.NewMockStorage(mockCtrl)
+
+		transactions = []goserver.Transaction{}
+		for i := 1; i <= 6; i++ {
+			transactions = append(transactions, spend(1000, now.AddDate(0, -i, 0)))
+		}
+		transactions = append(transactions, spend(3000, now))
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	expectData := func(family *models.Family) {
+		mockDB.EXPECT().GetFamily(familyID).Return(family, nil)
+		mockDB.EXPECT().GetAccounts(familyID).Return(accounts, nil)
+		mockDB.EXPECT().GetCurrencies(familyID).Return([]goserver.Currency{{Id: "czk", Name: "CZK"}}, nil)
+		mockDB.EXPECT().GetTransactions(familyID, time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), time.Time{}, false).
+			Return(transactions, nil)
+	}
+
+	Describe("processFamilyAnomalies", func() {
+		It("should notify about unusual spending once", func() {
+			expectData(&models.Family{})
+			url := "/transactions?accountId=groceries&month=11&year=2024"
+			mockDB.EXPECT().CreateNotificationOnce(familyID, gomock.Any()).DoAndReturn(func(_ uuid.UUID, n *goserver.Notification) (bool, error) {
+				Expect(n.Type).To(Equal(string(models.NotificationTypeSpendingAnomaly)))
+				Expect(n.Url).To(Equal(url))
+				Expect(n.Title).To(ContainSubstring("Groceries"))
+				Expect(n.Description).To(ContainSubstring("Spent 3000.00 CZK since 2024-12-01, usually about 1000.00 CZK"))
+				return true, nil
+			})
+
+			processFamilyAnomalies(logger, mockDB, familyID, now)
+		})
+
+		It("should not repeat reported anomalies", func() {
+			expectData(&models.Family{})
+			// The storage creates a notification once per type and URL
+			mockDB.EXPECT().CreateNotificationOnce(familyID, gomock.Any()).Return(false, nil)
+
+			processFamilyAnomalies(logger, mockDB, familyID, now)
+		})
+
+		It("should skip muted accounts", func() {
+			expectData(&models.Family{AnomalyMutedAccountIDs: []string{"groceries"}})
+			// No notification expected
+
+			processFamilyAnomalies(logger, mockDB, familyID, now)
+		})
+
+		It("should do nothing when anomaly detection is off", func() {
+			mockDB.EXPECT().GetFamily(familyID).Return(&models.Family{AnomalySensitivity: utils.AnomalySensitivityOff}, nil)
+
+			processFamilyAnomalies(logger, mockDB, familyID, now)
+		})
+	})
+
+	Describe("with storage", func() {
+		var st database.Storage
+
+		BeforeEach(func() {
+			st = database.NewStorage(logger, &config.Config{DBPath: ":memory:"})
+			Expect(st.Open()).To(Succeed())
+			DeferCleanup(st.Close)
+		})
+
+		It("should use settings of the family, not of a user with the family ID", func() {
+			family, err := st.CreateFamily("family")
+			Expect(err).ToNot(HaveOccurred())
+			user, err := st.CreateUser("user@test.com", "hash", family.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(user.ID).ToNot(Equal(family.ID))
+
+			czk, err := st.CreateCurrency(family.ID, &goserver.CurrencyNoId{Name: "CZK"})
+			Expect(err).ToNot(HaveOccurred())
+			bank, err := st.CreateAccount(family.ID, &goserver.AccountNoId{Name: "Bank", Type: constants.AccountAsset})
+			Expect(err).ToNot(HaveOccurred())
+			groceries, err := st.CreateAccount(family.ID,
+				&goserver.AccountNoId{Name: "Groceries", Type: constants.AccountExpense})
+			Expect(err).ToNot(HaveOccurred())
+			for _, t := range transactions {
+				_, err := st.CreateTransaction(family.ID, &goserver.TransactionNoId{
+					Date: t.Date,
+					Movements: []goserver.Movement{
+						{AccountId: bank.Id, CurrencyId: czk.Id, Amount: t.Movements[0].Amount},
+						{AccountId: groceries.Id, CurrencyId: czk.Id, Amount: t.Movements[1].Amount},
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			family.AnomalyMutedAccountIDs = []string{groceries.Id}
+			Expect(st.PutFamily(family)).To(Succeed())
+			processFamilyAnomalies(logger, st, family.ID, now)
+			notifications, err := st.GetNotifications(family.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(notifications).To(BeEmpty())
+
+			family.AnomalyMutedAccountIDs = nil
+			Expect(st.PutFamily(family)).To(Succeed())
+			processFamilyAnomalies(logger, st, family.ID, now)
+			notifications, err = st.GetNotifications(family.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(notifications).To(HaveLen(1))
+			Expect(notifications[0].Title).To(Equal("Unusual Spending: Groceries"))
+		})
+	})
+})
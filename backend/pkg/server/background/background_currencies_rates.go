@@ -5,13 +5,14 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database"
 	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 )
 
 func StartCurrenciesRatesFetcher(
-	ctx context.Context, logger *slog.Logger, db database.Storage,
+	ctx context.Context, logger *slog.Logger, db database.Storage, rates *common.RateService,
 ) <-chan struct{} {
 	logger.Info("Starting currencies rates fetcher...")
 
@@ -28,7 +29,7 @@ func StartCurrenciesRatesFetcher(
 				// Do something
 				logger.Info("Fetching currencies rates...")
 
-				fetcher := common.NewCurrenciesRatesFetcher(logger, db)
+				fetcher := rates.Fetcher(uuid.Nil)
 				_, err := fetcher.Convert(ctx, time.Now(), "CZK", "USD", decimal.NewFromInt(100))
 				if err != nil {
 					logger.With("error", err).Error("Failed to fetch currencies rates, retring in 1 hour")
@@ -42,6 +43,8 @@ func StartCurrenciesRatesFetcher(
 					}
 				}
 
+				prefetchCurrenciesRates(ctx, logger, db, rates)
+
 				logger.Info("Received rates for today. Delaying currencies rates fetcher for 24 hours...")
 				select {
 				case <-time.After(24 * time.Hour):
@@ -55,3 +58,48 @@ func StartCurrenciesRatesFetcher(
 
 	return done
 }
+
+// prefetchCurrenciesRates fetches missing rates of the providers of every family with several
+// currencies for the date range of its transactions
+func prefetchCurrenciesRates(
+	ctx context.Context, logger *slog.Logger, db database.Storage, rates *common.RateService,
+) {
+	familyIDs, err := db.GetAllFamilyIDs()
+	if err != nil {
+		logger.With("error", err).Error("Failed to get families for currencies rates prefetch")
+		return
+	}
+
+	for _, familyID := range familyIDs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		currencies, err := db.GetCurrencies(familyID)
+		if err != nil {
+			logger.With("error", err, "familyID", familyID).Error("Failed to get currencies")
+			continue
+		}
+		if len(currencies) < 2 {
+			continue
+		}
+
+		dateFrom, dateTo, err := db.GetTransactionDateRange(familyID)
+		if err != nil {
+			logger.With("error", err, "familyID", familyID).Error("Failed to get transaction date range")
+			continue
+		}
+		if dateFrom.IsZero() {
+			continue
+		}
+
+		fetcher := rates.Fetcher(familyID)
+		fetched, err := fetcher.Prefetch(ctx, dateFrom, dateTo)
+		if err != nil {
+			logger.With("error", err, "familyID", familyID).Warn("Failed to prefetch some currencies rates")
+		}
+		if fetched > 0 {
+			logger.Info("Prefetched currencies rates", "familyID", familyID, "days", fetched)
+		}
+	}
+}
@@ -17,7 +17,7 @@ import (
 )
 
 func StartDuplicateDetection(
-	ctx context.Context, logger *slog.Logger, db database.Storage,
+	ctx context.Context, logger *slog.Logger, db database.Storage, rates *common.RateService,
 ) <-chan struct{} {
 	logger.Info("Starting duplicate detection task...")
 
@@ -40,7 +40,7 @@ func StartDuplicateDetection(
 				return
 			default:
 				ctx := context.WithValue(ctx, constants.ChangeSourceKey, constants.ChangeSourceSystem)
-				detectDuplicates(ctx, logger, db.WithContext(ctx))
+				detectDuplicates(ctx, logger, db.WithContext(ctx), rates)
 
 				logger.Info("Delaying duplicate detection for 24 hours...")
 				select {
@@ -56,7 +56,9 @@ func StartDuplicateDetection(
 	return done
 }
 
-func detectDuplicates(ctx context.Context, logger *slog.Logger, db database.Storage) {
+func detectDuplicates(
+	ctx context.Context, logger *slog.Logger, db database.Storage, rates *common.RateService,
+) {
 	logger.Info("Running duplicate detection...")
 
 	familyIDs, err := db.GetAllFamilyIDs()
@@ -66,6 +68,8 @@ func detectDuplicates(ctx context.Context, logger *slog.Logger, db database.Stor
 	}
 
 	for _, familyID := range familyIDs {
+		// Pair transfers first, so their halves are not reported as duplicates
+		processFamilyTransfers(ctx, logger, db, rates, familyID)
 		processFamilyDuplicates(ctx, logger, db, familyID)
 	}
 
@@ -87,6 +87,32 @@ var _ = Describe("Background Duplicate Detection", func() {
 			processFamilyDuplicates(ctx, logger, mockDB, familyID)
 		})
 
+		It("should not flag two halves of a transfer between own accounts", func() {
+			t1 := goserver.Transaction{
+				Id:          uuid.New().String(),
+				Date:        time.Now(),
+				ExternalIds: []string{"fio-1"},
+				Movements: []goserver.Movement{
+					{Amount: decimal.NewFromInt(100), CurrencyId: "USD"},
+					{AccountId: "fio", Amount: decimal.NewFromInt(-100), CurrencyId: "USD"},
+				},
+			}
+			t2 := goserver.Transaction{
+				Id:          uuid.New().String(),
+				Date:        time.Now(),
+				ExternalIds: []string{"revolut-1"},
+				Movements: []goserver.Movement{
+					{Amount: decimal.NewFromInt(-100), CurrencyId: "USD"},
+					{AccountId: "revolut", Amount: decimal.NewFromInt(100), CurrencyId: "USD"},
+				},
+			}
+
+			mockDB.EXPECT().GetTransactions(familyID, gomock.Any(), time.Time{}, false).Return([]goserver.Transaction{t1, t2}, nil)
+			// No AddDuplicateRelationship, UpdateTransactionInternal, or CreateNotification expected
+
+			processFamilyDuplicates(ctx, logger, mockDB, familyID)
+		})
+
 		It("should skip transactions with different amounts", func() {
 			t1 := goserver.Transaction{
 				Id:          uuid.New().String(),
@@ -19,7 +19,8 @@ import (
 
 //nolint:funlen,gocognit,cyclop // TODO refactor
 func StartBankImporters(
-	ctx context.Context, logger *slog.Logger, db database.Storage, cfg *config.Config, forcedImports <-chan common.ForcedImport,
+	ctx context.Context, logger *slog.Logger, db database.Storage, rates *common.RateService, cfg *config.Config,
+	forcedImports <-chan common.ForcedImport,
 ) <-chan struct{} {
 	logger.Info("Starting bank importers...")
 
@@ -57,7 +58,7 @@ func StartBankImporters(
 			// Do the work
 			logger.Info("Importing from bank importers...")
 
-			importer := api.NewBankImportersAPIServiceImpl(logger, db, cfg)
+			importer := api.NewBankImportersAPIServiceImpl(logger, db, cfg, rates)
 			pairs, err := db.GetAllBankImporters()
 
 			var nextDelay time.Duration
@@ -84,7 +85,7 @@ func StartBankImporters(
 				}
 
 				// Process unprocessed transactions for auto-conversion before delay
-				processUnprocessedTransactionsForAutoConversion(ctx, logger, db)
+				processUnprocessedTransactionsForAutoConversion(ctx, logger, db, rates)
 
 				nextDelay = 24 * time.Hour
 				logger.Info("Delaying bank imports for 24 hours...")
@@ -100,7 +101,7 @@ func StartBankImporters(
 // processUnprocessedTransactionsForAutoConversion processes all unprocessed transactions
 // and automatically converts those that have exactly one matcher with 100% success history
 func processUnprocessedTransactionsForAutoConversion(
-	ctx context.Context, logger *slog.Logger, db database.Storage,
+	ctx context.Context, logger *slog.Logger, db database.Storage, rates *common.RateService,
 ) {
 	logger.Info("Processing unprocessed transactions for auto-conversion...")
 
@@ -111,7 +112,7 @@ func processUnprocessedTransactionsForAutoConversion(
 		return
 	}
 
-	unprocessedService := api.NewUnprocessedTransactionsAPIServiceImpl(logger, db)
+	unprocessedService := api.NewUnprocessedTransactionsAPIServiceImpl(logger, db, rates)
 
 	for _, familyID := range familyIDs {
 		logger.Info("Processing unprocessed transactions for family", "familyID", familyID)
@@ -139,6 +140,11 @@ func processUnprocessedTransactionsForAutoConversion(
 				ctx, logger, db, unprocessedService, familyID, unprocessed,
 			)
 		}
+
+		// Converted transactions may have used up budgets
+		if err := api.CheckBudgetAlerts(ctx, logger, db, rates, familyID, time.Now()); err != nil {
+			logger.With("error", err, "familyID", familyID).Error("Failed to check budget alerts after auto-conversion")
+		}
 	}
 
 	logger.Info("Completed processing unprocessed transactions for auto-conversion")
@@ -260,9 +266,9 @@ func findPerfectMatchers(
 // ProcessUnprocessedTransactionsForAutoConversion is an exported wrapper used by
 // tests and external callers to trigger the auto-conversion pass.
 func ProcessUnprocessedTransactionsForAutoConversion(
-	ctx context.Context, logger *slog.Logger, db database.Storage,
+	ctx context.Context, logger *slog.Logger, db database.Storage, rates *common.RateService,
 ) {
-	processUnprocessedTransactionsForAutoConversion(ctx, logger, db)
+	processUnprocessedTransactionsForAutoConversion(ctx, logger, db, rates)
 }
 
 // getAllFamilies retrieves all family IDs from the database
@@ -74,7 +74,9 @@ func setupTestFixture(t *testing.T, logger *slog.Logger, username string) *TestF
 	}
 
 	// Create unprocessed service
-	unprocessedService := api.NewUnprocessedTransactionsAPIServiceImpl(logger, storage)
+	unprocessedService := api.NewUnprocessedTransactionsAPIServiceImpl(
+		logger, storage, common.NewRateService(logger, storage, 0),
+	)
 
 	return &TestFixture{
 		Storage:            storage,
@@ -187,7 +189,8 @@ func TestProcessUnprocessedTransactionsForAutoConversion(t *testing.T) {
 	}
 
 	// Run the auto-conversion process
-	background.ProcessUnprocessedTransactionsForAutoConversion(t.Context(), logger, fixture.Storage)
+	background.ProcessUnprocessedTransactionsForAutoConversion(
+		t.Context(), logger, fixture.Storage, common.NewRateService(logger, fixture.Storage, 0))
 
 	// Verify transaction was auto-converted
 	unprocessedAfter := fixture.getUnprocessedTransactions(t)
@@ -268,7 +271,8 @@ func TestProcessUnprocessedTransactionsMultiplePerfectMatchers(t *testing.T) {
 	}
 
 	// Run the auto-conversion process
-	background.ProcessUnprocessedTransactionsForAutoConversion(t.Context(), logger, fixture.Storage)
+	background.ProcessUnprocessedTransactionsForAutoConversion(
+		t.Context(), logger, fixture.Storage, common.NewRateService(logger, fixture.Storage, 0))
 
 	// Verify transaction is still unprocessed (multiple perfect matchers = ambiguous)
 	unprocessedAfter := fixture.getUnprocessedTransactions(t)
@@ -315,7 +319,8 @@ func TestProcessUnprocessedTransactionsInsufficientConfirmationHistory(t *testin
 	}
 
 	// Run the auto-conversion process
-	background.ProcessUnprocessedTransactionsForAutoConversion(t.Context(), logger, fixture.Storage)
+	background.ProcessUnprocessedTransactionsForAutoConversion(
+		t.Context(), logger, fixture.Storage, common.NewRateService(logger, fixture.Storage, 0))
 
 	// Verify transaction is still unprocessed (insufficient confirmation history)
 	unprocessedAfter := fixture.getUnprocessedTransactions(t)
@@ -376,7 +381,8 @@ func TestProcessUnprocessedTransactionsWithoutBankImporter(t *testing.T) {
 	}
 
 	// Run the auto-conversion process
-	background.ProcessUnprocessedTransactionsForAutoConversion(t.Context(), logger, fixture.Storage)
+	background.ProcessUnprocessedTransactionsForAutoConversion(
+		t.Context(), logger, fixture.Storage, common.NewRateService(logger, fixture.Storage, 0))
 
 	// Verify transaction was auto-converted
 	unprocessedAfter := fixture.getUnprocessedTransactions(t)
@@ -443,7 +449,7 @@ func TestStartBankImporters_RunsOnStartup(t *testing.T) {
 
 	// Start the background task
 	cfg := &config.Config{}
-	done := background.StartBankImporters(ctx, logger, mock, cfg, forcedImports)
+	done := background.StartBankImporters(ctx, logger, mock, common.NewRateService(logger, mock, 0), cfg, forcedImports)
 
 	// Verify it runs immediately (within reasonable time)
 	select {
@@ -0,0 +1,56 @@
+package background
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+)
+
+// processFamilyTransfers pairs one-sided transactions between own accounts. Pairs between
+// accounts with a learned transfer rule are merged automatically, other candidates are left
+// for the user to confirm.
+func processFamilyTransfers(
+	ctx context.Context, logger *slog.Logger, db database.Storage, rates *common.RateService, familyID uuid.UUID,
+) {
+	logger.Info("Processing transfers for family", "familyID", familyID)
+
+	candidates, err := common.FindTransferCandidates(ctx, logger, db, rates, familyID, time.Time{}, time.Time{})
+	if err != nil {
+		logger.With("error", err, "familyID", familyID).Error("Failed to find transfer candidates")
+		return
+	}
+
+	pairedCount := 0
+	for _, c := range candidates {
+		if !c.Learned {
+			continue
+		}
+
+		if _, err := db.ConfirmTransfer(familyID, c.Outgoing.Id, c.Incoming.Id); err != nil {
+			logger.With("error", err, "outgoing", c.Outgoing.Id, "incoming", c.Incoming.Id).
+				Error("Failed to confirm transfer")
+			continue
+		}
+		pairedCount++
+	}
+
+	if pairedCount > 0 {
+		logger.Info("Paired transfers for family", "familyID", familyID, "count", pairedCount)
+		_, err := db.CreateNotification(familyID, &goserver.Notification{
+			Date:        time.Now(),
+			Type:        string(models.NotificationTypeTransferPaired),
+			Title:       "Transfers Paired",
+			Description: fmt.Sprintf("Merged %d transfers between own accounts using learned transfer rules.", pairedCount),
+		})
+		if err != nil {
+			logger.With("error", err, "familyID", familyID).Error("Failed to create notification for paired transfers")
+		}
+	}
+}
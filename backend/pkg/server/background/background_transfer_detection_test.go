@@ -0,0 +1,86 @@
+package background
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("Background Transfer Detection", func() {
+	var (
+		mockCtrl *gomock.Controller
+		mockDB   *mocks.MockStorage
+		logger   = test.CreateTestLogger()
+		familyID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		ctx      = context.Background()
+		accounts = []goserver.Account{
+			{Id: "fio", Type: constants.AccountAsset},
+			{Id: "revolut", Type: constants.AccountAsset},
+		}
+		currencies = []goserver.Currency{{Id: "czk", Name: "CZK"}}
+	)
+
+	oneSided := func(accountID string, amount int64) goserver.Transaction {
+		return goserver.Transaction{
+			Id:   uuid.New().String(),
+			Date: time.Now(),
+			Movements: []goserver.Movement{
+				{Amount: decimal.NewFromInt(-amount), CurrencyId: "czk"},
+				{AccountId: accountID, Amount: decimal.NewFromInt(amount), CurrencyId: "czk"},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockDB = mocks.NewMockStorage(mockCtrl)
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Describe("processFamilyTransfers", func() {
+		It("should confirm transfers between accounts with learned rule", func() {
+			out := oneSided("fio", -100)
+			in := oneSided("revolut", 100)
+
+			mockDB.EXPECT().GetAccounts(familyID).Return(accounts, nil)
+			mockDB.EXPECT().GetTransferRules(familyID).Return([]goserver.TransferRule{
+				{Id: uuid.New().String(), FromAccountId: "fio", ToAccountId: "revolut", Confirmations: 1},
+			}, nil)
+			mockDB.EXPECT().GetCurrencies(familyID).Return(currencies, nil)
+			mockDB.EXPECT().GetTransactions(familyID, gomock.Any(), time.Time{}, false).Return([]goserver.Transaction{out, in}, nil)
+			mockDB.EXPECT().ConfirmTransfer(familyID, out.Id, in.Id).Return(goserver.Transaction{}, nil)
+			mockDB.EXPECT().CreateNotification(familyID, gomock.Any()).DoAndReturn(func(_ uuid.UUID, n *goserver.Notification) (goserver.Notification, error) {
+				Expect(n.Type).To(Equal(string(models.NotificationTypeTransferPaired)))
+				Expect(n.Description).To(ContainSubstring("Merged 1 transfers"))
+				return goserver.Notification{}, nil
+			})
+
+			processFamilyTransfers(ctx, logger, mockDB, common.NewRateService(logger, mockDB, 0), familyID)
+		})
+
+		It("should leave transfers without learned rule to the user", func() {
+			mockDB.EXPECT().GetAccounts(familyID).Return(accounts, nil)
+			mockDB.EXPECT().GetTransferRules(familyID).Return([]goserver.TransferRule{}, nil)
+			mockDB.EXPECT().GetCurrencies(familyID).Return(currencies, nil)
+			mockDB.EXPECT().GetTransactions(familyID, gomock.Any(), time.Time{}, false).
+				Return([]goserver.Transaction{oneSided("fio", -100), oneSided("revolut", 100)}, nil)
+			// No ConfirmTransfer or CreateNotification expected
+
+			processFamilyTransfers(ctx, logger, mockDB, common.NewRateService(logger, mockDB, 0), familyID)
+		})
+	})
+})
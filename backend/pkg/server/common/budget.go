@@ -0,0 +1,44 @@
+package common
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+// BudgetMonth returns the month granularity of budgets, which start on the month start day of the family.
+func BudgetMonth(logger *slog.Logger, db database.Storage, familyID uuid.UUID) utils.Granularity {
+	return utils.FinancialMonth(FamilySettings(logger, db, familyID).MonthStartDay)
+}
+
+// GetBudget returns the budget items of the family together with the budgets of its plans in
+// months before the end date, see utils.ExpandBudgetPlans.
+func GetBudget(
+	db database.Storage, familyID uuid.UUID, month utils.Granularity, to time.Time,
+) ([]goserver.BudgetItem, error) {
+	items, err := db.GetBudgetItems(familyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budget items: %w", err)
+	}
+	plans, err := db.GetBudgetPlans(familyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budget plans: %w", err)
+	}
+
+	return utils.ExpandBudgetPlans(plans, items, month, to), nil
+}
+
+// BudgetOverspending returns how overspending of envelopes is handled by the family, see
+// utils.BudgetOverspendingRollover and utils.BudgetOverspendingReset.
+func BudgetOverspending(logger *slog.Logger, db database.Storage, familyID uuid.UUID) string {
+	overspending := FamilySettings(logger, db, familyID).BudgetOverspending
+	if overspending == "" {
+		return utils.BudgetOverspendingRollover
+	}
+	return overspending
+}
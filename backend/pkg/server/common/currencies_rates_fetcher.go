@@ -1,176 +1,311 @@
 package common
 
 import (
-	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
-	"net/http"
-	"strings"
+	"sort"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
 )
 
+// historyProvider is a rate provider which stores the rates it publishes
+type historyProvider interface {
+	RateProvider
+	// History returns stored rates of the currency in the date range by date
+	History(currency string, dateFrom, dateTo time.Time) (map[string]decimal.Decimal, error)
+	// PublishedDates returns dates in the date range with stored rates
+	PublishedDates(dateFrom, dateTo time.Time) ([]time.Time, error)
+}
+
+// CurrenciesRatesFetcher converts amounts between currencies using manual rates of the family and
+// the chain of exchange rate providers configured by the family. Families without configuration
+// use CNB rates. Fetchers are created by RateService.Fetcher and are safe for concurrent use.
 type CurrenciesRatesFetcher struct {
-	logger  *slog.Logger
-	storage database.Storage
-	// date -> currency -> rate
-	rateCache map[string]map[string]decimal.Decimal
+	logger   *slog.Logger
+	storage  database.Storage
+	service  *RateService
+	familyID uuid.UUID
 
-	BaseURL string
-}
+	// providers and baseCurrency are loaded from the family settings on first conversion
+	loadOnce     sync.Once
+	providers    []RateProvider
+	baseCurrency string
 
-func NewCurrenciesRatesFetcher(logger *slog.Logger, storage database.Storage) *CurrenciesRatesFetcher {
-	return &CurrenciesRatesFetcher{
-		logger:    logger,
-		storage:   storage,
-		rateCache: make(map[string]map[string]decimal.Decimal),
-		BaseURL: "https://www.cnb.cz/" +
-			"cs/financni-trhy/devizovy-trh/kurzy-devizoveho-trhu/kurzy-devizoveho-trhu/denni_kurz.txt",
-	}
+	// date -> currency -> rate of the family's manual rates, rates of other providers are cached
+	// by the rate service
+	mu          sync.Mutex
+	manualRates map[string]map[string]decimal.Decimal
 }
 
 func (f *CurrenciesRatesFetcher) Convert(
 	ctx context.Context, day time.Time, from, to string, amount decimal.Decimal,
 ) (decimal.Decimal, error) {
-	// Check if we have cached rates for this date
-	dateKey := day.Format("2006-01-02")
-	rates, ok := f.rateCache[dateKey]
-	if !ok {
-		// Try to get rates from DB first
-		dbRates, err := f.storage.GetCNBRates(day)
-		if err != nil {
-			f.logger.Warn("failed to get rates from DB", "error", err, "date", dateKey)
-		}
-
-		if len(dbRates) > 0 {
-			// Use rates from DB
-			rates = dbRates
-			f.logger.Debug("using rates from DB", "date", dateKey)
-		} else {
-			// Fetch rates if not in DB
-			// Format date for the URL in DD.MM.YYYY format
-			dateStr := day.Format("02.01.2006")
-			rates, err = f.fetchRates(ctx, dateStr)
-			if err != nil {
-				return decimal.Zero, fmt.Errorf("failed to fetch currency rates: %w", err)
-			}
-
-			// Store rates in DB
-			if err := f.storage.SaveCNBRates(rates, day); err != nil {
-				f.logger.Warn("failed to store rates to DB", "error", err, "date", dateKey)
-			}
-		}
+	res, _, err := f.ConvertWithSources(ctx, day, from, to, amount)
+	return res, err
+}
 
-		// Cache the rates
-		f.rateCache[dateKey] = rates
+// ConvertWithSources converts the amount and returns names of the providers whose rates were used.
+func (f *CurrenciesRatesFetcher) ConvertWithSources(
+	ctx context.Context, day time.Time, from, to string, amount decimal.Decimal,
+) (decimal.Decimal, []string, error) {
+	if from == to {
+		return amount, nil, nil
 	}
+	f.loadProviders()
 
-	// Perform the currency conversion
-	return f.performConversion(from, to, amount, rates)
-}
+	res, source, err := f.convertByProvider(ctx, day, from, to, amount)
+	if err == nil {
+		return res, []string{source}, nil
+	}
+	if from == f.baseCurrency || to == f.baseCurrency {
+		return decimal.Zero, nil, err
+	}
 
-// performConversion handles the actual currency conversion calculation
-func (f *CurrenciesRatesFetcher) performConversion(
-	from, to string, amount decimal.Decimal, rates map[string]decimal.Decimal,
-) (decimal.Decimal, error) {
-	if from == to {
-		return amount, nil
+	// No provider knows both currencies, convert via the rate base currency of the family
+	base, fromSource, baseErr := f.convertByProvider(ctx, day, from, f.baseCurrency, amount)
+	if baseErr != nil {
+		return decimal.Zero, nil, err
+	}
+	res, toSource, baseErr := f.convertByProvider(ctx, day, f.baseCurrency, to, base)
+	if baseErr != nil {
+		return decimal.Zero, nil, err
+	}
+	if fromSource == toSource {
+		return res, []string{fromSource}, nil
 	}
+	return res, []string{fromSource, toSource}, nil
+}
 
-	// Convert CZK to another currency or vice versa
-	switch {
-	case from == "CZK":
-		rate, ok := rates[to]
-		if !ok {
-			return decimal.Zero, fmt.Errorf("currency not found: %s", to)
-		}
-		return amount.Div(rate), nil
-	case to == "CZK":
-		rate, ok := rates[from]
-		if !ok {
-			return decimal.Zero, fmt.Errorf("currency not found: %s", from)
+// convertByProvider converts the amount with the first provider in the chain which knows both
+// currencies and returns the name of the provider
+func (f *CurrenciesRatesFetcher) convertByProvider(
+	ctx context.Context, day time.Time, from, to string, amount decimal.Decimal,
+) (decimal.Decimal, string, error) {
+	var errs []error
+	for _, provider := range f.providers {
+		rates, err := f.providerRates(ctx, provider, day)
+		if err != nil {
+			f.logger.Warn("failed to get currency rates", "error", err, "provider", provider.Name())
+			errs = append(errs, fmt.Errorf("failed to fetch currency rates from %s: %w", provider.Name(), err))
+			continue
 		}
-		return amount.Mul(rate), nil
-	default:
-		// For other currency pairs, convert via CZK
-		fromRate, ok := rates[from]
+
+		fromRate, ok := unitRate(provider, rates, from)
 		if !ok {
-			return decimal.Zero, fmt.Errorf("currency not found: %s", from)
+			errs = append(errs, fmt.Errorf("currency not found in %s: %s", provider.Name(), from))
+			continue
 		}
-		toRate, ok := rates[to]
+		toRate, ok := unitRate(provider, rates, to)
 		if !ok {
-			return decimal.Zero, fmt.Errorf("currency not found: %s", to)
+			errs = append(errs, fmt.Errorf("currency not found in %s: %s", provider.Name(), to))
+			continue
 		}
 
-		// First convert to CZK, then to target currency
-		czk := amount.Mul(fromRate)
-		return czk.Div(toRate), nil
+		return amount.Mul(fromRate).Div(toRate), provider.Name(), nil
 	}
-}
 
-func (f *CurrenciesRatesFetcher) fetchRates(ctx context.Context, date string) (map[string]decimal.Decimal, error) {
-	url := fmt.Sprintf("%s?date=%s", f.BaseURL, date)
-	f.logger.Debug("fetching rates", "url", url)
+	return decimal.Zero, "", errors.Join(errs...)
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
+// providerRates returns rates of the provider for the day. Manual rates are cached for the lifetime
+// of the fetcher, rates of other providers are shared by all fetchers of the rate service.
+func (f *CurrenciesRatesFetcher) providerRates(
+	ctx context.Context, provider RateProvider, day time.Time,
+) (map[string]decimal.Decimal, error) {
+	if provider.Name() != RateProviderManual {
+		return f.service.rates(ctx, provider, day)
 	}
-	// Set user-agent
-	req.Header.Set("User-Agent", "GeekBudgetBE/1.0")
 
-	resp, err := http.DefaultClient.Do(req)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	dateKey := day.Format("2006-01-02")
+	if rates, ok := f.manualRates[dateKey]; ok {
+		return rates, nil
+	}
+	rates, _, err := provider.Rates(ctx, day)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("bad response status: %s", resp.Status)
-	}
+	f.manualRates[dateKey] = rates
+	return rates, nil
+}
 
-	// Parse the CNB format
-	rates := make(map[string]decimal.Decimal)
-	scanner := bufio.NewScanner(resp.Body)
+// History returns stored rates of one unit of the "from" currency in the "to" currency ordered by
+// date. Rates of a day are taken from the first provider of the chain which published both currencies.
+func (f *CurrenciesRatesFetcher) History(
+	from, to string, dateFrom, dateTo time.Time,
+) ([]goserver.ExchangeRateHistoryPoint, error) {
+	f.loadProviders()
 
-	// Skip the first two lines (header)
-	scanner.Scan() // Skip first line
-	scanner.Scan() // Skip second line
+	points := make(map[string]goserver.ExchangeRateHistoryPoint)
+	for _, provider := range f.providers {
+		hp, ok := provider.(historyProvider)
+		if !ok {
+			continue
+		}
 
-	// Parse the rest of the lines
-	for scanner.Scan() {
-		line := scanner.Text()
-		parts := strings.Split(line, "|")
+		fromRates, err := unitHistory(hp, from, dateFrom, dateTo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s history of %s: %w", provider.Name(), from, err)
+		}
+		toRates, err := unitHistory(hp, to, dateFrom, dateTo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s history of %s: %w", provider.Name(), to, err)
+		}
 
-		if len(parts) < 5 {
-			continue
+		for dateKey, fromRate := range fromRates {
+			toRate, ok := toRates[dateKey]
+			if !ok || !toRate.IsPositive() {
+				continue
+			}
+			if _, ok := points[dateKey]; ok {
+				continue
+			}
+			date, err := time.Parse("2006-01-02", dateKey)
+			if err != nil {
+				continue
+			}
+			points[dateKey] = goserver.ExchangeRateHistoryPoint{
+				Date:   date,
+				Rate:   fromRate.Div(toRate),
+				Source: provider.Name(),
+			}
 		}
+	}
 
-		// Format is: Country|Currency|Amount|Code|Rate
-		currencyCode := parts[3]
-		amountStr := parts[2]
-		rateStr := strings.Replace(parts[4], ",", ".", 1) // Replace comma with dot for decimal point
+	res := make([]goserver.ExchangeRateHistoryPoint, 0, len(points))
+	for _, point := range points {
+		res = append(res, point)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Date.Before(res[j].Date)
+	})
+	return res, nil
+}
 
-		amount, err := decimal.NewFromString(amountStr)
-		if err != nil {
+// Prefetch fetches rates of the providers of the chain for business days in the date range which
+// are not stored yet, so conversions of the range don't need network requests
+func (f *CurrenciesRatesFetcher) Prefetch(ctx context.Context, dateFrom, dateTo time.Time) (int, error) {
+	f.loadProviders()
+	dateFrom = time.Date(dateFrom.Year(), dateFrom.Month(), dateFrom.Day(), 0, 0, 0, 0, dateFrom.Location())
+	if now := time.Now(); dateTo.After(now) {
+		dateTo = now
+	}
+
+	fetched := 0
+	var errs []error
+	for _, provider := range f.providers {
+		hp, ok := provider.(historyProvider)
+		if !ok {
 			continue
 		}
 
-		rate, err := decimal.NewFromString(rateStr)
+		dates, err := hp.PublishedDates(
+			dateFrom.AddDate(0, 0, -ratesFallbackDays), dateTo.AddDate(0, 0, ratesFallbackDays))
 		if err != nil {
-			continue
+			return fetched, fmt.Errorf("failed to get %s rate dates: %w", provider.Name(), err)
+		}
+
+		for day := dateFrom; !day.After(dateTo); day = day.AddDate(0, 0, 1) {
+			if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+				continue
+			}
+			if _, ok := lastPublishedDate(dates, day); ok {
+				continue
+			}
+
+			if _, _, err := provider.Rates(ctx, day); err != nil {
+				if ctx.Err() != nil {
+					return fetched, ctx.Err()
+				}
+				errs = append(errs, fmt.Errorf("failed to fetch %s rates for %s: %w",
+					provider.Name(), day.Format("2006-01-02"), err))
+				continue
+			}
+			fetched++
 		}
+	}
+
+	return fetched, errors.Join(errs...)
+}
 
-		// Store rate per unit of currency
-		rates[currencyCode] = rate.Div(amount)
+// unitHistory returns stored rates of one unit of the currency in the base currency of the provider
+func unitHistory(
+	provider historyProvider, currency string, dateFrom, dateTo time.Time,
+) (map[string]decimal.Decimal, error) {
+	if currency != provider.BaseCurrency() {
+		return provider.History(currency, dateFrom, dateTo)
 	}
 
-	if err := scanner.Err(); err != nil {
+	dates, err := provider.PublishedDates(dateFrom, dateTo)
+	if err != nil {
 		return nil, err
 	}
+	res := make(map[string]decimal.Decimal, len(dates))
+	for _, date := range dates {
+		res[date.Format("2006-01-02")] = decimal.NewFromInt(1)
+	}
+	return res, nil
+}
 
-	return rates, nil
+// unitRate returns value of one unit of the currency in the base currency of the provider
+func unitRate(provider RateProvider, rates map[string]decimal.Decimal, currency string) (decimal.Decimal, bool) {
+	if currency == provider.BaseCurrency() {
+		return decimal.NewFromInt(1), true
+	}
+	rate, ok := rates[currency]
+	if !ok || !rate.IsPositive() {
+		return decimal.Zero, false
+	}
+	return rate, true
+}
+
+// loadProviders builds the provider chain from the family settings, manual rates of the family
+// are always preferred to other providers
+func (f *CurrenciesRatesFetcher) loadProviders() {
+	f.loadOnce.Do(f.doLoadProviders)
+}
+
+func (f *CurrenciesRatesFetcher) doLoadProviders() {
+	names := []string{RateProviderCNB}
+	f.baseCurrency = DefaultRateBaseCurrency
+	if f.familyID != uuid.Nil {
+		family := FamilySettings(f.logger, f.storage, f.familyID)
+		if len(family.RateProviders) > 0 {
+			names = family.RateProviders
+		}
+		if family.RateBaseCurrencyID != "" {
+			currency, err := f.storage.GetCurrency(f.familyID, family.RateBaseCurrencyID)
+			if err != nil {
+				f.logger.With("error", err).Warn("Failed to get rate base currency, using CZK")
+			} else {
+				f.baseCurrency = currency.Name
+			}
+		}
+	}
+
+	f.providers = make([]RateProvider, 0, len(names)+1)
+	if f.familyID != uuid.Nil {
+		f.providers = append(f.providers, NewManualRateProvider(f.storage, f.familyID, f.baseCurrency))
+	}
+	for _, name := range names {
+		switch name {
+		case RateProviderCNB:
+			f.providers = append(f.providers, f.service.CNB)
+		case RateProviderECB:
+			f.providers = append(f.providers, f.service.ECB)
+		case RateProviderManual:
+			// manual rates are already first in the chain
+		default:
+			f.logger.Warn("unknown exchange rate provider", "provider", name)
+		}
+	}
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
 	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
@@ -53,6 +54,10 @@ func TestCurrenciesRatesFetcher_Convert(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockStorage := mocks.NewMockStorage(ctrl)
+	mockStorage.EXPECT().
+		GetCNBRateDates(gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
 
 	// Set expectations for the storage
 	// Expect storage to look for the rates first
@@ -67,8 +72,9 @@ func TestCurrenciesRatesFetcher_Convert(t *testing.T) {
 		Return(nil).
 		AnyTimes()
 
-	sut := common.NewCurrenciesRatesFetcher(test.CreateTestLogger(), mockStorage)
-	sut.BaseURL = cnbMockServer.URL
+	rates := common.NewRateService(test.CreateTestLogger(), mockStorage, 0)
+	rates.CNB.BaseURL = cnbMockServer.URL
+	sut := rates.Fetcher(uuid.Nil)
 	ctx := t.Context()
 
 	tests := []struct {
@@ -162,6 +168,10 @@ func TestCurrenciesRatesFetcher_FetchRates(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockStorage := mocks.NewMockStorage(ctrl)
+	mockStorage.EXPECT().
+		GetCNBRateDates(gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
 
 	// Set expectations for the storage
 	// Expect storage to look for the rates first
@@ -176,8 +186,9 @@ func TestCurrenciesRatesFetcher_FetchRates(t *testing.T) {
 		Return(nil).
 		AnyTimes()
 
-	sut := common.NewCurrenciesRatesFetcher(test.CreateTestLogger(), mockStorage)
-	sut.BaseURL = cnbMockServer.URL
+	rates := common.NewRateService(test.CreateTestLogger(), mockStorage, 0)
+	rates.CNB.BaseURL = cnbMockServer.URL
+	sut := rates.Fetcher(uuid.Nil)
 	ctx := t.Context()
 
 	// First call should fetch from server
@@ -199,7 +210,7 @@ func TestCurrenciesRatesFetcher_FetchRates(t *testing.T) {
 	}
 
 	// Different date should cause another HTTP call
-	differentDate := time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)
+	differentDate := time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)
 	_, err = sut.Convert(ctx, differentDate, "USD", "CZK", decimal.NewFromInt(300))
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -218,6 +229,10 @@ func TestCurrenciesRatesFetcher_GetRatesFromStorage(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockStorage := mocks.NewMockStorage(ctrl)
+	mockStorage.EXPECT().
+		GetCNBRateDates(gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
 
 	// Create mock rates to return from storage
 	mockRates := map[string]decimal.Decimal{
@@ -236,8 +251,9 @@ func TestCurrenciesRatesFetcher_GetRatesFromStorage(t *testing.T) {
 	// The server should not save rates since we already have them
 	// No SaveCNBRates calls should happen
 
-	sut := common.NewCurrenciesRatesFetcher(test.CreateTestLogger(), mockStorage)
-	sut.BaseURL = cnbMockServer.URL
+	rates := common.NewRateService(test.CreateTestLogger(), mockStorage, 0)
+	rates.CNB.BaseURL = cnbMockServer.URL
+	sut := rates.Fetcher(uuid.Nil)
 	ctx := t.Context()
 
 	// Test some conversions with our mock rates
@@ -307,6 +323,10 @@ func TestCurrenciesRatesFetcher_ErrorHandling(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockStorage := mocks.NewMockStorage(ctrl)
+	mockStorage.EXPECT().
+		GetCNBRateDates(gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
 
 	// Set expectations for the storage
 	// Expect storage to look for the rates first
@@ -316,8 +336,9 @@ func TestCurrenciesRatesFetcher_ErrorHandling(t *testing.T) {
 		Return(nil, nil).
 		AnyTimes()
 
-	sut := common.NewCurrenciesRatesFetcher(test.CreateTestLogger(), mockStorage)
-	sut.BaseURL = cnbMockServer.URL
+	rates := common.NewRateService(test.CreateTestLogger(), mockStorage, 0)
+	rates.CNB.BaseURL = cnbMockServer.URL
+	sut := rates.Fetcher(uuid.Nil)
 	ctx := t.Context()
 
 	_, err := sut.Convert(ctx, testDate, "USD", "CZK", decimal.NewFromInt(100))
@@ -334,17 +355,30 @@ func TestCurrenciesRatesFetcher_ContextCancellation(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockStorage := mocks.NewMockStorage(ctrl)
+	mockStorage.EXPECT().
+		GetCNBRateDates(gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
 
 	// Set expectations for the storage
 	// Expect storage to look for the rates first
-	// No save call should happen due to context cancellation
 	mockStorage.EXPECT().
 		GetCNBRates(testDate).
 		Return(nil, nil).
 		AnyTimes()
+	// The fetch isn't stopped by the cancelled lookup, it stores the rates for later ones
+	saved := make(chan struct{})
+	mockStorage.EXPECT().
+		SaveCNBRates(gomock.Any(), testDate).
+		DoAndReturn(func(map[string]decimal.Decimal, time.Time) error {
+			close(saved)
+			return nil
+		}).
+		Times(1)
 
-	sut := common.NewCurrenciesRatesFetcher(test.CreateTestLogger(), mockStorage)
-	sut.BaseURL = cnbMockServer.URL
+	rates := common.NewRateService(test.CreateTestLogger(), mockStorage, 0)
+	rates.CNB.BaseURL = cnbMockServer.URL
+	sut := rates.Fetcher(uuid.Nil)
 
 	// Create a context with timeout shorter than the server's response time
 	ctx, cancel := context.WithTimeout(t.Context(), 1*time.Millisecond)
@@ -354,4 +388,66 @@ func TestCurrenciesRatesFetcher_ContextCancellation(t *testing.T) {
 	if err == nil {
 		t.Error("expected error due to context timeout but got nil")
 	}
+	<-saved
+}
+
+func TestCurrenciesRatesFetcher_LastPublishedDay(t *testing.T) {
+	cnbMockServer, callCount := createMockServer()
+	defer cnbMockServer.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStorage := mocks.NewMockStorage(ctrl)
+
+	thursday := testDate.AddDate(0, 0, -1)
+	monday := testDate.AddDate(0, 0, 3)
+	tuesday := testDate.AddDate(0, 0, 4)
+	mockRates := map[string]decimal.Decimal{"USD": decimal.NewFromFloat(20.5)}
+
+	// Friday is a holiday, its rates are bridged by the stored rates of Thursday and Monday
+	mockStorage.EXPECT().GetCNBRates(thursday).Return(mockRates, nil).AnyTimes()
+	mockStorage.EXPECT().GetCNBRates(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockStorage.EXPECT().
+		GetCNBRateDates(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(dateFrom, dateTo time.Time) ([]time.Time, error) {
+			if dateFrom.After(testDate) {
+				return nil, nil
+			}
+			return []time.Time{thursday, monday}, nil
+		}).
+		AnyTimes()
+	// Tuesday isn't stored, CNB returns the list of Friday which is stored under its date
+	mockStorage.EXPECT().
+		SaveCNBRates(gomock.Any(), time.Date(2025, 3, 14, 0, 0, 0, 0, location)).
+		Return(nil)
+
+	rates := common.NewRateService(test.CreateTestLogger(), mockStorage, 0)
+	rates.CNB.BaseURL = cnbMockServer.URL
+	sut := rates.Fetcher(uuid.Nil)
+	ctx := t.Context()
+
+	// Saturday and Sunday use the rates of Friday, which are the rates of Thursday
+	for _, day := range []time.Time{testDate, testDate.AddDate(0, 0, 1), testDate.AddDate(0, 0, 2)} {
+		result, err := sut.Convert(ctx, day, "USD", "CZK", decimal.NewFromInt(2))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !result.Equal(decimal.NewFromInt(41)) {
+			t.Errorf("expected 41 on %s, got %s", day, result)
+		}
+	}
+	if *callCount != 0 {
+		t.Errorf("expected 0 HTTP calls, got %d", *callCount)
+	}
+
+	result, err := sut.Convert(ctx, tuesday, "USD", "CZK", decimal.NewFromInt(2))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqualDecimal(result, decimal.NewFromFloat(45.516), decimal.NewFromFloat(0.0001)) {
+		t.Errorf("expected 45.516, got %s", result)
+	}
+	if *callCount != 1 {
+		t.Errorf("expected 1 HTTP call, got %d", *callCount)
+	}
 }
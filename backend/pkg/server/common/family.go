@@ -0,0 +1,20 @@
+package common
+
+import (
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+)
+
+// FamilySettings returns the family with its settings. If it can't be read, a family with the
+// default settings is returned, so callers can always fall back to them.
+func FamilySettings(logger *slog.Logger, db database.Storage, familyID uuid.UUID) *models.Family {
+	family, err := db.GetFamily(familyID)
+	if err != nil || family == nil {
+		logger.With("error", err, "familyID", familyID).Warn("Failed to get family, using default settings")
+		return &models.Family{}
+	}
+	return family
+}
@@ -0,0 +1,288 @@
+package common
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+// ForecastLookbackMonths is the history used to detect recurring payments and usual payers of
+// expense accounts.
+const ForecastLookbackMonths = 6
+
+// ForecastOptions selects accounts and period of the balance forecast.
+type ForecastOptions struct {
+	Today         time.Time
+	Months        int
+	Threshold     decimal.Decimal
+	AccountID     string
+	IncludeHidden bool
+}
+
+type accountCurrency struct {
+	accountID  string
+	currencyID string
+}
+
+// ForecastBalances projects balances of asset accounts day by day starting from their current
+// balances. Detected recurring payments, scheduled templates and the part of budgets which is
+// not spent yet are applied as future payments. Recurring payments with the same partner or
+// name as a scheduled template are left to the template.
+func ForecastBalances(
+	logger *slog.Logger, db database.Storage, familyID uuid.UUID, opts ForecastOptions,
+) (*goserver.BalanceForecast, error) {
+	today := time.Date(opts.Today.Year(), opts.Today.Month(), opts.Today.Day(), 0, 0, 0, 0, opts.Today.Location())
+	horizon := today.AddDate(0, opts.Months, 0)
+
+	accounts, err := db.GetAccounts(familyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	isAsset := isAssetAccountFunc(accounts)
+	selected := make(map[string]bool)
+	for _, a := range accounts {
+		if a.Type != constants.AccountAsset || (a.HideFromReports && !opts.IncludeHidden) {
+			continue
+		}
+		if opts.AccountID != "" && a.Id != opts.AccountID {
+			continue
+		}
+		if !a.ClosingDate.IsZero() && a.ClosingDate.Before(today) {
+			continue
+		}
+		selected[a.Id] = true
+	}
+
+	transactions, err := db.GetTransactions(familyID, today.AddDate(0, -ForecastLookbackMonths, 0), today.AddDate(0, 0, 1), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	templates, err := db.GetTemplates(familyID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get templates: %w", err)
+	}
+	budgetMonth := BudgetMonth(logger, db, familyID)
+	budgetItems, err := GetBudget(db, familyID, budgetMonth, horizon)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := utils.TemplateEvents(templates, isAsset, today, horizon)
+	recurring := slices.DeleteFunc(utils.DetectRecurringPayments(transactions, isAsset, today),
+		func(p utils.RecurringPayment) bool { return isScheduled(p, templates) })
+	payments = append(payments, utils.RecurringPaymentEvents(recurring, today, horizon)...)
+	payments = append(payments,
+		remainingBudgetEvents(logger, accounts, budgetItems, budgetMonth, transactions, payments, today, horizon)...)
+
+	res := &goserver.BalanceForecast{
+		From:      today,
+		To:        horizon,
+		Threshold: opts.Threshold,
+		Dates:     []time.Time{},
+		Accounts:  []goserver.AccountForecast{},
+		Events:    []goserver.ForecastEvent{},
+	}
+	for d := today; d.Before(horizon); d = d.AddDate(0, 0, 1) {
+		res.Dates = append(res.Dates, d)
+	}
+	for _, p := range payments {
+		if selected[p.AccountId] && !p.Amount.IsZero() {
+			res.Events = append(res.Events, p.ForecastEvent)
+		}
+	}
+
+	for _, key := range forecastAccounts(accounts, selected, transactions, res.Events) {
+		balance, err := db.GetAccountBalance(familyID, key.accountID, key.currencyID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get balance of account %s: %w", key.accountID, err)
+		}
+		res.Accounts = append(res.Accounts, goserver.AccountForecast{
+			AccountId:    key.accountID,
+			CurrencyId:   key.currencyID,
+			StartBalance: balance,
+		})
+	}
+	res.Accounts, res.Warnings = utils.ProjectBalances(res.Accounts, res.Events, res.Dates, opts.Threshold)
+
+	return res, nil
+}
+
+// isScheduled checks if the recurring payment is already planned by a scheduled template.
+func isScheduled(p utils.RecurringPayment, templates []goserver.TransactionTemplate) bool {
+	for _, t := range templates {
+		if t.Repeat == "" {
+			continue
+		}
+		usesAccount := slices.ContainsFunc(t.Movements, func(m goserver.Movement) bool {
+			return m.AccountId == p.AccountID && m.CurrencyId == p.CurrencyID
+		})
+		if !usesAccount {
+			continue
+		}
+		if t.PartnerName != "" && strings.EqualFold(t.PartnerName, p.PartnerName) ||
+			t.Description != "" && strings.EqualFold(t.Description, p.Description) ||
+			strings.EqualFold(t.Name, p.PartnerName) || strings.EqualFold(t.Name, p.Description) {
+			return true
+		}
+	}
+	return false
+}
+
+// forecastAccounts returns selected accounts with all currencies they hold or use.
+func forecastAccounts(
+	accounts []goserver.Account, selected map[string]bool,
+	transactions []goserver.Transaction, events []goserver.ForecastEvent,
+) []accountCurrency {
+	res := []accountCurrency{}
+	add := func(accountID, currencyID string) {
+		key := accountCurrency{accountID, currencyID}
+		if selected[accountID] && currencyID != "" && !slices.Contains(res, key) {
+			res = append(res, key)
+		}
+	}
+
+	for _, a := range accounts {
+		for _, b := range a.BankInfo.Balances {
+			add(a.Id, b.CurrencyId)
+		}
+	}
+	for _, t := range transactions {
+		for _, m := range t.Movements {
+			add(m.AccountId, m.CurrencyId)
+		}
+	}
+	for _, e := range events {
+		add(e.AccountId, e.CurrencyId)
+	}
+
+	return res
+}
+
+// remainingBudgetEvents spreads budget of expense accounts which is neither spent nor planned
+// by other payments over the rest of its period. It's paid from the asset account which paid
+// most for the expense account in the history.
+func remainingBudgetEvents(
+	logger *slog.Logger, accounts []goserver.Account,
+	budgetItems []goserver.BudgetItem, granularity utils.Granularity, transactions []goserver.Transaction,
+	planned []utils.PlannedPayment, today, horizon time.Time,
+) []utils.PlannedPayment {
+	isAsset := isAssetAccountFunc(accounts)
+
+	type budgetPeriod struct {
+		accountID string
+		start     time.Time
+	}
+	budgets := make(map[budgetPeriod]decimal.Decimal)
+	periods := []budgetPeriod{}
+	for _, b := range budgetItems {
+		// Budgets of tags and partners cut across accounts, there is no account to pay them to
+		if b.AccountId == "" {
+			continue
+		}
+		start := utils.RoundToGranularity(b.Date, granularity, false)
+		end := utils.AddIntervals(start, granularity, 1)
+		if !end.After(today) || !start.Before(horizon) {
+			continue
+		}
+		key := budgetPeriod{b.AccountId, start}
+		if _, ok := budgets[key]; !ok {
+			periods = append(periods, key)
+		}
+		budgets[key] = budgets[key].Add(b.Amount)
+	}
+
+	res := []utils.PlannedPayment{}
+	for _, period := range periods {
+		end := utils.AddIntervals(period.start, granularity, 1)
+		from := period.start
+		if from.Before(today) {
+			from = today
+		}
+
+		remaining := budgets[period]
+		for _, t := range transactions {
+			if t.Date.Before(period.start) || !t.Date.Before(end) {
+				continue
+			}
+			for _, m := range t.Movements {
+				if m.AccountId == period.accountID && m.Amount.IsPositive() {
+					remaining = remaining.Sub(m.Amount)
+				}
+			}
+		}
+		for _, p := range planned {
+			if p.ExpenseAccountID == period.accountID && !p.Date.Before(from) && p.Date.Before(end) {
+				remaining = remaining.Sub(p.Amount.Abs())
+			}
+		}
+		if !remaining.IsPositive() {
+			continue
+		}
+
+		payer, ok := getUsualPayer(transactions, period.accountID, isAsset)
+		if !ok {
+			logger.Debug("No payer found for budget", "accountId", period.accountID)
+			continue
+		}
+		to := end
+		if to.After(horizon) {
+			to = horizon
+		}
+		// Only the part of the budget for the forecasted days is spent
+		days := to.Sub(from).Hours() / 24
+		periodDays := end.Sub(from).Hours() / 24
+		amount := remaining.Mul(decimal.NewFromFloat(days / periodDays)).Round(2)
+
+		events := utils.SpreadAmount(goserver.ForecastEvent{
+			AccountId:   payer.accountID,
+			CurrencyId:  payer.currencyID,
+			Amount:      amount.Neg(),
+			Source:      utils.ForecastSourceBudget,
+			Description: utils.GetAccount(period.accountID, accounts).Name,
+		}, from, to)
+		for _, e := range events {
+			res = append(res, utils.PlannedPayment{ForecastEvent: e, ExpenseAccountID: period.accountID})
+		}
+	}
+
+	return res
+}
+
+// getUsualPayer returns the asset account and currency which paid most to the expense account.
+func getUsualPayer(
+	transactions []goserver.Transaction, expenseAccountID string, isAsset func(accountID string) bool,
+) (accountCurrency, bool) {
+	totals := make(map[accountCurrency]decimal.Decimal)
+	for _, t := range transactions {
+		if !slices.ContainsFunc(t.Movements, func(m goserver.Movement) bool {
+			return m.AccountId == expenseAccountID && m.Amount.IsPositive()
+		}) {
+			continue
+		}
+		for _, m := range t.Movements {
+			if m.AccountId != "" && isAsset(m.AccountId) && m.Amount.IsNegative() {
+				key := accountCurrency{m.AccountId, m.CurrencyId}
+				totals[key] = totals[key].Add(m.Amount.Abs())
+			}
+		}
+	}
+
+	var res accountCurrency
+	best := decimal.Zero
+	for key, total := range totals {
+		if total.GreaterThan(best) || total.Equal(best) && key.accountID < res.accountID {
+			res = key
+			best = total
+		}
+	}
+	return res, best.IsPositive()
+}
@@ -0,0 +1,136 @@
+package common
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+var _ = Describe("ForecastBalances", func() {
+	var (
+		mockCtrl  *gomock.Controller
+		mockDB    *mocks.MockStorage
+		logger    = test.CreateTestLogger()
+		familyID  = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+		today     = time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)
+		templates []goserver.TransactionTemplate
+	)
+
+	payment := func(partner, accountID string, amount int64, date time.Time) goserver.Transaction {
+		return goserver.Transaction{
+			Date:        date,
+			PartnerName: partner,
+			Movements: []goserver.Movement{
+				{AccountId: "fio", Amount: decimal.NewFromInt(-amount), CurrencyId: "CZK"},
+				{AccountId: accountID, Amount: decimal.NewFromInt(amount), CurrencyId: "CZK"},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockDB = mocks.NewMockStorage(mockCtrl)
+		templates = nil
+
+		mockDB.EXPECT().GetAccounts(familyID).Return([]goserver.Account{
+			{Id: "fio", Name: "Fio", Type: constants.AccountAsset},
+			{Id: "rent", Name: "Rent", Type: constants.AccountExpense},
+			{Id: "groceries", Name: "Groceries", Type: constants.AccountExpense},
+		}, nil)
+		mockDB.EXPECT().GetTransactions(familyID, gomock.Any(), gomock.Any(), false).Return([]goserver.Transaction{
+			payment("Landlord", "rent", 8000, time.Date(2024, 2, 25, 0, 0, 0, 0, time.UTC)),
+			payment("Landlord", "rent", 8000, time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC)),
+			payment("Landlord", "rent", 8000, time.Date(2024, 4, 25, 0, 0, 0, 0, time.UTC)),
+			payment("Shop", "groceries", 1000, time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC)),
+		}, nil)
+		mockDB.EXPECT().GetTemplates(familyID, nil).DoAndReturn(
+			func(uuid.UUID, *string) ([]goserver.TransactionTemplate, error) {
+				return templates, nil
+			})
+		mockDB.EXPECT().GetBudgetItems(familyID).Return([]goserver.BudgetItem{
+			{AccountId: "groceries", Date: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Amount: decimal.NewFromInt(3000)},
+		}, nil)
+		mockDB.EXPECT().GetFamily(familyID).Return(&models.Family{}, nil)
+		mockDB.EXPECT().GetBudgetPlans(familyID).Return(nil, nil)
+		mockDB.EXPECT().GetAccountBalance(familyID, "fio", "CZK").Return(decimal.NewFromInt(10000), nil)
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should project recurring payments and remaining budget", func() {
+		res, err := ForecastBalances(logger, mockDB, familyID, ForecastOptions{
+			Today:     today,
+			Months:    1,
+			Threshold: decimal.NewFromInt(1000),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Dates).To(HaveLen(31))
+		Expect(res.Accounts).To(HaveLen(1))
+
+		rent := []goserver.ForecastEvent{}
+		budget := decimal.Zero
+		for _, e := range res.Events {
+			switch e.Source {
+			case utils.ForecastSourceRecurring:
+				rent = append(rent, e)
+			case utils.ForecastSourceBudget:
+				Expect(e.Description).To(Equal("Groceries"))
+				Expect(e.Date.Before(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))).To(BeTrue())
+				budget = budget.Add(e.Amount)
+			}
+		}
+		Expect(rent).To(HaveLen(1))
+		Expect(rent[0].Date).To(Equal(time.Date(2024, 5, 25, 0, 0, 0, 0, time.UTC)))
+		Expect(rent[0].Description).To(Equal("Landlord"))
+		// 3000 budgeted, 1000 already spent
+		Expect(budget.Equal(decimal.NewFromInt(-2000))).To(BeTrue())
+
+		account := res.Accounts[0]
+		Expect(account.StartBalance.Equal(decimal.NewFromInt(10000))).To(BeTrue())
+		Expect(account.Balances[len(account.Balances)-1].IsZero()).To(BeTrue())
+		Expect(res.Warnings).To(HaveLen(1))
+		Expect(res.Warnings[0].Date).To(Equal(time.Date(2024, 5, 25, 0, 0, 0, 0, time.UTC)))
+	})
+
+	It("should prefer scheduled templates over detected recurring payments", func() {
+		templates = []goserver.TransactionTemplate{{
+			Name:        "Rent",
+			PartnerName: "Landlord",
+			Repeat:      utils.RepeatMonthly,
+			NextDate:    time.Date(2024, 5, 24, 0, 0, 0, 0, time.UTC),
+			Movements: []goserver.Movement{
+				{AccountId: "fio", Amount: decimal.NewFromInt(-8500), CurrencyId: "CZK"},
+				{AccountId: "rent", Amount: decimal.NewFromInt(8500), CurrencyId: "CZK"},
+			},
+		}}
+
+		res, err := ForecastBalances(logger, mockDB, familyID, ForecastOptions{Today: today, Months: 1})
+		Expect(err).ToNot(HaveOccurred())
+
+		sources := map[string]int{}
+		for _, e := range res.Events {
+			sources[e.Source]++
+			if e.Source == utils.ForecastSourceTemplate {
+				Expect(e.Date).To(Equal(time.Date(2024, 5, 24, 0, 0, 0, 0, time.UTC)))
+				Expect(e.Amount.Equal(decimal.NewFromInt(-8500))).To(BeTrue())
+			}
+		}
+		Expect(sources[utils.ForecastSourceTemplate]).To(Equal(1))
+		Expect(sources[utils.ForecastSourceRecurring]).To(BeZero())
+		balances := res.Accounts[0].Balances
+		Expect(balances[len(balances)-1].Equal(decimal.NewFromInt(-500))).To(BeTrue())
+		Expect(res.Warnings).To(HaveLen(1))
+	})
+})
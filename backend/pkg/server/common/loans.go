@@ -0,0 +1,161 @@
+package common
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+type loanMovement struct {
+	transactionID string
+	date          time.Time
+	amount        decimal.Decimal
+}
+
+// LoanPaymentSplitter splits payments to liability accounts with loan terms into the principal
+// part, which stays on the liability, and the interest part, which goes to the interest account
+// of the loan. The interest is calculated from the principal remaining before the payment, i.e.
+// minus the balance of the liability account in the loan currency.
+type LoanPaymentSplitter struct {
+	logger    *slog.Logger
+	loans     map[string]goserver.Account
+	movements map[string][]loanMovement
+}
+
+// NewLoanPaymentSplitter prepares the splitter from accounts of the family and their transactions.
+func NewLoanPaymentSplitter(
+	logger *slog.Logger, accounts []goserver.Account, transactions []goserver.Transaction,
+) *LoanPaymentSplitter {
+	res := &LoanPaymentSplitter{
+		logger:    logger,
+		loans:     make(map[string]goserver.Account),
+		movements: make(map[string][]loanMovement),
+	}
+	for _, a := range accounts {
+		if a.Type == constants.AccountLiability && utils.HasLoanTerms(a.LoanTerms) && a.LoanTerms.InterestAccountId != "" {
+			res.loans[a.Id] = a
+		}
+	}
+	if len(res.loans) == 0 {
+		return res
+	}
+	for _, t := range transactions {
+		res.addMovements(t.Id, t.Date, t.Movements)
+	}
+	return res
+}
+
+// LoadLoanPaymentSplitter loads accounts of the family and prepares the splitter. Transactions are
+// loaded only if there are loans which payments can be split.
+func LoadLoanPaymentSplitter(logger *slog.Logger, db database.Storage, familyID uuid.UUID) (*LoanPaymentSplitter, error) {
+	accounts, err := db.GetAccounts(familyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	if !slices.ContainsFunc(accounts, func(a goserver.Account) bool {
+		return a.Type == constants.AccountLiability && utils.HasLoanTerms(a.LoanTerms)
+	}) {
+		return NewLoanPaymentSplitter(logger, accounts, nil), nil
+	}
+
+	transactions, err := db.GetTransactions(familyID, time.Time{}, time.Time{}, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	return NewLoanPaymentSplitter(logger, accounts, transactions), nil
+}
+
+func (s *LoanPaymentSplitter) addMovements(transactionID string, date time.Time, movements []goserver.Movement) {
+	for _, m := range movements {
+		loan, ok := s.loans[m.AccountId]
+		if !ok || m.CurrencyId != loan.LoanTerms.CurrencyId {
+			continue
+		}
+		s.movements[m.AccountId] = append(s.movements[m.AccountId], loanMovement{
+			transactionID: transactionID, date: date, amount: m.Amount,
+		})
+	}
+}
+
+// remainingPrincipal returns the principal owed before the date, not counting the transaction
+// with the given ID.
+func (s *LoanPaymentSplitter) remainingPrincipal(loan goserver.Account, date time.Time, transactionID string) decimal.Decimal {
+	balance := decimal.Zero
+	for _, b := range loan.BankInfo.Balances {
+		if b.CurrencyId == loan.LoanTerms.CurrencyId {
+			balance = balance.Add(b.OpeningBalance)
+		}
+	}
+	for _, m := range s.movements[loan.Id] {
+		if m.date.Before(date) && (transactionID == "" || m.transactionID != transactionID) {
+			balance = balance.Add(m.amount)
+		}
+	}
+	return balance.Neg()
+}
+
+// Split replaces a payment to a loan in the transaction with principal and interest movements.
+// Transactions which already have a movement to the interest account of the loan are kept as
+// they are. transactionID is the ID of the stored transaction, empty for new ones. Returns true
+// if the transaction was changed.
+func (s *LoanPaymentSplitter) Split(transactionID string, t *goserver.TransactionNoId) bool {
+	if len(s.loans) == 0 {
+		return false
+	}
+
+	split := false
+	movements := make([]goserver.Movement, 0, len(t.Movements)+1)
+	for _, m := range t.Movements {
+		loan, ok := s.loans[m.AccountId]
+		if !ok || m.CurrencyId != loan.LoanTerms.CurrencyId || !m.Amount.IsPositive() ||
+			slices.ContainsFunc(t.Movements, func(other goserver.Movement) bool {
+				return other.AccountId == loan.LoanTerms.InterestAccountId
+			}) {
+			movements = append(movements, m)
+			continue
+		}
+
+		remaining := s.remainingPrincipal(loan, t.Date, transactionID)
+		if !remaining.IsPositive() {
+			s.logger.Warn("Loan payment isn't split, nothing is owed", "account", loan.Id, "date", t.Date)
+			movements = append(movements, m)
+			continue
+		}
+
+		principal, interest := utils.SplitLoanPayment(m.Amount, remaining, loan.LoanTerms.AnnualRate)
+		if interest.IsZero() {
+			movements = append(movements, m)
+			continue
+		}
+		if principal.IsPositive() {
+			principalMovement := m
+			principalMovement.Amount = principal
+			movements = append(movements, principalMovement)
+		}
+		movements = append(movements, goserver.Movement{
+			AccountId:   loan.LoanTerms.InterestAccountId,
+			CurrencyId:  m.CurrencyId,
+			Amount:      interest,
+			Description: m.Description,
+		})
+		split = true
+		s.logger.Info("Split loan payment",
+			"account", loan.Id, "date", t.Date, "principal", principal, "interest", interest)
+	}
+	if !split {
+		return false
+	}
+
+	t.Movements = movements
+	// Later payments of the same batch see the reduced principal
+	s.addMovements(transactionID, t.Date, movements)
+	return true
+}
@@ -0,0 +1,498 @@
+package common
+
+import (
+	"bufio"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+)
+
+const (
+	// RateProviderCNB is the daily exchange rate list of the Czech National Bank
+	RateProviderCNB = "cnb"
+	// RateProviderECB is the euro foreign exchange reference rates of the European Central Bank
+	RateProviderECB = "ecb"
+	// RateProviderManual is the exchange rate table managed by the family
+	RateProviderManual = "manual"
+
+	// RateSourceTransaction is the rate a transaction was executed at, it is preferred to providers
+	RateSourceTransaction = "transaction"
+
+	// DefaultRateBaseCurrency is the rate base currency of families which didn't configure one
+	DefaultRateBaseCurrency = "CZK"
+
+	ecbHistoryDays = 90
+	// ratesFallbackDays is the longest period without published rates (weekends and holidays) which
+	// is bridged by the rates of the last published business day
+	ratesFallbackDays = 7
+)
+
+// IsRateProvider returns true if name is a known exchange rate provider.
+func IsRateProvider(name string) bool {
+	return slices.Contains([]string{RateProviderCNB, RateProviderECB, RateProviderManual}, name)
+}
+
+// RateProvider publishes exchange rates of currencies against its base currency.
+type RateProvider interface {
+	Name() string
+	BaseCurrency() string
+	// Rates returns the value of one unit of every known currency in the base currency on the day and
+	// the date the rates were published on. It's earlier than the day if no rates were published for
+	// the day, e.g. on weekends, holidays or before today's rates are published.
+	Rates(ctx context.Context, day time.Time) (map[string]decimal.Decimal, time.Time, error)
+}
+
+// CNBRateProvider reads the daily TXT exchange rate list of the Czech National Bank.
+type CNBRateProvider struct {
+	logger  *slog.Logger
+	storage database.Storage
+
+	BaseURL string
+}
+
+func NewCNBRateProvider(logger *slog.Logger, storage database.Storage) *CNBRateProvider {
+	return &CNBRateProvider{
+		logger:  logger,
+		storage: storage,
+		BaseURL: "https://www.cnb.cz/" +
+			"cs/financni-trhy/devizovy-trh/kurzy-devizoveho-trhu/kurzy-devizoveho-trhu/denni_kurz.txt",
+	}
+}
+
+func (p *CNBRateProvider) Name() string {
+	return RateProviderCNB
+}
+
+func (p *CNBRateProvider) BaseCurrency() string {
+	return "CZK"
+}
+
+func (p *CNBRateProvider) Rates(ctx context.Context, day time.Time) (map[string]decimal.Decimal, time.Time, error) {
+	// CNB doesn't publish rates on weekends, the rates of Friday are valid then
+	day = lastBusinessDay(day)
+	dateKey := day.Format("2006-01-02")
+
+	// Try to get rates from DB first
+	rates, err := p.storage.GetCNBRates(day)
+	if err != nil {
+		p.logger.Warn("failed to get rates from DB", "error", err, "date", dateKey)
+	}
+	if len(rates) > 0 {
+		p.logger.Debug("using rates from DB", "date", dateKey)
+		return rates, day, nil
+	}
+
+	dates, err := p.storage.GetCNBRateDates(
+		day.AddDate(0, 0, -ratesFallbackDays), day.AddDate(0, 0, ratesFallbackDays))
+	if err != nil {
+		p.logger.Warn("failed to get rate dates from DB", "error", err, "date", dateKey)
+	}
+	if published, ok := lastPublishedDate(dates, day); ok {
+		rates, err = p.storage.GetCNBRates(published)
+		if err != nil {
+			p.logger.Warn("failed to get rates from DB", "error", err, "date", published)
+		}
+		if len(rates) > 0 {
+			p.logger.Debug("using rates of the last published day from DB", "date", dateKey, "published", published)
+			return rates, published, nil
+		}
+	}
+
+	// Fetch rates if not in DB, the date in the URL is in DD.MM.YYYY format
+	rates, published, err := p.fetchRates(ctx, day)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	// For days without rates CNB returns the rates of the last published day, they are stored
+	// under that day
+	if published.IsZero() || published.Format("2006-01-02") == dateKey {
+		published = day
+	}
+	if err := p.storage.SaveCNBRates(rates, published); err != nil {
+		p.logger.Warn("failed to store rates to DB", "error", err, "date", published)
+	}
+
+	return rates, published, nil
+}
+
+// History returns stored rates of the currency in the date range by date
+func (p *CNBRateProvider) History(currency string, dateFrom, dateTo time.Time) (map[string]decimal.Decimal, error) {
+	rates, err := p.storage.GetCNBRatesHistory(currency, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]decimal.Decimal, len(rates))
+	for _, r := range rates {
+		res[r.RateDate.Format("2006-01-02")] = r.RateToCZK
+	}
+	return res, nil
+}
+
+// PublishedDates returns dates in the date range with stored rates
+func (p *CNBRateProvider) PublishedDates(dateFrom, dateTo time.Time) ([]time.Time, error) {
+	return p.storage.GetCNBRateDates(dateFrom, dateTo)
+}
+
+// fetchRates returns the rates valid on the day and the date they were published on
+func (p *CNBRateProvider) fetchRates(ctx context.Context, day time.Time) (map[string]decimal.Decimal, time.Time, error) {
+	url := fmt.Sprintf("%s?date=%s", p.BaseURL, day.Format("02.01.2006"))
+	p.logger.Debug("fetching rates", "url", url)
+
+	resp, err := getRates(ctx, url)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	defer resp.Body.Close()
+
+	// Parse the CNB format
+	rates := make(map[string]decimal.Decimal)
+	scanner := bufio.NewScanner(resp.Body)
+
+	// The first line is the date of the list and its number, e.g. "14.03.2025 #53"
+	var published time.Time
+	if scanner.Scan() {
+		date, _, _ := strings.Cut(scanner.Text(), " ")
+		if published, err = time.ParseInLocation("02.01.2006", date, day.Location()); err != nil {
+			p.logger.Warn("failed to parse date of the rates", "error", err, "header", scanner.Text())
+		}
+	}
+	scanner.Scan() // Skip second line (header)
+
+	// Parse the rest of the lines
+	for scanner.Scan() {
+		line := scanner.Text()
+		parts := strings.Split(line, "|")
+
+		if len(parts) < 5 {
+			continue
+		}
+
+		// Format is: Country|Currency|Amount|Code|Rate
+		currencyCode := parts[3]
+		amountStr := parts[2]
+		rateStr := strings.Replace(parts[4], ",", ".", 1) // Replace comma with dot for decimal point
+
+		amount, err := decimal.NewFromString(amountStr)
+		if err != nil {
+			continue
+		}
+
+		rate, err := decimal.NewFromString(rateStr)
+		if err != nil {
+			continue
+		}
+
+		// Store rate per unit of currency
+		rates[currencyCode] = rate.Div(amount)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return rates, published, nil
+}
+
+// ECBRateProvider reads the euro foreign exchange reference rates of the European Central Bank. Rates
+// of the last 90 days come from the short history document, older ones from the full history.
+type ECBRateProvider struct {
+	logger  *slog.Logger
+	storage database.Storage
+	// document URL -> date -> currency -> rate, documents are fetched once under the lock
+	mu        sync.Mutex
+	documents map[string]map[string]map[string]decimal.Decimal
+
+	BaseURL string
+}
+
+func NewECBRateProvider(logger *slog.Logger, storage database.Storage) *ECBRateProvider {
+	return &ECBRateProvider{
+		logger:    logger,
+		storage:   storage,
+		documents: make(map[string]map[string]map[string]decimal.Decimal),
+		BaseURL:   "https://www.ecb.europa.eu/stats/eurofxref",
+	}
+}
+
+func (p *ECBRateProvider) Name() string {
+	return RateProviderECB
+}
+
+func (p *ECBRateProvider) BaseCurrency() string {
+	return "EUR"
+}
+
+func (p *ECBRateProvider) Rates(ctx context.Context, day time.Time) (map[string]decimal.Decimal, time.Time, error) {
+	// ECB doesn't publish rates on weekends, the rates of Friday are valid then
+	day = lastBusinessDay(day)
+	dateKey := day.Format("2006-01-02")
+
+	rates, err := p.storage.GetProviderRates(RateProviderECB, day)
+	if err != nil {
+		p.logger.Warn("failed to get rates from DB", "error", err, "date", dateKey, "provider", RateProviderECB)
+	}
+	if len(rates) > 0 {
+		return rates, day, nil
+	}
+
+	dates, err := p.storage.GetProviderRateDates(RateProviderECB,
+		day.AddDate(0, 0, -ratesFallbackDays), day.AddDate(0, 0, ratesFallbackDays))
+	if err != nil {
+		p.logger.Warn("failed to get rate dates from DB", "error", err, "date", dateKey, "provider", RateProviderECB)
+	}
+	if published, ok := lastPublishedDate(dates, day); ok {
+		rates, err = p.storage.GetProviderRates(RateProviderECB, published)
+		if err != nil {
+			p.logger.Warn("failed to get rates from DB", "error", err, "date", published, "provider", RateProviderECB)
+		}
+		if len(rates) > 0 {
+			return rates, published, nil
+		}
+	}
+
+	url := p.BaseURL + "/eurofxref-hist.xml"
+	if time.Since(day) < ecbHistoryDays*24*time.Hour {
+		url = p.BaseURL + "/eurofxref-hist-90d.xml"
+	}
+	document, err := p.document(ctx, url)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	// Use the rates of the last published day if there are no rates for the day (a holiday or
+	// rates of today which are not published yet)
+	var published time.Time
+	for i := 0; i <= ratesFallbackDays && published.IsZero(); i++ {
+		var ok bool
+		if rates, ok = document[day.AddDate(0, 0, -i).Format("2006-01-02")]; ok {
+			published = day.AddDate(0, 0, -i)
+		}
+	}
+	if published.IsZero() {
+		return nil, time.Time{}, fmt.Errorf("no ECB rates published for %s", dateKey)
+	}
+
+	if err := p.storage.SaveProviderRates(RateProviderECB, rates, published); err != nil {
+		p.logger.Warn("failed to store rates to DB", "error", err, "date", published, "provider", RateProviderECB)
+	}
+
+	return rates, published, nil
+}
+
+// History returns stored rates of the currency in the date range by date
+func (p *ECBRateProvider) History(currency string, dateFrom, dateTo time.Time) (map[string]decimal.Decimal, error) {
+	rates, err := p.storage.GetProviderRatesHistory(RateProviderECB, currency, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]decimal.Decimal, len(rates))
+	for _, r := range rates {
+		res[r.RateDate.Format("2006-01-02")] = r.Rate
+	}
+	return res, nil
+}
+
+// PublishedDates returns dates in the date range with stored rates
+func (p *ECBRateProvider) PublishedDates(dateFrom, dateTo time.Time) ([]time.Time, error) {
+	return p.storage.GetProviderRateDates(RateProviderECB, dateFrom, dateTo)
+}
+
+// document returns rates of every date published in the document, it is fetched on first use
+func (p *ECBRateProvider) document(ctx context.Context, url string) (map[string]map[string]decimal.Decimal, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if document, ok := p.documents[url]; ok {
+		return document, nil
+	}
+	document, err := p.fetchRates(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	p.documents[url] = document
+	return document, nil
+}
+
+type ecbEnvelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// fetchRates parses the eurofxref XML document into rates of every published date
+func (p *ECBRateProvider) fetchRates(ctx context.Context, url string) (map[string]map[string]decimal.Decimal, error) {
+	p.logger.Debug("fetching rates", "url", url)
+
+	resp, err := getRates(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return nil, fmt.Errorf("parsing ECB rates: %w", err)
+	}
+
+	res := make(map[string]map[string]decimal.Decimal, len(envelope.Days))
+	for _, day := range envelope.Days {
+		rates := make(map[string]decimal.Decimal, len(day.Rates))
+		for _, r := range day.Rates {
+			rate, err := decimal.NewFromString(r.Rate)
+			if err != nil || !rate.IsPositive() {
+				continue
+			}
+			// ECB publishes units of the currency per one euro
+			rates[r.Currency] = decimal.NewFromInt(1).Div(rate)
+		}
+		res[day.Time] = rates
+	}
+
+	return res, nil
+}
+
+// ManualRateProvider uses the exchange rate table managed by the family. A rate is valid from its
+// date until its end date, and if several rates of a currency are valid the latest one is used.
+type ManualRateProvider struct {
+	storage      database.Storage
+	familyID     uuid.UUID
+	baseCurrency string
+	// currency name -> rates ordered by date
+	rates map[string][]manualRate
+}
+
+type manualRate struct {
+	date   time.Time
+	dateTo time.Time
+	rate   decimal.Decimal
+}
+
+func NewManualRateProvider(storage database.Storage, familyID uuid.UUID, baseCurrency string) *ManualRateProvider {
+	return &ManualRateProvider{storage: storage, familyID: familyID, baseCurrency: baseCurrency}
+}
+
+func (p *ManualRateProvider) Name() string {
+	return RateProviderManual
+}
+
+func (p *ManualRateProvider) BaseCurrency() string {
+	return p.baseCurrency
+}
+
+func (p *ManualRateProvider) Rates(_ context.Context, day time.Time) (map[string]decimal.Decimal, time.Time, error) {
+	if p.rates == nil {
+		if err := p.load(); err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+
+	res := make(map[string]decimal.Decimal)
+	for currency, rates := range p.rates {
+		for _, r := range rates {
+			if r.date.After(day) {
+				break
+			}
+			// the end date is the last day the rate is valid on
+			if !r.dateTo.IsZero() && !day.Before(r.dateTo.AddDate(0, 0, 1)) {
+				continue
+			}
+			res[currency] = r.rate
+		}
+	}
+	return res, day, nil
+}
+
+func (p *ManualRateProvider) load() error {
+	currencies, err := p.storage.GetCurrencies(p.familyID)
+	if err != nil {
+		return fmt.Errorf("failed to get currencies: %w", err)
+	}
+	names := make(map[string]string, len(currencies))
+	for _, c := range currencies {
+		names[c.Id] = c.Name
+	}
+
+	exchangeRates, err := p.storage.GetExchangeRates(p.familyID)
+	if err != nil {
+		return fmt.Errorf("failed to get exchange rates: %w", err)
+	}
+
+	// exchange rates are ordered by date
+	p.rates = make(map[string][]manualRate)
+	for _, r := range exchangeRates {
+		name, ok := names[r.CurrencyId]
+		if !ok {
+			continue
+		}
+		p.rates[name] = append(p.rates[name], manualRate{date: r.Date, dateTo: r.DateTo, rate: r.Rate})
+	}
+	return nil
+}
+
+// lastBusinessDay returns the day, or the Friday before it if the day is on a weekend
+func lastBusinessDay(day time.Time) time.Time {
+	switch day.Weekday() {
+	case time.Saturday:
+		return day.AddDate(0, 0, -1)
+	case time.Sunday:
+		return day.AddDate(0, 0, -2)
+	default:
+		return day
+	}
+}
+
+// lastPublishedDate returns the date of the stored rates which are valid on the day: the day
+// itself or, if rates of a later day are stored and so the day wasn't published (a holiday), the
+// last date before the day. Dates are ordered.
+func lastPublishedDate(dates []time.Time, day time.Time) (time.Time, bool) {
+	dateKey := day.Format("2006-01-02")
+	var last time.Time
+	for _, date := range dates {
+		switch key := date.Format("2006-01-02"); {
+		case key == dateKey:
+			return date, true
+		case key < dateKey:
+			last = date
+		case !last.IsZero():
+			return last, true
+		default:
+			return time.Time{}, false
+		}
+	}
+	return time.Time{}, false
+}
+
+func getRates(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	// Set user-agent
+	req.Header.Set("User-Agent", "GeekBudgetBE/1.0")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("bad response status: %s", resp.Status)
+	}
+	return resp, nil
+}
@@ -0,0 +1,217 @@
+package common_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/models"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+func createECBMockServer() (*httptest.Server, *int) {
+	callCount := 0
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		if r.URL.Path != "/eurofxref-hist.xml" && r.URL.Path != "/eurofxref-hist-90d.xml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		mockResponse := `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2025-03-14">
+			<Cube currency="USD" rate="1.0880"/>
+			<Cube currency="CZK" rate="25.000"/>
+			<Cube currency="ISK" rate="150.00"/>
+		</Cube>
+		<Cube time="2025-03-13">
+			<Cube currency="USD" rate="1.0850"/>
+			<Cube currency="CZK" rate="25.100"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(mockResponse))
+	}))
+
+	return s, &callCount
+}
+
+func mockFamilySettings(
+	mockStorage *mocks.MockStorage, familyID uuid.UUID, providers []string, base goserver.Currency,
+) {
+	family := &models.Family{RateProviders: providers, RateBaseCurrencyID: base.Id}
+	mockStorage.EXPECT().GetFamily(familyID).Return(family, nil).AnyTimes()
+	mockStorage.EXPECT().GetCurrency(familyID, base.Id).Return(base, nil).AnyTimes()
+}
+
+func TestECBRateProvider(t *testing.T) {
+	ecbMockServer, callCount := createECBMockServer()
+	defer ecbMockServer.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStorage := mocks.NewMockStorage(ctrl)
+
+	familyID := uuid.New()
+	mockFamilySettings(mockStorage, familyID, []string{common.RateProviderECB}, goserver.Currency{Id: uuid.NewString(), Name: "EUR"})
+	mockStorage.EXPECT().GetProviderRates(common.RateProviderECB, gomock.Any()).Return(nil, nil).AnyTimes()
+	mockStorage.EXPECT().GetProviderRateDates(common.RateProviderECB, gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockStorage.EXPECT().SaveProviderRates(common.RateProviderECB, gomock.Any(), gomock.Any()).Return(nil).Times(4)
+	mockStorage.EXPECT().GetCurrencies(familyID).Return(nil, nil).AnyTimes()
+	mockStorage.EXPECT().GetExchangeRates(familyID).Return(nil, nil).AnyTimes()
+
+	rates := common.NewRateService(test.CreateTestLogger(), mockStorage, 0)
+	rates.ECB.BaseURL = ecbMockServer.URL
+	sut := rates.Fetcher(familyID)
+	ctx := t.Context()
+
+	result, err := sut.Convert(ctx, testDate, "USD", "CZK", decimal.NewFromInt(100))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := decimal.NewFromInt(100).Div(decimal.NewFromFloat(1.088)).Mul(decimal.NewFromInt(25))
+	if !almostEqualDecimal(result, expected, decimal.NewFromFloat(0.0001)) {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+
+	// Other dates are read from the already fetched history
+	result, err = sut.Convert(ctx, testDate.AddDate(0, 0, -1), "EUR", "CZK", decimal.NewFromInt(2))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !almostEqualDecimal(result, decimal.NewFromFloat(50.2), decimal.NewFromFloat(0.0001)) {
+		t.Errorf("expected 50.2, got %s", result)
+	}
+	if *callCount != 1 {
+		t.Errorf("expected 1 HTTP call, got %d", *callCount)
+	}
+
+	// Weekends and days without published rates use the rates of the last published day
+	for _, day := range []time.Time{testDate.AddDate(0, 0, 1), testDate.AddDate(0, 0, 3)} {
+		result, err = sut.Convert(ctx, day, "EUR", "CZK", decimal.NewFromInt(2))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !almostEqualDecimal(result, decimal.NewFromInt(50), decimal.NewFromFloat(0.0001)) {
+			t.Errorf("expected 50 on %s, got %s", day, result)
+		}
+	}
+
+	// Too old dates are not bridged
+	if _, err = sut.Convert(ctx, testDate.AddDate(0, 0, -10), "EUR", "CZK", decimal.NewFromInt(2)); err == nil {
+		t.Error("expected error for a day without rates but got nil")
+	}
+}
+
+//nolint:funlen // Test function with many cases
+func TestCurrenciesRatesFetcher_ProviderChain(t *testing.T) {
+	cnbMockServer, _ := createMockServer()
+	defer cnbMockServer.Close()
+	ecbMockServer, _ := createECBMockServer()
+	defer ecbMockServer.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStorage := mocks.NewMockStorage(ctrl)
+
+	familyID := uuid.New()
+	czk := goserver.Currency{Id: uuid.NewString(), Name: "CZK"}
+	btc := goserver.Currency{Id: uuid.NewString(), Name: "BTC"}
+	mockFamilySettings(mockStorage, familyID,
+		[]string{common.RateProviderCNB, common.RateProviderECB, common.RateProviderManual}, czk)
+	mockStorage.EXPECT().GetCNBRates(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockStorage.EXPECT().GetCNBRateDates(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockStorage.EXPECT().GetProviderRateDates(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockStorage.EXPECT().SaveCNBRates(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockStorage.EXPECT().GetProviderRates(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockStorage.EXPECT().SaveProviderRates(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockStorage.EXPECT().GetCurrencies(familyID).Return([]goserver.Currency{czk, btc}, nil).AnyTimes()
+	mockStorage.EXPECT().GetExchangeRates(familyID).Return([]goserver.ExchangeRate{
+		{CurrencyId: btc.Id, Date: testDate.AddDate(0, 0, -10), Rate: decimal.NewFromInt(1_000_000)},
+		{CurrencyId: btc.Id, Date: testDate.AddDate(0, 0, 10), Rate: decimal.NewFromInt(2_000_000)},
+	}, nil).AnyTimes()
+
+	rates := common.NewRateService(test.CreateTestLogger(), mockStorage, 0)
+	rates.CNB.BaseURL = cnbMockServer.URL
+	rates.ECB.BaseURL = ecbMockServer.URL
+	sut := rates.Fetcher(familyID)
+	ctx := t.Context()
+
+	tests := []struct {
+		name          string
+		from          string
+		to            string
+		amount        decimal.Decimal
+		expected      decimal.Decimal
+		expectedError bool
+	}{
+		{
+			name:     "First provider knows both currencies",
+			from:     "USD",
+			to:       "EUR",
+			amount:   decimal.NewFromInt(100),
+			expected: decimal.NewFromInt(100).Mul(decimal.NewFromFloat(22.758)).Div(decimal.NewFromFloat(25.490)),
+		},
+		{
+			name:     "Currency unknown to CNB is converted by ECB",
+			from:     "ISK",
+			to:       "CZK",
+			amount:   decimal.NewFromInt(1500),
+			expected: decimal.NewFromInt(250),
+		},
+		{
+			name:     "Manual rate is valid until the next one",
+			from:     "BTC",
+			to:       "CZK",
+			amount:   decimal.NewFromInt(2),
+			expected: decimal.NewFromInt(2_000_000),
+		},
+		{
+			name:     "Currencies of different providers are converted via the base currency",
+			from:     "BTC",
+			to:       "USD",
+			amount:   decimal.NewFromInt(1),
+			expected: decimal.NewFromInt(1_000_000).Div(decimal.NewFromFloat(22.758)),
+		},
+		{
+			name:          "Currency unknown to all providers",
+			from:          "XYZ",
+			to:            "USD",
+			amount:        decimal.NewFromInt(100),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := sut.Convert(ctx, testDate, tt.from, tt.to, tt.amount)
+
+			if tt.expectedError {
+				if err == nil {
+					t.Errorf("expected error but got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if !almostEqualDecimal(result, tt.expected, decimal.NewFromFloat(0.0001)) {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
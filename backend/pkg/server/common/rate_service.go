@@ -0,0 +1,202 @@
+package common
+
+import (
+	"container/list"
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultRateCacheSize is the number of provider rate lists (one per provider and date) kept in memory
+const DefaultRateCacheSize = 1024
+
+// DefaultProvisionalRatesTTL is how long rates published before the requested day are cached. They
+// may be replaced by rates published later, e.g. today's rates which weren't published yet.
+const DefaultProvisionalRatesTTL = time.Hour
+
+// rateFetchTimeout limits fetches of rates shared by several lookups, which don't stop with their contexts
+const rateFetchTimeout = time.Minute
+
+// RateCacheStats are counters of the shared rate cache since the start of the process.
+type RateCacheStats struct {
+	Size     int `json:"size"`
+	Capacity int `json:"capacity"`
+	// Hits and Misses count lookups of provider rates of a date
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+	// Fetches counts reads of provider rates from the database or from the provider
+	Fetches uint64 `json:"fetches"`
+	// Deduplicated counts misses which waited for a fetch of the same rates started by another lookup
+	Deduplicated uint64 `json:"deduplicated"`
+	Evictions    uint64 `json:"evictions"`
+}
+
+// RateService is the process-wide source of exchange rates. It shares the CNB and ECB providers and
+// a bounded cache of their rates between requests, and rates of a date needed by several requests at
+// the same time are fetched only once. It is safe for concurrent use.
+type RateService struct {
+	logger  *slog.Logger
+	storage database.Storage
+	group   singleflight.Group
+
+	mu       sync.Mutex
+	capacity int
+	// provider|date -> element of order with *rateCacheEntry, the most recently used first
+	entries map[string]*list.Element
+	order   *list.List
+	stats   RateCacheStats
+
+	CNB *CNBRateProvider
+	ECB *ECBRateProvider
+	// ProvisionalRatesTTL is how long rates published before the requested business day are cached
+	ProvisionalRatesTTL time.Duration
+}
+
+type rateCacheEntry struct {
+	key   string
+	rates map[string]decimal.Decimal
+	// expires is zero for rates which were published for the requested day
+	expires time.Time
+}
+
+// NewRateService returns service which keeps up to cacheSize provider rate lists in memory,
+// DefaultRateCacheSize if cacheSize isn't positive.
+func NewRateService(logger *slog.Logger, storage database.Storage, cacheSize int) *RateService {
+	if cacheSize <= 0 {
+		cacheSize = DefaultRateCacheSize
+	}
+	return &RateService{
+		logger:   logger,
+		storage:  storage,
+		capacity: cacheSize,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		CNB:      NewCNBRateProvider(logger, storage),
+		ECB:      NewECBRateProvider(logger, storage),
+
+		ProvisionalRatesTTL: DefaultProvisionalRatesTTL,
+	}
+}
+
+// Fetcher returns fetcher which converts with the rate providers and the rate base currency
+// configured by the family, uuid.Nil returns fetcher which uses CNB rates only.
+func (s *RateService) Fetcher(familyID uuid.UUID) *CurrenciesRatesFetcher {
+	return &CurrenciesRatesFetcher{
+		logger:      s.logger,
+		storage:     s.storage,
+		service:     s,
+		familyID:    familyID,
+		manualRates: make(map[string]map[string]decimal.Decimal),
+	}
+}
+
+// Stats returns the current counters of the rate cache
+func (s *RateService) Stats() RateCacheStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := s.stats
+	stats.Size = s.order.Len()
+	stats.Capacity = s.capacity
+	return stats
+}
+
+// rates returns rates of the provider for the day. The returned map is shared and must not be modified.
+// The fetch is shared by concurrent lookups of the same rates, so it doesn't stop when the context of
+// one of them is cancelled, the cancelled lookup returns without waiting for it.
+func (s *RateService) rates(
+	ctx context.Context, provider RateProvider, day time.Time,
+) (map[string]decimal.Decimal, error) {
+	key := provider.Name() + "|" + day.Format("2006-01-02")
+	if rates, ok := s.get(key); ok {
+		return rates, nil
+	}
+
+	leader := false
+	ch := s.group.DoChan(key, func() (any, error) {
+		leader = true
+		s.mu.Lock()
+		s.stats.Fetches++
+		s.mu.Unlock()
+
+		// Waiting lookups still need the rates if the one which started the fetch is cancelled
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rateFetchTimeout)
+		defer cancel()
+		rates, published, err := provider.Rates(fetchCtx, day)
+		if err != nil {
+			return nil, err
+		}
+		var expires time.Time
+		if published.Before(lastBusinessDay(day)) {
+			expires = time.Now().Add(s.ProvisionalRatesTTL)
+		}
+		s.add(key, rates, expires)
+		return rates, nil
+	})
+
+	var res singleflight.Result
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res = <-ch:
+	}
+	if !leader {
+		s.mu.Lock()
+		s.stats.Deduplicated++
+		s.mu.Unlock()
+	}
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	rates, _ := res.Val.(map[string]decimal.Decimal)
+	return rates, nil
+}
+
+func (s *RateService) get(key string) (map[string]decimal.Decimal, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, ok := s.entries[key]
+	if ok {
+		if entry, _ := element.Value.(*rateCacheEntry); !entry.expires.IsZero() && !time.Now().Before(entry.expires) {
+			s.order.Remove(element)
+			delete(s.entries, key)
+			ok = false
+		}
+	}
+	if !ok {
+		s.stats.Misses++
+		return nil, false
+	}
+	s.stats.Hits++
+	s.order.MoveToFront(element)
+	entry, _ := element.Value.(*rateCacheEntry)
+	return entry.rates, true
+}
+
+func (s *RateService) add(key string, rates map[string]decimal.Decimal, expires time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := &rateCacheEntry{key: key, rates: rates, expires: expires}
+	if element, ok := s.entries[key]; ok {
+		element.Value = entry
+		s.order.MoveToFront(element)
+		return
+	}
+
+	s.entries[key] = s.order.PushFront(entry)
+	for s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		entry, _ := s.order.Remove(oldest).(*rateCacheEntry)
+		delete(s.entries, entry.key)
+		s.stats.Evictions++
+	}
+}
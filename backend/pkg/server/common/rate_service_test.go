@@ -0,0 +1,210 @@
+package common_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database/mocks"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
+	"github.com/ya-breeze/geekbudgetbe/test"
+)
+
+func TestRateService_ConcurrentFetchers(t *testing.T) {
+	cnbMockServer, callCount := createMockServer()
+	defer cnbMockServer.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStorage := mocks.NewMockStorage(ctrl)
+	mockStorage.EXPECT().
+		GetCNBRateDates(gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
+	// Only the first lookup reads the database, the others wait for it
+	mockStorage.EXPECT().
+		GetCNBRates(gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+	mockStorage.EXPECT().
+		SaveCNBRates(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	rates := common.NewRateService(test.CreateTestLogger(), mockStorage, 0)
+	rates.CNB.BaseURL = cnbMockServer.URL
+	ctx := t.Context()
+
+	const requests = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, requests)
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Every request builds its own fetcher, as the API services do
+			_, err := rates.Fetcher(uuid.Nil).Convert(ctx, testDate, "USD", "CZK", decimal.NewFromInt(100))
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if *callCount != 1 {
+		t.Errorf("expected 1 HTTP call, got %d", *callCount)
+	}
+
+	stats := rates.Stats()
+	if stats.Fetches != 1 {
+		t.Errorf("expected 1 fetch, got %d", stats.Fetches)
+	}
+	if stats.Hits+stats.Misses != requests {
+		t.Errorf("expected %d lookups, got %d", requests, stats.Hits+stats.Misses)
+	}
+	if stats.Hits+stats.Deduplicated != requests-1 {
+		t.Errorf("expected %d lookups served without fetch, got %d", requests-1, stats.Hits+stats.Deduplicated)
+	}
+	if stats.Size != 1 {
+		t.Errorf("expected 1 cached entry, got %d", stats.Size)
+	}
+}
+
+func TestRateService_Eviction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStorage := mocks.NewMockStorage(ctrl)
+	mockStorage.EXPECT().
+		GetCNBRates(gomock.Any()).
+		Return(map[string]decimal.Decimal{"USD": decimal.NewFromFloat(22.758)}, nil).
+		AnyTimes()
+
+	rates := common.NewRateService(test.CreateTestLogger(), mockStorage, 2)
+	sut := rates.Fetcher(uuid.Nil)
+	ctx := t.Context()
+
+	days := []time.Time{
+		time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 13, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+	}
+	for _, day := range days {
+		if _, err := sut.Convert(ctx, day, "USD", "CZK", decimal.NewFromInt(100)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	stats := rates.Stats()
+	if stats.Capacity != 2 || stats.Size != 2 {
+		t.Errorf("expected 2 of 2 cached entries, got %d of %d", stats.Size, stats.Capacity)
+	}
+	if stats.Evictions != 1 {
+		t.Errorf("expected 1 eviction, got %d", stats.Evictions)
+	}
+
+	// The most recent date is still cached, the oldest one was evicted
+	if _, err := sut.Convert(ctx, days[2], "USD", "CZK", decimal.NewFromInt(100)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := sut.Convert(ctx, days[0], "USD", "CZK", decimal.NewFromInt(100)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stats = rates.Stats()
+	if stats.Hits != 1 || stats.Fetches != 4 {
+		t.Errorf("expected 1 hit and 4 fetches, got %d hits and %d fetches", stats.Hits, stats.Fetches)
+	}
+}
+
+func TestRateService_CancelledLookup(t *testing.T) {
+	cnbMockServer, callCount := createMockServer()
+	defer cnbMockServer.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStorage := mocks.NewMockStorage(ctrl)
+	mockStorage.EXPECT().GetCNBRateDates(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockStorage.EXPECT().GetCNBRates(gomock.Any()).Return(nil, nil).Times(1)
+	mockStorage.EXPECT().SaveCNBRates(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	rates := common.NewRateService(test.CreateTestLogger(), mockStorage, 0)
+	rates.CNB.BaseURL = cnbMockServer.URL
+
+	// The first lookup starts the fetch and is cancelled while the second one waits for it
+	cancelled, cancel := context.WithCancel(t.Context())
+	errs := make(chan error, 1)
+	go func() {
+		_, err := rates.Fetcher(uuid.Nil).Convert(cancelled, testDate, "USD", "CZK", decimal.NewFromInt(100))
+		errs <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	waiting := make(chan error, 1)
+	go func() {
+		_, err := rates.Fetcher(uuid.Nil).Convert(t.Context(), testDate, "USD", "CZK", decimal.NewFromInt(100))
+		waiting <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	if err := <-errs; err == nil {
+		t.Error("expected error of the cancelled lookup")
+	}
+	if err := <-waiting; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *callCount != 1 {
+		t.Errorf("expected 1 HTTP call, got %d", *callCount)
+	}
+}
+
+func TestRateService_ProvisionalRates(t *testing.T) {
+	cnbMockServer, callCount := createMockServer()
+	defer cnbMockServer.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStorage := mocks.NewMockStorage(ctrl)
+	mockStorage.EXPECT().GetCNBRateDates(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockStorage.EXPECT().GetCNBRates(gomock.Any()).Return(nil, nil).AnyTimes()
+	// Rates of Monday aren't published yet, CNB returns the rates of Friday
+	mockStorage.EXPECT().SaveCNBRates(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	rates := common.NewRateService(test.CreateTestLogger(), mockStorage, 0)
+	rates.CNB.BaseURL = cnbMockServer.URL
+	sut := rates.Fetcher(uuid.Nil)
+	ctx := t.Context()
+	monday, tuesday := testDate.AddDate(0, 0, 3), testDate.AddDate(0, 0, 4)
+	convert := func(day time.Time) {
+		t.Helper()
+		if _, err := sut.Convert(ctx, day, "USD", "CZK", decimal.NewFromInt(100)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// Rates published for the day are cached
+	convert(testDate)
+	convert(testDate)
+	if *callCount != 1 {
+		t.Errorf("expected 1 HTTP call, got %d", *callCount)
+	}
+
+	// Rates published before the day are cached until they expire
+	convert(monday)
+	convert(monday)
+	if *callCount != 2 {
+		t.Errorf("expected 2 HTTP calls, got %d", *callCount)
+	}
+	rates.ProvisionalRatesTTL = 0
+	convert(tuesday)
+	convert(tuesday)
+	if *callCount != 4 {
+		t.Errorf("expected 4 HTTP calls, got %d", *callCount)
+	}
+}
@@ -0,0 +1,83 @@
+package common
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/ya-breeze/geekbudgetbe/pkg/constants"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+func isAssetAccountFunc(accounts []goserver.Account) func(accountID string) bool {
+	assets := make(map[string]bool)
+	for _, a := range accounts {
+		if a.Type == constants.AccountAsset {
+			assets[a.Id] = true
+		}
+	}
+
+	return func(accountID string) bool { return assets[accountID] }
+}
+
+// FindRefundCandidates returns transactions which could be refunded by the transaction with
+// the given ID, see utils.FindRefundCandidates.
+func FindRefundCandidates(db database.Storage, familyID uuid.UUID, id string) ([]goserver.RefundCandidate, error) {
+	refund, err := db.GetTransaction(familyID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := db.GetAccounts(familyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	transactions, err := db.GetTransactions(familyID, refund.Date.Add(-utils.RefundWindow), refund.Date.AddDate(0, 0, 1), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	return utils.FindRefundCandidates(refund, transactions, isAssetAccountFunc(accounts)), nil
+}
+
+// NetRefunds nets linked refunds and reimbursements in transactions against the expense
+// accounts of their original transactions. Originals outside of transactions are loaded
+// from storage.
+func NetRefunds(
+	logger *slog.Logger, db database.Storage, familyID uuid.UUID,
+	accounts []goserver.Account, transactions []goserver.Transaction,
+) []goserver.Transaction {
+	byID := make(map[string]goserver.Transaction, len(transactions))
+	for _, t := range transactions {
+		byID[t.Id] = t
+	}
+
+	originals := make(map[string]goserver.Transaction)
+	for _, t := range transactions {
+		if t.RefundOfId == "" {
+			continue
+		}
+		if _, ok := originals[t.RefundOfId]; ok {
+			continue
+		}
+		if original, ok := byID[t.RefundOfId]; ok {
+			originals[t.RefundOfId] = original
+			continue
+		}
+
+		original, err := db.GetTransaction(familyID, t.RefundOfId)
+		if err != nil {
+			logger.With("error", err, "id", t.Id, "refundOfId", t.RefundOfId).Warn("Failed to get original transaction of refund")
+			continue
+		}
+		originals[t.RefundOfId] = original
+	}
+	if len(originals) == 0 {
+		return transactions
+	}
+
+	return utils.NetRefunds(transactions, originals, isAssetAccountFunc(accounts))
+}
@@ -3,6 +3,8 @@ package common
 import (
 	"fmt"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,128 +12,708 @@ import (
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
 )
 
+// Codes of structured parser warnings, see goserver.TransactionParseWarning.
+const (
+	ParseWarningInvalidDate      = "invalidDate"
+	ParseWarningMissingAmount    = "missingAmount"
+	ParseWarningInvalidAmount    = "invalidAmount"
+	ParseWarningUnknownCurrency  = "unknownCurrency"
+	ParseWarningPartialCurrency  = "partialCurrency"
+	ParseWarningMissingCurrency  = "missingCurrency"
+	ParseWarningUnknownAccount   = "unknownAccount"
+	ParseWarningPartialAccount   = "partialAccount"
+	ParseWarningMissingAccount   = "missingAccount"
+	ParseWarningUnknownTemplate  = "unknownTemplate"
+	ParseWarningPartialTemplate  = "partialTemplate"
+	ParseWarningCurrencyMismatch = "currencyMismatch"
+)
+
 // datePatterns lists supported date formats in descending specificity.
 var datePatterns = []string{
 	`(\d{4}[/\-]\d{2}[/\-]\d{2})`,
+	`(\d{1,2})\.(\d{1,2})\.(\d{4})?`,
 }
 
 var (
-	dateRe   = regexp.MustCompile(`^` + datePatterns[0] + `\s+`)
-	amountRe = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s+`)
+	dateRe        = regexp.MustCompile(`^` + datePatterns[0] + `\s+`)
+	dottedDateRe  = regexp.MustCompile(`^` + datePatterns[1] + `\s+`)
+	daysAgoRe     = regexp.MustCompile(`(?i)^(\d+)\s+(days?|weeks?)\s+ago\s+`)
+	lastWeekdayRe = regexp.MustCompile(`(?i)^last\s+(monday|tuesday|wednesday|thursday|friday|saturday|sunday)\s+`)
+	relativeDayRe = regexp.MustCompile(`(?i)^(today|yesterday|day before yesterday)\s+`)
+	templateRe    = regexp.MustCompile(`^@(\S+)\s*`)
+	// amountRe accepts thousands separators (1,234.50 / 1.234,50 / 1'234 / 1 234 with
+	// non-breaking spaces) and both decimal point and decimal comma.
+	amountRe = regexp.MustCompile(`^([+-]?\d{1,3}(?:[.,'\x{00A0}\x{202F}]\d{3})+(?:[.,]\d+)?|[+-]?\d+(?:[.,]\d+)?)`)
 	wordRe   = regexp.MustCompile(`^(\S+)\s*`)
+	legRe    = regexp.MustCompile(`\s+\+\s+`)
+	// currencyCodeRe recognizes unknown currency codes, so they are reported instead of
+	// becoming part of the description.
+	currencyCodeRe = regexp.MustCompile(`^[A-Z]{3}$`)
 )
 
+// currencySymbols maps currency symbols to currency names. Longer symbols go first, so
+// that "Kč" is not taken for something shorter.
+var currencySymbols = []struct {
+	symbol string
+	name   string
+}{
+	{"Kč", "CZK"},
+	{"kč", "CZK"},
+	{"€", "EUR"},
+	{"$", "USD"},
+	{"£", "GBP"},
+}
+
+// TransactionParseOptions holds the family data and user preferences which quick entry
+// text is resolved against.
+type TransactionParseOptions struct {
+	Accounts   []goserver.Account
+	Currencies []goserver.Currency
+	Templates  []goserver.TransactionTemplate
+	// Today is the default date and the base of relative dates
+	Today time.Time
+	// DefaultCurrencyID is used when the text has no currency, e.g. the user's favorite one
+	DefaultCurrencyID string
+	// DefaultAccountID is the account money is taken from when the text has no "from" clause
+	DefaultAccountID string
+}
+
+// quickEntryLeg is one "AMOUNT [CURRENCY] [ACCOUNTS] [DESCRIPTION]" part of the text.
+type quickEntryLeg struct {
+	amount     decimal.Decimal
+	currencyID string
+	// hasCurrency is set when the text contains a currency, even an unknown one
+	hasCurrency bool
+	fromName    string
+	toName      string
+	description string
+}
+
+type transactionParser struct {
+	opts     TransactionParseOptions
+	warnings []goserver.TransactionParseWarning
+	// leg is the index of the leg being parsed, -1 outside of legs or without splits
+	leg int32
+}
+
+func (p *transactionParser) warn(code, token, format string, args ...any) {
+	w := goserver.TransactionParseWarning{
+		Code:    code,
+		Token:   token,
+		Message: fmt.Sprintf(format, args...),
+	}
+	if p.leg >= 0 {
+		leg := p.leg
+		w.Leg = &leg
+	}
+	p.warnings = append(p.warnings, w)
+}
+
 // ParseTransactionText parses a natural-language transaction string and returns a
 // partially- or fully-populated TransactionNoId plus human-readable warnings for
+// fields that could not be resolved. See ParseTransaction for the grammar.
+func ParseTransactionText(
+	text string,
+	accounts []goserver.Account,
+	currencies []goserver.Currency,
+	today time.Time,
+) (goserver.TransactionNoId, []string) {
+	result, warnings := ParseTransaction(text, TransactionParseOptions{
+		Accounts:   accounts,
+		Currencies: currencies,
+		Today:      today,
+	})
+
+	return result, ParseWarningMessages(warnings)
+}
+
+// ParseWarningMessages returns human-readable messages of structured warnings.
+func ParseWarningMessages(warnings []goserver.TransactionParseWarning) []string {
+	var res []string
+	for _, w := range warnings {
+		res = append(res, w.Message)
+	}
+	return res
+}
+
+// ParseTransaction parses a natural-language transaction string and returns a
+// partially- or fully-populated TransactionNoId plus structured warnings for
 // fields that could not be resolved.
 //
 // Grammar (all tokens are whitespace-separated):
 //
-//	[DATE] AMOUNT CURRENCY [from ACCOUNT] [to ACCOUNT] [DESCRIPTION...]
+//	[DATE] [@TEMPLATE] AMOUNT [CURRENCY] [from ACCOUNT] [to ACCOUNT] [DESCRIPTION...] [+ LEG...]
+//
+// DATE     — YYYY/MM/DD, YYYY-MM-DD, DD.MM.YYYY, DD.MM., today, yesterday,
+//
+//	day before yesterday, N days/weeks ago or last WEEKDAY; defaults to today.
+//
+// TEMPLATE — transaction template name; the template provides description, tags and
+//
+//	accounts. "@rent" alone creates the template's movements as they are.
+//
+// AMOUNT   — decimal number with point or comma and optional thousands separators
+//
+//	(e.g. 100, 50.5, 50,5 or 1 234,50), optionally with a currency symbol (€, $, Kč).
+//
+// CURRENCY — currency name, case-insensitive; fuzzy-matched. Defaults to the template
+//
+//	currency or DefaultCurrencyID.
 //
-// DATE     — YYYY/MM/DD or YYYY-MM-DD; defaults to today when omitted.
-// AMOUNT   — decimal number (e.g. 100 or 50.5).
-// CURRENCY — currency name, case-insensitive; fuzzy-matched.
 // from/to  — keywords introducing account names; account name is matched
 //
 //	greedily against known accounts so that trailing description words
-//	are not consumed into the account name.
-func ParseTransactionText(
-	text string,
-	accounts []goserver.Account,
-	currencies []goserver.Currency,
-	today time.Time,
-) (goserver.TransactionNoId, []string) {
-	var warnings []string
+//	are not consumed into the account name. Without "from" the money is
+//	taken from the template account or DefaultAccountID.
+//
+// #TAG     — any description word starting with # is a tag.
+// LEG      — "+ AMOUNT [CURRENCY] [[to] ACCOUNT] [DESCRIPTION...]" splits the transaction,
+//
+//	e.g. "120 CZK groceries + 30 household": the source account pays the total
+//	and each leg goes to its own account; "to" is optional in splits.
+func ParseTransaction(text string, opts TransactionParseOptions) (goserver.TransactionNoId, []goserver.TransactionParseWarning) {
+	p := &transactionParser{opts: opts, leg: -1}
+	result := p.parse(text)
+	return result, p.warnings
+}
+
+func (p *transactionParser) parse(text string) goserver.TransactionNoId {
 	result := goserver.TransactionNoId{
-		Date: today,
+		Date: p.opts.Today,
 	}
 
 	s := strings.TrimSpace(text)
 
 	// --- optional date ---
+	s = p.parseDate(s, &result)
+
+	// --- optional template ---
+	var template *goserver.TransactionTemplate
+	if m := templateRe.FindStringSubmatch(s); m != nil {
+		template = p.matchTemplate(m[1])
+		s = s[len(m[0]):]
+	}
+	if template != nil {
+		result.Description = template.Description
+		result.Place = template.Place
+		result.PartnerName = template.PartnerName
+		result.Extra = template.Extra
+		result.Tags = slices.Clone(template.Tags)
+		if strings.TrimSpace(s) == "" {
+			result.Movements = slices.Clone(template.Movements)
+			return result
+		}
+	}
+
+	// --- legs ---
+	parts := splitLegs(s)
+	split := len(parts) > 1
+	legs := make([]quickEntryLeg, 0, len(parts))
+	for i, part := range parts {
+		if split {
+			p.leg = int32(i)
+		}
+		leg, ok := p.parseLeg(part, i, split)
+		if !ok {
+			if i == 0 {
+				return result
+			}
+			continue
+		}
+		legs = append(legs, leg)
+	}
+	p.leg = -1
+
+	// --- tags and description ---
+	descriptions := make([]string, 0, len(legs))
+	for i := range legs {
+		var tags []string
+		legs[i].description, tags = extractTags(legs[i].description)
+		for _, tag := range tags {
+			if !slices.Contains(result.Tags, tag) {
+				result.Tags = append(result.Tags, tag)
+			}
+		}
+		if legs[i].description != "" {
+			descriptions = append(descriptions, legs[i].description)
+		}
+	}
+	if len(descriptions) > 0 {
+		result.Description = strings.Join(descriptions, ", ")
+	}
+
+	result.Movements = p.buildMovements(legs, template, split)
+
+	return result
+}
+
+// parseDate parses the optional leading date into result and returns the rest of s.
+func (p *transactionParser) parseDate(s string, result *goserver.TransactionNoId) string {
+	today := p.opts.Today
+
 	if m := dateRe.FindStringSubmatch(s); m != nil {
 		raw := strings.ReplaceAll(m[1], "/", "-")
 		if t, err := time.Parse("2006-01-02", raw); err == nil {
 			result.Date = t
+		} else {
+			p.warn(ParseWarningInvalidDate, m[1], "invalid date %q", m[1])
+		}
+		return s[len(m[0]):]
+	}
+
+	if m := dottedDateRe.FindStringSubmatch(s); m != nil {
+		day, _ := strconv.Atoi(m[1])
+		month, _ := strconv.Atoi(m[2])
+		year := today.Year()
+		if m[3] != "" {
+			year, _ = strconv.Atoi(m[3])
+		}
+		t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, today.Location())
+		// time.Date normalizes overflows like 31.02., so check that nothing was shifted
+		if t.Day() == day && int(t.Month()) == month {
+			result.Date = t
+		} else {
+			raw := strings.TrimSpace(m[0])
+			p.warn(ParseWarningInvalidDate, raw, "invalid date %q", raw)
 		}
-		s = s[len(m[0]):]
+		return s[len(m[0]):]
+	}
+
+	if m := relativeDayRe.FindStringSubmatch(s); m != nil {
+		switch strings.ToLower(m[1]) {
+		case "yesterday":
+			result.Date = today.AddDate(0, 0, -1)
+		case "day before yesterday":
+			result.Date = today.AddDate(0, 0, -2)
+		}
+		return s[len(m[0]):]
+	}
+
+	if m := daysAgoRe.FindStringSubmatch(s); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		if strings.HasPrefix(strings.ToLower(m[2]), "week") {
+			n *= 7
+		}
+		result.Date = today.AddDate(0, 0, -n)
+		return s[len(m[0]):]
 	}
 
+	if m := lastWeekdayRe.FindStringSubmatch(s); m != nil {
+		weekday := parseWeekday(m[1])
+		// the most recent such day strictly before today
+		diff := (int(today.Weekday()) - int(weekday) + 7) % 7
+		if diff == 0 {
+			diff = 7
+		}
+		result.Date = today.AddDate(0, 0, -diff)
+		return s[len(m[0]):]
+	}
+
+	return s
+}
+
+func parseWeekday(name string) time.Weekday {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), name) {
+			return d
+		}
+	}
+	return time.Sunday
+}
+
+// matchTemplate returns the template by name (case-insensitive, spaces are optional so
+// that "@monthlyrent" finds "Monthly rent"), falling back to a partial match.
+func (p *transactionParser) matchTemplate(token string) *goserver.TransactionTemplate {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.ReplaceAll(s, " ", ""))
+	}
+	low := normalize(token)
+
+	for i := range p.opts.Templates {
+		if normalize(p.opts.Templates[i].Name) == low {
+			return &p.opts.Templates[i]
+		}
+	}
+	for i := range p.opts.Templates {
+		if strings.Contains(normalize(p.opts.Templates[i].Name), low) {
+			t := &p.opts.Templates[i]
+			p.warn(ParseWarningPartialTemplate, token,
+				"template %q matched %q by partial name — please verify", token, t.Name)
+			return t
+		}
+	}
+
+	p.warn(ParseWarningUnknownTemplate, token, "template %q not found", token)
+	return nil
+}
+
+// splitLegs splits s at " + " where the following part starts with an amount, so a
+// plus sign inside the description doesn't start a new leg.
+func splitLegs(s string) []string {
+	parts := legRe.Split(s, -1)
+	res := []string{parts[0]}
+	for _, part := range parts[1:] {
+		if _, _, ok := cutAmount(part); ok {
+			res = append(res, part)
+			continue
+		}
+		res[len(res)-1] += " + " + part
+	}
+	return res
+}
+
+// parseLeg parses "AMOUNT [CURRENCY] [ACCOUNTS] [DESCRIPTION]". Only the first leg may
+// have a "from" clause; legs of a split may omit the "to" keyword.
+func (p *transactionParser) parseLeg(s string, idx int, split bool) (quickEntryLeg, bool) {
+	var leg quickEntryLeg
+	s = strings.TrimSpace(s)
+
 	// --- amount ---
-	amountStr := ""
-	if m := amountRe.FindStringSubmatch(s); m != nil {
-		amountStr = m[1]
-		s = s[len(m[0]):]
-	} else {
-		warnings = append(warnings, "could not parse amount — expected a number")
-		return result, warnings
+	raw, symbol, ok := cutAmount(s)
+	if !ok {
+		if idx == 0 {
+			p.warn(ParseWarningMissingAmount, "", "could not parse amount — expected a number")
+		}
+		return leg, false
 	}
+	s = strings.TrimSpace(s[len(symbol):])
+	s = strings.TrimSpace(s[len(raw):])
 
-	amount, err := decimal.NewFromString(amountStr)
+	amount, err := parseAmount(raw)
 	if err != nil {
-		warnings = append(warnings, fmt.Sprintf("invalid amount %q", amountStr))
-		return result, warnings
+		p.warn(ParseWarningInvalidAmount, raw, "invalid amount %q", raw)
+		return leg, false
 	}
+	leg.amount = amount
 
 	// --- currency ---
-	currencyID := ""
-	if m := wordRe.FindStringSubmatch(s); m != nil {
-		tok := m[1]
-		id, partialWarn := fuzzyMatchCurrency(tok, currencies)
-		if id != "" {
-			currencyID = id
-			if partialWarn != "" {
-				warnings = append(warnings, partialWarn)
+	if symbol == "" {
+		symbol, s = cutCurrencySymbol(s)
+	}
+	switch {
+	case symbol != "":
+		leg.currencyID = p.matchCurrencySymbol(symbol)
+		leg.hasCurrency = true
+	default:
+		if m := wordRe.FindStringSubmatch(s); m != nil {
+			if id, isCurrency := p.matchCurrencyToken(m[1]); isCurrency {
+				leg.currencyID = id
+				leg.hasCurrency = true
+				s = s[len(m[0]):]
 			}
-		} else {
-			warnings = append(warnings, fmt.Sprintf("currency %q not found", tok))
 		}
-		s = s[len(m[0]):]
 	}
 
 	// --- parse "from ACCOUNT" and "to ACCOUNT" clauses ---
-	fromName, toName, remainder := parseFromToWithAccounts(s, accounts)
+	if idx == 0 {
+		leg.fromName, leg.toName, leg.description = parseFromToWithAccounts(s, p.opts.Accounts)
+	} else {
+		s = strings.TrimSpace(s)
+		if strings.HasPrefix(strings.ToLower(s), "to ") {
+			leg.toName, leg.description = extractAccountName(s[3:], p.opts.Accounts)
+		} else {
+			leg.description = s
+		}
+	}
+	if split && leg.toName == "" {
+		if name, rest, found := matchAccountPrefix(leg.description, p.opts.Accounts); found {
+			leg.toName, leg.description = name, rest
+		}
+	}
+	leg.description = strings.TrimSpace(leg.description)
+
+	return leg, true
+}
+
+// cutAmount returns the raw amount at the beginning of s and the currency symbol
+// written before it (e.g. "€12"), if any.
+func cutAmount(s string) (raw, symbol string, ok bool) {
+	s = strings.TrimSpace(s)
+	for _, cs := range currencySymbols {
+		if strings.HasPrefix(s, cs.symbol) {
+			symbol = cs.symbol
+			break
+		}
+	}
+
+	rest := s[len(symbol):]
+	m := amountRe.FindString(rest)
+	if m == "" {
+		return "", "", false
+	}
+
+	// the amount must be a separate word, maybe followed by a currency symbol
+	after := rest[len(m):]
+	if after != "" && !strings.HasPrefix(after, " ") {
+		if sym, _ := cutCurrencySymbol(after); sym == "" {
+			return "", "", false
+		}
+	}
+
+	return m, symbol, true
+}
+
+// cutCurrencySymbol returns the currency symbol at the beginning of s (if it is a
+// separate word or followed by a space) and the rest of s.
+func cutCurrencySymbol(s string) (symbol, rest string) {
+	for _, cs := range currencySymbols {
+		if !strings.HasPrefix(s, cs.symbol) {
+			continue
+		}
+		after := s[len(cs.symbol):]
+		if after == "" || strings.HasPrefix(after, " ") {
+			return cs.symbol, strings.TrimSpace(after)
+		}
+	}
+	return "", s
+}
+
+// parseAmount parses amounts with either decimal point or comma and optional thousands
+// separators. A single separator followed by exactly three digits is a thousands
+// separator ("1,500" is 1500), otherwise the last separator is the decimal one.
+func parseAmount(raw string) (decimal.Decimal, error) {
+	s := strings.NewReplacer("'", "", "\u00a0", "", "\u202f", "").Replace(raw)
+
+	dots := strings.Count(s, ".")
+	commas := strings.Count(s, ",")
+	switch {
+	case dots > 0 && commas > 0:
+		if strings.LastIndex(s, ",") > strings.LastIndex(s, ".") {
+			s = strings.ReplaceAll(s, ".", "")
+			s = strings.Replace(s, ",", ".", 1)
+		} else {
+			s = strings.ReplaceAll(s, ",", "")
+		}
+	case dots > 1:
+		s = strings.ReplaceAll(s, ".", "")
+	case commas > 1:
+		s = strings.ReplaceAll(s, ",", "")
+	case dots == 1 || commas == 1:
+		sep := "."
+		if commas == 1 {
+			sep = ","
+		}
+		intPart, fracPart, _ := strings.Cut(s, sep)
+		digits := strings.TrimLeft(intPart, "+-")
+		if len(fracPart) == 3 && digits != "0" && len(digits) <= 3 {
+			s = intPart + fracPart
+		} else {
+			s = intPart + "." + fracPart
+		}
+	}
+
+	return decimal.NewFromString(s)
+}
+
+func (p *transactionParser) matchCurrencySymbol(symbol string) string {
+	for _, cs := range currencySymbols {
+		if cs.symbol != symbol {
+			continue
+		}
+		for _, c := range p.opts.Currencies {
+			if strings.EqualFold(c.Name, cs.name) {
+				return c.Id
+			}
+		}
+		p.warn(ParseWarningUnknownCurrency, symbol, "currency %q (%s) not found", symbol, cs.name)
+	}
+	return ""
+}
+
+// matchCurrencyToken checks if token is a currency. Unknown words are not currencies
+// (the currency is optional), except three-letter uppercase codes which are reported
+// as unknown currencies.
+func (p *transactionParser) matchCurrencyToken(token string) (id string, isCurrency bool) {
+	low := strings.ToLower(token)
+	if low == "from" || low == "to" || strings.HasPrefix(token, "#") {
+		return "", false
+	}
+
+	id, partialWarn := fuzzyMatchCurrency(token, p.opts.Currencies)
+	if id != "" {
+		if partialWarn != "" {
+			if len(token) < 2 {
+				return "", false
+			}
+			p.warn(ParseWarningPartialCurrency, token, "%s", partialWarn)
+		}
+		return id, true
+	}
+
+	if currencyCodeRe.MatchString(token) {
+		p.warn(ParseWarningUnknownCurrency, token, "currency %q not found", token)
+		return "", true
+	}
+
+	return "", false
+}
+
+// buildMovements resolves accounts and currencies of the legs and creates movements.
+func (p *transactionParser) buildMovements(
+	legs []quickEntryLeg, template *goserver.TransactionTemplate, split bool,
+) []goserver.Movement {
+	var templateFrom, templateTo, templateCurrency string
+	if template != nil {
+		for _, m := range template.Movements {
+			if templateCurrency == "" {
+				templateCurrency = m.CurrencyId
+			}
+			switch {
+			case m.Amount.IsNegative() && templateFrom == "":
+				templateFrom = m.AccountId
+			case m.Amount.IsPositive() && templateTo == "":
+				templateTo = m.AccountId
+			}
+		}
+	}
+
+	// --- currency defaults ---
+	if !legs[0].hasCurrency {
+		switch {
+		case templateCurrency != "":
+			legs[0].currencyID = templateCurrency
+		case p.opts.DefaultCurrencyID != "":
+			legs[0].currencyID = p.opts.DefaultCurrencyID
+		default:
+			p.warn(ParseWarningMissingCurrency, "", "no currency found")
+		}
+	}
+	// legs of a split without currency use the currency of the first one
+	for i := 1; i < len(legs); i++ {
+		if !legs[i].hasCurrency {
+			legs[i].currencyID = legs[0].currencyID
+		}
+	}
+
+	// --- source account ---
+	fromID := ""
+	switch {
+	case legs[0].fromName != "":
+		id, w := fuzzyMatchAccount(legs[0].fromName, p.opts.Accounts)
+		p.addAccountWarnings(legs[0].fromName, w)
+		fromID = id
+	case templateFrom != "":
+		fromID = templateFrom
+	case p.opts.DefaultAccountID != "":
+		fromID = p.opts.DefaultAccountID
+	}
+	hasFrom := legs[0].fromName != "" || fromID != ""
 
 	var movements []goserver.Movement
 
-	if fromName != "" {
-		id, w := fuzzyMatchAccount(fromName, accounts)
-		warnings = append(warnings, w...)
-		movements = append(movements, goserver.Movement{
-			AccountId:  id,
-			CurrencyId: currencyID,
-			Amount:     amount.Neg(),
-		})
+	if !split {
+		leg := legs[0]
+		toID := ""
+		hasTo := leg.toName != ""
+		if hasTo {
+			id, w := fuzzyMatchAccount(leg.toName, p.opts.Accounts)
+			p.addAccountWarnings(leg.toName, w)
+			toID = id
+		} else if templateTo != "" {
+			toID, hasTo = templateTo, true
+		}
+
+		if hasFrom {
+			movements = append(movements, goserver.Movement{
+				AccountId:  fromID,
+				CurrencyId: leg.currencyID,
+				Amount:     leg.amount.Neg(),
+			})
+		}
+		if hasTo {
+			movements = append(movements, goserver.Movement{
+				AccountId:  toID,
+				CurrencyId: leg.currencyID,
+				Amount:     leg.amount,
+			})
+		}
+
+		// if neither from nor to, produce a single positive movement with no account
+		if !hasFrom && !hasTo {
+			p.warn(ParseWarningMissingAccount, "", "no 'from' or 'to' account found")
+			movements = append(movements, goserver.Movement{
+				CurrencyId: leg.currencyID,
+				Amount:     leg.amount,
+			})
+		}
+
+		return movements
 	}
 
-	if toName != "" {
-		id, w := fuzzyMatchAccount(toName, accounts)
-		warnings = append(warnings, w...)
-		movements = append(movements, goserver.Movement{
-			AccountId:  id,
-			CurrencyId: currencyID,
-			Amount:     amount,
-		})
+	// --- split: the source pays the total per currency, each leg goes to its account ---
+	totals := make(map[string]decimal.Decimal)
+	var currencyOrder []string
+	for i, leg := range legs {
+		if _, ok := totals[leg.currencyID]; !ok {
+			currencyOrder = append(currencyOrder, leg.currencyID)
+			if i > 0 {
+				p.leg = int32(i)
+				p.warn(ParseWarningCurrencyMismatch, "",
+					"leg %d uses a different currency than the first one — please verify", i+1)
+			}
+		}
+		totals[leg.currencyID] = totals[leg.currencyID].Add(leg.amount)
+	}
+	p.leg = -1
+
+	if hasFrom {
+		for _, currencyID := range currencyOrder {
+			movements = append(movements, goserver.Movement{
+				AccountId:  fromID,
+				CurrencyId: currencyID,
+				Amount:     totals[currencyID].Neg(),
+			})
+		}
 	}
 
-	// if neither from nor to, produce a single positive movement with no account
-	if fromName == "" && toName == "" {
-		warnings = append(warnings, "no 'from' or 'to' account found")
+	for i, leg := range legs {
+		p.leg = int32(i)
+		toID := ""
+		switch {
+		case leg.toName != "":
+			id, w := fuzzyMatchAccount(leg.toName, p.opts.Accounts)
+			p.addAccountWarnings(leg.toName, w)
+			toID = id
+		case i == 0 && templateTo != "":
+			toID = templateTo
+		default:
+			p.warn(ParseWarningMissingAccount, leg.description, "no account found for leg %d", i+1)
+		}
 		movements = append(movements, goserver.Movement{
-			CurrencyId: currencyID,
-			Amount:     amount,
+			AccountId:   toID,
+			CurrencyId:  leg.currencyID,
+			Amount:      leg.amount,
+			Description: leg.description,
 		})
 	}
+	p.leg = -1
 
-	result.Movements = movements
+	return movements
+}
 
-	desc := strings.TrimSpace(remainder)
-	if desc != "" {
-		result.Description = desc
+// addAccountWarnings converts warnings of fuzzyMatchAccount into structured ones.
+func (p *transactionParser) addAccountWarnings(name string, warnings []string) {
+	for _, w := range warnings {
+		code := ParseWarningUnknownAccount
+		if strings.Contains(w, "partial") {
+			code = ParseWarningPartialAccount
+		}
+		p.warn(code, name, "%s", w)
 	}
+}
 
-	return result, warnings
+// extractTags removes #tag words from s and returns them separately.
+func extractTags(s string) (string, []string) {
+	var tags []string
+	var words []string
+	for _, w := range strings.Fields(s) {
+		if len(w) > 1 && strings.HasPrefix(w, "#") {
+			tags = append(tags, w[1:])
+			continue
+		}
+		words = append(words, w)
+	}
+	return strings.Join(words, " "), tags
 }
 
 // parseFromTo splits the remaining string into optional fromName, toName,
@@ -256,17 +838,29 @@ func splitFirstWordGroup(s string) (name, remainder string) {
 }
 
 // extractAccountName extracts the best matching account name from the beginning
-// of s given a known list of accounts. It tries progressively shorter prefixes
-// (greedy) so that "Others groceries" resolves to account "Others" with
-// remainder "groceries" rather than failing to match "Others groceries".
+// of s given a known list of accounts, see matchAccountPrefix.
 //
 // If no prefix matches any account, it returns the first keyword-terminated
 // group as-is (fallback so we still produce a warning rather than silently
 // dropping the text).
 func extractAccountName(s string, accounts []goserver.Account) (name, remainder string) {
+	if name, remainder, ok := matchAccountPrefix(s, accounts); ok {
+		return name, remainder
+	}
+
+	// No match — return the keyword-terminated group so we can warn.
+	name, remainder = splitFirstWordGroup(s)
+	return name, remainder
+}
+
+// matchAccountPrefix finds the longest prefix of s which matches a known account. It
+// tries progressively shorter prefixes (greedy) so that "Others groceries" resolves to
+// account "Others" with remainder "groceries" rather than failing to match
+// "Others groceries".
+func matchAccountPrefix(s string, accounts []goserver.Account) (name, remainder string, ok bool) {
 	words := strings.Fields(strings.TrimSpace(s))
 	if len(words) == 0 {
-		return "", ""
+		return "", "", false
 	}
 
 	// Stop greedily expanding at "to"/"from" — these are reserved keywords.
@@ -285,14 +879,12 @@ func extractAccountName(s string, accounts []goserver.Account) (name, remainder
 		low := strings.ToLower(candidate)
 		for _, a := range accounts {
 			if strings.ToLower(a.Name) == low || strings.Contains(strings.ToLower(a.Name), low) {
-				return candidate, strings.TrimSpace(strings.Join(words[end:], " "))
+				return candidate, strings.TrimSpace(strings.Join(words[end:], " ")), true
 			}
 		}
 	}
 
-	// No match — return the keyword-terminated group so we can warn.
-	name, remainder = splitFirstWordGroup(s)
-	return name, remainder
+	return "", "", false
 }
 
 // fuzzyMatchCurrency returns the currency ID for the best match (case-insensitive
@@ -122,4 +122,219 @@ var _ = Describe("ParseTransactionText", func() {
 		Expect(result.Movements[0].AccountId).To(Equal("acc-fio"))
 		Expect(result.Movements[1].AccountId).To(Equal("acc-others"))
 	})
+
+	Describe("ParseTransaction", func() {
+		var opts common.TransactionParseOptions
+
+		BeforeEach(func() {
+			opts = common.TransactionParseOptions{
+				Accounts: append(accounts,
+					goserver.Account{Id: "acc-groceries", Name: "Groceries"},
+					goserver.Account{Id: "acc-household", Name: "Household"},
+					goserver.Account{Id: "acc-rent", Name: "Rent"},
+				),
+				Currencies: currencies,
+				Today:      today,
+			}
+		})
+
+		codes := func(warnings []goserver.TransactionParseWarning) []string {
+			res := make([]string, 0, len(warnings))
+			for _, w := range warnings {
+				res = append(res, w.Code)
+			}
+			return res
+		}
+
+		DescribeTable("parses dates",
+			func(text string, expected time.Time) {
+				result, warnings := common.ParseTransaction(text+" 100 CZK to Others", opts)
+				Expect(warnings).To(BeEmpty())
+				Expect(result.Date).To(Equal(expected))
+			},
+			Entry("dd.mm.yyyy", "15.01.2026", time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)),
+			Entry("d.m. of the current year", "5.3.", time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)),
+			Entry("today", "today", today),
+			Entry("yesterday", "Yesterday", today.AddDate(0, 0, -1)),
+			Entry("N days ago", "3 days ago", today.AddDate(0, 0, -3)),
+			Entry("N weeks ago", "2 weeks ago", today.AddDate(0, 0, -14)),
+			// 2026-03-22 is Sunday
+			Entry("last weekday", "last friday", time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)),
+			Entry("last weekday on the same weekday", "last sunday", time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)),
+		)
+
+		It("warns about invalid dates", func() {
+			result, warnings := common.ParseTransaction("31.02.2026 100 CZK to Others", opts)
+			Expect(codes(warnings)).To(ConsistOf(common.ParseWarningInvalidDate))
+			Expect(warnings[0].Token).To(Equal("31.02.2026"))
+			Expect(result.Date).To(Equal(today))
+		})
+
+		DescribeTable("parses amounts",
+			func(amount string, expected float64) {
+				result, warnings := common.ParseTransaction(amount+" CZK to Others", opts)
+				Expect(warnings).To(BeEmpty())
+				Expect(result.Movements[0].Amount.Equal(decimal.NewFromFloat(expected))).To(BeTrue(),
+					"got %s", result.Movements[0].Amount)
+			},
+			Entry("decimal comma", "50,5", 50.5),
+			Entry("thousands comma", "1,500", 1500.0),
+			Entry("thousands comma with decimals", "1,234.50", 1234.5),
+			Entry("thousands dot with decimal comma", "1.234,50", 1234.5),
+			Entry("several thousands separators", "1.234.567", 1234567.0),
+			Entry("apostrophe", "1'234", 1234.0),
+			Entry("non-breaking space", "1\u00a0234,5", 1234.5),
+			Entry("small decimals", "0,125", 0.125),
+		)
+
+		DescribeTable("parses currency symbols",
+			func(text, currencyID string) {
+				result, warnings := common.ParseTransaction(text+" to Others", opts)
+				Expect(warnings).To(BeEmpty())
+				Expect(result.Movements[0].CurrencyId).To(Equal(currencyID))
+				Expect(result.Movements[0].Amount.Equal(decimal.NewFromInt(12))).To(BeTrue())
+			},
+			Entry("prefix", "€12", "cur-eur"),
+			Entry("suffix", "12€", "cur-eur"),
+			Entry("separate word", "12 Kč", "cur-czk"),
+		)
+
+		It("warns about symbols of unknown currencies", func() {
+			_, warnings := common.ParseTransaction("$12 to Others", opts)
+			Expect(codes(warnings)).To(ConsistOf(common.ParseWarningUnknownCurrency))
+			Expect(warnings[0].Token).To(Equal("$"))
+		})
+
+		It("extracts #tags from description", func() {
+			result, warnings := common.ParseTransaction("100 CZK to Others #food weekly shop #family", opts)
+			Expect(warnings).To(BeEmpty())
+			Expect(result.Tags).To(Equal([]string{"food", "family"}))
+			Expect(result.Description).To(Equal("weekly shop"))
+		})
+
+		It("uses default currency and account from preferences", func() {
+			opts.DefaultCurrencyID = "cur-eur"
+			opts.DefaultAccountID = "acc-kb"
+			result, warnings := common.ParseTransaction("100 to Groceries bread", opts)
+			Expect(warnings).To(BeEmpty())
+			Expect(result.Movements).To(HaveLen(2))
+			Expect(result.Movements[0].AccountId).To(Equal("acc-kb"))
+			Expect(result.Movements[0].CurrencyId).To(Equal("cur-eur"))
+			Expect(result.Movements[1].AccountId).To(Equal("acc-groceries"))
+			Expect(result.Description).To(Equal("bread"))
+		})
+
+		It("warns when currency is omitted without a default", func() {
+			result, warnings := common.ParseTransaction("100 from KB Current to Others", opts)
+			Expect(codes(warnings)).To(ConsistOf(common.ParseWarningMissingCurrency))
+			Expect(result.Movements).To(HaveLen(2))
+		})
+
+		Describe("templates", func() {
+			BeforeEach(func() {
+				opts.Templates = []goserver.TransactionTemplate{{
+					Id:          "tpl-rent",
+					Name:        "Monthly rent",
+					Description: "Rent",
+					Tags:        []string{"home"},
+					Movements: []goserver.Movement{
+						{AccountId: "acc-kb", Amount: decimal.NewFromInt(-15000), CurrencyId: "cur-czk"},
+						{AccountId: "acc-rent", Amount: decimal.NewFromInt(15000), CurrencyId: "cur-czk"},
+					},
+				}}
+			})
+
+			It("creates template movements when only template is given", func() {
+				result, warnings := common.ParseTransaction("yesterday @monthlyrent", opts)
+				Expect(warnings).To(BeEmpty())
+				Expect(result.Date).To(Equal(today.AddDate(0, 0, -1)))
+				Expect(result.Description).To(Equal("Rent"))
+				Expect(result.Tags).To(Equal([]string{"home"}))
+				Expect(result.Movements).To(Equal(opts.Templates[0].Movements))
+			})
+
+			It("uses template accounts and currency with another amount", func() {
+				result, warnings := common.ParseTransaction("@rent 16000 #increase", opts)
+				Expect(codes(warnings)).To(ConsistOf(common.ParseWarningPartialTemplate))
+				Expect(result.Tags).To(Equal([]string{"home", "increase"}))
+				Expect(result.Movements).To(HaveLen(2))
+				Expect(result.Movements[0].AccountId).To(Equal("acc-kb"))
+				Expect(result.Movements[0].Amount.Equal(decimal.NewFromInt(-16000))).To(BeTrue())
+				Expect(result.Movements[1].AccountId).To(Equal("acc-rent"))
+				Expect(result.Movements[1].CurrencyId).To(Equal("cur-czk"))
+			})
+
+			It("warns about unknown templates", func() {
+				_, warnings := common.ParseTransaction("@parking 100 CZK to Others", opts)
+				Expect(codes(warnings)).To(ConsistOf(common.ParseWarningUnknownTemplate))
+				Expect(warnings[0].Token).To(Equal("parking"))
+			})
+		})
+
+		Describe("splits", func() {
+			It("splits the amount between accounts", func() {
+				result, warnings := common.ParseTransaction(
+					"120 CZK from KB Current groceries + 30 household soap #home", opts)
+				Expect(warnings).To(BeEmpty())
+				Expect(result.Tags).To(Equal([]string{"home"}))
+				Expect(result.Description).To(Equal("soap"))
+				Expect(result.Movements).To(HaveLen(3))
+				Expect(result.Movements[0].AccountId).To(Equal("acc-kb"))
+				Expect(result.Movements[0].Amount.Equal(decimal.NewFromInt(-150))).To(BeTrue())
+				Expect(result.Movements[1].AccountId).To(Equal("acc-groceries"))
+				Expect(result.Movements[1].Amount.Equal(decimal.NewFromInt(120))).To(BeTrue())
+				Expect(result.Movements[2].AccountId).To(Equal("acc-household"))
+				Expect(result.Movements[2].CurrencyId).To(Equal("cur-czk"))
+				Expect(result.Movements[2].Description).To(Equal("soap"))
+			})
+
+			It("reports warnings with the leg index", func() {
+				opts.DefaultAccountID = "acc-kb"
+				_, warnings := common.ParseTransaction("120 CZK to Groceries + 30 to Pets + 10 EUR tip", opts)
+				Expect(codes(warnings)).To(ConsistOf(
+					common.ParseWarningCurrencyMismatch,
+					common.ParseWarningUnknownAccount,
+					common.ParseWarningMissingAccount,
+				))
+				for _, w := range warnings {
+					switch w.Code {
+					case common.ParseWarningUnknownAccount:
+						Expect(w.Leg).To(HaveValue(Equal(int32(1))))
+						Expect(w.Token).To(Equal("Pets"))
+					default:
+						Expect(w.Leg).To(HaveValue(Equal(int32(2))))
+					}
+				}
+			})
+
+			It("reports warnings of the first leg", func() {
+				opts.DefaultAccountID = "acc-kb"
+				_, warnings := common.ParseTransaction("120 CZK to Pets + 30 to Groceries", opts)
+				Expect(codes(warnings)).To(ConsistOf(common.ParseWarningUnknownAccount))
+				Expect(warnings[0].Leg).To(HaveValue(Equal(int32(0))))
+			})
+
+			It("reports warnings without splits without a leg", func() {
+				_, warnings := common.ParseTransaction("120 CZK to Pets", opts)
+				Expect(warnings).ToNot(BeEmpty())
+				for _, w := range warnings {
+					Expect(w.Leg).To(BeNil())
+				}
+			})
+
+			It("keeps plus signs inside description", func() {
+				result, warnings := common.ParseTransaction("100 CZK to Others bread + butter", opts)
+				Expect(warnings).To(BeEmpty())
+				Expect(result.Description).To(Equal("bread + butter"))
+				Expect(result.Movements).To(HaveLen(1))
+			})
+		})
+
+		It("returns the same messages as ParseTransactionText", func() {
+			_, warnings := common.ParseTransaction("100 USD from Unknown Bank to Others", opts)
+			_, messages := common.ParseTransactionText("100 USD from Unknown Bank to Others", accounts, currencies, today)
+			Expect(common.ParseWarningMessages(warnings)).To(Equal(messages))
+			Expect(codes(warnings)).To(Equal([]string{common.ParseWarningUnknownCurrency, common.ParseWarningUnknownAccount}))
+		})
+	})
 })
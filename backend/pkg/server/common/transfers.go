@@ -0,0 +1,96 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/database"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
+)
+
+// TransferLookback is the default period scanned for transfer candidates.
+const TransferLookback = 30 * 24 * time.Hour
+
+// NewTransferConverter returns utils.TransferConverter which resolves currency IDs to
+// currency names and converts amounts using the given rates fetcher.
+func NewTransferConverter(
+	ctx context.Context, fetcher *CurrenciesRatesFetcher, currencies []goserver.Currency,
+) utils.TransferConverter {
+	names := make(map[string]string, len(currencies))
+	for _, c := range currencies {
+		names[c.Id] = c.Name
+	}
+
+	return func(day time.Time, fromCurrencyID, toCurrencyID string, amount decimal.Decimal) (decimal.Decimal, error) {
+		from, ok := names[fromCurrencyID]
+		if !ok {
+			return decimal.Zero, fmt.Errorf("unknown currency %q", fromCurrencyID)
+		}
+		to, ok := names[toCurrencyID]
+		if !ok {
+			return decimal.Zero, fmt.Errorf("unknown currency %q", toCurrencyID)
+		}
+
+		return fetcher.Convert(ctx, day, from, to, amount)
+	}
+}
+
+// FindTransferCandidates returns pairs of one-sided transactions between own asset accounts
+// which look like two halves of one transfer. If dateFrom is zero, only the last
+// TransferLookback is scanned.
+func FindTransferCandidates(
+	ctx context.Context, logger *slog.Logger, db database.Storage, rates *RateService,
+	familyID uuid.UUID, dateFrom, dateTo time.Time,
+) ([]goserver.TransferCandidate, error) {
+	if dateFrom.IsZero() {
+		dateFrom = time.Now().Add(-TransferLookback)
+	}
+
+	accounts, err := db.GetAccounts(familyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	rules, err := db.GetTransferRules(familyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transfer rules: %w", err)
+	}
+	learned := make(map[string]bool)
+	for _, r := range rules {
+		learned[r.FromAccountId+"|"+r.ToAccountId] = true
+	}
+	isLearned := func(fromAccountID, toAccountID string) bool {
+		return learned[fromAccountID+"|"+toAccountID]
+	}
+
+	currencies, err := db.GetCurrencies(familyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get currencies: %w", err)
+	}
+
+	transactions, err := db.GetTransactions(familyID, dateFrom, dateTo, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	convert := NewTransferConverter(ctx, rates.Fetcher(familyID), currencies)
+	pairs := utils.FindTransferPairs(transactions, isAssetAccountFunc(accounts), isLearned, convert)
+
+	res := make([]goserver.TransferCandidate, 0, len(pairs))
+	for _, p := range pairs {
+		res = append(res, goserver.TransferCandidate{
+			Outgoing:      p.Outgoing,
+			Incoming:      p.Incoming,
+			FromAccountId: p.OutgoingLeg.AccountId,
+			ToAccountId:   p.IncomingLeg.AccountId,
+			Learned:       isLearned(p.OutgoingLeg.AccountId, p.IncomingLeg.AccountId),
+		})
+	}
+
+	return res, nil
+}
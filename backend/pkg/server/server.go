@@ -36,8 +36,11 @@ func Server(logger *slog.Logger, cfg *config.Config) error {
 		return fmt.Errorf("failed to open storage: %w", err)
 	}
 
+	// Exchange rates are shared by the API and the background tasks
+	rates := common.NewRateService(logger, storage, cfg.RateCacheSize)
+
 	forcedImportChan := make(chan common.ForcedImport, 100)
-	_, finishChan, err := Serve(ctx, logger, storage, cfg, forcedImportChan)
+	_, finishChan, err := Serve(ctx, logger, storage, rates, cfg, forcedImportChan)
 	if err != nil {
 		return fmt.Errorf("failed to serve: %w", err)
 	}
@@ -45,7 +48,7 @@ func Server(logger *slog.Logger, cfg *config.Config) error {
 	// Start bank importers
 	var importFinishChan <-chan struct{}
 	if !cfg.DisableImporters {
-		importFinishChan = background.StartBankImporters(ctx, logger, storage, cfg, forcedImportChan)
+		importFinishChan = background.StartBankImporters(ctx, logger, storage, rates, cfg, forcedImportChan)
 	} else {
 		logger.Info("Bank importers are disabled")
 	}
@@ -53,13 +56,16 @@ func Server(logger *slog.Logger, cfg *config.Config) error {
 	// Start currency rate fetcher
 	var fetchCurrenciesRatesChan <-chan struct{}
 	if !cfg.DisableCurrenciesRatesFetch {
-		fetchCurrenciesRatesChan = background.StartCurrenciesRatesFetcher(ctx, logger, storage)
+		fetchCurrenciesRatesChan = background.StartCurrenciesRatesFetcher(ctx, logger, storage, rates)
 	} else {
 		logger.Info("Fetcher for currencies rates is disabled")
 	}
 
 	// Start duplicate detection
-	duplicateDetectionFinishChan := background.StartDuplicateDetection(ctx, logger, storage)
+	duplicateDetectionFinishChan := background.StartDuplicateDetection(ctx, logger, storage, rates)
+
+	// Start spending anomaly detection
+	anomalyDetectionFinishChan := background.StartAnomalyDetection(ctx, logger, storage)
 
 	// Start database backup
 	backupFinishChan := background.StartDatabaseBackup(ctx, logger, storage, cfg)
@@ -80,35 +86,43 @@ func Server(logger *slog.Logger, cfg *config.Config) error {
 		<-fetchCurrenciesRatesChan
 	}
 	<-duplicateDetectionFinishChan
+	<-anomalyDetectionFinishChan
 	<-backupFinishChan
 	return nil
 }
 
-func createControllers(logger *slog.Logger, cfg *config.Config, db database.Storage) goserver.CustomControllers {
-	unprocessedService := api.NewUnprocessedTransactionsAPIServiceImpl(logger, db)
+func createControllers(
+	logger *slog.Logger, cfg *config.Config, db database.Storage, rates *common.RateService,
+) goserver.CustomControllers {
+	unprocessedService := api.NewUnprocessedTransactionsAPIServiceImpl(logger, db, rates)
 	return goserver.CustomControllers{
 		AuthAPIService:                    api.NewAuthAPIService(logger, db, cfg),
 		UserAPIService:                    api.NewUserAPIService(logger, db),
 		AccountsAPIService:                api.NewAccountsAPIService(logger, db, cfg),
-		CurrenciesAPIService:              api.NewCurrenciesAPIServicer(logger, db),
-		TransactionsAPIService:            api.NewTransactionsAPIService(logger, db),
+		CurrenciesAPIService:              api.NewCurrenciesAPIServicer(logger, db, rates),
+		SecuritiesAPIService:              api.NewSecuritiesAPIServiceImpl(logger, db, rates),
+		GoalsAPIService:                   api.NewGoalsAPIServiceImpl(logger, db, rates),
+		TransactionsAPIService:            api.NewTransactionsAPIService(logger, db, rates),
 		UnprocessedTransactionsAPIService: unprocessedService,
 		MatchersAPIService:                api.NewMatchersAPIServiceImpl(logger, db, cfg, unprocessedService),
-		BankImportersAPIService:           api.NewBankImportersAPIServiceImpl(logger, db, cfg),
-		AggregationsAPIService:            api.NewAggregationsAPIServiceImpl(logger, db),
+		BankImportersAPIService:           api.NewBankImportersAPIServiceImpl(logger, db, cfg, rates),
+		AggregationsAPIService:            api.NewAggregationsAPIServiceImpl(logger, db, rates),
 		NotificationsAPIService:           api.NewNotificationsAPIServiceImpl(logger, db),
 		ImportAPIService:                  api.NewImportAPIServiceImpl(logger, db),
 		ExportAPIService:                  api.NewExportAPIServiceImpl(logger, db),
-		BudgetItemsAPIService:             api.NewBudgetItemsAPIService(logger, db),
+		ForecastAPIService:                api.NewForecastAPIServiceImpl(logger, db),
+		TagsAPIService:                    api.NewTagsAPIServiceImpl(logger, db, rates),
+		BudgetItemsAPIService:             api.NewBudgetItemsAPIService(logger, db, rates),
 		MergedTransactionsAPIService:      api.NewMergedTransactionsAPIService(logger, db),
 		ReconciliationAPIService:          api.NewReconciliationAPIServiceImpl(logger, db),
 		TemplatesAPIService:               api.NewTemplatesAPIServiceImpl(logger, db),
+		TransfersAPIService:               api.NewTransfersAPIService(logger, db, rates),
 	}
 }
 
 func Serve(
 	ctx context.Context, logger *slog.Logger,
-	storage database.Storage, cfg *config.Config,
+	storage database.Storage, rates *common.RateService, cfg *config.Config,
 	forcedImports chan<- common.ForcedImport,
 ) (net.Addr, chan int, error) {
 	// Initialize version info
@@ -165,10 +179,10 @@ func Serve(
 		}
 	}()
 
-	controllers := createControllers(logger, cfg, storage)
-	extraRouters := []goserver.Router{webapp.NewWebAppRouter(version.Commit, logger, cfg, storage, gormDB)}
+	controllers := createControllers(logger, cfg, storage, rates)
+	extraRouters := []goserver.Router{webapp.NewWebAppRouter(version.Commit, logger, cfg, storage, gormDB, rates)}
 	extraRouters = append(extraRouters, api.NewCustomAuthAPIController(controllers.AuthAPIService, logger, cfg, storage, gormDB))
-	extraRouters = append(extraRouters, api.NewStatusAPIController())
+	extraRouters = append(extraRouters, api.NewStatusAPIController(rates))
 
 	return goserver.Serve(ctx, logger, cfg,
 		controllers,
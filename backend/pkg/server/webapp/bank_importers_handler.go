@@ -108,7 +108,7 @@ func (r *WebAppRouter) bankImporterUploadHandler(w http.ResponseWriter, req *htt
 		return
 	}
 
-	parser := api.NewBankImportersAPIServiceImpl(r.logger, r.db, r.cfg)
+	parser := api.NewBankImportersAPIServiceImpl(r.logger, r.db, r.cfg, r.rates)
 	containsAllTransactions := req.URL.Query().Get("containsAllTransactions") == "true"
 	lastImport, err := parser.Upload(familyID, bankImporterID, format, fileData, containsAllTransactions)
 	if err != nil {
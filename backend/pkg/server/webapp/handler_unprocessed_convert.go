@@ -42,7 +42,7 @@ func (r *WebAppRouter) unprocessedConvertHandler(w http.ResponseWriter, req *htt
 		t.IsAuto = false
 	}
 
-	s := api.NewUnprocessedTransactionsAPIServiceImpl(r.logger, r.db)
+	s := api.NewUnprocessedTransactionsAPIServiceImpl(r.logger, r.db, r.rates)
 	_, err = s.Convert(req.Context(), familyID, transactionID, &t)
 	if err != nil {
 		r.logger.Error("Failed to convert unprocessed transaction", "error", err)
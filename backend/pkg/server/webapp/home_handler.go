@@ -40,7 +40,7 @@ func (r *WebAppRouter) homeHandler(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	a := api.NewAggregationsAPIServiceImpl(r.logger, r.db)
+	a := api.NewAggregationsAPIServiceImpl(r.logger, r.db, r.rates)
 	// dateFrom := utils.RoundToGranularity(time.Now(), utils.GranularityYear, false)
 	// dateTo := utils.RoundToGranularity(time.Now(), utils.GranularityMonth, true)
 
@@ -64,7 +64,7 @@ func (r *WebAppRouter) homeHandler(w http.ResponseWriter, req *http.Request) {
 		}
 	}
 
-	expenses, err := a.GetAggregatedExpenses(req.Context(), familyID, dateFrom, dateTo, outputCurrencyID, utils.GranularityMonth, false, "account", nil, nil)
+	expenses, err := a.GetAggregatedExpenses(req.Context(), familyID, dateFrom, dateTo, outputCurrencyID, utils.GranularityMonth, false, "account", nil, nil, 0)
 	if err != nil {
 		r.logger.Error("Failed to get aggregated expenses", "error", err)
 		r.RespondError(w, err.Error(), http.StatusInternalServerError)
@@ -221,7 +221,7 @@ func (r *WebAppRouter) matcherCheckHandler(w http.ResponseWriter, req *http.Requ
 	ctx := context.WithValue(req.Context(), constants.FamilyIDKey, familyID)
 
 	// Call the API service directly
-	unprocessedService := api.NewUnprocessedTransactionsAPIServiceImpl(r.logger, r.db)
+	unprocessedService := api.NewUnprocessedTransactionsAPIServiceImpl(r.logger, r.db, r.rates)
 	matchersService := api.NewMatchersAPIServiceImpl(r.logger, r.db, r.cfg, unprocessedService)
 	result, err := matchersService.CheckMatcher(ctx, checkRequest)
 	if err != nil {
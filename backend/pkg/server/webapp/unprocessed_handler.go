@@ -42,7 +42,7 @@ func (r *WebAppRouter) unprocessedHandler(w http.ResponseWriter, req *http.Reque
 	if id != "" {
 		r.logger.Info("Skipping unprocessed transactions to specified ID", "id", id)
 	}
-	s := api.NewUnprocessedTransactionsAPIServiceImpl(r.logger, r.db)
+	s := api.NewUnprocessedTransactionsAPIServiceImpl(r.logger, r.db, r.rates)
 	unprocessed, cnt, err := s.PrepareUnprocessedTransactions(req.Context(), familyID, true, id)
 	if err != nil {
 		r.logger.Error("Failed to get unprocessed", "error", err)
@@ -17,6 +17,7 @@ import (
 	"github.com/ya-breeze/geekbudgetbe/pkg/config"
 	"github.com/ya-breeze/geekbudgetbe/pkg/database"
 	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+	"github.com/ya-breeze/geekbudgetbe/pkg/server/common"
 	"github.com/ya-breeze/geekbudgetbe/pkg/utils"
 )
 
@@ -26,6 +27,7 @@ type WebAppRouter struct {
 	cfg    *config.Config
 	db     database.Storage
 	gormDB *gorm.DB
+	rates  *common.RateService
 }
 
 // RespondError logs the error and sends an error response
@@ -36,6 +38,7 @@ func (r *WebAppRouter) RespondError(w http.ResponseWriter, msg string, code int)
 
 func NewWebAppRouter(
 	commit string, logger *slog.Logger, cfg *config.Config, db database.Storage, gormDB *gorm.DB,
+	rates *common.RateService,
 ) *WebAppRouter {
 	return &WebAppRouter{
 		commit: commit,
@@ -43,6 +46,7 @@ func NewWebAppRouter(
 		cfg:    cfg,
 		db:     db,
 		gormDB: gormDB,
+		rates:  rates,
 	}
 }
 
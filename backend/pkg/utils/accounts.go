@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"slices"
+
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+// AccountAncestors returns IDs of the account and all its parents starting with the top-level
+// one. Unknown parents and cycles end the chain, so broken data can't loop forever.
+func AccountAncestors(accounts []goserver.Account, accountID string) []string {
+	parents := make(map[string]string, len(accounts))
+	for _, a := range accounts {
+		parents[a.Id] = a.ParentId
+	}
+
+	res := []string{}
+	for id := accountID; id != "" && !slices.Contains(res, id); id = parents[id] {
+		res = append(res, id)
+		if _, ok := parents[id]; !ok {
+			break
+		}
+	}
+	slices.Reverse(res)
+	return res
+}
+
+// IsSubAccount checks if the account is the ancestor itself or is nested in it on any level.
+func IsSubAccount(accounts []goserver.Account, accountID, ancestorID string) bool {
+	return slices.Contains(AccountAncestors(accounts, accountID), ancestorID)
+}
+
+// AccountRollup maps accounts nested deeper than depth levels to their ancestor on that level,
+// e.g. with depth 1 every sub-account maps to its top-level account. Accounts on the level or
+// above aren't in the map. Returns an empty map for non-positive depth.
+func AccountRollup(accounts []goserver.Account, depth int) map[string]string {
+	res := make(map[string]string)
+	if depth <= 0 {
+		return res
+	}
+	for _, a := range accounts {
+		if ancestors := AccountAncestors(accounts, a.Id); len(ancestors) > depth {
+			res[a.Id] = ancestors[depth-1]
+		}
+	}
+	return res
+}
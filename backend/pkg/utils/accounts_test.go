@@ -0,0 +1,34 @@
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Accounts Utils", func() {
+	accounts := []goserver.Account{
+		{Id: "food"},
+		{Id: "groceries", ParentId: "food"},
+		{Id: "bakery", ParentId: "groceries"},
+		{Id: "rent"},
+	}
+
+	It("returns ancestors of an account", func() {
+		Expect(AccountAncestors(accounts, "bakery")).To(Equal([]string{"food", "groceries", "bakery"}))
+		Expect(AccountAncestors(accounts, "rent")).To(Equal([]string{"rent"}))
+		Expect(IsSubAccount(accounts, "bakery", "food")).To(BeTrue())
+		Expect(IsSubAccount(accounts, "food", "bakery")).To(BeFalse())
+	})
+
+	It("stops on cycles", func() {
+		broken := []goserver.Account{{Id: "a", ParentId: "b"}, {Id: "b", ParentId: "a"}}
+		Expect(AccountAncestors(broken, "a")).To(Equal([]string{"b", "a"}))
+	})
+
+	It("maps deep accounts to their ancestor on the level", func() {
+		Expect(AccountRollup(accounts, 1)).To(Equal(map[string]string{"groceries": "food", "bakery": "food"}))
+		Expect(AccountRollup(accounts, 2)).To(Equal(map[string]string{"bakery": "groceries"}))
+		Expect(AccountRollup(accounts, 0)).To(BeEmpty())
+	})
+})
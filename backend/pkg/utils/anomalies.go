@@ -0,0 +1,255 @@
+package utils
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+const (
+	AnomalySensitivityOff    = "off"
+	AnomalySensitivityLow    = "low"
+	AnomalySensitivityMedium = "medium"
+	AnomalySensitivityHigh   = "high"
+
+	// AnomalyHistoryPeriods is the number of previous periods the current spending is compared with
+	AnomalyHistoryPeriods = 12
+	// AnomalyMinHistoryPeriods is the number of periods since the first spending needed to judge it
+	AnomalyMinHistoryPeriods = 3
+	// AnomalyMinPartnerTransactions is the number of previous payments to a partner needed to judge
+	// a new one
+	AnomalyMinPartnerTransactions = 5
+
+	// anomalyMinPeriodExcess is the minimal relative excess over the expected period spending, so
+	// small changes of a very stable spending are not reported
+	anomalyMinPeriodExcess = 0.2
+	// anomalyMinTransactionExcess is the minimal relative excess over the usual payment to a partner
+	anomalyMinTransactionExcess = 1.0
+)
+
+// IsAnomalySensitivity checks if the sensitivity is known, empty means medium.
+func IsAnomalySensitivity(sensitivity string) bool {
+	switch sensitivity {
+	case "", AnomalySensitivityOff, AnomalySensitivityLow, AnomalySensitivityMedium, AnomalySensitivityHigh:
+		return true
+	default:
+		return false
+	}
+}
+
+// AnomalyDeviations returns how many standard deviations spending has to differ from the usual
+// one to be reported. False means anomalies are not reported at all.
+func AnomalyDeviations(sensitivity string) (float64, bool) {
+	switch sensitivity {
+	case AnomalySensitivityOff:
+		return 0, false
+	case AnomalySensitivityLow:
+		return 3, true
+	case AnomalySensitivityHigh:
+		return 1.5, true
+	default:
+		return 2, true
+	}
+}
+
+// PeriodAnomaly is spending of an expense account in the current period which is much higher
+// than in previous periods.
+type PeriodAnomaly struct {
+	AccountID   string
+	CurrencyID  string
+	PeriodStart time.Time
+	Amount      decimal.Decimal
+	Expected    decimal.Decimal
+}
+
+// TransactionAnomaly is a payment to a partner which is much higher than previous ones.
+type TransactionAnomaly struct {
+	Transaction goserver.Transaction
+	CurrencyID  string
+	Amount      decimal.Decimal
+	Expected    decimal.Decimal
+}
+
+// DetectPeriodAnomalies compares spending of expense accounts in the period containing today with
+// the previous AnomalyHistoryPeriods periods. Spending is anomalous when it exceeds the mean by
+// the given number of standard deviations. If the account has history for the same period a year
+// ago, the expected spending is the average of the mean and that period to respect seasonality.
+// Only overspending is reported, because the current period is not finished yet.
+func DetectPeriodAnomalies(
+	transactions []goserver.Transaction, isExpense func(accountID string) bool,
+	granularity Granularity, today time.Time, deviations float64,
+) []PeriodAnomaly {
+	current := RoundToGranularity(today, granularity, false)
+	oldest := AddIntervals(current, granularity, -AnomalyHistoryPeriods)
+	end := AddIntervals(current, granularity, 1)
+
+	type key struct {
+		accountID  string
+		currencyID string
+	}
+	// spending[key][i] is spending i periods ago, 0 is the current period
+	spending := make(map[key][]decimal.Decimal)
+	keys := []key{}
+	for _, t := range transactions {
+		if t.Date.Before(oldest) || !t.Date.Before(end) {
+			continue
+		}
+		idx := 0
+		for start := current; t.Date.Before(start); start = AddIntervals(start, granularity, -1) {
+			idx++
+		}
+		for _, m := range t.Movements {
+			if m.AccountId == "" || !isExpense(m.AccountId) {
+				continue
+			}
+			k := key{m.AccountId, m.CurrencyId}
+			if _, ok := spending[k]; !ok {
+				spending[k] = make([]decimal.Decimal, AnomalyHistoryPeriods+1)
+				keys = append(keys, k)
+			}
+			spending[k][idx] = spending[k][idx].Add(m.Amount)
+		}
+	}
+
+	res := []PeriodAnomaly{}
+	for _, k := range keys {
+		values := spending[k]
+		if !values[0].IsPositive() {
+			continue
+		}
+
+		// Periods before the first spending don't tell anything about the account
+		first := 0
+		for i := AnomalyHistoryPeriods; i > 0; i-- {
+			if !values[i].IsZero() {
+				first = i
+				break
+			}
+		}
+		if first < AnomalyMinHistoryPeriods {
+			continue
+		}
+
+		mean, stdDev := meanAndStdDev(values[1 : first+1])
+		expected := mean
+		if first == AnomalyHistoryPeriods {
+			expected = mean.Add(values[AnomalyHistoryPeriods]).Div(decimal.NewFromInt(2))
+		}
+		limit := expected.Add(decimal.Max(
+			stdDev.Mul(decimal.NewFromFloat(deviations)),
+			expected.Mul(decimal.NewFromFloat(anomalyMinPeriodExcess))))
+		if values[0].GreaterThan(limit) {
+			res = append(res, PeriodAnomaly{
+				AccountID:   k.accountID,
+				CurrencyID:  k.currencyID,
+				PeriodStart: current,
+				Amount:      values[0],
+				Expected:    expected.Round(2),
+			})
+		}
+	}
+
+	return res
+}
+
+// DetectTransactionAnomalies checks if recent transactions pay a partner much more than the
+// transactions in history. A payment is anomalous when it exceeds the mean of previous payments
+// by the given number of standard deviations and is at least twice as high.
+func DetectTransactionAnomalies(
+	history, recent []goserver.Transaction, isExpense func(accountID string) bool, deviations float64,
+) []TransactionAnomaly {
+	type payment struct {
+		id     string
+		amount decimal.Decimal
+	}
+	payments := make(map[partnerCurrency][]payment)
+	for _, t := range history {
+		if key, amount, ok := getPartnerPayment(t, isExpense); ok {
+			payments[key] = append(payments[key], payment{t.Id, amount})
+		}
+	}
+
+	res := []TransactionAnomaly{}
+	for _, t := range recent {
+		key, amount, ok := getPartnerPayment(t, isExpense)
+		if !ok {
+			continue
+		}
+
+		previous := []decimal.Decimal{}
+		for _, p := range payments[key] {
+			if p.id != t.Id {
+				previous = append(previous, p.amount)
+			}
+		}
+		if len(previous) < AnomalyMinPartnerTransactions {
+			continue
+		}
+
+		mean, stdDev := meanAndStdDev(previous)
+		limit := mean.Add(decimal.Max(
+			stdDev.Mul(decimal.NewFromFloat(deviations)),
+			mean.Mul(decimal.NewFromFloat(anomalyMinTransactionExcess))))
+		if amount.GreaterThan(limit) {
+			res = append(res, TransactionAnomaly{
+				Transaction: t,
+				CurrencyID:  key.currencyID,
+				Amount:      amount,
+				Expected:    mean.Round(2),
+			})
+		}
+	}
+
+	return res
+}
+
+type partnerCurrency struct {
+	partner    string
+	currencyID string
+}
+
+// getPartnerPayment returns partner, currency and the amount paid to expense accounts.
+// Transactions without partner or paying in several currencies are skipped.
+func getPartnerPayment(
+	t goserver.Transaction, isExpense func(accountID string) bool,
+) (partnerCurrency, decimal.Decimal, bool) {
+	partner := strings.ToLower(strings.TrimSpace(t.PartnerName))
+	if partner == "" {
+		return partnerCurrency{}, decimal.Zero, false
+	}
+
+	currencyID := ""
+	amount := decimal.Zero
+	for _, m := range t.Movements {
+		if m.AccountId == "" || !isExpense(m.AccountId) || !m.Amount.IsPositive() {
+			continue
+		}
+		if currencyID != "" && currencyID != m.CurrencyId {
+			return partnerCurrency{}, decimal.Zero, false
+		}
+		currencyID = m.CurrencyId
+		amount = amount.Add(m.Amount)
+	}
+
+	return partnerCurrency{partner, currencyID}, amount, amount.IsPositive()
+}
+
+func meanAndStdDev(values []decimal.Decimal) (decimal.Decimal, decimal.Decimal) {
+	if len(values) == 0 {
+		return decimal.Zero, decimal.Zero
+	}
+
+	count := decimal.NewFromInt(int64(len(values)))
+	mean := decimal.Sum(decimal.Zero, values...).Div(count)
+	variance := decimal.Zero
+	for _, v := range values {
+		diff := v.Sub(mean)
+		variance = variance.Add(diff.Mul(diff))
+	}
+	variance = variance.Div(count)
+
+	return mean, decimal.NewFromFloat(math.Sqrt(variance.InexactFloat64()))
+}
@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Anomalies Utils", func() {
+	today := time.Date(2024, 12, 10, 0, 0, 0, 0, time.UTC)
+	isExpense := func(accountID string) bool {
+		return accountID == "groceries" || accountID == "gifts"
+	}
+	spend := func(accountID, partner string, amount int64, date time.Time) goserver.Transaction {
+		return goserver.Transaction{
+			Id:          date.Format(time.DateOnly) + accountID,
+			Date:        date,
+			PartnerName: partner,
+			Movements: []goserver.Movement{
+				{AccountId: "fio", Amount: decimal.NewFromInt(-amount), CurrencyId: "CZK"},
+				{AccountId: accountID, Amount: decimal.NewFromInt(amount), CurrencyId: "CZK"},
+			},
+		}
+	}
+	// monthly returns spending for the previous months, the oldest first
+	monthly := func(accountID string, amounts ...int64) []goserver.Transaction {
+		res := []goserver.Transaction{}
+		for i, amount := range amounts {
+			res = append(res, spend(accountID, "", amount, today.AddDate(0, i-len(amounts), 0)))
+		}
+		return res
+	}
+
+	Describe("AnomalyDeviations", func() {
+		It("should map sensitivity to standard deviations", func() {
+			_, ok := AnomalyDeviations(AnomalySensitivityOff)
+			Expect(ok).To(BeFalse())
+			low, _ := AnomalyDeviations(AnomalySensitivityLow)
+			medium, _ := AnomalyDeviations("")
+			high, _ := AnomalyDeviations(AnomalySensitivityHigh)
+			Expect(low).To(BeNumerically(">", medium))
+			Expect(medium).To(BeNumerically(">", high))
+		})
+	})
+
+	Describe("DetectPeriodAnomalies", func() {
+		history := monthly("groceries", 900, 1100, 1000, 950, 1050, 1000, 1000, 900, 1100, 1000)
+
+		It("should report spending much higher than usual", func() {
+			transactions := append(history, spend("groceries", "", 2500, today))
+			anomalies := DetectPeriodAnomalies(transactions, isExpense, GranularityMonth, today, 2)
+			Expect(anomalies).To(HaveLen(1))
+			Expect(anomalies[0].AccountID).To(Equal("groceries"))
+			Expect(anomalies[0].PeriodStart).To(Equal(time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(anomalies[0].Amount.Equal(decimal.NewFromInt(2500))).To(BeTrue())
+			Expect(anomalies[0].Expected.Equal(decimal.NewFromInt(1000))).To(BeTrue())
+		})
+
+		It("should ignore usual spending, short history and other accounts", func() {
+			transactions := append(history,
+				spend("groceries", "", 1150, today),
+				spend("gifts", "", 300, today.AddDate(0, -2, 0)),
+				spend("gifts", "", 300, today.AddDate(0, -1, 0)),
+				spend("gifts", "", 5000, today),
+				spend("rent", "", 50000, today))
+			Expect(DetectPeriodAnomalies(transactions, isExpense, GranularityMonth, today, 2)).To(BeEmpty())
+		})
+
+		It("should expect seasonal spending from the same period a year ago", func() {
+			gifts := monthly("gifts", 3000, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300)
+			transactions := append(gifts, spend("gifts", "", 2000, today))
+			Expect(DetectPeriodAnomalies(transactions, isExpense, GranularityMonth, today, 2)).To(BeEmpty())
+
+			gifts[0] = spend("gifts", "", 300, gifts[0].Date)
+			transactions = append(gifts, spend("gifts", "", 2000, today))
+			Expect(DetectPeriodAnomalies(transactions, isExpense, GranularityMonth, today, 2)).To(HaveLen(1))
+		})
+	})
+
+	Describe("DetectTransactionAnomalies", func() {
+		history := []goserver.Transaction{}
+		for i, amount := range []int64{100, 120, 90, 110, 100} {
+			history = append(history, spend("groceries", "Shop", amount, today.AddDate(0, 0, -10*(i+1))))
+		}
+
+		It("should report payments much higher than usual to the partner", func() {
+			large := spend("groceries", "shop", 500, today)
+			usual := spend("groceries", "Shop", 150, today.AddDate(0, 0, -1))
+			other := spend("groceries", "Other", 500, today.AddDate(0, 0, -2))
+			all := append(history, large, usual, other)
+
+			anomalies := DetectTransactionAnomalies(all, []goserver.Transaction{large, usual, other}, isExpense, 2)
+			Expect(anomalies).To(HaveLen(1))
+			Expect(anomalies[0].Transaction.Id).To(Equal(large.Id))
+			Expect(anomalies[0].CurrencyID).To(Equal("CZK"))
+			// Mean of the history and the usual payment
+			Expect(anomalies[0].Expected.Equal(decimal.NewFromFloat(111.67))).To(BeTrue())
+		})
+
+		It("should need enough previous payments", func() {
+			large := spend("groceries", "Shop", 500, today)
+			all := append(history[1:], large)
+			Expect(DetectTransactionAnomalies(all, []goserver.Transaction{large}, isExpense, 2)).To(BeEmpty())
+		})
+	})
+})
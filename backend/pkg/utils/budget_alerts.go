@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+const (
+	BudgetAlertConsumed  = "consumed"
+	BudgetAlertExceeded  = "exceeded"
+	BudgetAlertProjected = "projected"
+)
+
+// HasBudgetAlerts checks if any budget alert is enabled.
+func HasBudgetAlerts(alerts goserver.BudgetAlerts) bool {
+	return alerts.ConsumedPercent > 0 || alerts.Exceeded || alerts.Projected
+}
+
+// ReachedBudgetAlerts returns the enabled alerts reached by spending of the available budget of
+// the period from start till end on the day today. Spending over the budget reports only that it's
+// exceeded. The projection extrapolates the spending at its daily run rate to the whole period.
+func ReachedBudgetAlerts(
+	alerts goserver.BudgetAlerts, available, spent decimal.Decimal, start, end, today time.Time,
+) []string {
+	if !available.IsPositive() || !spent.IsPositive() {
+		return nil
+	}
+	if spent.GreaterThan(available) {
+		if alerts.Exceeded {
+			return []string{BudgetAlertExceeded}
+		}
+		return nil
+	}
+
+	res := []string{}
+	if alerts.ConsumedPercent > 0 &&
+		spent.Mul(decimal.NewFromInt(100)).GreaterThanOrEqual(available.Mul(decimal.NewFromInt32(alerts.ConsumedPercent))) {
+		res = append(res, BudgetAlertConsumed)
+	}
+	elapsedDays := int64(today.Sub(start).Hours()/24) + 1
+	periodDays := int64(end.Sub(start).Hours() / 24)
+	if alerts.Projected && elapsedDays > 0 &&
+		spent.Mul(decimal.NewFromInt(periodDays)).Div(decimal.NewFromInt(elapsedDays)).GreaterThan(available) {
+		res = append(res, BudgetAlertProjected)
+	}
+	return res
+}
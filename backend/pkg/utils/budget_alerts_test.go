@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Budget Alerts Utils", func() {
+	start := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	all := goserver.BudgetAlerts{ConsumedPercent: 80, Exceeded: true, Projected: true}
+	reached := func(alerts goserver.BudgetAlerts, spent int64, day int) []string {
+		return ReachedBudgetAlerts(alerts, decimal.NewFromInt(300), decimal.NewFromInt(spent),
+			start, end, start.AddDate(0, 0, day-1))
+	}
+
+	It("reports consumed budgets", func() {
+		Expect(reached(all, 240, 30)).To(Equal([]string{BudgetAlertConsumed}))
+		Expect(reached(all, 239, 30)).To(BeEmpty())
+	})
+
+	It("reports projected overspending at the daily run rate", func() {
+		// 100 in 10 days is 300 in the month
+		Expect(reached(all, 100, 10)).To(BeEmpty())
+		Expect(reached(all, 101, 10)).To(Equal([]string{BudgetAlertProjected}))
+		Expect(reached(all, 250, 20)).To(Equal([]string{BudgetAlertConsumed, BudgetAlertProjected}))
+	})
+
+	It("reports only exceeded budgets once they're overspent", func() {
+		Expect(reached(all, 301, 5)).To(Equal([]string{BudgetAlertExceeded}))
+		Expect(reached(goserver.BudgetAlerts{ConsumedPercent: 80}, 301, 5)).To(BeEmpty())
+	})
+
+	It("ignores accounts without budget", func() {
+		Expect(ReachedBudgetAlerts(all, decimal.Zero, decimal.NewFromInt(10), start, end, start)).To(BeEmpty())
+	})
+})
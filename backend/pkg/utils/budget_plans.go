@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+const (
+	BudgetRecurrenceMonthly   = "monthly"
+	BudgetRecurrenceQuarterly = "quarterly"
+	BudgetRecurrenceYearly    = "yearly"
+	BudgetRecurrenceCustom    = "custom"
+)
+
+const (
+	// BudgetOverspendingRollover carries overspending of an envelope to its next period
+	BudgetOverspendingRollover = "rollover"
+	// BudgetOverspendingReset covers overspending of an envelope from ready to assign
+	BudgetOverspendingReset = "reset"
+)
+
+// IsBudgetOverspending checks if the overspending handling is known, empty means rollover.
+func IsBudgetOverspending(overspending string) bool {
+	return overspending == "" || overspending == BudgetOverspendingRollover || overspending == BudgetOverspendingReset
+}
+
+// ExpandBudgetPlans returns the budget items together with the budget of the plans in every month
+// before the end date. Months start as in the month granularity. In a month the plan of an account
+// with the latest effective date applies, and only if there are no budget items of the account in
+// that month. Accruing plans budget their amount split evenly over the months between their
+// recurrences, rounded to cents. The returned plan budgets are dated at the start of their month
+// and have no ID.
+func ExpandBudgetPlans(
+	plans []goserver.BudgetPlan, items []goserver.BudgetItem, month Granularity, to time.Time,
+) []goserver.BudgetItem {
+	type accountMonth struct {
+		accountID string
+		month     time.Time
+	}
+	overridden := make(map[accountMonth]bool, len(items))
+	for _, item := range items {
+		overridden[accountMonth{item.AccountId, RoundToGranularity(item.Date, month, false)}] = true
+	}
+
+	// Newer plans of an account replace older ones from their first month
+	plans = slices.Clone(plans)
+	slices.SortStableFunc(plans, func(a, b goserver.BudgetPlan) int { return a.EffectiveFrom.Compare(b.EffectiveFrom) })
+
+	res := slices.Clone(items)
+	for i, plan := range plans {
+		start := RoundToGranularity(plan.EffectiveFrom, month, false)
+		end := to
+		if !plan.EffectiveTo.IsZero() {
+			end = minTime(end, RoundToGranularity(plan.EffectiveTo, month, false))
+		}
+		for _, next := range plans[i+1:] {
+			if next.AccountId == plan.AccountId {
+				end = minTime(end, RoundToGranularity(next.EffectiveFrom, month, false))
+				break
+			}
+		}
+
+		amount := plan.Amount
+		if plan.Accrue {
+			amount = amount.Div(decimal.NewFromInt(budgetPlanMonths(plan))).Round(2)
+		}
+		for m := start; m.Before(end); m = AddIntervals(m, month, 1) {
+			if overridden[accountMonth{plan.AccountId, m}] || (!plan.Accrue && !budgetPlanRecursIn(plan, start, m)) {
+				continue
+			}
+			res = append(res, goserver.BudgetItem{
+				Date:        m,
+				AccountId:   plan.AccountId,
+				Amount:      amount,
+				Description: plan.Description,
+			})
+		}
+	}
+	return res
+}
+
+// budgetPlanRecursIn checks whether the plan starting in the month start has a budget in the month m.
+// Months of custom plans are the calendar months the periods start in.
+func budgetPlanRecursIn(plan goserver.BudgetPlan, start, m time.Time) bool {
+	offset := (m.Year()-start.Year())*12 + int(m.Month()) - int(start.Month())
+	switch plan.Recurrence {
+	case BudgetRecurrenceMonthly:
+		return true
+	case BudgetRecurrenceQuarterly:
+		return offset%3 == 0
+	case BudgetRecurrenceYearly:
+		return offset%12 == 0
+	case BudgetRecurrenceCustom:
+		return slices.Contains(plan.Months, int32(m.Month()))
+	default:
+		return false
+	}
+}
+
+// budgetPlanMonths returns the number of months between recurrences of quarterly and yearly plans.
+func budgetPlanMonths(plan goserver.BudgetPlan) int64 {
+	if plan.Recurrence == BudgetRecurrenceQuarterly {
+		return 3
+	}
+	return 12
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
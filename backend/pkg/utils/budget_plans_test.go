@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Budget Plans Utils", func() {
+	month := func(m int) time.Time { return time.Date(2025, time.Month(m), 1, 0, 0, 0, 0, time.UTC) }
+	// budgets returns the amounts of the account by month
+	budgets := func(items []goserver.BudgetItem, accountID string) map[int]string {
+		res := map[int]string{}
+		for _, item := range items {
+			if item.AccountId == accountID {
+				res[int(item.Date.Month())] = item.Amount.String()
+			}
+		}
+		return res
+	}
+
+	It("expands recurrences until the end date", func() {
+		items := ExpandBudgetPlans([]goserver.BudgetPlan{
+			{AccountId: "food", Amount: decimal.NewFromInt(100), Recurrence: BudgetRecurrenceMonthly, EffectiveFrom: month(10)},
+			{AccountId: "car", Amount: decimal.NewFromInt(300), Recurrence: BudgetRecurrenceQuarterly, EffectiveFrom: month(2)},
+			{AccountId: "gifts", Amount: decimal.NewFromInt(500), Recurrence: BudgetRecurrenceCustom, Months: []int32{5, 12}, EffectiveFrom: month(1)},
+		}, nil, GranularityMonth, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		Expect(budgets(items, "food")).To(Equal(map[int]string{10: "100", 11: "100", 12: "100"}))
+		Expect(budgets(items, "car")).To(Equal(map[int]string{2: "300", 5: "300", 8: "300", 11: "300"}))
+		Expect(budgets(items, "gifts")).To(Equal(map[int]string{5: "500", 12: "500"}))
+	})
+
+	It("replaces plans from the month of a newer plan", func() {
+		items := ExpandBudgetPlans([]goserver.BudgetPlan{
+			{AccountId: "food", Amount: decimal.NewFromInt(120), Recurrence: BudgetRecurrenceMonthly, EffectiveFrom: month(3)},
+			{AccountId: "food", Amount: decimal.NewFromInt(100), Recurrence: BudgetRecurrenceMonthly, EffectiveFrom: month(1)},
+			{AccountId: "rent", Amount: decimal.NewFromInt(900), Recurrence: BudgetRecurrenceYearly, EffectiveFrom: month(1), EffectiveTo: month(2)},
+		}, []goserver.BudgetItem{
+			{AccountId: "food", Date: month(4).AddDate(0, 0, 10), Amount: decimal.NewFromInt(50), Id: "override"},
+		}, GranularityMonth, month(6))
+
+		Expect(budgets(items, "food")).To(Equal(map[int]string{1: "100", 2: "100", 3: "120", 4: "50", 5: "120"}))
+		Expect(budgets(items, "rent")).To(Equal(map[int]string{1: "900"}))
+	})
+
+	It("starts months on the month start day", func() {
+		items := ExpandBudgetPlans([]goserver.BudgetPlan{
+			{AccountId: "food", Amount: decimal.NewFromInt(100), Recurrence: BudgetRecurrenceMonthly, EffectiveFrom: month(1)},
+		}, nil, FinancialMonth(25), month(3))
+
+		Expect(items).To(HaveLen(3))
+		Expect(items[0].Date).To(Equal(time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)))
+		Expect(items[2].Date).To(Equal(time.Date(2025, 2, 25, 0, 0, 0, 0, time.UTC)))
+	})
+	It("spreads accruing plans over their months", func() {
+		items := ExpandBudgetPlans([]goserver.BudgetPlan{
+			{AccountId: "insurance", Amount: decimal.NewFromInt(1200), Recurrence: BudgetRecurrenceYearly, EffectiveFrom: month(1), Accrue: true},
+			{AccountId: "car", Amount: decimal.NewFromInt(100), Recurrence: BudgetRecurrenceQuarterly, EffectiveFrom: month(1), Accrue: true},
+		}, nil, GranularityMonth, month(4))
+
+		Expect(budgets(items, "insurance")).To(Equal(map[int]string{1: "100", 2: "100", 3: "100"}))
+		Expect(budgets(items, "car")).To(Equal(map[int]string{1: "33.33", 2: "33.33", 3: "33.33"}))
+	})
+})
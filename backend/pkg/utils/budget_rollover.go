@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+const (
+	BudgetRolloverUnlimited = "unlimited"
+	BudgetRolloverNone      = "none"
+	BudgetRolloverPositive  = "positive"
+	BudgetRolloverNegative  = "negative"
+	BudgetRolloverCapped    = "capped"
+)
+
+// IsValidBudgetRollover checks the policy and the reset period of the rollover settings, capped
+// rollover needs a non-negative cap.
+func IsValidBudgetRollover(rollover goserver.BudgetRollover) bool {
+	switch rollover.Policy {
+	case "", BudgetRolloverUnlimited, BudgetRolloverNone, BudgetRolloverPositive, BudgetRolloverNegative:
+	case BudgetRolloverCapped:
+		if rollover.Cap.IsNegative() {
+			return false
+		}
+	default:
+		return false
+	}
+
+	switch Granularity(rollover.ResetEvery) {
+	case "", GranularityQuarter, GranularityYear:
+		return true
+	default:
+		return false
+	}
+}
+
+// BudgetRolloverAmount returns the part of the remainder of a period which the rollover policy
+// carries over to the next period.
+func BudgetRolloverAmount(rollover goserver.BudgetRollover, remainder decimal.Decimal) decimal.Decimal {
+	switch rollover.Policy {
+	case BudgetRolloverNone:
+		return decimal.Zero
+	case BudgetRolloverPositive:
+		return decimal.Max(remainder, decimal.Zero)
+	case BudgetRolloverNegative:
+		return decimal.Min(remainder, decimal.Zero)
+	case BudgetRolloverCapped:
+		return decimal.Max(decimal.Min(remainder, rollover.Cap), rollover.Cap.Neg())
+	default:
+		return remainder
+	}
+}
+
+// BudgetRolloverResets checks if the rollover from the period starting at previous is dropped in
+// the period starting at current, because a new calendar quarter or year started.
+func BudgetRolloverResets(rollover goserver.BudgetRollover, previous, current time.Time) bool {
+	if rollover.ResetEvery == "" {
+		return false
+	}
+	resetPeriod := Granularity(rollover.ResetEvery)
+	return !RoundToGranularity(previous, resetPeriod, false).Equal(RoundToGranularity(current, resetPeriod, false))
+}
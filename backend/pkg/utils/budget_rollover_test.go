@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Budget Rollover Utils", func() {
+	carried := func(rollover goserver.BudgetRollover, remainder int64) string {
+		return BudgetRolloverAmount(rollover, decimal.NewFromInt(remainder)).String()
+	}
+
+	It("limits the rollover by the policy", func() {
+		Expect(carried(goserver.BudgetRollover{}, -50)).To(Equal("-50"))
+		Expect(carried(goserver.BudgetRollover{Policy: BudgetRolloverNone}, 50)).To(Equal("0"))
+		Expect(carried(goserver.BudgetRollover{Policy: BudgetRolloverPositive}, -50)).To(Equal("0"))
+		Expect(carried(goserver.BudgetRollover{Policy: BudgetRolloverPositive}, 50)).To(Equal("50"))
+		Expect(carried(goserver.BudgetRollover{Policy: BudgetRolloverNegative}, 50)).To(Equal("0"))
+		Expect(carried(goserver.BudgetRollover{Policy: BudgetRolloverNegative}, -50)).To(Equal("-50"))
+
+		capped := goserver.BudgetRollover{Policy: BudgetRolloverCapped, Cap: decimal.NewFromInt(30)}
+		Expect(carried(capped, 50)).To(Equal("30"))
+		Expect(carried(capped, -50)).To(Equal("-30"))
+		Expect(carried(capped, 10)).To(Equal("10"))
+	})
+
+	It("resets the rollover in a new quarter or year", func() {
+		quarterly := goserver.BudgetRollover{ResetEvery: string(GranularityQuarter)}
+		march := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+		Expect(BudgetRolloverResets(quarterly, march.AddDate(0, -1, 0), march)).To(BeFalse())
+		Expect(BudgetRolloverResets(quarterly, march, march.AddDate(0, 1, 0))).To(BeTrue())
+		Expect(BudgetRolloverResets(goserver.BudgetRollover{}, march, march.AddDate(1, 0, 0))).To(BeFalse())
+	})
+
+	It("validates the settings", func() {
+		Expect(IsValidBudgetRollover(goserver.BudgetRollover{Policy: BudgetRolloverPositive, ResetEvery: "year"})).To(BeTrue())
+		Expect(IsValidBudgetRollover(goserver.BudgetRollover{Policy: "sometimes"})).To(BeFalse())
+		Expect(IsValidBudgetRollover(goserver.BudgetRollover{ResetEvery: "month"})).To(BeFalse())
+		Expect(IsValidBudgetRollover(goserver.BudgetRollover{Policy: BudgetRolloverCapped, Cap: decimal.NewFromInt(-1)})).To(BeFalse())
+	})
+})
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+const (
+	budgetSelectorTagPrefix     = "tag:"
+	budgetSelectorPartnerPrefix = "partner:"
+)
+
+// BudgetSelector is the target of a budget: an account, a tag with its sub-tags or a partner.
+type BudgetSelector struct {
+	AccountID string
+	Tag       string
+	Partner   string
+}
+
+// NewBudgetSelector normalizes the tag of the target, partner names are normalized when compared
+// so the selector keeps the name as it was entered.
+func NewBudgetSelector(accountID, tag, partner string) BudgetSelector {
+	return BudgetSelector{AccountID: accountID, Tag: NormalizeTag(tag), Partner: strings.TrimSpace(partner)}
+}
+
+// BudgetItemSelector returns the target of the budget item.
+func BudgetItemSelector(item goserver.BudgetItem) BudgetSelector {
+	return NewBudgetSelector(item.AccountId, item.Tag, item.Partner)
+}
+
+// IsValid checks that exactly one target is set.
+func (s BudgetSelector) IsValid() bool {
+	set := 0
+	for _, v := range []string{s.AccountID, s.Tag, s.Partner} {
+		if v != "" {
+			set++
+		}
+	}
+	return set == 1
+}
+
+// IsAccount tells whether the target is an account.
+func (s BudgetSelector) IsAccount() bool {
+	return s.Tag == "" && s.Partner == ""
+}
+
+// Key identifies the target, accounts are identified by their ID so their budgets can be keyed
+// together with other account data.
+func (s BudgetSelector) Key() string {
+	switch {
+	case s.Tag != "":
+		return budgetSelectorTagPrefix + s.Tag
+	case s.Partner != "":
+		return budgetSelectorPartnerPrefix + NormalizePartnerName(s.Partner)
+	default:
+		return s.AccountID
+	}
+}
+
+// Matches tells whether a movement of the transaction to the account counts for the target.
+// Transactions match a partner by their partner name or, if there is none, by their place.
+func (s BudgetSelector) Matches(t goserver.Transaction, accountID string) bool {
+	switch {
+	case s.Tag != "":
+		return slices.ContainsFunc(t.Tags, func(tag string) bool { return IsTagOrSubTag(NormalizeTag(tag), s.Tag) })
+	case s.Partner != "":
+		partner := NormalizePartnerName(t.PartnerName)
+		if partner == "" {
+			partner = NormalizePartnerName(t.Place)
+		}
+		return partner == NormalizePartnerName(s.Partner)
+	default:
+		return accountID == s.AccountID
+	}
+}
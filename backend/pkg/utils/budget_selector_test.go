@@ -0,0 +1,33 @@
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Budget Selector Utils", func() {
+	It("needs exactly one target", func() {
+		Expect(NewBudgetSelector("acc", "", "").IsValid()).To(BeTrue())
+		Expect(NewBudgetSelector("", " vacation / 2026 ", "").IsValid()).To(BeTrue())
+		Expect(NewBudgetSelector("", "", "").IsValid()).To(BeFalse())
+		Expect(NewBudgetSelector("acc", "", "Amazon").IsValid()).To(BeFalse())
+	})
+
+	It("keys targets apart from account IDs", func() {
+		Expect(NewBudgetSelector("acc", "", "").Key()).To(Equal("acc"))
+		Expect(NewBudgetSelector("", " vacation / 2026 ", "").Key()).To(Equal("tag:vacation/2026"))
+		Expect(NewBudgetSelector("", "", "Amazon, Inc.").Key()).To(Equal("partner:amazon inc"))
+	})
+
+	It("matches transactions by tag, partner or account", func() {
+		t := goserver.Transaction{Tags: []string{"vacation/2026/flights"}, Place: "AMAZON INC"}
+		Expect(NewBudgetSelector("", "vacation/2026", "").Matches(t, "food")).To(BeTrue())
+		Expect(NewBudgetSelector("", "vacation/2025", "").Matches(t, "food")).To(BeFalse())
+		Expect(NewBudgetSelector("", "", "Amazon Inc.").Matches(t, "food")).To(BeTrue())
+
+		t.PartnerName = "Alza"
+		Expect(NewBudgetSelector("", "", "Amazon Inc.").Matches(t, "food")).To(BeFalse())
+		Expect(NewBudgetSelector("food", "", "").Matches(t, "food")).To(BeTrue())
+	})
+})
@@ -1,23 +1,103 @@
 package utils
 
 import (
+	"strconv"
+	"strings"
 	"time"
 )
 
 type Granularity string
 
 const (
-	GranularityMonth Granularity = "month"
-	GranularityYear  Granularity = "year"
+	GranularityDay     Granularity = "day"
+	GranularityWeek    Granularity = "week"
+	GranularityMonth   Granularity = "month"
+	GranularityQuarter Granularity = "quarter"
+	GranularityYear    Granularity = "year"
+
+	// MaxMonthStartDay is the latest day a financial month may start on, so every month has it
+	MaxMonthStartDay = 28
 )
 
+// FinancialMonth returns monthly granularity with months starting on the given day, e.g.
+// on the salary day. Day 1 (or an invalid day) means calendar months.
+func FinancialMonth(startDay int) Granularity {
+	if startDay <= 1 || startDay > MaxMonthStartDay {
+		return GranularityMonth
+	}
+	return Granularity(string(GranularityMonth) + ":" + strconv.Itoa(startDay))
+}
+
+// ParseGranularity converts granularity name from API to Granularity. Months start on
+// monthStartDay, unknown names fall back to months.
+func ParseGranularity(name string, monthStartDay int) Granularity {
+	switch Granularity(name) {
+	case GranularityDay, GranularityWeek, GranularityQuarter, GranularityYear:
+		return Granularity(name)
+	default:
+		return FinancialMonth(monthStartDay)
+	}
+}
+
+// Base returns granularity without month start day, i.e. one of the Granularity constants.
+func (g Granularity) Base() Granularity {
+	base, _, _ := strings.Cut(string(g), ":")
+	return Granularity(base)
+}
+
+// MonthStartDay returns the day months start on for monthly granularity.
+func (g Granularity) MonthStartDay() int {
+	_, day, ok := strings.Cut(string(g), ":")
+	if !ok {
+		return 1
+	}
+	res, err := strconv.Atoi(day)
+	if err != nil || res < 1 || res > MaxMonthStartDay {
+		return 1
+	}
+	return res
+}
+
+// AddIntervals moves date by n intervals of the granularity, n may be negative.
+func AddIntervals(date time.Time, granularity Granularity, n int) time.Time {
+	switch granularity.Base() {
+	case GranularityDay:
+		return date.AddDate(0, 0, n)
+	case GranularityWeek:
+		return date.AddDate(0, 0, 7*n)
+	case GranularityMonth:
+		return date.AddDate(0, n, 0)
+	case GranularityQuarter:
+		return date.AddDate(0, 3*n, 0)
+	default:
+		return date.AddDate(n, 0, 0)
+	}
+}
+
 func RoundToGranularity(date time.Time, granularity Granularity, roundUp bool,
 ) time.Time {
 	var rounded time.Time
-	if granularity == GranularityMonth {
-		rounded = time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
-	} else if granularity == GranularityYear {
+	switch granularity.Base() {
+	case GranularityDay:
+		rounded = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	case GranularityWeek:
+		// ISO weeks start on Monday
+		offset := (int(date.Weekday()) + 6) % 7
+		rounded = time.Date(date.Year(), date.Month(), date.Day()-offset, 0, 0, 0, 0, date.Location())
+	case GranularityMonth:
+		startDay := granularity.MonthStartDay()
+		month := date.Month()
+		if date.Day() < startDay {
+			month--
+		}
+		rounded = time.Date(date.Year(), month, startDay, 0, 0, 0, 0, date.Location())
+	case GranularityQuarter:
+		month := date.Month() - (date.Month()-1)%3
+		rounded = time.Date(date.Year(), month, 1, 0, 0, 0, 0, date.Location())
+	case GranularityYear:
 		rounded = time.Date(date.Year(), 1, 1, 0, 0, 0, 0, date.Location())
+	default:
+		return date
 	}
 
 	if !roundUp {
@@ -31,11 +111,5 @@ func RoundToGranularity(date time.Time, granularity Granularity, roundUp bool,
 		return rounded
 	}
 
-	add := 1
-	if granularity == GranularityMonth {
-		return rounded.AddDate(0, add, 0)
-	} else if granularity == GranularityYear {
-		return rounded.AddDate(add, 0, 0)
-	}
-	return date
+	return AddIntervals(rounded, granularity, 1)
 }
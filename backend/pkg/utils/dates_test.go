@@ -83,5 +83,82 @@ var _ = Describe("Dates Utils", func() {
 				Expect(result).To(Equal(input))
 			})
 		})
+
+		Context("GranularityDay", func() {
+			It("should round to midnight", func() {
+				input := time.Date(2024, 5, 15, 10, 30, 0, 0, time.UTC)
+				Expect(RoundToGranularity(input, GranularityDay, false)).To(Equal(time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)))
+				Expect(RoundToGranularity(input, GranularityDay, true)).To(Equal(time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC)))
+			})
+		})
+
+		Context("GranularityWeek", func() {
+			It("should round to Monday of the ISO week", func() {
+				// 2024-05-15 is Wednesday, 2024-05-19 is Sunday
+				monday := time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC)
+				Expect(RoundToGranularity(time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC), GranularityWeek, false)).To(Equal(monday))
+				Expect(RoundToGranularity(time.Date(2024, 5, 19, 10, 0, 0, 0, time.UTC), GranularityWeek, false)).To(Equal(monday))
+				Expect(RoundToGranularity(time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC), GranularityWeek, true)).
+					To(Equal(time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)))
+			})
+
+			It("should cross month boundaries", func() {
+				// 2024-03-01 is Friday
+				input := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+				Expect(RoundToGranularity(input, GranularityWeek, false)).To(Equal(time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC)))
+			})
+		})
+
+		Context("GranularityQuarter", func() {
+			It("should round to the first day of the quarter", func() {
+				input := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+				Expect(RoundToGranularity(input, GranularityQuarter, false)).To(Equal(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)))
+				Expect(RoundToGranularity(input, GranularityQuarter, true)).To(Equal(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)))
+				Expect(RoundToGranularity(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), GranularityQuarter, true)).
+					To(Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+			})
+		})
+
+		Context("FinancialMonth", func() {
+			g := FinancialMonth(25)
+
+			It("should round to the month start day", func() {
+				Expect(RoundToGranularity(time.Date(2024, 5, 26, 0, 0, 0, 0, time.UTC), g, false)).
+					To(Equal(time.Date(2024, 5, 25, 0, 0, 0, 0, time.UTC)))
+				Expect(RoundToGranularity(time.Date(2024, 5, 24, 0, 0, 0, 0, time.UTC), g, false)).
+					To(Equal(time.Date(2024, 4, 25, 0, 0, 0, 0, time.UTC)))
+				Expect(RoundToGranularity(time.Date(2024, 5, 24, 0, 0, 0, 0, time.UTC), g, true)).
+					To(Equal(time.Date(2024, 5, 25, 0, 0, 0, 0, time.UTC)))
+			})
+
+			It("should handle January", func() {
+				Expect(RoundToGranularity(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), g, false)).
+					To(Equal(time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)))
+			})
+
+			It("should behave as calendar months for day 1", func() {
+				Expect(FinancialMonth(1)).To(Equal(GranularityMonth))
+				Expect(FinancialMonth(31)).To(Equal(GranularityMonth))
+				Expect(g.Base()).To(Equal(GranularityMonth))
+				Expect(g.MonthStartDay()).To(Equal(25))
+			})
+		})
+	})
+
+	Context("ParseGranularity", func() {
+		It("should apply month start day to months only", func() {
+			Expect(ParseGranularity("quarter", 25)).To(Equal(GranularityQuarter))
+			Expect(ParseGranularity("month", 25)).To(Equal(FinancialMonth(25)))
+			Expect(ParseGranularity("", 1)).To(Equal(GranularityMonth))
+		})
+	})
+
+	Context("AddIntervals", func() {
+		It("should move by whole intervals in both directions", func() {
+			date := time.Date(2024, 5, 25, 0, 0, 0, 0, time.UTC)
+			Expect(AddIntervals(date, GranularityWeek, -2)).To(Equal(time.Date(2024, 5, 11, 0, 0, 0, 0, time.UTC)))
+			Expect(AddIntervals(date, FinancialMonth(25), 1)).To(Equal(time.Date(2024, 6, 25, 0, 0, 0, 0, time.UTC)))
+			Expect(AddIntervals(date, GranularityQuarter, -1)).To(Equal(time.Date(2024, 2, 25, 0, 0, 0, 0, time.UTC)))
+		})
 	})
 })
@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+// TransactionExchangeRates returns the effective exchange rate of a transaction with movements in
+// exactly two currencies, e.g. a currency exchange or a card payment abroad. The rate is the amount
+// received in one currency divided by the amount paid in the other one.
+func TransactionExchangeRates(movements []goserver.Movement) []goserver.TransactionRate {
+	sums := map[string]decimal.Decimal{}
+	for _, m := range movements {
+		sums[m.CurrencyId] = sums[m.CurrencyId].Add(m.Amount)
+	}
+	if len(sums) != 2 {
+		return nil
+	}
+
+	var paid, received string
+	for currencyID, sum := range sums {
+		switch {
+		case sum.IsNegative():
+			paid = currencyID
+		case sum.IsPositive():
+			received = currencyID
+		}
+	}
+	if paid == "" || received == "" {
+		return nil
+	}
+
+	return []goserver.TransactionRate{{
+		FromCurrencyId: paid,
+		ToCurrencyId:   received,
+		Rate:           sums[received].Div(sums[paid].Neg()),
+	}}
+}
+
+// ConvertByTransactionRate converts the amount with the rate the transaction was executed at, false
+// is returned if the transaction has no rate between the currencies.
+func ConvertByTransactionRate(
+	rates []goserver.TransactionRate, fromCurrencyID, toCurrencyID string, amount decimal.Decimal,
+) (decimal.Decimal, bool) {
+	for _, r := range rates {
+		if !r.Rate.IsPositive() {
+			continue
+		}
+		switch {
+		case r.FromCurrencyId == fromCurrencyID && r.ToCurrencyId == toCurrencyID:
+			return amount.Mul(r.Rate), true
+		case r.FromCurrencyId == toCurrencyID && r.ToCurrencyId == fromCurrencyID:
+			return amount.Div(r.Rate), true
+		}
+	}
+	return decimal.Zero, false
+}
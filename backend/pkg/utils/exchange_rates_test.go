@@ -0,0 +1,39 @@
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Exchange rates Utils", func() {
+	movement := func(currencyID string, amount int64) goserver.Movement {
+		return goserver.Movement{AccountId: "account", CurrencyId: currencyID, Amount: decimal.NewFromInt(amount)}
+	}
+
+	It("calculates the effective rate of a currency exchange", func() {
+		rates := TransactionExchangeRates([]goserver.Movement{movement("EUR", -100), movement("CZK", 2480)})
+		Expect(rates).To(HaveLen(1))
+		Expect(rates[0].FromCurrencyId).To(Equal("EUR"))
+		Expect(rates[0].ToCurrencyId).To(Equal("CZK"))
+		Expect(rates[0].Rate.String()).To(Equal("24.8"))
+
+		amount, ok := ConvertByTransactionRate(rates, "EUR", "CZK", decimal.NewFromInt(10))
+		Expect(ok).To(BeTrue())
+		Expect(amount.String()).To(Equal("248"))
+		amount, ok = ConvertByTransactionRate(rates, "CZK", "EUR", decimal.NewFromInt(2480))
+		Expect(ok).To(BeTrue())
+		Expect(amount.String()).To(Equal("100"))
+		_, ok = ConvertByTransactionRate(rates, "CZK", "USD", decimal.NewFromInt(2480))
+		Expect(ok).To(BeFalse())
+	})
+
+	It("has no rate for transactions which aren't exchanges of two currencies", func() {
+		Expect(TransactionExchangeRates([]goserver.Movement{movement("CZK", -100), movement("CZK", 100)})).To(BeEmpty())
+		Expect(TransactionExchangeRates([]goserver.Movement{
+			movement("EUR", -100), movement("CZK", 2480), movement("USD", 1),
+		})).To(BeEmpty())
+		Expect(TransactionExchangeRates([]goserver.Movement{movement("EUR", 100), movement("CZK", 2480)})).To(BeEmpty())
+	})
+})
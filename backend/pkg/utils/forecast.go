@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+const (
+	ForecastSourceRecurring = "recurring"
+	ForecastSourceTemplate  = "template"
+	ForecastSourceBudget    = "budget"
+)
+
+// PlannedPayment is a future change of an asset account balance used by the forecast.
+type PlannedPayment struct {
+	goserver.ForecastEvent
+	// ExpenseAccountID is the category the payment goes to, it's already part of its budget
+	ExpenseAccountID string
+}
+
+// RecurringPaymentEvents returns occurrences of recurring payments in [from, to).
+func RecurringPaymentEvents(payments []RecurringPayment, from, to time.Time) []PlannedPayment {
+	res := []PlannedPayment{}
+	for _, p := range payments {
+		description := p.PartnerName
+		if description == "" {
+			description = p.Description
+		}
+		for i := 1; ; i++ {
+			date := AddRepeat(p.LastDate, p.Repeat, i)
+			if !date.Before(to) {
+				break
+			}
+			if date.Before(from) {
+				continue
+			}
+			res = append(res, PlannedPayment{
+				ForecastEvent: goserver.ForecastEvent{
+					Date:        date,
+					AccountId:   p.AccountID,
+					CurrencyId:  p.CurrencyID,
+					Amount:      p.Amount,
+					Source:      ForecastSourceRecurring,
+					Description: description,
+				},
+				ExpenseAccountID: p.ExpenseAccountID,
+			})
+		}
+	}
+
+	return res
+}
+
+// TemplateEvents returns asset movements of scheduled templates in [from, to). Scheduled dates
+// which already passed are skipped.
+func TemplateEvents(
+	templates []goserver.TransactionTemplate, isAsset func(accountID string) bool, from, to time.Time,
+) []PlannedPayment {
+	res := []PlannedPayment{}
+	for _, t := range templates {
+		if t.Repeat == "" || t.NextDate.IsZero() {
+			continue
+		}
+		_, expenseAccountID, _ := getPaymentSides(goserver.Transaction{Movements: t.Movements}, isAsset)
+		for i := 0; ; i++ {
+			date := AddRepeat(t.NextDate, t.Repeat, i)
+			if !date.Before(to) {
+				break
+			}
+			if date.Before(from) {
+				continue
+			}
+			for _, m := range t.Movements {
+				if m.AccountId == "" || !isAsset(m.AccountId) {
+					continue
+				}
+				res = append(res, PlannedPayment{
+					ForecastEvent: goserver.ForecastEvent{
+						Date:        date,
+						AccountId:   m.AccountId,
+						CurrencyId:  m.CurrencyId,
+						Amount:      m.Amount,
+						Source:      ForecastSourceTemplate,
+						Description: t.Name,
+					},
+					ExpenseAccountID: expenseAccountID,
+				})
+			}
+		}
+	}
+
+	return res
+}
+
+// SpreadAmount splits amount evenly into daily payments in [from, to). Rounding difference is
+// added to the last day.
+func SpreadAmount(event goserver.ForecastEvent, from, to time.Time) []goserver.ForecastEvent {
+	days := 0
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		days++
+	}
+	if days == 0 {
+		return nil
+	}
+
+	daily := event.Amount.Div(decimal.NewFromInt(int64(days))).Round(2)
+	res := make([]goserver.ForecastEvent, 0, days)
+	rest := event.Amount
+	for i := 0; i < days; i++ {
+		e := event
+		e.Date = from.AddDate(0, 0, i)
+		e.Amount = daily
+		if i == days-1 {
+			e.Amount = rest
+		}
+		rest = rest.Sub(e.Amount)
+		res = append(res, e)
+	}
+
+	return res
+}
+
+// ProjectBalances applies events to start balances day by day for the given days and returns
+// balances at the end of each day. A warning is returned each time a balance drops below the
+// threshold, including the first day if it starts below it.
+func ProjectBalances(
+	accounts []goserver.AccountForecast, events []goserver.ForecastEvent, days []time.Time,
+	threshold decimal.Decimal,
+) ([]goserver.AccountForecast, []goserver.ForecastWarning) {
+	sorted := append([]goserver.ForecastEvent{}, events...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	warnings := []goserver.ForecastWarning{}
+	res := make([]goserver.AccountForecast, 0, len(accounts))
+	for _, acc := range accounts {
+		acc.Balances = make([]decimal.Decimal, 0, len(days))
+		balance := acc.StartBalance
+		wasBelow := false
+		idx := 0
+		for i, day := range days {
+			end := day.AddDate(0, 0, 1)
+			for ; idx < len(sorted) && sorted[idx].Date.Before(end); idx++ {
+				e := sorted[idx]
+				if e.AccountId == acc.AccountId && e.CurrencyId == acc.CurrencyId {
+					balance = balance.Add(e.Amount)
+				}
+			}
+			acc.Balances = append(acc.Balances, balance)
+
+			if i == 0 || balance.LessThan(acc.MinBalance) {
+				acc.MinBalance = balance
+				acc.MinBalanceDate = day
+			}
+
+			isBelow := balance.LessThan(threshold)
+			if isBelow && !wasBelow {
+				warnings = append(warnings, goserver.ForecastWarning{
+					AccountId:  acc.AccountId,
+					CurrencyId: acc.CurrencyId,
+					Date:       day,
+					Balance:    balance,
+				})
+			}
+			wasBelow = isBelow
+		}
+		res = append(res, acc)
+	}
+
+	return res, warnings
+}
@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Forecast Utils", func() {
+	today := time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)
+	isAsset := func(accountID string) bool {
+		return accountID == "fio" || accountID == "savings"
+	}
+	payment := func(partner string, amount int64, date time.Time) goserver.Transaction {
+		return goserver.Transaction{
+			Date:        date,
+			PartnerName: partner,
+			Movements: []goserver.Movement{
+				{AccountId: "fio", Amount: decimal.NewFromInt(-amount), CurrencyId: "CZK"},
+				{AccountId: "rent", Amount: decimal.NewFromInt(amount), CurrencyId: "CZK"},
+			},
+		}
+	}
+
+	Describe("DetectRecurringPayments", func() {
+		It("should detect monthly payments with similar amounts", func() {
+			payments := DetectRecurringPayments([]goserver.Transaction{
+				payment("Landlord", 10000, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+				payment("Landlord", 10000, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+				payment("Landlord", 10500, time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)),
+				payment("Landlord", 10000, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)),
+				payment("Shop", 300, time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)),
+			}, isAsset, today)
+			Expect(payments).To(HaveLen(1))
+			Expect(payments[0].Repeat).To(Equal(RepeatMonthly))
+			Expect(payments[0].AccountID).To(Equal("fio"))
+			Expect(payments[0].ExpenseAccountID).To(Equal("rent"))
+			Expect(payments[0].Amount.Equal(decimal.NewFromInt(-10000))).To(BeTrue())
+		})
+
+		It("should ignore irregular, too different and finished payments", func() {
+			irregular := []goserver.Transaction{
+				payment("Shop", 100, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+				payment("Shop", 100, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)),
+				payment("Shop", 100, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)),
+			}
+			Expect(DetectRecurringPayments(irregular, isAsset, today)).To(BeEmpty())
+
+			different := []goserver.Transaction{
+				payment("Shop", 100, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+				payment("Shop", 500, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)),
+				payment("Shop", 100, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)),
+			}
+			Expect(DetectRecurringPayments(different, isAsset, today)).To(BeEmpty())
+
+			finished := []goserver.Transaction{
+				payment("Gym", 100, time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)),
+				payment("Gym", 100, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				payment("Gym", 100, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+			}
+			Expect(DetectRecurringPayments(finished, isAsset, today)).To(BeEmpty())
+		})
+
+		It("should ignore transfers between asset accounts", func() {
+			transfer := func(date time.Time) goserver.Transaction {
+				return goserver.Transaction{
+					Date:        date,
+					Description: "Saving",
+					Movements: []goserver.Movement{
+						{AccountId: "fio", Amount: decimal.NewFromInt(-1000), CurrencyId: "CZK"},
+						{AccountId: "savings", Amount: decimal.NewFromInt(1000), CurrencyId: "CZK"},
+					},
+				}
+			}
+			Expect(DetectRecurringPayments([]goserver.Transaction{
+				transfer(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+				transfer(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)),
+				transfer(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)),
+			}, isAsset, today)).To(BeEmpty())
+		})
+	})
+
+	Describe("TemplateEvents", func() {
+		It("should repeat asset movements of scheduled templates", func() {
+			events := TemplateEvents([]goserver.TransactionTemplate{
+				{
+					Name:     "Insurance",
+					Repeat:   RepeatWeekly,
+					NextDate: today.AddDate(0, 0, -3),
+					Movements: []goserver.Movement{
+						{AccountId: "fio", Amount: decimal.NewFromInt(-50), CurrencyId: "CZK"},
+						{AccountId: "insurance", Amount: decimal.NewFromInt(50), CurrencyId: "CZK"},
+					},
+				},
+				{Name: "Not scheduled", Movements: []goserver.Movement{{AccountId: "fio", Amount: decimal.NewFromInt(-1)}}},
+			}, isAsset, today, today.AddDate(0, 0, 14))
+			Expect(events).To(HaveLen(2))
+			Expect(events[0].Date).To(Equal(today.AddDate(0, 0, 4)))
+			Expect(events[0].AccountId).To(Equal("fio"))
+			Expect(events[0].ExpenseAccountID).To(Equal("insurance"))
+			Expect(events[1].Date).To(Equal(today.AddDate(0, 0, 11)))
+		})
+	})
+
+	Describe("SpreadAmount", func() {
+		It("should split the amount by days keeping the total", func() {
+			events := SpreadAmount(goserver.ForecastEvent{Amount: decimal.NewFromInt(-100)}, today, today.AddDate(0, 0, 3))
+			Expect(events).To(HaveLen(3))
+			Expect(events[0].Amount.Equal(decimal.NewFromFloat(-33.33))).To(BeTrue())
+			Expect(events[2].Date).To(Equal(today.AddDate(0, 0, 2)))
+			Expect(events[2].Amount.Equal(decimal.NewFromFloat(-33.34))).To(BeTrue())
+		})
+	})
+
+	Describe("ProjectBalances", func() {
+		It("should apply events and warn when the balance drops below the threshold", func() {
+			days := []time.Time{today, today.AddDate(0, 0, 1), today.AddDate(0, 0, 2), today.AddDate(0, 0, 3)}
+			accounts, warnings := ProjectBalances(
+				[]goserver.AccountForecast{{AccountId: "fio", CurrencyId: "CZK", StartBalance: decimal.NewFromInt(100)}},
+				[]goserver.ForecastEvent{
+					{Date: today.AddDate(0, 0, 3), AccountId: "fio", CurrencyId: "CZK", Amount: decimal.NewFromInt(200)},
+					{Date: today.AddDate(0, 0, 1), AccountId: "fio", CurrencyId: "CZK", Amount: decimal.NewFromInt(-150)},
+					{Date: today.AddDate(0, 0, 1), AccountId: "fio", CurrencyId: "EUR", Amount: decimal.NewFromInt(-1000)},
+				},
+				days, decimal.Zero)
+			Expect(accounts).To(HaveLen(1))
+			Expect(accounts[0].Balances).To(HaveLen(4))
+			Expect(accounts[0].Balances[0].Equal(decimal.NewFromInt(100))).To(BeTrue())
+			Expect(accounts[0].Balances[2].Equal(decimal.NewFromInt(-50))).To(BeTrue())
+			Expect(accounts[0].Balances[3].Equal(decimal.NewFromInt(150))).To(BeTrue())
+			Expect(accounts[0].MinBalance.Equal(decimal.NewFromInt(-50))).To(BeTrue())
+			Expect(accounts[0].MinBalanceDate).To(Equal(today.AddDate(0, 0, 1)))
+
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0].Date).To(Equal(today.AddDate(0, 0, 1)))
+			Expect(warnings[0].Balance.Equal(decimal.NewFromInt(-50))).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+const (
+	GoalStatusAchieved = "achieved"
+	GoalStatusOnTrack  = "onTrack"
+	GoalStatusBehind   = "behind"
+
+	// GoalHistoryMonths is the number of recent months the average contribution is calculated from
+	GoalHistoryMonths = 3
+)
+
+// GoalMonthsLeft returns the number of months from the date till the target date, a started
+// month counts as a whole one. It's zero once the target date is reached.
+func GoalMonthsLeft(date, targetDate time.Time) int {
+	if !date.Before(targetDate) {
+		return 0
+	}
+	months := (targetDate.Year()-date.Year())*12 + int(targetDate.Month()-date.Month())
+	if date.AddDate(0, months, 0).Before(targetDate) {
+		months++
+	}
+	return max(months, 1)
+}
+
+// CalculateGoalProgress returns the progress of the goal at the date from the amount saved till
+// the date and the amount saved GoalHistoryMonths months earlier. The goal is on track while the
+// average recent contribution covers the contribution required to reach the target in time.
+func CalculateGoalProgress(goal goserver.Goal, saved, savedBefore decimal.Decimal, date time.Time) goserver.GoalProgress {
+	res := goserver.GoalProgress{
+		GoalId:          goal.Id,
+		Name:            goal.Name,
+		Priority:        goal.Priority,
+		CurrencyId:      goal.CurrencyId,
+		TargetAmount:    goal.TargetAmount,
+		TargetDate:      goal.TargetDate,
+		CurrentAmount:   saved,
+		RemainingAmount: decimal.Max(goal.TargetAmount.Sub(saved), decimal.Zero),
+		MonthsLeft:      int32(GoalMonthsLeft(date, goal.TargetDate)),
+		AverageMonthlyContribution: saved.Sub(savedBefore).
+			Div(decimal.NewFromInt(GoalHistoryMonths)).Round(2),
+	}
+	if goal.TargetAmount.IsPositive() {
+		res.Progress = saved.Div(goal.TargetAmount).Mul(decimal.NewFromInt(100)).Round(2)
+	}
+	res.RequiredMonthlyContribution = res.RemainingAmount.
+		Div(decimal.NewFromInt(int64(max(res.MonthsLeft, 1)))).Round(2)
+
+	switch {
+	case res.RemainingAmount.IsZero():
+		res.Status = GoalStatusAchieved
+	case res.MonthsLeft > 0 && res.AverageMonthlyContribution.GreaterThanOrEqual(res.RequiredMonthlyContribution):
+		res.Status = GoalStatusOnTrack
+	default:
+		res.Status = GoalStatusBehind
+	}
+	return res
+}
+
+// GoalPeriodContribution returns the part of the remaining amount of a goal which has to be saved
+// in the period [start, end) to reach the target in time. The remaining amount is spread evenly
+// over the days till the target date, the whole of it is due in the period of the target date.
+func GoalPeriodContribution(remaining decimal.Decimal, start, end, targetDate time.Time) decimal.Decimal {
+	if !remaining.IsPositive() {
+		return decimal.Zero
+	}
+	if !end.Before(targetDate) {
+		return remaining
+	}
+	periodDays := decimal.NewFromFloat(end.Sub(start).Hours() / 24)
+	daysLeft := decimal.NewFromFloat(targetDate.Sub(start).Hours() / 24)
+	return remaining.Mul(periodDays).Div(daysLeft).Round(2)
+}
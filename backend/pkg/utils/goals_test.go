@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Goals Utils", func() {
+	date := time.Date(2025, 4, 15, 0, 0, 0, 0, time.UTC)
+	goal := goserver.Goal{
+		Id: "goal", Name: "Vacation", TargetAmount: decimal.NewFromInt(1200),
+		TargetDate: time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	It("counts started months as whole ones", func() {
+		Expect(GoalMonthsLeft(date, goal.TargetDate)).To(Equal(6))
+		Expect(GoalMonthsLeft(date, date.AddDate(0, 2, 0))).To(Equal(2))
+		Expect(GoalMonthsLeft(date, date.AddDate(0, 0, 1))).To(Equal(1))
+		Expect(GoalMonthsLeft(date, date)).To(Equal(0))
+	})
+
+	It("compares recent contributions with the required ones", func() {
+		progress := CalculateGoalProgress(goal, decimal.NewFromInt(600), decimal.NewFromInt(300), date)
+		Expect(progress.Progress.String()).To(Equal("50"))
+		Expect(progress.RemainingAmount.String()).To(Equal("600"))
+		Expect(progress.RequiredMonthlyContribution.String()).To(Equal("100"))
+		Expect(progress.AverageMonthlyContribution.String()).To(Equal("100"))
+		Expect(progress.Status).To(Equal(GoalStatusOnTrack))
+
+		progress = CalculateGoalProgress(goal, decimal.NewFromInt(600), decimal.NewFromInt(400), date)
+		Expect(progress.Status).To(Equal(GoalStatusBehind))
+
+		progress = CalculateGoalProgress(goal, decimal.NewFromInt(1300), decimal.NewFromInt(1300), date)
+		Expect(progress.RemainingAmount.String()).To(Equal("0"))
+		Expect(progress.Status).To(Equal(GoalStatusAchieved))
+
+		progress = CalculateGoalProgress(goal, decimal.NewFromInt(600), decimal.Zero, goal.TargetDate)
+		Expect(progress.MonthsLeft).To(BeZero())
+		Expect(progress.RequiredMonthlyContribution.String()).To(Equal("600"))
+		Expect(progress.Status).To(Equal(GoalStatusBehind))
+	})
+
+	It("spreads the remaining amount over the periods till the target date", func() {
+		start := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+		Expect(GoalPeriodContribution(decimal.NewFromInt(600), start, start.AddDate(0, 0, 10), goal.TargetDate).String()).
+			To(Equal("200"))
+		Expect(GoalPeriodContribution(decimal.NewFromInt(600), start, start.AddDate(0, 1, 0), goal.TargetDate).String()).
+			To(Equal("600"))
+		Expect(GoalPeriodContribution(decimal.NewFromInt(-10), start, start.AddDate(0, 1, 0), goal.TargetDate).String()).
+			To(Equal("0"))
+	})
+})
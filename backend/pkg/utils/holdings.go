@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+// HoldingTrade is a purchase (positive quantity) or a sale (negative quantity) of a security. Amount
+// is the movement amount on the holding account: the cost of a purchase, minus the proceeds of a
+// sale.
+type HoldingTrade struct {
+	Date     time.Time
+	Quantity decimal.Decimal
+	Amount   decimal.Decimal
+}
+
+// FIFOLots returns lots remaining after the trades and the gain realized by sales. Sold units are
+// taken from the oldest lots first; units sold beyond the held quantity have zero cost.
+func FIFOLots(trades []HoldingTrade) ([]goserver.HoldingLot, decimal.Decimal) {
+	sorted := append([]HoldingTrade{}, trades...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	lots := []goserver.HoldingLot{}
+	realized := decimal.Zero
+	for _, t := range sorted {
+		if t.Quantity.IsPositive() {
+			lots = append(lots, goserver.HoldingLot{Date: t.Date, Quantity: t.Quantity, Cost: t.Amount})
+			continue
+		}
+
+		toSell := t.Quantity.Neg()
+		soldCost := decimal.Zero
+		for len(lots) > 0 && toSell.IsPositive() {
+			lot := &lots[0]
+			if lot.Quantity.LessThanOrEqual(toSell) {
+				toSell = toSell.Sub(lot.Quantity)
+				soldCost = soldCost.Add(lot.Cost)
+				lots = lots[1:]
+				continue
+			}
+			cost := lot.Cost.Mul(toSell).Div(lot.Quantity).Round(2)
+			lot.Quantity = lot.Quantity.Sub(toSell)
+			lot.Cost = lot.Cost.Sub(cost)
+			soldCost = soldCost.Add(cost)
+			toSell = decimal.Zero
+		}
+		realized = realized.Add(t.Amount.Neg().Sub(soldCost))
+	}
+	return lots, realized
+}
+
+// PriceOn returns the last price on or before the date from prices sorted by date. Returns false
+// if there is no such price.
+func PriceOn(prices []goserver.SecurityPrice, date time.Time) (goserver.SecurityPrice, bool) {
+	idx := sort.Search(len(prices), func(i int) bool { return prices[i].Date.After(date) })
+	if idx == 0 {
+		return goserver.SecurityPrice{}, false
+	}
+	return prices[idx-1], true
+}
+
+var priceDateLayouts = []string{"2006-01-02", "02.01.2006", time.RFC3339}
+
+// ParseSecurityPricesCSV reads prices from CSV with the date in the first column and the price in
+// the second one. Columns are separated by commas or semicolons, with semicolons the decimal
+// separator may be a comma. A header line is skipped.
+func ParseSecurityPricesCSV(data []byte) ([]goserver.SecurityPrice, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	if firstLine, _, _ := bytes.Cut(data, []byte("\n")); bytes.Contains(firstLine, []byte(";")) {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV: %w", err)
+	}
+
+	res := []goserver.SecurityPrice{}
+	for i, record := range records {
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("line %d: expected date and price", i+1)
+		}
+
+		date, ok := parsePriceDate(strings.TrimSpace(record[0]))
+		if !ok {
+			if i == 0 {
+				// Header
+				continue
+			}
+			return nil, fmt.Errorf("line %d: invalid date %q", i+1, record[0])
+		}
+		value := strings.ReplaceAll(strings.TrimSpace(record[1]), " ", "")
+		if reader.Comma == ';' {
+			value = strings.ReplaceAll(value, ",", ".")
+		}
+		price, err := decimal.NewFromString(value)
+		if err != nil || !price.IsPositive() {
+			return nil, fmt.Errorf("line %d: invalid price %q", i+1, record[1])
+		}
+		res = append(res, goserver.SecurityPrice{Date: date, Price: price})
+	}
+	return res, nil
+}
+
+func parsePriceDate(value string) (time.Time, bool) {
+	for _, layout := range priceDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
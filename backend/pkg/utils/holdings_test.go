@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Holdings Utils", func() {
+	day := func(d int) time.Time { return time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	It("sells the oldest lots first", func() {
+		lots, realized := FIFOLots([]HoldingTrade{
+			{Date: day(20), Quantity: decimal.NewFromInt(-15), Amount: decimal.NewFromInt(-1800)},
+			{Date: day(1), Quantity: decimal.NewFromInt(10), Amount: decimal.NewFromInt(1000)},
+			{Date: day(10), Quantity: decimal.NewFromInt(10), Amount: decimal.NewFromInt(1200)},
+		})
+		Expect(lots).To(HaveLen(1))
+		Expect(lots[0].Date).To(Equal(day(10)))
+		Expect(lots[0].Quantity.String()).To(Equal("5"))
+		Expect(lots[0].Cost.String()).To(Equal("600"))
+		// 1800 - (1000 + 600)
+		Expect(realized.String()).To(Equal("200"))
+	})
+
+	It("returns the last price on or before the date", func() {
+		prices := []goserver.SecurityPrice{
+			{Date: day(1), Price: decimal.NewFromInt(10)},
+			{Date: day(5), Price: decimal.NewFromInt(12)},
+		}
+		_, ok := PriceOn(prices, day(0))
+		Expect(ok).To(BeFalse())
+		price, ok := PriceOn(prices, day(5))
+		Expect(ok).To(BeTrue())
+		Expect(price.Price.String()).To(Equal("12"))
+		price, _ = PriceOn(prices, day(4))
+		Expect(price.Price.String()).To(Equal("10"))
+	})
+
+	It("parses prices from CSV", func() {
+		prices, err := ParseSecurityPricesCSV([]byte("Date,Close\n2025-01-02,101.5\n2025-01-03, 102\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(prices).To(HaveLen(2))
+		Expect(prices[0].Date).To(Equal(day(2)))
+		Expect(prices[1].Price.String()).To(Equal("102"))
+
+		prices, err = ParseSecurityPricesCSV([]byte("02.01.2025;1 234,50\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(prices[0].Price.String()).To(Equal("1234.5"))
+
+		_, err = ParseSecurityPricesCSV([]byte("2025-01-02,abc\n"))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var monthsInYear = decimal.NewFromInt(12)
+
+// HasLoanTerms checks if the loan terms are filled, accounts without a loan have zero terms.
+func HasLoanTerms(terms goserver.LoanTerms) bool {
+	return terms.Principal.IsPositive() && terms.TermMonths > 0
+}
+
+// LoanMonthlyRate converts the yearly rate in percent to the rate of one month as a fraction.
+func LoanMonthlyRate(annualRate decimal.Decimal) decimal.Decimal {
+	return annualRate.Div(decimal.NewFromInt(100)).Div(monthsInYear)
+}
+
+// LoanInterest returns interest of one month on the remaining principal, rounded to cents.
+func LoanInterest(remaining, annualRate decimal.Decimal) decimal.Decimal {
+	return remaining.Mul(LoanMonthlyRate(annualRate)).Round(2)
+}
+
+// LoanMonthlyPayment returns the equal monthly payment repaying the loan with interest in
+// TermMonths payments, rounded to cents.
+func LoanMonthlyPayment(terms goserver.LoanTerms) decimal.Decimal {
+	if !HasLoanTerms(terms) {
+		return decimal.Zero
+	}
+	n := decimal.NewFromInt32(terms.TermMonths)
+	rate := LoanMonthlyRate(terms.AnnualRate)
+	if rate.IsZero() {
+		return terms.Principal.Div(n).Round(2)
+	}
+	// payment = P * r / (1 - (1 + r)^-n)
+	growth := decimal.NewFromInt(1).Add(rate).Pow(n)
+	return terms.Principal.Mul(rate).Mul(growth).Div(growth.Sub(decimal.NewFromInt(1))).Round(2)
+}
+
+// LoanPaymentDate returns the date of the n-th payment, the first one is a month after the
+// start. Payment days after the end of a short month fall on its last day.
+func LoanPaymentDate(terms goserver.LoanTerms, n int) time.Time {
+	day := int(terms.PaymentDay)
+	if day <= 0 {
+		day = terms.StartDate.Day()
+	}
+	month := time.Date(terms.StartDate.Year(), terms.StartDate.Month()+time.Month(n), 1,
+		0, 0, 0, 0, terms.StartDate.Location())
+	lastDay := month.AddDate(0, 1, -1).Day()
+	return month.AddDate(0, 0, min(day, lastDay)-1)
+}
+
+// AmortizationSchedule returns payments of the loan with their split into principal and interest.
+// The last payment repays whatever principal is left after rounding.
+func AmortizationSchedule(terms goserver.LoanTerms) []goserver.AmortizationPayment {
+	res := []goserver.AmortizationPayment{}
+	if !HasLoanTerms(terms) {
+		return res
+	}
+
+	payment := LoanMonthlyPayment(terms)
+	remaining := terms.Principal
+	for n := 1; n <= int(terms.TermMonths) && remaining.IsPositive(); n++ {
+		interest := LoanInterest(remaining, terms.AnnualRate)
+		principal := payment.Sub(interest)
+		if n == int(terms.TermMonths) || principal.GreaterThan(remaining) {
+			principal = remaining
+		}
+		remaining = remaining.Sub(principal)
+		res = append(res, goserver.AmortizationPayment{
+			Number:             int32(n),
+			Date:               LoanPaymentDate(terms, n),
+			Payment:            principal.Add(interest),
+			Principal:          principal,
+			Interest:           interest,
+			RemainingPrincipal: remaining,
+		})
+	}
+	return res
+}
+
+// SplitLoanPayment splits the payment into interest on the remaining principal and the rest which
+// repays the principal. Payments smaller than the interest are all interest.
+func SplitLoanPayment(payment, remaining, annualRate decimal.Decimal) (principal, interest decimal.Decimal) {
+	interest = LoanInterest(remaining, annualRate)
+	if !interest.IsPositive() {
+		return payment, decimal.Zero
+	}
+	if payment.LessThanOrEqual(interest) {
+		return decimal.Zero, payment
+	}
+	return payment.Sub(interest), interest
+}
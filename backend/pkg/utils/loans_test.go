@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Loans Utils", func() {
+	terms := goserver.LoanTerms{
+		Principal:  decimal.NewFromInt(12000),
+		AnnualRate: decimal.NewFromInt(6),
+		TermMonths: 12,
+		StartDate:  time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+		CurrencyId: "usd",
+	}
+
+	It("calculates the monthly payment", func() {
+		Expect(LoanMonthlyPayment(terms).String()).To(Equal("1032.8"))
+
+		noInterest := terms
+		noInterest.AnnualRate = decimal.Zero
+		Expect(LoanMonthlyPayment(noInterest).String()).To(Equal("1000"))
+		Expect(LoanMonthlyPayment(goserver.LoanTerms{}).IsZero()).To(BeTrue())
+	})
+
+	It("builds a schedule repaying the whole principal", func() {
+		schedule := AmortizationSchedule(terms)
+		Expect(schedule).To(HaveLen(12))
+		Expect(schedule[0].Interest.String()).To(Equal("60"))
+		Expect(schedule[0].Principal.String()).To(Equal("972.8"))
+
+		principal := decimal.Zero
+		for _, p := range schedule {
+			principal = principal.Add(p.Principal)
+			Expect(p.Payment.Equal(p.Principal.Add(p.Interest))).To(BeTrue())
+		}
+		Expect(principal.Equal(terms.Principal)).To(BeTrue())
+		Expect(schedule[11].RemainingPrincipal.IsZero()).To(BeTrue())
+	})
+
+	It("moves payment days to the end of short months", func() {
+		Expect(LoanPaymentDate(terms, 1)).To(Equal(time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)))
+		Expect(LoanPaymentDate(terms, 2)).To(Equal(time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)))
+
+		withDay := terms
+		withDay.PaymentDay = 15
+		Expect(LoanPaymentDate(withDay, 1)).To(Equal(time.Date(2025, 2, 15, 0, 0, 0, 0, time.UTC)))
+	})
+
+	It("splits a payment into principal and interest", func() {
+		principal, interest := SplitLoanPayment(decimal.NewFromInt(1000), decimal.NewFromInt(12000), terms.AnnualRate)
+		Expect(principal.String()).To(Equal("940"))
+		Expect(interest.String()).To(Equal("60"))
+
+		principal, interest = SplitLoanPayment(decimal.NewFromInt(50), decimal.NewFromInt(12000), terms.AnnualRate)
+		Expect(principal.IsZero()).To(BeTrue())
+		Expect(interest.String()).To(Equal("50"))
+	})
+})
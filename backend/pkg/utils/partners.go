@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NormalizePartnerName lowercases the name and keeps only letters and digits separated by single
+// spaces, so "ALBERT, s.r.o." and "Albert s r o" are the same partner.
+func NormalizePartnerName(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// NormalizePartnerAccount drops spaces from the account number and uppercases it, so IBANs
+// written in groups match.
+func NormalizePartnerAccount(account string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(account), ""))
+}
+
+// PartnerKey identifies the partner of a transaction by its partner name, then by the partner
+// account and then by the place. Returns an empty key if the transaction has none of them.
+func PartnerKey(partnerName, partnerAccount, place string) string {
+	if key := NormalizePartnerName(partnerName); key != "" {
+		return "name:" + key
+	}
+	if key := NormalizePartnerAccount(partnerAccount); key != "" {
+		return "account:" + key
+	}
+	if key := NormalizePartnerName(place); key != "" {
+		return "place:" + key
+	}
+	return ""
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Partners Utils", func() {
+	It("normalizes partner names", func() {
+		Expect(NormalizePartnerName("ALBERT, s.r.o.")).To(Equal("albert s r o"))
+		Expect(NormalizePartnerName("  Albert   s r o ")).To(Equal("albert s r o"))
+		Expect(NormalizePartnerName(" - ")).To(BeEmpty())
+	})
+
+	It("normalizes partner accounts", func() {
+		Expect(NormalizePartnerAccount("cz65 0800 0000 1920")).To(Equal("CZ65080000001920"))
+	})
+
+	It("prefers name over account over place", func() {
+		Expect(PartnerKey("Albert", "123/0800", "Praha")).To(Equal("name:albert"))
+		Expect(PartnerKey("", "123/0800", "Praha")).To(Equal("account:123/0800"))
+		Expect(PartnerKey("", "", "LIDL Praha")).To(Equal("place:lidl praha"))
+		Expect(PartnerKey("", " ", "")).To(BeEmpty())
+	})
+})
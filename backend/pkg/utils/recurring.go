@@ -0,0 +1,200 @@
+package utils
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+const (
+	RepeatWeekly  = "weekly"
+	RepeatMonthly = "monthly"
+	RepeatYearly  = "yearly"
+
+	// RecurringMinOccurrences is the number of payments needed to consider them recurring
+	RecurringMinOccurrences = 3
+
+	// recurringAmountTolerance is the maximum relative difference of a payment from the typical one
+	recurringAmountTolerance = 0.25
+)
+
+// RecurringPayment is a regular payment detected in transactions history, e.g. rent or salary.
+type RecurringPayment struct {
+	Description string
+	PartnerName string
+	// AccountID and CurrencyID of the asset side of the payment
+	AccountID  string
+	CurrencyID string
+	// ExpenseAccountID is the main non-asset account of the payment, e.g. the expense category
+	ExpenseAccountID string
+	// Amount is the typical change of the asset account, negative for outgoing payments
+	Amount   decimal.Decimal
+	Repeat   string
+	LastDate time.Time
+}
+
+// AddRepeat moves date by n periods of repeat (weekly, monthly or yearly).
+func AddRepeat(date time.Time, repeat string, n int) time.Time {
+	switch repeat {
+	case RepeatWeekly:
+		return date.AddDate(0, 0, 7*n)
+	case RepeatYearly:
+		return date.AddDate(n, 0, 0)
+	default:
+		return date.AddDate(0, n, 0)
+	}
+}
+
+// getRepeat returns the period of payments if all gaps between them fit it.
+func getRepeat(dates []time.Time) (string, bool) {
+	ranges := []struct {
+		repeat   string
+		min, max int
+	}{
+		{RepeatWeekly, 5, 9},
+		{RepeatMonthly, 26, 35},
+		{RepeatYearly, 355, 376},
+	}
+
+	for _, r := range ranges {
+		fits := true
+		for i := 1; i < len(dates); i++ {
+			days := int(dates[i].Sub(dates[i-1]).Hours() / 24)
+			if days < r.min || days > r.max {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			return r.repeat, true
+		}
+	}
+
+	return "", false
+}
+
+// getPaymentSides returns the only asset account of the transaction and its main non-asset
+// account. Transfers between asset accounts are not payments.
+func getPaymentSides(t goserver.Transaction, isAsset func(accountID string) bool) (string, string, bool) {
+	assetAccountID := ""
+	expenseAccountID := ""
+	expenseAmount := decimal.Zero
+	for _, m := range t.Movements {
+		if m.AccountId != "" && isAsset(m.AccountId) {
+			if assetAccountID != "" && assetAccountID != m.AccountId {
+				return "", "", false
+			}
+			assetAccountID = m.AccountId
+			continue
+		}
+		if m.Amount.Abs().GreaterThan(expenseAmount) {
+			expenseAmount = m.Amount.Abs()
+			expenseAccountID = m.AccountId
+		}
+	}
+
+	return assetAccountID, expenseAccountID, assetAccountID != ""
+}
+
+// DetectRecurringPayments finds payments from/to asset accounts which repeat weekly, monthly
+// or yearly with the same partner (or description) and similar amounts. Payments which were
+// missed for more than one period are considered finished.
+func DetectRecurringPayments(
+	transactions []goserver.Transaction, isAsset func(accountID string) bool, today time.Time,
+) []RecurringPayment {
+	groups := make(map[string][]goserver.Transaction)
+	keys := []string{}
+	for _, t := range transactions {
+		name := strings.ToLower(strings.TrimSpace(t.PartnerName))
+		if name == "" {
+			name = strings.ToLower(strings.TrimSpace(t.Description))
+		}
+		if name == "" {
+			continue
+		}
+		accountID, _, ok := getPaymentSides(t, isAsset)
+		if !ok {
+			continue
+		}
+		amount, currencyID, ok := getAssetFlow(t.Movements, isAsset)
+		if !ok || amount.IsZero() {
+			continue
+		}
+
+		key := strings.Join([]string{name, accountID, currencyID, strconv.Itoa(amount.Sign())}, "|")
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], t)
+	}
+
+	res := []RecurringPayment{}
+	for _, key := range keys {
+		group := groups[key]
+		if len(group) < RecurringMinOccurrences {
+			continue
+		}
+		sort.SliceStable(group, func(i, j int) bool { return group[i].Date.Before(group[j].Date) })
+
+		dates := make([]time.Time, 0, len(group))
+		amounts := make([]decimal.Decimal, 0, len(group))
+		for _, t := range group {
+			dates = append(dates, t.Date)
+			amount, _, _ := getAssetFlow(t.Movements, isAsset)
+			amounts = append(amounts, amount)
+		}
+
+		repeat, ok := getRepeat(dates)
+		if !ok {
+			continue
+		}
+
+		typical := median(amounts)
+		tolerance := typical.Abs().Mul(decimal.NewFromFloat(recurringAmountTolerance))
+		similar := true
+		for _, a := range amounts {
+			if a.Sub(typical).Abs().GreaterThan(tolerance) {
+				similar = false
+				break
+			}
+		}
+		if !similar {
+			continue
+		}
+
+		last := group[len(group)-1]
+		if AddRepeat(last.Date, repeat, 2).Before(today) {
+			continue
+		}
+
+		accountID, expenseAccountID, _ := getPaymentSides(last, isAsset)
+		_, currencyID, _ := getAssetFlow(last.Movements, isAsset)
+		res = append(res, RecurringPayment{
+			Description:      last.Description,
+			PartnerName:      last.PartnerName,
+			AccountID:        accountID,
+			CurrencyID:       currencyID,
+			ExpenseAccountID: expenseAccountID,
+			Amount:           typical,
+			Repeat:           repeat,
+			LastDate:         last.Date,
+		})
+	}
+
+	return res
+}
+
+func median(values []decimal.Decimal) decimal.Decimal {
+	sorted := append([]decimal.Decimal{}, values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LessThan(sorted[j]) })
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[middle]
+	}
+	return sorted[middle-1].Add(sorted[middle]).Div(decimal.NewFromInt(2))
+}
@@ -0,0 +1,203 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+const (
+	RefundKindRefund        = "refund"
+	RefundKindReimbursement = "reimbursement"
+
+	// RefundWindow is the maximum distance between the original expense and its refund
+	RefundWindow = 90 * 24 * time.Hour
+	// RefundCandidatesLimit is the maximum number of suggested original transactions
+	RefundCandidatesLimit = 10
+
+	refundPartnerWeight = 0.5
+	refundAmountWeight  = 0.3
+	refundDateWeight    = 0.2
+)
+
+func IsValidRefundKind(kind string) bool {
+	return kind == RefundKindRefund || kind == RefundKindReimbursement
+}
+
+// getAssetFlow returns the net amount which moved to (positive) or from (negative) asset
+// accounts of the transaction. Returns false if there are no asset movements or they use
+// several currencies.
+func getAssetFlow(movements []goserver.Movement, isAsset func(accountID string) bool) (decimal.Decimal, string, bool) {
+	total := decimal.Zero
+	currencyID := ""
+	for _, m := range movements {
+		if m.AccountId == "" || !isAsset(m.AccountId) {
+			continue
+		}
+		if currencyID != "" && currencyID != m.CurrencyId {
+			return decimal.Zero, "", false
+		}
+		currencyID = m.CurrencyId
+		total = total.Add(m.Amount)
+	}
+
+	return total, currencyID, currencyID != ""
+}
+
+func isSamePartner(a, b goserver.Transaction) bool {
+	if a.PartnerAccount != "" && a.PartnerAccount == b.PartnerAccount {
+		return true
+	}
+
+	nameA := strings.ToLower(strings.TrimSpace(a.PartnerName))
+	nameB := strings.ToLower(strings.TrimSpace(b.PartnerName))
+	if nameA == "" || nameB == "" {
+		return false
+	}
+
+	return strings.Contains(nameA, nameB) || strings.Contains(nameB, nameA)
+}
+
+// ScoreRefundCandidate checks if original could be the expense refunded by refund and scores
+// the match by partner, amount and date proximity. Money must come back to asset accounts in
+// the same currency it was spent, not more than was spent and within RefundWindow after the
+// original. Candidates without the same partner must match the amount exactly.
+func ScoreRefundCandidate(
+	refund, original goserver.Transaction, isAsset func(accountID string) bool,
+) (goserver.RefundCandidate, bool) {
+	if refund.Id == original.Id || original.RefundOfId != "" {
+		return goserver.RefundCandidate{}, false
+	}
+
+	returned, refundCurrencyID, ok := getAssetFlow(refund.Movements, isAsset)
+	if !ok || !returned.IsPositive() {
+		return goserver.RefundCandidate{}, false
+	}
+	spent, originalCurrencyID, ok := getAssetFlow(original.Movements, isAsset)
+	if !ok || !spent.IsNegative() || originalCurrencyID != refundCurrencyID {
+		return goserver.RefundCandidate{}, false
+	}
+	spent = spent.Neg()
+	if returned.GreaterThan(spent) {
+		return goserver.RefundCandidate{}, false
+	}
+
+	delta := refund.Date.Sub(original.Date)
+	if delta < 0 || delta > RefundWindow {
+		return goserver.RefundCandidate{}, false
+	}
+
+	partnerMatch := isSamePartner(refund, original)
+	amountMatch := returned.Equal(spent)
+	if !partnerMatch && !amountMatch {
+		return goserver.RefundCandidate{}, false
+	}
+
+	score := decimal.NewFromFloat(refundAmountWeight).Mul(returned.Div(spent))
+	if partnerMatch {
+		score = score.Add(decimal.NewFromFloat(refundPartnerWeight))
+	}
+	proximity := 1 - float64(delta)/float64(RefundWindow)
+	score = score.Add(decimal.NewFromFloat(refundDateWeight * proximity))
+
+	return goserver.RefundCandidate{
+		Transaction:  original,
+		Score:        score.Round(2),
+		PartnerMatch: partnerMatch,
+		AmountMatch:  amountMatch,
+	}, true
+}
+
+// FindRefundCandidates returns transactions which could be refunded by refund, best first,
+// at most RefundCandidatesLimit.
+func FindRefundCandidates(
+	refund goserver.Transaction, transactions []goserver.Transaction, isAsset func(accountID string) bool,
+) []goserver.RefundCandidate {
+	res := make([]goserver.RefundCandidate, 0)
+	for _, t := range transactions {
+		if c, ok := ScoreRefundCandidate(refund, t, isAsset); ok {
+			res = append(res, c)
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if !res[i].Score.Equal(res[j].Score) {
+			return res[i].Score.GreaterThan(res[j].Score)
+		}
+		return res[i].Transaction.Date.After(res[j].Transaction.Date)
+	})
+	if len(res) > RefundCandidatesLimit {
+		res = res[:RefundCandidatesLimit]
+	}
+
+	return res
+}
+
+// NetRefundMovements returns movements of refund where everything which balances the asset
+// movements (income, unknown account etc.) is moved to the expense accounts of original,
+// proportionally to the amounts spent there. This way the refund reduces the original
+// expense category instead of appearing as a separate income. Movements are returned
+// unchanged if original has no expense movements.
+func NetRefundMovements(
+	refund, original goserver.Transaction, isAsset func(accountID string) bool,
+) []goserver.Movement {
+	targets := make([]goserver.Movement, 0)
+	targetsTotal := decimal.Zero
+	for _, m := range original.Movements {
+		if m.AccountId == "" || isAsset(m.AccountId) || !m.Amount.IsPositive() {
+			continue
+		}
+		targets = append(targets, m)
+		targetsTotal = targetsTotal.Add(m.Amount)
+	}
+	if len(targets) == 0 {
+		return refund.Movements
+	}
+
+	res := make([]goserver.Movement, 0, len(refund.Movements)+len(targets))
+	for _, m := range refund.Movements {
+		if m.AccountId != "" && isAsset(m.AccountId) {
+			res = append(res, m)
+			continue
+		}
+
+		rest := m.Amount
+		for i, target := range targets {
+			amount := rest
+			if i < len(targets)-1 {
+				amount = m.Amount.Mul(target.Amount).Div(targetsTotal).Round(2)
+				rest = rest.Sub(amount)
+			}
+			res = append(res, goserver.Movement{
+				AccountId:   target.AccountId,
+				Amount:      amount,
+				CurrencyId:  m.CurrencyId,
+				Description: m.Description,
+			})
+		}
+	}
+
+	return res
+}
+
+// NetRefunds returns transactions where linked refunds are netted against the expense
+// accounts of their originals, see NetRefundMovements. originals must contain the linked
+// original transactions by ID; refunds of unknown originals are returned unchanged.
+func NetRefunds(
+	transactions []goserver.Transaction, originals map[string]goserver.Transaction, isAsset func(accountID string) bool,
+) []goserver.Transaction {
+	res := make([]goserver.Transaction, 0, len(transactions))
+	for _, t := range transactions {
+		if t.RefundOfId != "" {
+			if original, ok := originals[t.RefundOfId]; ok {
+				t.Movements = NetRefundMovements(t, original, isAsset)
+			}
+		}
+		res = append(res, t)
+	}
+
+	return res
+}
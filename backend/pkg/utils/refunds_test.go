@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Refunds Utils", func() {
+	day := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	isAsset := func(accountID string) bool {
+		return accountID == "fio"
+	}
+
+	expense := func(id, partner string, amount float64, date time.Time) goserver.Transaction {
+		return goserver.Transaction{
+			Id:          id,
+			Date:        date,
+			PartnerName: partner,
+			Movements: []goserver.Movement{
+				{AccountId: "fio", Amount: decimal.NewFromFloat(-amount), CurrencyId: "CZK"},
+				{AccountId: "shopping", Amount: decimal.NewFromFloat(amount), CurrencyId: "CZK"},
+			},
+		}
+	}
+	incoming := func(id, partner string, amount float64, date time.Time) goserver.Transaction {
+		return goserver.Transaction{
+			Id:          id,
+			Date:        date,
+			PartnerName: partner,
+			Movements: []goserver.Movement{
+				{Amount: decimal.NewFromFloat(-amount), CurrencyId: "CZK"},
+				{AccountId: "fio", Amount: decimal.NewFromFloat(amount), CurrencyId: "CZK"},
+			},
+		}
+	}
+
+	Describe("ScoreRefundCandidate", func() {
+		It("should match same partner and amount with the highest score", func() {
+			c, ok := ScoreRefundCandidate(
+				incoming("r", "ALZA.CZ a.s.", 500, day), expense("o", "Alza.cz", 500, day), isAsset)
+			Expect(ok).To(BeTrue())
+			Expect(c.PartnerMatch).To(BeTrue())
+			Expect(c.AmountMatch).To(BeTrue())
+			Expect(c.Score.Equal(decimal.NewFromInt(1))).To(BeTrue())
+		})
+
+		It("should match partial refunds from the same partner", func() {
+			c, ok := ScoreRefundCandidate(
+				incoming("r", "Alza", 100, day), expense("o", "Alza", 500, day.AddDate(0, 0, -45)), isAsset)
+			Expect(ok).To(BeTrue())
+			Expect(c.AmountMatch).To(BeFalse())
+			Expect(c.Score.Equal(decimal.NewFromFloat(0.66))).To(BeTrue())
+		})
+
+		It("should match reimbursements from another partner only by exact amount", func() {
+			_, ok := ScoreRefundCandidate(
+				incoming("r", "Employer", 500, day), expense("o", "Hotel", 500, day.AddDate(0, 0, -5)), isAsset)
+			Expect(ok).To(BeTrue())
+
+			_, ok = ScoreRefundCandidate(
+				incoming("r", "Employer", 400, day), expense("o", "Hotel", 500, day.AddDate(0, 0, -5)), isAsset)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should reject refunds larger than the original, before it or too late", func() {
+			_, ok := ScoreRefundCandidate(incoming("r", "Alza", 600, day), expense("o", "Alza", 500, day), isAsset)
+			Expect(ok).To(BeFalse())
+			_, ok = ScoreRefundCandidate(incoming("r", "Alza", 500, day), expense("o", "Alza", 500, day.AddDate(0, 0, 1)), isAsset)
+			Expect(ok).To(BeFalse())
+			_, ok = ScoreRefundCandidate(incoming("r", "Alza", 500, day), expense("o", "Alza", 500, day.AddDate(0, 0, -91)), isAsset)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should reject originals which are refunds themselves", func() {
+			original := expense("o", "Alza", 500, day)
+			original.RefundOfId = "other"
+			_, ok := ScoreRefundCandidate(incoming("r", "Alza", 500, day), original, isAsset)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("FindRefundCandidates", func() {
+		It("should sort candidates by score", func() {
+			refund := incoming("r", "Alza", 500, day)
+			candidates := FindRefundCandidates(refund, []goserver.Transaction{
+				expense("other-partner", "Hotel", 500, day.AddDate(0, 0, -1)),
+				expense("same-partner", "Alza", 500, day.AddDate(0, 0, -20)),
+				refund,
+			}, isAsset)
+			Expect(candidates).To(HaveLen(2))
+			Expect(candidates[0].Transaction.Id).To(Equal("same-partner"))
+			Expect(candidates[1].Transaction.Id).To(Equal("other-partner"))
+		})
+	})
+
+	Describe("NetRefundMovements", func() {
+		It("should move the refund to the expense accounts of the original proportionally", func() {
+			original := goserver.Transaction{
+				Movements: []goserver.Movement{
+					{AccountId: "fio", Amount: decimal.NewFromInt(-300), CurrencyId: "CZK"},
+					{AccountId: "shopping", Amount: decimal.NewFromInt(200), CurrencyId: "CZK"},
+					{AccountId: "food", Amount: decimal.NewFromInt(100), CurrencyId: "CZK"},
+				},
+			}
+			movements := NetRefundMovements(incoming("r", "Alza", 100, day), original, isAsset)
+			Expect(movements).To(HaveLen(3))
+			Expect(movements[0].AccountId).To(Equal("shopping"))
+			Expect(movements[0].Amount.Equal(decimal.NewFromFloat(-66.67))).To(BeTrue())
+			Expect(movements[1].AccountId).To(Equal("food"))
+			Expect(movements[1].Amount.Equal(decimal.NewFromFloat(-33.33))).To(BeTrue())
+			Expect(movements[2].AccountId).To(Equal("fio"))
+			Expect(movements[2].Amount.Equal(decimal.NewFromInt(100))).To(BeTrue())
+		})
+
+		It("should keep movements if the original has no expense accounts", func() {
+			refund := incoming("r", "Alza", 100, day)
+			movements := NetRefundMovements(refund, incoming("o", "Alza", -100, day), isAsset)
+			Expect(movements).To(Equal(refund.Movements))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+)
+
+// TagSeparator separates a sub-tag from its parent, e.g. "travel/2026-italy".
+const TagSeparator = "/"
+
+// NormalizeTag trims spaces around levels of the tag and drops empty levels, so " car / fuel/"
+// becomes "car/fuel".
+func NormalizeTag(tag string) string {
+	levels := []string{}
+	for _, level := range strings.Split(tag, TagSeparator) {
+		if level = strings.TrimSpace(level); level != "" {
+			levels = append(levels, level)
+		}
+	}
+	return strings.Join(levels, TagSeparator)
+}
+
+// TagParent returns the parent of the tag or an empty string for top-level tags.
+func TagParent(tag string) string {
+	idx := strings.LastIndex(tag, TagSeparator)
+	if idx == -1 {
+		return ""
+	}
+	return tag[:idx]
+}
+
+// TagDepth returns the number of levels of the tag, 1 for top-level tags.
+func TagDepth(tag string) int {
+	return strings.Count(tag, TagSeparator) + 1
+}
+
+// TagWithAncestors returns the tag and all its parents starting with the top-level one.
+func TagWithAncestors(tag string) []string {
+	res := []string{}
+	for parent := tag; parent != ""; parent = TagParent(parent) {
+		res = append(res, parent)
+	}
+	slices.Reverse(res)
+	return res
+}
+
+// IsTagOrSubTag checks if the tag is the root tag or one of its sub-tags on any level.
+func IsTagOrSubTag(tag, root string) bool {
+	return tag == root || strings.HasPrefix(tag, root+TagSeparator)
+}
+
+// CompareTags orders tags by levels, so sub-tags directly follow their parent.
+func CompareTags(a, b string) int {
+	return slices.Compare(TagWithAncestors(a), TagWithAncestors(b))
+}
+
+// RenameTag replaces the tag from and its sub-tags with to, keeping names of sub-tags. Tags which
+// become equal are kept once. Returns false if nothing was renamed.
+func RenameTag(tags []string, from, to string) ([]string, bool) {
+	res := make([]string, 0, len(tags))
+	renamed := false
+	for _, tag := range tags {
+		if IsTagOrSubTag(tag, from) {
+			tag = to + strings.TrimPrefix(tag, from)
+			renamed = true
+		}
+		if !slices.Contains(res, tag) {
+			res = append(res, tag)
+		}
+	}
+	return res, renamed
+}
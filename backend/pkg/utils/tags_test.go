@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tags Utils", func() {
+	It("normalizes levels of a tag", func() {
+		Expect(NormalizeTag(" car / fuel/")).To(Equal("car/fuel"))
+		Expect(NormalizeTag("//")).To(BeEmpty())
+		Expect(NormalizeTag("travel")).To(Equal("travel"))
+	})
+
+	It("returns parents of a tag", func() {
+		Expect(TagParent("travel/2026/italy")).To(Equal("travel/2026"))
+		Expect(TagParent("travel")).To(BeEmpty())
+		Expect(TagWithAncestors("travel/2026/italy")).To(Equal([]string{"travel", "travel/2026", "travel/2026/italy"}))
+		Expect(TagDepth("travel/2026/italy")).To(Equal(3))
+	})
+
+	It("matches sub-tags only on level boundaries", func() {
+		Expect(IsTagOrSubTag("car/fuel", "car")).To(BeTrue())
+		Expect(IsTagOrSubTag("car", "car")).To(BeTrue())
+		Expect(IsTagOrSubTag("carsharing", "car")).To(BeFalse())
+	})
+
+	It("orders sub-tags directly after their parent", func() {
+		tags := []string{"car-wash", "car/fuel", "car", "car/fuel/diesel", "boat"}
+		slices.SortFunc(tags, CompareTags)
+		Expect(tags).To(Equal([]string{"boat", "car", "car/fuel", "car/fuel/diesel", "car-wash"}))
+	})
+
+	It("renames a tag with its sub-tags and merges duplicates", func() {
+		tags, ok := RenameTag([]string{"car/gas", "car/gas/diesel", "car/fuel", "cargo"}, "car/gas", "car/fuel")
+		Expect(ok).To(BeTrue())
+		Expect(tags).To(Equal([]string{"car/fuel", "car/fuel/diesel", "cargo"}))
+
+		_, ok = RenameTag([]string{"cargo"}, "car", "auto")
+		Expect(ok).To(BeFalse())
+	})
+})
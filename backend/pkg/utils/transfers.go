@@ -0,0 +1,189 @@
+package utils
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+const (
+	// TransferDateWindow is the maximum distance between the two halves of a transfer.
+	// Banks may book an incoming transfer a few days after the outgoing one.
+	TransferDateWindow = 3 * 24 * time.Hour
+	// TransferAmountTolerance is the absolute tolerance for same-currency transfers.
+	TransferAmountTolerance = 0.01
+	// TransferFXTolerance is the relative tolerance for cross-currency transfers, covering
+	// the spread between the reference rate and the rate actually applied by the bank.
+	TransferFXTolerance = 0.03
+)
+
+// TransferConverter converts amount from one currency to another on the given day.
+// Currencies are passed as currency IDs.
+type TransferConverter func(day time.Time, fromCurrencyID, toCurrencyID string, amount decimal.Decimal) (decimal.Decimal, error)
+
+// TransferPair is an outgoing and an incoming one-sided transaction which are likely
+// two halves of one transfer between own accounts.
+type TransferPair struct {
+	Outgoing goserver.Transaction
+	Incoming goserver.Transaction
+	// OutgoingLeg is the transfer leg of the source account (negative amount), see GetOneSidedLeg
+	OutgoingLeg goserver.Movement
+	// IncomingLeg is the transfer leg of the destination account (positive amount)
+	IncomingLeg goserver.Movement
+}
+
+// GetOneSidedLeg returns the transfer leg of a one-sided transaction, i.e. a transaction created
+// by an importer with exactly one movement without account. The returned movement has the
+// account of the largest movement balancing the unknown one (other movements are e.g. bank
+// fees) and the amount and currency of the transfer as seen from that account (the negated
+// movement without account). Returns false for any other transaction.
+func GetOneSidedLeg(movements []goserver.Movement) (goserver.Movement, bool) {
+	var unknown *goserver.Movement
+	for i := range movements {
+		if movements[i].AccountId == "" {
+			if unknown != nil {
+				return goserver.Movement{}, false
+			}
+			unknown = &movements[i]
+		}
+	}
+	if unknown == nil || unknown.Amount.IsZero() {
+		return goserver.Movement{}, false
+	}
+
+	var known *goserver.Movement
+	for i := range movements {
+		m := &movements[i]
+		if m.AccountId == "" || m.Amount.Sign() == unknown.Amount.Sign() {
+			continue
+		}
+		if known == nil || m.Amount.Abs().GreaterThan(known.Amount.Abs()) {
+			known = m
+		}
+	}
+	if known == nil {
+		return goserver.Movement{}, false
+	}
+
+	return goserver.Movement{
+		AccountId:  known.AccountId,
+		Amount:     unknown.Amount.Neg(),
+		CurrencyId: unknown.CurrencyId,
+	}, true
+}
+
+// IsTransferPair checks if outgoing and incoming transactions look like two halves of one
+// transfer: both are one-sided, on different accounts accepted by isAsset, in opposite
+// directions, within TransferDateWindow and with the same (or FX-plausible) amount.
+// convert may be nil, then only same-currency pairs are considered.
+func IsTransferPair(
+	outgoing, incoming goserver.Transaction, isAsset func(accountID string) bool, convert TransferConverter,
+) (TransferPair, bool) {
+	outLeg, ok := GetOneSidedLeg(outgoing.Movements)
+	if !ok || !outLeg.Amount.IsNegative() || !isAsset(outLeg.AccountId) {
+		return TransferPair{}, false
+	}
+	inLeg, ok := GetOneSidedLeg(incoming.Movements)
+	if !ok || !inLeg.Amount.IsPositive() || !isAsset(inLeg.AccountId) {
+		return TransferPair{}, false
+	}
+	if outLeg.AccountId == inLeg.AccountId {
+		return TransferPair{}, false
+	}
+
+	delta := incoming.Date.Sub(outgoing.Date)
+	if delta < 0 {
+		delta = -delta
+	}
+	if delta > TransferDateWindow {
+		return TransferPair{}, false
+	}
+
+	if !isTransferAmountPlausible(outgoing.Date, outLeg, inLeg, convert) {
+		return TransferPair{}, false
+	}
+
+	return TransferPair{
+		Outgoing:    outgoing,
+		Incoming:    incoming,
+		OutgoingLeg: outLeg,
+		IncomingLeg: inLeg,
+	}, true
+}
+
+func isTransferAmountPlausible(day time.Time, outLeg, inLeg goserver.Movement, convert TransferConverter) bool {
+	sent := outLeg.Amount.Neg()
+	if outLeg.CurrencyId == inLeg.CurrencyId {
+		return sent.Sub(inLeg.Amount).Abs().LessThan(decimal.NewFromFloat(TransferAmountTolerance))
+	}
+
+	if convert == nil {
+		return false
+	}
+	expected, err := convert(day, outLeg.CurrencyId, inLeg.CurrencyId, sent)
+	if err != nil || !expected.IsPositive() {
+		return false
+	}
+
+	diff := expected.Sub(inLeg.Amount).Abs().Div(expected)
+	return diff.LessThanOrEqual(decimal.NewFromFloat(TransferFXTolerance))
+}
+
+// FindTransferPairs returns transfer pairs found in transactions. Each transaction is used
+// in at most one pair; when several incoming transactions match, the closest by date wins.
+// Pairs whose accounts are accepted by preferred (if not nil) are matched first.
+func FindTransferPairs(
+	transactions []goserver.Transaction,
+	isAsset func(accountID string) bool,
+	preferred func(fromAccountID, toAccountID string) bool,
+	convert TransferConverter,
+) []TransferPair {
+	candidates := make([]TransferPair, 0)
+	for _, out := range transactions {
+		for _, in := range transactions {
+			if out.Id == in.Id {
+				continue
+			}
+			if pair, ok := IsTransferPair(out, in, isAsset, convert); ok {
+				candidates = append(candidates, pair)
+			}
+		}
+	}
+
+	isPreferred := func(p TransferPair) bool {
+		return preferred != nil && preferred(p.OutgoingLeg.AccountId, p.IncomingLeg.AccountId)
+	}
+	distance := func(p TransferPair) time.Duration {
+		d := p.Incoming.Date.Sub(p.Outgoing.Date)
+		if d < 0 {
+			return -d
+		}
+		return d
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		pi, pj := isPreferred(candidates[i]), isPreferred(candidates[j])
+		if pi != pj {
+			return pi
+		}
+		return distance(candidates[i]) < distance(candidates[j])
+	})
+
+	used := make(map[string]bool)
+	res := make([]TransferPair, 0)
+	for _, p := range candidates {
+		if used[p.Outgoing.Id] || used[p.Incoming.Id] {
+			continue
+		}
+		used[p.Outgoing.Id] = true
+		used[p.Incoming.Id] = true
+		res = append(res, p)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Outgoing.Date.Before(res[j].Outgoing.Date)
+	})
+
+	return res
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+	"github.com/ya-breeze/geekbudgetbe/pkg/generated/goserver"
+)
+
+var _ = Describe("Transfers Utils", func() {
+	day := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	isAsset := func(accountID string) bool {
+		return accountID == "fio" || accountID == "revolut"
+	}
+
+	oneSided := func(id, accountID, currencyID string, amount float64, date time.Time) goserver.Transaction {
+		return goserver.Transaction{
+			Id:   id,
+			Date: date,
+			Movements: []goserver.Movement{
+				{Amount: decimal.NewFromFloat(-amount), CurrencyId: currencyID},
+				{AccountId: accountID, Amount: decimal.NewFromFloat(amount), CurrencyId: currencyID},
+			},
+		}
+	}
+
+	Describe("GetOneSidedLeg", func() {
+		It("should return the movement on the known account", func() {
+			t := oneSided("t1", "fio", "CZK", -100, day)
+			leg, ok := GetOneSidedLeg(t.Movements)
+			Expect(ok).To(BeTrue())
+			Expect(leg.AccountId).To(Equal("fio"))
+			Expect(leg.Amount.Equal(decimal.NewFromInt(-100))).To(BeTrue())
+		})
+
+		It("should allow fee movements and use the amount of the unknown movement", func() {
+			// Revolut: incoming 100 EUR, 1 EUR fee goes to the fee account
+			movements := []goserver.Movement{
+				{Amount: decimal.NewFromInt(-100), CurrencyId: "EUR"},
+				{AccountId: "revolut", Amount: decimal.NewFromInt(99), CurrencyId: "EUR"},
+				{AccountId: "fees", Amount: decimal.NewFromInt(1), CurrencyId: "EUR"},
+			}
+			leg, ok := GetOneSidedLeg(movements)
+			Expect(ok).To(BeTrue())
+			Expect(leg.AccountId).To(Equal("revolut"))
+			Expect(leg.Amount.Equal(decimal.NewFromInt(100))).To(BeTrue())
+		})
+
+		It("should reject fully processed transactions", func() {
+			movements := []goserver.Movement{
+				{AccountId: "fio", Amount: decimal.NewFromInt(-100), CurrencyId: "CZK"},
+				{AccountId: "food", Amount: decimal.NewFromInt(100), CurrencyId: "CZK"},
+			}
+			_, ok := GetOneSidedLeg(movements)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("IsTransferPair", func() {
+		It("should match same amount in opposite directions between asset accounts", func() {
+			out := oneSided("out", "fio", "CZK", -100, day)
+			in := oneSided("in", "revolut", "CZK", 100, day.AddDate(0, 0, 2))
+			pair, ok := IsTransferPair(out, in, isAsset, nil)
+			Expect(ok).To(BeTrue())
+			Expect(pair.OutgoingLeg.AccountId).To(Equal("fio"))
+			Expect(pair.IncomingLeg.AccountId).To(Equal("revolut"))
+		})
+
+		It("should not match outside of the date window", func() {
+			out := oneSided("out", "fio", "CZK", -100, day)
+			in := oneSided("in", "revolut", "CZK", 100, day.AddDate(0, 0, 4))
+			_, ok := IsTransferPair(out, in, isAsset, nil)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should not match same account or non-asset accounts", func() {
+			out := oneSided("out", "fio", "CZK", -100, day)
+			Expect(func() bool {
+				_, ok := IsTransferPair(out, oneSided("in", "fio", "CZK", 100, day), isAsset, nil)
+				return ok
+			}()).To(BeFalse())
+			Expect(func() bool {
+				_, ok := IsTransferPair(out, oneSided("in", "card", "CZK", 100, day), isAsset, nil)
+				return ok
+			}()).To(BeFalse())
+		})
+
+		It("should not match same directions", func() {
+			out := oneSided("out", "fio", "CZK", -100, day)
+			in := oneSided("in", "revolut", "CZK", -100, day)
+			_, ok := IsTransferPair(out, in, isAsset, nil)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should match FX-plausible amounts using converter", func() {
+			convert := func(_ time.Time, from, to string, amount decimal.Decimal) (decimal.Decimal, error) {
+				if from == "CZK" && to == "EUR" {
+					return amount.Div(decimal.NewFromInt(25)), nil
+				}
+				return decimal.Zero, errors.New("unknown rate")
+			}
+			out := oneSided("out", "fio", "CZK", -2500, day)
+
+			_, ok := IsTransferPair(out, oneSided("in", "revolut", "EUR", 99, day), isAsset, convert)
+			Expect(ok).To(BeTrue())
+
+			_, ok = IsTransferPair(out, oneSided("in", "revolut", "EUR", 90, day), isAsset, convert)
+			Expect(ok).To(BeFalse())
+
+			_, ok = IsTransferPair(out, oneSided("in", "revolut", "EUR", 100, day), isAsset, nil)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("FindTransferPairs", func() {
+		It("should use each transaction only once and prefer the closest date", func() {
+			transactions := []goserver.Transaction{
+				oneSided("out", "fio", "CZK", -100, day),
+				oneSided("in-far", "revolut", "CZK", 100, day.AddDate(0, 0, 3)),
+				oneSided("in-near", "revolut", "CZK", 100, day.AddDate(0, 0, 1)),
+			}
+			pairs := FindTransferPairs(transactions, isAsset, nil, nil)
+			Expect(pairs).To(HaveLen(1))
+			Expect(pairs[0].Outgoing.Id).To(Equal("out"))
+			Expect(pairs[0].Incoming.Id).To(Equal("in-near"))
+		})
+
+		It("should prefer pairs between preferred accounts", func() {
+			isAsset := func(string) bool { return true }
+			transactions := []goserver.Transaction{
+				oneSided("out", "fio", "CZK", -100, day),
+				oneSided("in-kb", "kb", "CZK", 100, day),
+				oneSided("in-revolut", "revolut", "CZK", 100, day.AddDate(0, 0, 2)),
+			}
+			preferred := func(from, to string) bool { return from == "fio" && to == "revolut" }
+			pairs := FindTransferPairs(transactions, isAsset, preferred, nil)
+			Expect(pairs).To(HaveLen(1))
+			Expect(pairs[0].Incoming.Id).To(Equal("in-revolut"))
+		})
+	})
+})
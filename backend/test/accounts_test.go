@@ -41,7 +41,9 @@ var _ = Describe("Accounts API", func() {
 		if err = storage.Open(); err != nil {
 			panic(err)
 		}
-		addr, finishChan, err = server.Serve(ctx, logger, storage, cfg, forcedImportChan)
+		addr, finishChan, err = server.Serve(
+			ctx, logger, storage, common.NewRateService(logger, storage, 0), cfg, forcedImportChan,
+		)
 		Expect(err).ToNot(HaveOccurred())
 
 		clientCfg := goclient.NewConfiguration()
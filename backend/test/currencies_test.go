@@ -44,7 +44,9 @@ var _ = Describe("Currencies API", func() {
 		if err = storage.Open(); err != nil {
 			panic(err)
 		}
-		addr, finishCham, err = server.Serve(ctx, logger, storage, cfg, forcedImportChan)
+		addr, finishCham, err = server.Serve(
+			ctx, logger, storage, common.NewRateService(logger, storage, 0), cfg, forcedImportChan,
+		)
 		Expect(err).ToNot(HaveOccurred())
 
 		clientCfg := goclient.NewConfiguration()
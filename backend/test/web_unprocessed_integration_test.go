@@ -48,7 +48,9 @@ var _ = Describe("Web unprocessed convert integration", func() {
 		Expect(storage.Open()).To(Succeed())
 
 		var err error
-		addr, finishCham, err = server.Serve(ctx, logger, storage, cfg, forcedImportChan)
+		addr, finishCham, err = server.Serve(
+			ctx, logger, storage, common.NewRateService(logger, storage, 0), cfg, forcedImportChan,
+		)
 		Expect(err).ToNot(HaveOccurred())
 
 		clientCfg := goclient.NewConfiguration()
@@ -21,6 +21,7 @@
                     <select class="form-select" aria-label="Account type" name="type">
                         <option value="expense" {{ if eq .Type "expense"}}selected{{ end }}>Expense</option>
                         <option value="asset" {{ if eq .Type "asset"}}selected{{ end }}>Asset</option>
+                        <option value="liability" {{ if eq .Type "liability"}}selected{{ end }}>Liability</option>
                         <option value="income" {{ if eq .Type "income"}}selected{{ end }}>Income</option>
                     </select>
                 </div>